@@ -5,6 +5,7 @@ package authors
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -51,3 +52,53 @@ func TestAuthors(t *testing.T) {
 	}
 	t.Log(fetchedAuthor)
 }
+
+func TestCreateAuthors(t *testing.T) {
+	ctx := context.Background()
+	uri := local.MySQL(t, []string{"schema.sql"})
+	sdb, err := sql.Open("mysql", uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sdb.Close()
+
+	db := New(sdb)
+
+	// Values with special characters, which are sent as query arguments rather
+	// than spliced into the INSERT, and NULL
+	params := []CreateAuthorsParams{
+		{Name: "tab\there", Bio: sql.NullString{String: "line\nbreak", Valid: true}},
+		{Name: `back\slash 'quote'`, Bio: sql.NullString{String: "\\N", Valid: true}},
+		{Name: "null bio"},
+	}
+	// More rows than fit into a single INSERT
+	for i := 0; i < 2500; i++ {
+		params = append(params, CreateAuthorsParams{Name: fmt.Sprintf("author %04d", i)})
+	}
+
+	n, err := db.CreateAuthors(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(params)) {
+		t.Fatalf("inserted %d rows, want %d", n, len(params))
+	}
+
+	authors, err := db.ListAuthors(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := map[string]Author{}
+	for _, a := range authors {
+		byName[a.Name] = a
+	}
+	for _, p := range params[:3] {
+		a, ok := byName[p.Name]
+		if !ok {
+			t.Fatalf("author %q wasn't inserted", p.Name)
+		}
+		if a.Bio != p.Bio {
+			t.Errorf("author %q has bio %#v, want %#v", p.Name, a.Bio, p.Bio)
+		}
+	}
+}
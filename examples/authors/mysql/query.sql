@@ -16,3 +16,10 @@ INSERT INTO authors (
 /* name: DeleteAuthor :exec */
 DELETE FROM authors
 WHERE id = ?;
+
+/* name: CreateAuthors :copyfrom */
+INSERT INTO authors (
+  name, bio
+) VALUES (
+  ?, ?
+);
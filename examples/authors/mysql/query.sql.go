@@ -27,6 +27,19 @@ func (q *Queries) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (sql
 	return q.db.ExecContext(ctx, createAuthor, arg.Name, arg.Bio)
 }
 
+const createAuthors = `-- name: CreateAuthors :copyfrom
+INSERT INTO authors (
+  name, bio
+) VALUES (
+  ?, ?
+)
+`
+
+type CreateAuthorsParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
 const deleteAuthor = `-- name: DeleteAuthor :exec
 DELETE FROM authors
 WHERE id = ?
@@ -6,6 +6,7 @@ package batch
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -19,15 +20,22 @@ const (
 )
 
 func (e *BookType) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = BookType(s)
+		s = string(v)
 	case string:
-		*e = BookType(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for BookType: %T", src)
 	}
-	return nil
+	switch BookType(s) {
+	case BookTypeFICTION,
+		BookTypeNONFICTION:
+		*e = BookType(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for BookType: %q", s)
 }
 
 type NullBookType struct {
@@ -35,14 +43,22 @@ type NullBookType struct {
 	Valid    bool     `json:"valid"` // Valid is true if BookType is not NULL
 }
 
+// NewNullBookType returns a valid NullBookType holding e.
+func NewNullBookType(e BookType) NullBookType {
+	return NullBookType{BookType: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullBookType) Scan(value interface{}) error {
 	if value == nil {
 		ns.BookType, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.BookType.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.BookType.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -53,6 +69,31 @@ func (ns NullBookType) Value() (driver.Value, error) {
 	return string(ns.BookType), nil
 }
 
+// MarshalJSON encodes ns as null if it isn't valid.
+func (ns NullBookType) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ns.BookType)
+}
+
+// UnmarshalJSON decodes null as a NullBookType that isn't valid.
+func (ns *NullBookType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		ns.BookType, ns.Valid = "", false
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if err := ns.BookType.Scan(s); err != nil {
+		return err
+	}
+	ns.Valid = true
+	return nil
+}
+
 type Author struct {
 	AuthorID  int32  `json:"author_id"`
 	Name      string `json:"name"`
@@ -18,15 +18,22 @@ const (
 )
 
 func (e *BooksBookType) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = BooksBookType(s)
+		s = string(v)
 	case string:
-		*e = BooksBookType(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for BooksBookType: %T", src)
 	}
-	return nil
+	switch BooksBookType(s) {
+	case BooksBookTypeFICTION,
+		BooksBookTypeNONFICTION:
+		*e = BooksBookType(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for BooksBookType: %q", s)
 }
 
 type NullBooksBookType struct {
@@ -34,14 +41,22 @@ type NullBooksBookType struct {
 	Valid         bool // Valid is true if BooksBookType is not NULL
 }
 
+// NewNullBooksBookType returns a valid NullBooksBookType holding e.
+func NewNullBooksBookType(e BooksBookType) NullBooksBookType {
+	return NullBooksBookType{BooksBookType: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullBooksBookType) Scan(value interface{}) error {
 	if value == nil {
 		ns.BooksBookType, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.BooksBookType.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.BooksBookType.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -19,15 +19,22 @@ const (
 )
 
 func (e *BookType) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = BookType(s)
+		s = string(v)
 	case string:
-		*e = BookType(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for BookType: %T", src)
 	}
-	return nil
+	switch BookType(s) {
+	case BookTypeFICTION,
+		BookTypeNONFICTION:
+		*e = BookType(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for BookType: %q", s)
 }
 
 type NullBookType struct {
@@ -35,14 +42,22 @@ type NullBookType struct {
 	Valid    bool // Valid is true if BookType is not NULL
 }
 
+// NewNullBookType returns a valid NullBookType holding e.
+func NewNullBookType(e BookType) NullBookType {
+	return NullBookType{BookType: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullBookType) Scan(value interface{}) error {
 	if value == nil {
 		ns.BookType, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.BookType.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.BookType.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
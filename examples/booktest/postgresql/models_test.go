@@ -0,0 +1,44 @@
+package booktest
+
+import (
+	"testing"
+)
+
+func TestBookTypeScan(t *testing.T) {
+	var b BookType
+	if err := b.Scan([]byte("FICTION")); err != nil {
+		t.Fatal(err)
+	}
+	if b != BookTypeFICTION {
+		t.Errorf("expected %q, got %q", BookTypeFICTION, b)
+	}
+	if err := b.Scan("POETRY"); err == nil || err.Error() != `invalid value for BookType: "POETRY"` {
+		t.Errorf("expected an error for an unknown value, got %v", err)
+	}
+	if b != BookTypeFICTION {
+		t.Errorf("an unknown value was stored: %q", b)
+	}
+}
+
+func TestNullBookTypeScan(t *testing.T) {
+	var nb NullBookType
+	if err := nb.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if nb.Valid {
+		t.Error("expected NULL to be scanned as invalid")
+	}
+	if err := nb.Scan("NONFICTION"); err != nil {
+		t.Fatal(err)
+	}
+	if nb != NewNullBookType(BookTypeNONFICTION) {
+		t.Errorf("unexpected value: %+v", nb)
+	}
+	nb = NullBookType{}
+	if err := nb.Scan([]byte("POETRY")); err == nil {
+		t.Error("expected an error for an unknown value")
+	}
+	if nb.Valid {
+		t.Error("an unknown value was scanned as valid")
+	}
+}
@@ -7,6 +7,7 @@ package ondeck
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -19,15 +20,22 @@ const (
 )
 
 func (e *VenueStatus) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = VenueStatus(s)
+		s = string(v)
 	case string:
-		*e = VenueStatus(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for VenueStatus: %T", src)
 	}
-	return nil
+	switch VenueStatus(s) {
+	case VenueStatusOpen,
+		VenueStatusClosed:
+		*e = VenueStatus(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for VenueStatus: %q", s)
 }
 
 type NullVenueStatus struct {
@@ -35,14 +43,22 @@ type NullVenueStatus struct {
 	Valid       bool        `json:"valid"` // Valid is true if VenueStatus is not NULL
 }
 
+// NewNullVenueStatus returns a valid NullVenueStatus holding e.
+func NewNullVenueStatus(e VenueStatus) NullVenueStatus {
+	return NullVenueStatus{VenueStatus: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullVenueStatus) Scan(value interface{}) error {
 	if value == nil {
 		ns.VenueStatus, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.VenueStatus.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.VenueStatus.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -53,6 +69,31 @@ func (ns NullVenueStatus) Value() (driver.Value, error) {
 	return string(ns.VenueStatus), nil
 }
 
+// MarshalJSON encodes ns as null if it isn't valid.
+func (ns NullVenueStatus) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ns.VenueStatus)
+}
+
+// UnmarshalJSON decodes null as a NullVenueStatus that isn't valid.
+func (ns *NullVenueStatus) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		ns.VenueStatus, ns.Valid = "", false
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if err := ns.VenueStatus.Scan(s); err != nil {
+		return err
+	}
+	ns.Valid = true
+	return nil
+}
+
 type City struct {
 	Slug string `json:"slug"`
 	Name string `json:"name"`
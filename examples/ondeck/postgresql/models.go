@@ -7,6 +7,7 @@ package ondeck
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -20,15 +21,22 @@ const (
 )
 
 func (e *Status) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = Status(s)
+		s = string(v)
 	case string:
-		*e = Status(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for Status: %T", src)
 	}
-	return nil
+	switch Status(s) {
+	case StatusOpen,
+		StatusClosed:
+		*e = Status(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for Status: %q", s)
 }
 
 type NullStatus struct {
@@ -36,14 +44,22 @@ type NullStatus struct {
 	Valid  bool   `json:"valid"` // Valid is true if Status is not NULL
 }
 
+// NewNullStatus returns a valid NullStatus holding e.
+func NewNullStatus(e Status) NullStatus {
+	return NullStatus{Status: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullStatus) Scan(value interface{}) error {
 	if value == nil {
 		ns.Status, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.Status.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.Status.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -54,6 +70,31 @@ func (ns NullStatus) Value() (driver.Value, error) {
 	return string(ns.Status), nil
 }
 
+// MarshalJSON encodes ns as null if it isn't valid.
+func (ns NullStatus) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ns.Status)
+}
+
+// UnmarshalJSON decodes null as a NullStatus that isn't valid.
+func (ns *NullStatus) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		ns.Status, ns.Valid = "", false
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if err := ns.Status.Scan(s); err != nil {
+		return err
+	}
+	ns.Valid = true
+	return nil
+}
+
 type City struct {
 	Slug string `json:"slug"`
 	Name string `json:"name"`
@@ -0,0 +1,35 @@
+package ondeck
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullStatusJSON(t *testing.T) {
+	for _, tc := range []struct {
+		status NullStatus
+		json   string
+	}{
+		{NullStatus{}, `null`},
+		{NewNullStatus(StatusOpen), `"op!en"`},
+	} {
+		blob, err := json.Marshal(tc.status)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(blob) != tc.json {
+			t.Errorf("expected %s, got %s", tc.json, blob)
+		}
+		var got NullStatus
+		if err := json.Unmarshal(blob, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.status {
+			t.Errorf("expected %+v, got %+v", tc.status, got)
+		}
+	}
+	var s NullStatus
+	if err := json.Unmarshal([]byte(`"unknown"`), &s); err == nil {
+		t.Error("expected an error for an unknown value")
+	}
+}
@@ -9,7 +9,8 @@ import (
 // The cache directory defaults to os.UserCacheDir(). This location can be
 // overridden by the SQLCCACHE environment variable.
 //
-// Currently the cache stores two types of data: plugins and query analysis
+// Currently the cache stores four types of data: plugins, query analysis, the
+// inputs of generated packages and fetched schemas
 func Dir() (string, error) {
 	cache := os.Getenv("SQLCCACHE")
 	if cache != "" {
@@ -45,3 +46,27 @@ func AnalysisDir() (string, error) {
 	}
 	return dir, nil
 }
+
+func GenerateDir() (string, error) {
+	cacheRoot, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(cacheRoot, "generate")
+	if err := os.MkdirAll(dir, 0755); err != nil && !os.IsExist(err) {
+		return "", fmt.Errorf("failed to create %s directory: %w", dir, err)
+	}
+	return dir, nil
+}
+
+func SchemasDir() (string, error) {
+	cacheRoot, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(cacheRoot, "schemas")
+	if err := os.MkdirAll(dir, 0755); err != nil && !os.IsExist(err) {
+		return "", fmt.Errorf("failed to create %s directory: %w", dir, err)
+	}
+	return dir, nil
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/info"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// GenerateCache records the inputs and outputs of generated packages, so that
+// packages whose inputs haven't changed since they were last generated can be
+// skipped.
+//
+// A package is keyed by a hash of its configuration, the contents of its
+// schema and query files, the sqlc version and the plugin generating it. An
+// entry is only used if the files it generated are still on disk, unchanged.
+type GenerateCache struct {
+	dir string
+
+	m           sync.Mutex
+	pending     map[string]cacheEntry
+	upToDate    int
+	regenerated int
+}
+
+type cacheEntry struct {
+	// Files maps the generated files to the SHA-256 of their contents
+	Files map[string]string `json:"files"`
+}
+
+// NewGenerateCache returns a cache stored in dir.
+func NewGenerateCache(dir string) *GenerateCache {
+	return &GenerateCache{
+		dir:     dir,
+		pending: map[string]cacheEntry{},
+	}
+}
+
+// fresh reports whether all of the packages are up to date. Packages sharing
+// an output directory are only skipped together, as they may write the same
+// files.
+func (c *GenerateCache) fresh(conf *config.Config, pairs []OutputPair) bool {
+	for _, pair := range pairs {
+		key, ok := cacheKey(conf, pair)
+		if !ok {
+			return false
+		}
+		blob, err := os.ReadFile(filepath.Join(c.dir, key+".json"))
+		if err != nil {
+			return false
+		}
+		var entry cacheEntry
+		if err := json.Unmarshal(blob, &entry); err != nil {
+			return false
+		}
+		for filename, sum := range entry.Files {
+			contents, err := os.ReadFile(filename)
+			if err != nil || hashString(string(contents)) != sum {
+				return false
+			}
+		}
+	}
+	c.m.Lock()
+	c.upToDate += len(pairs)
+	c.m.Unlock()
+	return true
+}
+
+// record adds a generated package to the cache. It isn't stored until Save is
+// called, once the files have been written.
+func (c *GenerateCache) record(conf *config.Config, pair OutputPair, files map[string]string) {
+	entry := cacheEntry{Files: map[string]string{}}
+	for filename, source := range files {
+		entry.Files[filename] = hashString(source)
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.regenerated++
+	if key, ok := cacheKey(conf, pair); ok {
+		c.pending[key] = entry
+	}
+}
+
+// Save stores the packages generated since the cache was created.
+func (c *GenerateCache) Save() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for key, entry := range c.pending {
+		blob, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(c.dir, key+".json"), blob, 0644); err != nil {
+			return err
+		}
+	}
+	c.pending = map[string]cacheEntry{}
+	return nil
+}
+
+// Summary describes how many packages were skipped and how many regenerated.
+func (c *GenerateCache) Summary() string {
+	c.m.Lock()
+	defer c.m.Unlock()
+	noun := "packages"
+	if c.upToDate == 1 {
+		noun = "package"
+	}
+	return fmt.Sprintf("%d %s up to date, %d regenerated", c.upToDate, noun, c.regenerated)
+}
+
+// UpToDate returns the number of packages that were skipped.
+func (c *GenerateCache) UpToDate() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.upToDate
+}
+
+// cacheKey returns the key of a package, whose schema and query paths have
+// been joined with the directory of the configuration file. Packages that
+// can't be cached, such as those analyzed against a live database, return
+// false.
+func cacheKey(conf *config.Config, pair OutputPair) (string, bool) {
+	if pair.Database != nil && pair.Database.URI != "" && !pair.Database.Managed {
+		return "", false
+	}
+	h := sha256.New()
+	write := func(v any) bool {
+		blob, err := json.Marshal(v)
+		if err != nil {
+			return false
+		}
+		h.Write(blob)
+		h.Write([]byte{0})
+		return true
+	}
+	if !write(info.Version) || !write(pair) {
+		return "", false
+	}
+
+	switch {
+	case pair.Plugin != nil:
+		plug, err := findPlugin(*conf, pair.Plugin.Plugin)
+		if err != nil {
+			return "", false
+		}
+		if !write(plug) || !write(conf.Options[plug.Name]) {
+			return "", false
+		}
+		// The executable of a process plugin may change without any change
+		// to the configuration
+		if plug.Process != nil {
+			path, err := exec.LookPath(plug.Process.Cmd)
+			if err != nil || !hashFile(h, path) {
+				return "", false
+			}
+		}
+	case pair.Gen.Go != nil:
+		if !write(conf.Overrides.Go) {
+			return "", false
+		}
+	}
+
+	for _, paths := range []config.Paths{pair.Schema, pair.Queries} {
+		files, err := sqlpath.Glob(paths)
+		if err != nil {
+			return "", false
+		}
+		for _, file := range files {
+			if !write(file) || !hashFile(h, file) {
+				return "", false
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+func hashFile(w io.Writer, path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err == nil
+}
+
+func hashString(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
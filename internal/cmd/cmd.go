@@ -17,6 +17,7 @@ import (
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v3"
 
+	"github.com/sqlc-dev/sqlc/internal/cache"
 	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/debug"
 	"github.com/sqlc-dev/sqlc/internal/info"
@@ -30,20 +31,40 @@ func init() {
 	initCmd.Flags().BoolP("v1", "", false, "generate v1 config yaml file")
 	initCmd.Flags().BoolP("v2", "", true, "generate v2 config yaml file")
 	initCmd.MarkFlagsMutuallyExclusive("v1", "v2")
+	genCmd.Flags().Bool("watch", false, "regenerate code when the configuration, schema or query files change")
+	genCmd.Flags().Int("jobs", 0, "number of packages to generate concurrently (default: GOMAXPROCS)")
+	genCmd.Flags().Bool("no-cache", false, "regenerate all packages, even if their inputs haven't changed")
+	genCmd.Flags().Bool("check", false, "list the generated files that are out of date and exit with status 3 instead of writing them")
+	genCmd.Flags().Bool("dry-run", false, "print the files that would be written, with their sizes and whether they change, instead of writing them")
+	addQueryFilterFlags(genCmd)
+	addFormatFlag(genCmd)
+	addFormatFlag(checkCmd)
+	diffCmd.Flags().String("format", "text", "output format, either text or json")
+	diffCmd.Flags().Bool("include-patch", false, "include the unified diff of changed files in json output")
+	fmtCmd.Flags().Bool("check", false, "list unformatted files and exit non-zero instead of rewriting them")
+	introspectCmd.Flags().String("engine", "postgresql", "database engine, either postgresql or mysql")
+	introspectCmd.Flags().String("uri", "", "connection URI of the database")
+	introspectCmd.Flags().StringSlice("schema", nil, "schema to introspect, may be repeated (default: public for postgresql, the database in the URI for mysql)")
+	introspectCmd.Flags().String("out", ".", "directory to write schema.sql to")
 }
 
 // Do runs the command logic.
 func Do(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
 	rootCmd := &cobra.Command{Use: "sqlc", SilenceUsage: true}
 	rootCmd.PersistentFlags().StringP("file", "f", "", "specify an alternate config file (default: sqlc.yaml)")
-	rootCmd.PersistentFlags().Bool("no-remote", false, "disable remote execution (default: false)")
+	rootCmd.PersistentFlags().Bool("no-remote", false, "disable remote execution and fetching remote schemas (default: false)")
 	rootCmd.PersistentFlags().Bool("remote", false, "enable remote execution (default: false)")
+	rootCmd.PersistentFlags().Bool("strict", false, "treat warnings as errors (default: false)")
 
 	rootCmd.AddCommand(checkCmd)
 	rootCmd.AddCommand(createDBCmd)
+	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(diffCmd)
+	rootCmd.AddCommand(fmtCmd)
 	rootCmd.AddCommand(genCmd)
 	rootCmd.AddCommand(initCmd)
+	rootCmd.AddCommand(introspectCmd)
+	rootCmd.AddCommand(lintConfigCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(verifyCmd)
 	rootCmd.AddCommand(pushCmd)
@@ -140,17 +161,20 @@ type Env struct {
 	Debug    opts.Debug
 	Remote   bool
 	NoRemote bool
+	Strict   bool
 }
 
 func ParseEnv(c *cobra.Command) Env {
 	dr := c.Flag("dry-run")
 	r := c.Flag("remote")
 	nr := c.Flag("no-remote")
+	s := c.Flag("strict")
 	return Env{
 		DryRun:   dr != nil && dr.Changed,
 		Debug:    opts.DebugFromEnv(),
 		Remote:   r != nil && r.Value.String() == "true",
 		NoRemote: nr != nil && nr.Value.String() == "true",
+		Strict:   s != nil && s.Value.String() == "true",
 	}
 }
 
@@ -195,25 +219,141 @@ var genCmd = &cobra.Command{
 		defer trace.StartRegion(cmd.Context(), "generate").End()
 		stderr := cmd.ErrOrStderr()
 		dir, name := getConfigPath(stderr, cmd.Flag("file"))
-		output, err := Generate(cmd.Context(), dir, name, &Options{
+		jobs, _ := cmd.Flags().GetInt("jobs")
+		opts := &Options{
 			Env:    ParseEnv(cmd),
 			Stderr: stderr,
-		})
+			Stdout: cmd.OutOrStdout(),
+			Jobs:   jobs,
+		}
+		if err := setFormat(cmd, opts); err != nil {
+			fmt.Fprintln(stderr, err)
+			os.Exit(1)
+		}
+		check, _ := cmd.Flags().GetBool("check")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if check && dryRun {
+			fmt.Fprintln(stderr, "--dry-run can't be used with --check")
+			os.Exit(1)
+		}
+		if err := setQueryFilter(cmd, opts); err != nil {
+			fmt.Fprintln(stderr, err)
+			os.Exit(1)
+		}
+		if watch, _ := cmd.Flags().GetBool("watch"); watch {
+			if opts.parserOpts().Filtered() {
+				fmt.Fprintln(stderr, "--query and --query-file can't be used with --watch")
+				os.Exit(1)
+			}
+			if check {
+				fmt.Fprintln(stderr, "--check can't be used with --watch")
+				os.Exit(1)
+			}
+			if dryRun {
+				fmt.Fprintln(stderr, "--dry-run can't be used with --watch")
+				os.Exit(1)
+			}
+			if err := Watch(cmd.Context(), dir, name, opts, cmd.OutOrStdout()); err != nil {
+				os.Exit(1)
+			}
+			return nil
+		}
+		if check {
+			if err := CheckGenerated(cmd.Context(), dir, name, opts); err != nil {
+				if errors.Is(err, ErrStaleOutput) {
+					os.Exit(3)
+				}
+				os.Exit(1)
+			}
+			return nil
+		}
+		// A dry run generates every package, and doesn't update the cache
+		if noCache, _ := cmd.Flags().GetBool("no-cache"); !noCache && !dryRun && !opts.parserOpts().Filtered() {
+			if cacheDir, err := cache.GenerateDir(); err == nil {
+				opts.Cache = NewGenerateCache(cacheDir)
+			}
+		}
+		output, err := Generate(cmd.Context(), dir, name, opts)
 		if err != nil {
 			os.Exit(1)
 		}
 		defer trace.StartRegion(cmd.Context(), "writefiles").End()
-		for filename, source := range output {
-			os.MkdirAll(filepath.Dir(filename), 0755)
-			if err := os.WriteFile(filename, []byte(source), 0644); err != nil {
-				fmt.Fprintf(stderr, "%s: %s\n", filename, err)
+		if dryRun {
+			sink := &recordingSink{dir: dir}
+			if err := writeOutput(stderr, sink, output); err != nil {
 				return err
 			}
+			return writeDryRun(cmd.OutOrStdout(), opts.Format, sink)
+		}
+		if err := writeOutput(stderr, diskSink{}, output); err != nil {
+			return err
+		}
+		if opts.Cache != nil {
+			if err := opts.Cache.Save(); err != nil {
+				fmt.Fprintf(stderr, "error writing cache: %s\n", err)
+			}
+			if opts.Cache.UpToDate() > 0 {
+				fmt.Fprintln(stderr, opts.Cache.Summary())
+			}
 		}
 		return nil
 	},
 }
 
+// addQueryFilterFlags registers the flags that restrict a command to some of
+// the queries. The flag for query files isn't named --file, as that's the
+// persistent flag for the configuration file.
+func addQueryFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("query-file", nil, "only compile the queries in this file, may be repeated")
+	cmd.Flags().StringSlice("query", nil, "only compile the query with this name, may be repeated")
+}
+
+// addFormatFlag registers the flag that selects the format errors and
+// warnings are reported in.
+func addFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().String("format", FormatText, "format of errors and warnings, either text on stderr or json on stdout")
+}
+
+func setFormat(cmd *cobra.Command, o *Options) error {
+	format, _ := cmd.Flags().GetString("format")
+	switch format {
+	case FormatText, FormatJSON:
+		o.Format = format
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+func setQueryFilter(cmd *cobra.Command, o *Options) error {
+	files, _ := cmd.Flags().GetStringSlice("query-file")
+	for _, file := range files {
+		abs, err := filepath.Abs(file)
+		if err != nil {
+			return fmt.Errorf("query file %s: %w", file, err)
+		}
+		o.QueryFiles = append(o.QueryFiles, abs)
+	}
+	o.QueryNames, _ = cmd.Flags().GetStringSlice("query")
+	return nil
+}
+
+// writeOutput writes the generated files to sink, after removing the files
+// that are no longer generated.
+func writeOutput(stderr io.Writer, sink outputSink, output map[string]string) error {
+	if err := removeStaleFiles(sink, output); err != nil {
+		fmt.Fprintf(stderr, "error removing stale files: %s\n", err)
+		return err
+	}
+	for filename, source := range output {
+		if err := sink.WriteFile(filename, []byte(source)); err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", filename, err)
+			return err
+		}
+	}
+	return nil
+}
+
 var checkCmd = &cobra.Command{
 	Use:   "compile",
 	Short: "Statically check SQL for syntax and type errors",
@@ -221,10 +361,16 @@ var checkCmd = &cobra.Command{
 		defer trace.StartRegion(cmd.Context(), "compile").End()
 		stderr := cmd.ErrOrStderr()
 		dir, name := getConfigPath(stderr, cmd.Flag("file"))
-		_, err := Generate(cmd.Context(), dir, name, &Options{
+		opts := &Options{
 			Env:    ParseEnv(cmd),
 			Stderr: stderr,
-		})
+			Stdout: cmd.OutOrStdout(),
+		}
+		if err := setFormat(cmd, opts); err != nil {
+			fmt.Fprintln(stderr, err)
+			os.Exit(1)
+		}
+		_, err := Generate(cmd.Context(), dir, name, opts)
 		if err != nil {
 			os.Exit(1)
 		}
@@ -268,12 +414,20 @@ var diffCmd = &cobra.Command{
 		defer trace.StartRegion(cmd.Context(), "diff").End()
 		stderr := cmd.ErrOrStderr()
 		dir, name := getConfigPath(stderr, cmd.Flag("file"))
+		format, _ := cmd.Flags().GetString("format")
+		includePatch, _ := cmd.Flags().GetBool("include-patch")
 		opts := &Options{
-			Env:    ParseEnv(cmd),
-			Stderr: stderr,
+			Env:        ParseEnv(cmd),
+			Stderr:     stderr,
+			Stdout:     cmd.OutOrStdout(),
+			DiffFormat: format,
+			DiffPatch:  includePatch,
 		}
 		if err := Diff(cmd.Context(), dir, name, opts); err != nil {
-			os.Exit(1)
+			if errors.Is(err, ErrDiffFound) {
+				os.Exit(1)
+			}
+			os.Exit(2)
 		}
 		return nil
 	},
@@ -43,6 +43,10 @@ func CreateDB(ctx context.Context, dir, filename, querySetName string, o *Option
 	if err != nil {
 		return err
 	}
+	rep := newReport(FormatText, dir, o.Stderr)
+	if err := fetchRemoteSchemas(ctx, conf, o.Env, rep); err != nil {
+		return fmt.Errorf("error fetching schema: %w", err)
+	}
 	// Find the first queryset with a managed database
 	var queryset *config.SQL
 	var count int
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime/trace"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang"
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+func init() {
+	debugTypesCmd.Flags().String("format", "text", "output format, either text or json")
+	debugCmd.AddCommand(debugTypesCmd)
+}
+
+var debugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "Show how sqlc resolves the configuration",
+}
+
+var debugTypesCmd = &cobra.Command{
+	Use:   "types <package>",
+	Short: "Show the override and rename that chose the type and name of each field of a Go package",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer trace.StartRegion(cmd.Context(), "debug types").End()
+		stderr := cmd.ErrOrStderr()
+		dir, name := getConfigPath(stderr, cmd.Flag("file"))
+		format, _ := cmd.Flags().GetString("format")
+		opts := &Options{
+			Env:    ParseEnv(cmd),
+			Stderr: stderr,
+			Stdout: cmd.OutOrStdout(),
+		}
+		if err := DebugTypes(cmd.Context(), dir, name, args[0], format, opts); err != nil {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+type typeDebugger struct {
+	pkg    string
+	m      sync.Mutex
+	fields []golang.FieldType
+	found  bool
+}
+
+func (d *typeDebugger) Pairs(ctx context.Context, conf *config.Config) []OutputPair {
+	var pairs []OutputPair
+	for _, sql := range conf.SQL {
+		if sql.Gen.Go == nil {
+			continue
+		}
+		if sql.Gen.Go.Package != d.pkg && sql.Name != d.pkg {
+			continue
+		}
+		pairs = append(pairs, OutputPair{
+			SQL: sql,
+			Gen: config.SQLGen{Go: sql.Gen.Go},
+		})
+	}
+	d.found = len(pairs) > 0
+	return pairs
+}
+
+func (d *typeDebugger) ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result, rep *Report) error {
+	req := codeGenRequest(result, combo)
+	opts, err := json.Marshal(sql.Gen.Go)
+	if err != nil {
+		return fmt.Errorf("opts marshal failed: %w", err)
+	}
+	req.PluginOptions = opts
+	if combo.Global.Overrides.Go != nil {
+		opts, err := json.Marshal(combo.Global.Overrides.Go)
+		if err != nil {
+			return fmt.Errorf("opts marshal failed: %w", err)
+		}
+		req.GlobalOptions = opts
+	}
+	fields, err := golang.DebugTypes(req)
+	if err != nil {
+		return err
+	}
+	d.m.Lock()
+	d.fields = append(d.fields, fields...)
+	d.m.Unlock()
+	return nil
+}
+
+// DebugTypes writes the fields of the structs generated for the Go package
+// pkg, named by its package name or the name of its sql entry, with the
+// override and rename applied to each.
+func DebugTypes(ctx context.Context, dir, filename, pkg, format string, o *Options) error {
+	stderr := o.Stderr
+	switch format {
+	case "", "text", "json":
+	default:
+		fmt.Fprintf(stderr, "unknown format: %s\n", format)
+		return fmt.Errorf("unknown format: %s", format)
+	}
+	d := &typeDebugger{pkg: pkg}
+	if err := Process(ctx, d, dir, filename, o); err != nil {
+		return err
+	}
+	if !d.found {
+		fmt.Fprintf(stderr, "no Go package named %s\n", pkg)
+		return fmt.Errorf("no Go package named %s", pkg)
+	}
+	if format == "json" {
+		fields := d.fields
+		if fields == nil {
+			fields = []golang.FieldType{}
+		}
+		enc := json.NewEncoder(o.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(fields)
+	}
+	return writeFieldTypes(o.Stdout, d.fields)
+}
+
+func writeFieldTypes(w io.Writer, fields []golang.FieldType) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "STRUCT\tFIELD\tCOLUMN\tDB TYPE\tNULLABLE\tOVERRIDE\tGO TYPE\tRENAME")
+	for _, f := range fields {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			f.Struct, f.Field, f.Column, f.DBType, strconv.FormatBool(f.Nullable),
+			orDash(f.Override), f.GoType, orDash(f.Rename))
+	}
+	return tw.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"runtime/trace"
 	"sort"
 	"strings"
@@ -12,48 +16,190 @@ import (
 	"github.com/cubicdaiya/gonp"
 )
 
+// ErrDiffFound is returned by Diff if the generated files differ from the
+// existing files.
+var ErrDiffFound = errors.New("diff found")
+
+const (
+	diffAdded   = "added"
+	diffRemoved = "removed"
+	diffChanged = "changed"
+)
+
+type fileDiff struct {
+	Package string `json:"package"`
+	File    string `json:"file"`
+	Status  string `json:"status"`
+	Patch   string `json:"patch,omitempty"`
+}
+
+type diffSummary struct {
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+	Changed int `json:"changed"`
+}
+
+type diffReport struct {
+	Files   []fileDiff  `json:"files"`
+	Summary diffSummary `json:"summary"`
+}
+
+// Diff compares the generated files to the existing files. It returns
+// ErrDiffFound if they differ, and another error if the files couldn't be
+// generated or read.
 func Diff(ctx context.Context, dir, name string, opts *Options) error {
 	stderr := opts.Stderr
-	output, err := Generate(ctx, dir, name, opts)
+	switch opts.DiffFormat {
+	case "", "text", "json":
+	default:
+		fmt.Fprintf(stderr, "unknown diff format: %s\n", opts.DiffFormat)
+		return fmt.Errorf("unknown diff format: %s", opts.DiffFormat)
+	}
+	output, packages, err := generate(ctx, dir, name, opts)
 	if err != nil {
 		return err
 	}
 	defer trace.StartRegion(ctx, "checkfiles").End()
-	var errored bool
 
+	diffs, err := diffFiles(dir, output, packages)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return err
+	}
+
+	if opts.DiffFormat == "json" {
+		report := diffReport{Files: []fileDiff{}}
+		for _, d := range diffs {
+			switch d.Status {
+			case diffAdded:
+				report.Summary.Added++
+			case diffRemoved:
+				report.Summary.Removed++
+			case diffChanged:
+				report.Summary.Changed++
+			}
+			if !opts.DiffPatch {
+				d.Patch = ""
+			}
+			report.Files = append(report.Files, d)
+		}
+		stdout := opts.Stdout
+		if stdout == nil {
+			stdout = os.Stdout
+		}
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		for _, d := range diffs {
+			io.WriteString(stderr, d.Patch)
+		}
+	}
+	if len(diffs) > 0 {
+		return ErrDiffFound
+	}
+	return nil
+}
+
+// diffFiles compares each generated file to the existing file. Files in the
+// output directories that were generated by sqlc, but no longer are, are
+// reported as removed.
+func diffFiles(dir string, output, packages map[string]string) ([]fileDiff, error) {
 	keys := make([]string, 0, len(output))
-	for k, _ := range output {
-		kk := k
-		keys = append(keys, kk)
+	for k := range output {
+		keys = append(keys, k)
 	}
 	sort.Strings(keys)
 
+	var diffs []fileDiff
+	rel := func(filename string) string {
+		return filepath.ToSlash(strings.TrimPrefix(strings.TrimPrefix(filename, dir), string(filepath.Separator)))
+	}
+	dirs := map[string]string{}
 	for _, filename := range keys {
-		source := output[filename]
-		if _, err := os.Stat(filename); errors.Is(err, os.ErrNotExist) {
-			errored = true
-			// stdout message
+		dirs[filepath.Dir(filename)] = packages[filename]
+		existing, err := os.ReadFile(filename)
+		if errors.Is(err, os.ErrNotExist) {
+			patch := wholeFilePatch("/dev/null", "b/"+rel(filename), '+', getLines([]byte(output[filename])))
+			diffs = append(diffs, fileDiff{Package: packages[filename], File: rel(filename), Status: diffAdded, Patch: patch})
 			continue
 		}
-		existing, err := os.ReadFile(filename)
 		if err != nil {
-			errored = true
-			fmt.Fprintf(stderr, "%s: %s\n", filename, err)
-			continue
+			return nil, fmt.Errorf("%s: %w", filename, err)
 		}
-		diff := gonp.New(getLines(existing), getLines([]byte(source)))
+		diff := gonp.New(getLines(existing), getLines([]byte(output[filename])))
 		diff.Compose()
 		uniHunks := filterHunks(diff.UnifiedHunks())
+		if len(uniHunks) == 0 {
+			continue
+		}
+		var patch bytes.Buffer
+		fmt.Fprintf(&patch, "--- a/%s\n", rel(filename))
+		fmt.Fprintf(&patch, "+++ b/%s\n", rel(filename))
+		diff.FprintUniHunks(&patch, uniHunks)
+		diffs = append(diffs, fileDiff{Package: packages[filename], File: rel(filename), Status: diffChanged, Patch: patch.String()})
+	}
 
-		if len(uniHunks) > 0 {
-			errored = true
-			fmt.Fprintf(stderr, "--- a%s\n", strings.TrimPrefix(filename, dir))
-			fmt.Fprintf(stderr, "+++ b%s\n", strings.TrimPrefix(filename, dir))
-			diff.FprintUniHunks(stderr, uniHunks)
+	outdirs := make([]string, 0, len(dirs))
+	for d := range dirs {
+		outdirs = append(outdirs, d)
+	}
+	sort.Strings(outdirs)
+	for _, d := range outdirs {
+		entries, err := os.ReadDir(d)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d, err)
+		}
+		for _, entry := range entries {
+			filename := filepath.Join(d, entry.Name())
+			if _, ok := output[filename]; ok || !entry.Type().IsRegular() {
+				continue
+			}
+			existing, err := os.ReadFile(filename)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filename, err)
+			}
+			if isGenerated(existing) {
+				patch := wholeFilePatch("a/"+rel(filename), "/dev/null", '-', getLines(existing))
+				diffs = append(diffs, fileDiff{Package: dirs[d], File: rel(filename), Status: diffRemoved, Patch: patch})
+			}
 		}
 	}
-	if errored {
-		return errors.New("diff found")
+	return diffs, nil
+}
+
+// wholeFilePatch returns the unified diff of a file that was added or removed,
+// with a single hunk adding or removing each of its lines.
+func wholeFilePatch(from, to string, op byte, lines []string) string {
+	var patch bytes.Buffer
+	fmt.Fprintf(&patch, "--- %s\n+++ %s\n", from, to)
+	if len(lines) == 0 {
+		return patch.String()
 	}
-	return nil
+	if op == '+' {
+		fmt.Fprintf(&patch, "@@ -0,0 +1,%d @@\n", len(lines))
+	} else {
+		fmt.Fprintf(&patch, "@@ -1,%d +0,0 @@\n", len(lines))
+	}
+	for _, line := range lines {
+		patch.WriteByte(op)
+		patch.WriteString(line)
+		patch.WriteByte('\n')
+	}
+	return patch.String()
+}
+
+// isGenerated reports whether a file starts with the header that sqlc
+// generates.
+func isGenerated(contents []byte) bool {
+	header := contents
+	if len(header) > 512 {
+		header = header[:512]
+	}
+	return bytes.Contains(header, []byte("Code generated by sqlc. DO NOT EDIT."))
 }
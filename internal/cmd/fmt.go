@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/trace"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/engine/dolphin"
+	"github.com/sqlc-dev/sqlc/internal/engine/postgresql"
+	"github.com/sqlc-dev/sqlc/internal/engine/sqlite"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+	"github.com/sqlc-dev/sqlc/internal/sqlfmt"
+)
+
+var fmtCmd = &cobra.Command{
+	Use:   "fmt",
+	Short: "Format the query files listed in the configuration",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer trace.StartRegion(cmd.Context(), "fmt").End()
+		stderr := cmd.ErrOrStderr()
+		dir, name := getConfigPath(stderr, cmd.Flag("file"))
+		check, _ := cmd.Flags().GetBool("check")
+		opts := &Options{
+			Env:    ParseEnv(cmd),
+			Stderr: stderr,
+		}
+		if err := Fmt(cmd.Context(), dir, name, opts, check, cmd.OutOrStdout()); err != nil {
+			fmt.Fprintf(stderr, "error formatting queries: %s\n", err)
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+// Fmt formats the query files of every package in the configuration. In check
+// mode, files are left untouched; the names of those that aren't formatted
+// are written to stdout instead, and an error is returned if there are any.
+//
+// Files that the engine can't parse are skipped with a warning, as are files
+// whose formatted statements wouldn't parse the same way.
+func Fmt(ctx context.Context, dir, filename string, opts *Options, check bool, stdout io.Writer) error {
+	stderr := opts.Stderr
+	_, conf, err := readConfig(stderr, dir, filename)
+	if err != nil {
+		return err
+	}
+
+	var unformatted, warnings int
+	seen := map[string]bool{}
+	for _, pkg := range conf.SQL {
+		files, err := sqlpath.Glob(sqlpath.Join(dir, pkg.Queries))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if seen[file] {
+				continue
+			}
+			seen[file] = true
+			name := file
+			if rel, err := filepath.Rel(dir, file); err == nil {
+				name = rel
+			}
+			src, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			out, err := formatQueries(pkg.Engine, string(src))
+			if err != nil {
+				warnings++
+				fmt.Fprintf(stderr, "%s: warning: skipping file: %s\n", name, err)
+				continue
+			}
+			if out == string(src) {
+				continue
+			}
+			unformatted++
+			if check {
+				fmt.Fprintln(stdout, name)
+				continue
+			}
+			if err := os.WriteFile(file, []byte(out), 0644); err != nil {
+				return err
+			}
+		}
+	}
+	if warnings > 0 && strictMode(opts.Env, conf) {
+		return fmt.Errorf("%d files could not be formatted", warnings)
+	}
+	if check && unformatted > 0 {
+		return fmt.Errorf("%d files are not formatted", unformatted)
+	}
+	return nil
+}
+
+// formatQueries formats the contents of a query file, checking that the
+// result parses into the same statements.
+func formatQueries(engine config.Engine, src string) (string, error) {
+	var parser compiler.Parser
+	var dialect sqlfmt.Dialect
+	switch engine {
+	case config.EnginePostgreSQL:
+		parser, dialect = postgresql.NewParser(), sqlfmt.PostgreSQL
+	case config.EngineMySQL:
+		parser, dialect = dolphin.NewParser(), sqlfmt.MySQL
+	case config.EngineSQLite:
+		parser, dialect = sqlite.NewParser(), sqlfmt.SQLite
+	default:
+		return "", fmt.Errorf("unknown engine: %s", engine)
+	}
+	before, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		return "", err
+	}
+	out, err := sqlfmt.Format(src, dialect)
+	if err != nil {
+		return "", err
+	}
+	after, err := parser.Parse(strings.NewReader(out))
+	if err != nil {
+		return "", fmt.Errorf("formatted queries don't parse: %w", err)
+	}
+	if len(after) != len(before) {
+		return "", fmt.Errorf("formatted queries have %d statements, expected %d", len(after), len(before))
+	}
+	if engine == config.EnginePostgreSQL {
+		for i := range before {
+			a, err := postgresql.Fingerprint(statementText(src, before[i]))
+			if err != nil {
+				return "", err
+			}
+			b, err := postgresql.Fingerprint(statementText(out, after[i]))
+			if err != nil {
+				return "", err
+			}
+			if a != b {
+				return "", fmt.Errorf("formatting changes the meaning of statement %d", i+1)
+			}
+		}
+	}
+	return out, nil
+}
+
+func statementText(src string, stmt ast.Statement) string {
+	return src[stmt.Raw.StmtLocation : stmt.Raw.StmtLocation+stmt.Raw.StmtLen]
+}
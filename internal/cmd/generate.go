@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime/trace"
+	"slices"
 	"strings"
 	"sync"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/opts"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
 	"github.com/sqlc-dev/sqlc/internal/remote"
+	"github.com/sqlc-dev/sqlc/internal/shfmt"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
 )
 
@@ -47,14 +49,6 @@ The supported version can only be "1" or "2".
 
 const errMessageNoPackages = `No packages are configured`
 
-func printFileErr(stderr io.Writer, dir string, fileErr *multierr.FileError) {
-	filename, err := filepath.Rel(dir, fileErr.Filename)
-	if err != nil {
-		filename = fileErr.Filename
-	}
-	fmt.Fprintf(stderr, "%s:%d:%d: %s\n", filename, fileErr.Line, fileErr.Column, fileErr.Err)
-}
-
 func findPlugin(conf config.Config, name string) (*config.Plugin, error) {
 	for _, plug := range conf.Plugins {
 		if plug.Name == name {
@@ -64,7 +58,62 @@ func findPlugin(conf config.Config, name string) (*config.Plugin, error) {
 	return nil, fmt.Errorf("plugin not found")
 }
 
+// withoutPluginCommands drops the queries using a command that a plugin
+// generating code for the package accepts. The code for them is left to the
+// plugin, so the Go generator needn't warn that it skips them.
+func withoutPluginCommands(queries []*plugin.Query, conf config.Config, sql config.SQL) []*plugin.Query {
+	var commands []string
+	for _, cg := range sql.Codegen {
+		if plug, err := findPlugin(conf, cg.Plugin); err == nil {
+			commands = append(commands, plug.Commands...)
+		}
+	}
+	if len(commands) == 0 {
+		return queries
+	}
+	var kept []*plugin.Query
+	for _, q := range queries {
+		if !slices.Contains(commands, q.Cmd) {
+			kept = append(kept, q)
+		}
+	}
+	return kept
+}
+
 func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config, error) {
+	configPath, err := findConfig(stderr, dir, filename)
+	if err != nil {
+		return "", nil, err
+	}
+
+	base := filepath.Base(configPath)
+	file, err := os.Open(configPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "error parsing %s: file does not exist\n", base)
+		return configPath, nil, err
+	}
+	defer file.Close()
+
+	conf, err := config.ParseConfig(file)
+	if err != nil {
+		switch err {
+		case config.ErrMissingVersion:
+			fmt.Fprint(stderr, errMessageNoVersion)
+		case config.ErrUnknownVersion:
+			fmt.Fprint(stderr, errMessageUnknownVersion)
+		case config.ErrNoPackages:
+			fmt.Fprint(stderr, errMessageNoPackages)
+		}
+		fmt.Fprintf(stderr, "error parsing %s: %s\n", base, err)
+		return configPath, nil, err
+	}
+
+	return configPath, &conf, nil
+}
+
+// findConfig returns the path of the configuration file, which is filename in
+// dir if set, or else whichever of sqlc.yaml, sqlc.yml and sqlc.json exists.
+func findConfig(stderr io.Writer, dir, filename string) (string, error) {
 	configPath := ""
 	if filename != "" {
 		configPath = filepath.Join(dir, filename)
@@ -87,12 +136,12 @@ func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config,
 
 		if yamlMissing && ymlMissing && jsonMissing {
 			fmt.Fprintln(stderr, "error parsing configuration files. sqlc.(yaml|yml) or sqlc.json: file does not exist")
-			return "", nil, errors.New("config file missing")
+			return "", errors.New("config file missing")
 		}
 
 		if (!yamlMissing || !ymlMissing) && !jsonMissing {
 			fmt.Fprintln(stderr, "error: both sqlc.json and sqlc.(yaml|yml) files present")
-			return "", nil, errors.New("sqlc.json and sqlc.(yaml|yml) present")
+			return "", errors.New("sqlc.json and sqlc.(yaml|yml) present")
 		}
 
 		if jsonMissing {
@@ -105,73 +154,81 @@ func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config,
 			configPath = jsonPath
 		}
 	}
-
-	base := filepath.Base(configPath)
-	file, err := os.Open(configPath)
-	if err != nil {
-		fmt.Fprintf(stderr, "error parsing %s: file does not exist\n", base)
-		return "", nil, err
-	}
-	defer file.Close()
-
-	conf, err := config.ParseConfig(file)
-	if err != nil {
-		switch err {
-		case config.ErrMissingVersion:
-			fmt.Fprint(stderr, errMessageNoVersion)
-		case config.ErrUnknownVersion:
-			fmt.Fprint(stderr, errMessageUnknownVersion)
-		case config.ErrNoPackages:
-			fmt.Fprint(stderr, errMessageNoPackages)
-		}
-		fmt.Fprintf(stderr, "error parsing %s: %s\n", base, err)
-		return "", nil, err
-	}
-
-	return configPath, &conf, nil
+	return configPath, nil
 }
 
 func Generate(ctx context.Context, dir, filename string, o *Options) (map[string]string, error) {
+	output, _, err := generate(ctx, dir, filename, o)
+	return output, err
+}
+
+// generate is Generate, but also returns the name of the package that each
+// file was generated for. Files generated remotely have no package name.
+func generate(ctx context.Context, dir, filename string, o *Options) (map[string]string, map[string]string, error) {
 	e := o.Env
 	stderr := o.Stderr
 
 	configPath, conf, err := o.ReadConfig(dir, filename)
 	if err != nil {
-		return nil, err
-	}
-
-	base := filepath.Base(configPath)
-	if err := config.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
-		return nil, err
+		writeConfigProblem(o.Stdout, o.Format, configPath, err)
+		return nil, nil, err
 	}
 
-	if err := e.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
-		return nil, err
+	if err := validateConfig(conf, e, filepath.Base(configPath), stderr); err != nil {
+		writeConfigProblem(o.Stdout, o.Format, configPath, err)
+		return nil, nil, err
 	}
 
 	// Comment on why these two methods exist
 	if conf.Cloud.Project != "" && e.Remote && !e.NoRemote {
-		return remoteGenerate(ctx, configPath, conf, dir, stderr)
+		output, err := remoteGenerate(ctx, configPath, conf, dir, stderr)
+		return output, nil, err
 	}
 
+	return generateConfig(ctx, dir, configPath, conf, o)
+}
+
+// generateConfig generates the packages of conf, read from the configuration
+// file at configPath. It returns the generated files and the name of the
+// package that each was generated for.
+func generateConfig(ctx context.Context, dir, configPath string, conf *config.Config, o *Options) (map[string]string, map[string]string, error) {
 	g := &generator{
-		dir:    dir,
-		output: map[string]string{},
+		dir:        dir,
+		conf:       conf,
+		configPath: configPath,
+		output:     map[string]string{},
+		packages:   map[string]string{},
+		strict:     strictMode(o.Env, conf),
+		cache:      o.Cache,
+		filtered:   o.parserOpts().Filtered(),
+		stderr:     o.Stderr,
 	}
 
 	if err := processQuerySets(ctx, g, conf, dir, o); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return g.output, nil
+	return g.output, g.packages, nil
 }
 
 type generator struct {
-	m      sync.Mutex
-	dir    string
-	output map[string]string
+	m    sync.Mutex
+	dir  string
+	conf *config.Config
+	// configPath is the path of the configuration file, which diagnostics
+	// with a line but no filename point to.
+	configPath string
+	output     map[string]string
+	// packages maps the files in output to the name of their package.
+	packages map[string]string
+	// filtered is set if only some queries were compiled, in which case only
+	// the files generated from those queries are output.
+	filtered bool
+	// strict is passed on to plugins, which may treat warnings as errors.
+	strict bool
+	cache  *GenerateCache
+	// stderr receives the stderr of plugins while they run.
+	stderr io.Writer
 }
 
 func (g *generator) Pairs(ctx context.Context, conf *config.Config) []OutputPair {
@@ -189,6 +246,12 @@ func (g *generator) Pairs(ctx context.Context, conf *config.Config) []OutputPair
 				Gen: config.SQLGen{JSON: sql.Gen.JSON},
 			})
 		}
+		if sql.Gen.TypeScript != nil {
+			pairs = append(pairs, OutputPair{
+				SQL: sql,
+				Gen: config.SQLGen{TypeScript: sql.Gen.TypeScript},
+			})
+		}
 		for i := range sql.Codegen {
 			pairs = append(pairs, OutputPair{
 				SQL:    sql,
@@ -199,20 +262,27 @@ func (g *generator) Pairs(ctx context.Context, conf *config.Config) []OutputPair
 	return pairs
 }
 
-func (g *generator) ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) error {
-	out, resp, err := codegen(ctx, combo, sql, result)
+func (g *generator) ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result, rep *Report) error {
+	out, resp, err := codegen(ctx, combo, sql, result, g.strict, g.stderr)
 	if err != nil {
 		return err
 	}
+	if err := reportDiagnostics(rep, pluginName(sql), g.configPath, resp.Diagnostics); err != nil {
+		return err
+	}
 	files := map[string]string{}
 	for _, file := range resp.Files {
 		files[file.Name] = string(file.Contents)
 	}
-	g.m.Lock()
-
 	// out is specified by the user, not a plugin
 	absout := filepath.Join(g.dir, out)
+	if g.filtered {
+		files = filterOutput(result, absout, files, g.fullOutput(ctx, combo, sql, rep))
+	}
 
+	g.m.Lock()
+	defer g.m.Unlock()
+	generated := map[string]string{}
 	for n, source := range files {
 		filename := filepath.Join(g.dir, out, n)
 		// filepath.Join calls filepath.Clean which should remove all "..", but
@@ -225,11 +295,97 @@ func (g *generator) ProcessResult(ctx context.Context, combo config.CombinedSett
 			return fmt.Errorf("invalid file output path: %s", filename)
 		}
 		g.output[filename] = source
+		g.packages[filename] = packageName(combo, sql)
+		generated[filename] = source
+	}
+	if g.cache != nil {
+		g.cache.record(g.conf, sql, generated)
 	}
-	g.m.Unlock()
 	return nil
 }
 
+// codeFilteredShared is the code of the warning reported when the files that
+// depend on every query of a package can't be regenerated by a filtered run.
+const codeFilteredShared = "filtered-shared-files"
+
+// fullOutput returns the files generated from all the queries of a package,
+// for a run that only compiled some of them. It returns nil, with a warning,
+// if the package can't be compiled in full, such as when the queries that
+// weren't selected have errors.
+func (g *generator) fullOutput(ctx context.Context, combo config.CombinedSettings, sql OutputPair, rep *Report) map[string]string {
+	warn := func(reason string) map[string]string {
+		rep.Warn("", Problem{
+			Code:    codeFilteredShared,
+			Message: fmt.Sprintf("the files of package %s that depend on every query were left as they are, as %s", packageName(combo, sql), reason),
+		})
+		return nil
+	}
+	full, failed := parse(ctx, packageName(combo, sql), g.dir, sql.SQL, combo, opts.Parser{Debug: debug.Debug}, newReport(FormatText, g.dir, io.Discard))
+	if failed {
+		return warn("the package has errors outside the selected queries")
+	}
+	_, resp, err := codegen(ctx, combo, sql, full, g.strict, io.Discard)
+	if err != nil {
+		return warn(fmt.Sprintf("generating them failed: %s", err))
+	}
+	files := map[string]string{}
+	for _, file := range resp.Files {
+		files[file.Name] = string(file.Contents)
+	}
+	// Files generated from the query files that weren't selected are left
+	// as they are
+	for _, q := range full.Queries {
+		for name := range files {
+			if strings.HasPrefix(filepath.Base(name), q.Metadata.Filename) {
+				delete(files, name)
+			}
+		}
+	}
+	return files
+}
+
+// filterOutput returns the files generated from the queries selected by a
+// filter, those named after a query file that holds one of them, along with
+// the files that depend on every query, such as the models and the querier,
+// if their contents changed. Those are taken from full, the output of the
+// whole package, and are left as they are if it's nil. Manifests are always
+// returned, so the files they list aren't taken to be stale.
+func filterOutput(result *compiler.Result, absout string, files, full map[string]string) map[string]string {
+	var sources []string
+	for _, q := range result.Queries {
+		sources = append(sources, q.Metadata.Filename)
+	}
+	filtered := map[string]string{}
+	for name, source := range files {
+		for _, src := range sources {
+			if strings.HasPrefix(filepath.Base(name), src) {
+				filtered[name] = source
+				break
+			}
+		}
+	}
+	for name, source := range full {
+		if _, ok := filtered[name]; ok {
+			continue
+		}
+		existing, err := os.ReadFile(filepath.Join(absout, name))
+		if _, manifest := parseManifest(name, source); manifest || err != nil || string(existing) != source {
+			filtered[name] = source
+		}
+	}
+	if full == nil {
+		for name, source := range files {
+			if _, ok := parseManifest(name, source); !ok {
+				continue
+			}
+			if existing, err := os.ReadFile(filepath.Join(absout, name)); err == nil {
+				filtered[name] = string(existing)
+			}
+		}
+	}
+	return filtered
+}
+
 func remoteGenerate(ctx context.Context, configPath string, conf *config.Config, dir string, stderr io.Writer) (map[string]string, error) {
 	rpcClient, err := remote.NewClient(conf.Cloud)
 	if err != nil {
@@ -250,10 +406,7 @@ func remoteGenerate(ctx context.Context, configPath string, conf *config.Config,
 
 	for _, pkg := range conf.SQL {
 		for _, paths := range []config.Paths{pkg.Schema, pkg.Queries} {
-			for i, relFilePath := range paths {
-				paths[i] = filepath.Join(dir, relFilePath)
-			}
-			files, err := sqlpath.Glob(paths)
+			files, err := sqlpath.Glob(sqlpath.Join(dir, paths))
 			if err != nil {
 				fmt.Fprintf(stderr, "error globbing paths: %s\n", err)
 				return nil, err
@@ -293,7 +446,7 @@ func remoteGenerate(ctx context.Context, configPath string, conf *config.Config,
 	return output, nil
 }
 
-func parse(ctx context.Context, name, dir string, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser, stderr io.Writer) (*compiler.Result, bool) {
+func parse(ctx context.Context, name, dir string, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser, rep *Report) (*compiler.Result, bool) {
 	defer trace.StartRegion(ctx, "parse").End()
 	c, err := compiler.NewCompiler(sql, combo)
 	defer func() {
@@ -302,17 +455,17 @@ func parse(ctx context.Context, name, dir string, sql config.SQL, combo config.C
 		}
 	}()
 	if err != nil {
-		fmt.Fprintf(stderr, "error creating compiler: %s\n", err)
+		rep.Error(err, "error creating compiler: %s\n", err)
 		return nil, true
 	}
 	if err := c.ParseCatalog(sql.Schema); err != nil {
-		fmt.Fprintf(stderr, "# package %s\n", name)
+		rep.Printf("# package %s\n", name)
 		if parserErr, ok := err.(*multierr.Error); ok {
 			for _, fileErr := range parserErr.Errs() {
-				printFileErr(stderr, dir, fileErr)
+				rep.FileError(fileErr)
 			}
 		} else {
-			fmt.Fprintf(stderr, "error parsing schema: %s\n", err)
+			rep.Error(err, "error parsing schema: %s\n", err)
 		}
 		return nil, true
 	}
@@ -320,22 +473,42 @@ func parse(ctx context.Context, name, dir string, sql config.SQL, combo config.C
 		debug.Dump(c.Catalog())
 	}
 	if err := c.ParseQueries(sql.Queries, parserOpts); err != nil {
-		fmt.Fprintf(stderr, "# package %s\n", name)
+		rep.Printf("# package %s\n", name)
 		if parserErr, ok := err.(*multierr.Error); ok {
 			for _, fileErr := range parserErr.Errs() {
-				printFileErr(stderr, dir, fileErr)
+				rep.FileError(fileErr)
 			}
 		} else {
-			fmt.Fprintf(stderr, "error parsing queries: %s\n", err)
+			rep.Error(err, "error parsing queries: %s\n", err)
 		}
 		return nil, true
 	}
-	return c.Result(), false
+	result := c.Result()
+	for _, warning := range result.Warnings {
+		rep.Warn("", Problem{Code: warning.Code, Message: warning.Message})
+	}
+	// Plugins are given the files that were read, relative to the
+	// configuration file like the paths listed in it
+	result.SchemaFiles = relativePaths(dir, result.SchemaFiles)
+	result.QueryFiles = relativePaths(dir, result.QueryFiles)
+	return result, false
 }
 
-func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) (string, *plugin.GenerateResponse, error) {
+func relativePaths(dir string, files []string) []string {
+	rel := make([]string, 0, len(files))
+	for _, file := range files {
+		if r, err := filepath.Rel(dir, file); err == nil {
+			file = filepath.ToSlash(r)
+		}
+		rel = append(rel, file)
+	}
+	return rel
+}
+
+func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result, strict bool, stderr io.Writer) (string, *plugin.GenerateResponse, error) {
 	defer trace.StartRegion(ctx, "codegen").End()
 	req := codeGenRequest(result, combo)
+	req.Strict = strict
 	var handler grpc.ClientConnInterface
 	var out string
 	switch {
@@ -346,17 +519,39 @@ func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair,
 			return "", nil, fmt.Errorf("plugin not found: %s", err)
 		}
 
+		if plug.Requires != nil {
+			if err := plug.Requires.Check(info.Version, requestFeatures()); err != nil {
+				return "", nil, fmt.Errorf("plugin %s %w", plug.Name, err)
+			}
+		}
+
+		timeout, err := plug.ParseTimeout()
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid plugin timeout: %w", err)
+		}
+
+		env, err := plug.Environ(shfmt.NewReplacer(nil))
+		if err != nil {
+			return "", nil, err
+		}
+
 		switch {
 		case plug.Process != nil:
 			handler = &process.Runner{
-				Cmd: plug.Process.Cmd,
-				Env: plug.Env,
+				Name:    plug.Name,
+				Cmd:     plug.Process.Cmd,
+				Env:     env,
+				Timeout: timeout,
+				Stderr:  stderr,
 			}
 		case plug.WASM != nil:
 			handler = &wasm.Runner{
-				URL:    plug.WASM.URL,
-				SHA256: plug.WASM.SHA256,
-				Env:    plug.Env,
+				Name:    plug.Name,
+				URL:     plug.WASM.URL,
+				SHA256:  plug.WASM.SHA256,
+				Env:     env,
+				Timeout: timeout,
+				Stderr:  stderr,
 			}
 		default:
 			return "", nil, fmt.Errorf("unsupported plugin type")
@@ -380,6 +575,7 @@ func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair,
 	case sql.Gen.Go != nil:
 		out = combo.Go.Out
 		handler = ext.HandleFunc(golang.Generate)
+		req.Queries = withoutPluginCommands(req.Queries, combo.Global, sql.SQL)
 		opts, err := json.Marshal(sql.Gen.Go)
 		if err != nil {
 			return "", nil, fmt.Errorf("opts marshal failed: %w", err)
@@ -403,6 +599,36 @@ func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair,
 		}
 		req.PluginOptions = opts
 
+	case sql.Gen.TypeScript != nil:
+		out = sql.Gen.TypeScript.Out
+		handler = ext.HandleFunc(golang.GenerateTypeScript)
+		// The interfaces mirror the Go structs of the package, so they're
+		// named by its Go options and the global Go overrides
+		var goOpts json.RawMessage
+		if sql.SQL.Gen.Go != nil {
+			var err error
+			goOpts, err = json.Marshal(sql.SQL.Gen.Go)
+			if err != nil {
+				return "", nil, fmt.Errorf("opts marshal failed: %w", err)
+			}
+		}
+		opts, err := json.Marshal(struct {
+			*config.SQLTypeScript
+			Go json.RawMessage `json:"go,omitempty"`
+		}{sql.Gen.TypeScript, goOpts})
+		if err != nil {
+			return "", nil, fmt.Errorf("opts marshal failed: %w", err)
+		}
+		req.PluginOptions = opts
+
+		if combo.Global.Overrides.Go != nil {
+			opts, err := json.Marshal(combo.Global.Overrides.Go)
+			if err != nil {
+				return "", nil, fmt.Errorf("opts marshal failed: %w", err)
+			}
+			req.GlobalOptions = opts
+		}
+
 	default:
 		return "", nil, fmt.Errorf("missing language backend")
 	}
@@ -410,3 +636,67 @@ func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair,
 	resp, err := client.Generate(ctx, req)
 	return out, resp, err
 }
+
+// pluginName returns the name diagnostics of the code generator for sql are
+// reported under.
+func pluginName(sql OutputPair) string {
+	switch {
+	case sql.Plugin != nil:
+		return sql.Plugin.Plugin
+	case sql.Gen.Go != nil:
+		return "golang"
+	case sql.Gen.JSON != nil:
+		return "json"
+	case sql.Gen.TypeScript != nil:
+		return "typescript"
+	default:
+		return "codegen"
+	}
+}
+
+// reportDiagnostics adds the diagnostics returned by a plugin to rep. It
+// returns an error if any of them is an error. Warnings are left to rep, which
+// may suppress them or, in strict mode, treat them as errors. Diagnostics with
+// a line but no filename concern the configuration file at configPath.
+func reportDiagnostics(rep *Report, name, configPath string, diags []*plugin.Diagnostic) error {
+	var failed int
+	for _, d := range diags {
+		file := d.Filename
+		if file == "" && d.Line > 0 && configPath != "" {
+			file = rep.relative(configPath)
+		}
+		location := file
+		if location != "" && d.Line > 0 {
+			location = fmt.Sprintf("%s:%d:%d", file, d.Line, d.Column)
+		}
+		prefix := name
+		for _, part := range []string{location, d.QueryName} {
+			if part != "" {
+				prefix += ": " + part
+			}
+		}
+		p := Problem{
+			File:    file,
+			Line:    int(d.Line),
+			Column:  int(d.Column),
+			Query:   d.QueryName,
+			Code:    d.Code,
+			Message: d.Message,
+		}
+		if d.Severity != plugin.Diagnostic_ERROR {
+			rep.Warn(prefix, p)
+			continue
+		}
+		failed++
+		p.Severity = SeverityError
+		msg := d.Message
+		if d.Code != "" {
+			msg += " [" + d.Code + "]"
+		}
+		rep.Add(p, "%s: %s: %s\n", prefix, p.Severity, msg)
+	}
+	if failed > 0 {
+		return fmt.Errorf("plugin %s reported %d error(s)", name, failed)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/trace"
+	"sort"
+	"strings"
+)
+
+// ErrStaleOutput is returned by CheckGenerated if the generated files differ
+// from the files on disk.
+var ErrStaleOutput = errors.New("generated files are out of date")
+
+const (
+	checkChanged = "changed"
+	checkMissing = "missing"
+	checkExtra   = "extra"
+)
+
+type fileCheck struct {
+	File   string
+	Status string
+}
+
+// CheckGenerated generates the files in memory and compares them to the files
+// on disk, without writing anything. Each file that differs is listed on
+// stdout, as changed if its contents differ, missing if it doesn't exist, or
+// extra if a manifest lists it but it's no longer generated. It returns
+// ErrStaleOutput if any file differs.
+func CheckGenerated(ctx context.Context, dir, name string, opts *Options) error {
+	output, err := Generate(ctx, dir, name, opts)
+	if err != nil {
+		return err
+	}
+	defer trace.StartRegion(ctx, "checkfiles").End()
+
+	checks, err := checkFiles(dir, output)
+	if err != nil {
+		fmt.Fprintln(opts.Stderr, err)
+		return err
+	}
+	stdout := opts.Stdout
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+	for _, c := range checks {
+		fmt.Fprintf(stdout, "%s: %s\n", c.Status, c.File)
+	}
+	if len(checks) > 0 {
+		return ErrStaleOutput
+	}
+	return nil
+}
+
+// checkFiles compares each generated file to the file on disk, and reports
+// the files listed by manifests that are no longer generated, sorted by path.
+func checkFiles(dir string, output map[string]string) ([]fileCheck, error) {
+	rel := func(filename string) string {
+		return filepath.ToSlash(strings.TrimPrefix(strings.TrimPrefix(filename, dir), string(filepath.Separator)))
+	}
+	var checks []fileCheck
+	for filename, contents := range output {
+		existing, err := os.ReadFile(filename)
+		if errors.Is(err, os.ErrNotExist) {
+			checks = append(checks, fileCheck{File: rel(filename), Status: checkMissing})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		if string(existing) != contents {
+			checks = append(checks, fileCheck{File: rel(filename), Status: checkChanged})
+		}
+	}
+	stale, err := staleFiles(output)
+	if err != nil {
+		return nil, err
+	}
+	for _, filename := range stale {
+		checks = append(checks, fileCheck{File: rel(filename), Status: checkExtra})
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].File < checks[j].File })
+	return checks, nil
+}
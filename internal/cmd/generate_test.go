@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGenerateFiltered(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("sqlc.yaml", `version: "2"
+sql:
+  - engine: postgresql
+    schema: schema.sql
+    queries: [authors.sql, books.sql]
+    gen:
+      go:
+        package: db
+        out: db
+        emit_interface: true
+`)
+	write("schema.sql", "CREATE TABLE authors (id int PRIMARY KEY);\nCREATE TABLE books (id int PRIMARY KEY);\n")
+	write("authors.sql", "-- name: ListAuthors :many\nSELECT id FROM authors;\n")
+	write("books.sql", "-- name: ListBooks :many\nSELECT id FROM books;\n")
+
+	generate := func(o *Options) map[string]string {
+		t.Helper()
+		o.Stderr = io.Discard
+		output, err := Generate(context.Background(), dir, "", o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := writeOutput(io.Discard, diskSink{}, output); err != nil {
+			t.Fatal(err)
+		}
+		return output
+	}
+	generate(&Options{})
+
+	write("books.sql", "-- name: ListBooks :many\nSELECT id FROM books;\n\n-- name: CountBooks :one\nSELECT count(*) FROM books;\n")
+	output := generate(&Options{QueryFiles: []string{filepath.Join(dir, "authors.sql")}})
+
+	var written []string
+	for name := range output {
+		written = append(written, filepath.Base(name))
+	}
+	sort.Strings(written)
+	// The files of the other query files are left as they are, but the
+	// querier, which depends on every query, is regenerated
+	if diff := cmp.Diff([]string{"authors.sql.go", "querier.go"}, written); diff != "" {
+		t.Errorf("written files mismatch:\n%s", diff)
+	}
+	querier, err := os.ReadFile(filepath.Join(dir, "db", "querier.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(querier), "CountBooks") {
+		t.Errorf("querier.go is stale:\n%s", querier)
+	}
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/trace"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/introspect"
+)
+
+// introspectFilename is the name of the file written by the introspect
+// command.
+const introspectFilename = "schema.sql"
+
+var introspectCmd = &cobra.Command{
+	Use:   "introspect",
+	Short: "Write the schema of a live database as SQL",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer trace.StartRegion(cmd.Context(), "introspect").End()
+		stderr := cmd.ErrOrStderr()
+		engine, _ := cmd.Flags().GetString("engine")
+		uri, _ := cmd.Flags().GetString("uri")
+		schemas, _ := cmd.Flags().GetStringSlice("schema")
+		out, _ := cmd.Flags().GetString("out")
+		if err := Introspect(cmd.Context(), config.Engine(engine), uri, schemas, out); err != nil {
+			fmt.Fprintf(stderr, "error introspecting database: %s\n", err)
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+// Introspect reads the given schemas of the database at uri and writes
+// statements that create them to schema.sql in the directory out.
+func Introspect(ctx context.Context, engine config.Engine, uri string, schemas []string, out string) error {
+	if uri == "" {
+		return fmt.Errorf("--uri is required")
+	}
+	var objects []introspect.Object
+	var err error
+	switch engine {
+	case config.EnginePostgreSQL:
+		objects, err = introspect.PostgreSQL(ctx, uri, schemas)
+	case config.EngineMySQL:
+		objects, err = introspect.MySQL(ctx, uri, schemas)
+	default:
+		return fmt.Errorf("introspect does not support the %q engine", engine)
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(out, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(out, introspectFilename), []byte(introspect.Render(objects)), 0644)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/trace"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+var lintConfigCmd = &cobra.Command{
+	Use:   "lint-config",
+	Short: "Check the configuration file for mistakes",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer trace.StartRegion(cmd.Context(), "lint-config").End()
+		stderr := cmd.ErrOrStderr()
+		dir, name := getConfigPath(stderr, cmd.Flag("file"))
+		if err := LintConfig(dir, name, stderr); err != nil {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+// LintConfig writes every problem found in the configuration file to stderr,
+// and returns an error if there are any.
+func LintConfig(dir, filename string, stderr io.Writer) error {
+	configPath, err := findConfig(stderr, dir, filename)
+	if err != nil {
+		return err
+	}
+	base := filepath.Base(configPath)
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "error parsing %s: file does not exist\n", base)
+		return err
+	}
+	problems, err := config.Lint(dir, data)
+	if err != nil {
+		fmt.Fprintf(stderr, "error parsing %s: %s\n", base, err)
+		return err
+	}
+	for _, p := range problems {
+		fmt.Fprintf(stderr, "%s: %s\n", base, p)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%d problems in %s", len(problems), base)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// manifestHeader starts a manifest, a generated file with the .manifest
+// extension that lists the files of its directory a generator wrote, one
+// name per line.
+const manifestHeader = "# Code generated by sqlc. DO NOT EDIT.\n"
+
+// manifestFileName is the name of the JSON manifest a generator writes with
+// emit_manifest, listing every file it generated in its output directory.
+const manifestFileName = ".sqlc-manifest.json"
+
+// jsonManifest is the contents of a manifest named manifestFileName.
+type jsonManifest struct {
+	Generator string   `json:"generator"`
+	Version   string   `json:"version,omitempty"`
+	Files     []string `json:"files"`
+}
+
+// removeStaleFiles removes the files that the manifests of output listed when
+// they were last written, but no longer list, such as the models of a table
+// that was dropped. Files without the header of generated files are kept, as
+// they were written by hand since.
+func removeStaleFiles(sink outputSink, output map[string]string) error {
+	stale, err := staleFiles(output)
+	if err != nil {
+		return err
+	}
+	for _, path := range stale {
+		if err := sink.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// staleFiles returns the paths of the generated files that the manifests of
+// output listed when they were last written, but no longer list and that
+// aren't in output, sorted. A manifest in a directory of output that output no
+// longer has, such as after switching back from per_table models, is stale
+// along with every file it listed.
+func staleFiles(output map[string]string) ([]string, error) {
+	var stale []string
+	dirs := map[string]bool{}
+	for filename, contents := range output {
+		dirs[filepath.Dir(filename)] = true
+		listed, ok := parseManifest(filename, contents)
+		if !ok {
+			continue
+		}
+		paths, err := unlistedFiles(filename, listed, output)
+		if err != nil {
+			return nil, err
+		}
+		stale = append(stale, paths...)
+	}
+	for dir := range dirs {
+		manifests, err := filepath.Glob(filepath.Join(dir, "*.manifest"))
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, filepath.Join(dir, manifestFileName))
+		for _, filename := range manifests {
+			if _, ok := output[filename]; ok {
+				continue
+			}
+			paths, err := unlistedFiles(filename, nil, output)
+			if err != nil {
+				return nil, err
+			}
+			stale = append(stale, paths...)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// unlistedFiles returns the paths of the generated files that the manifest
+// on disk at filename lists, but that listed doesn't and that aren't in
+// output. If the manifest isn't in output, it's returned too. It returns nil
+// if there's no manifest at filename.
+func unlistedFiles(filename string, listed []string, output map[string]string) ([]string, error) {
+	previous, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	names, ok := parseManifest(filename, string(previous))
+	if !ok {
+		return nil, nil
+	}
+	var stale []string
+	if _, ok := output[filename]; !ok {
+		stale = append(stale, filename)
+	}
+	current := map[string]bool{}
+	for _, name := range listed {
+		current[name] = true
+	}
+	for _, name := range names {
+		path := filepath.Join(filepath.Dir(filename), name)
+		if _, ok := output[path]; ok || current[name] {
+			continue
+		}
+		existing, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !isGenerated(existing) {
+			continue
+		}
+		stale = append(stale, path)
+	}
+	return stale, nil
+}
+
+// parseManifest returns the names of the files listed by a manifest. It
+// returns false if the file isn't a manifest generated by sqlc.
+func parseManifest(filename, contents string) ([]string, bool) {
+	switch {
+	case filepath.Base(filename) == manifestFileName:
+		var m jsonManifest
+		if err := json.Unmarshal([]byte(contents), &m); err != nil || m.Generator != "sqlc" {
+			return nil, false
+		}
+		return manifestNames(m.Files), true
+	case filepath.Ext(filename) == ".manifest" && strings.HasPrefix(contents, manifestHeader):
+		return manifestFiles(contents), true
+	}
+	return nil, false
+}
+
+// manifestFiles returns the names of the files a manifest lists. Names of
+// files outside of the directory of the manifest are ignored.
+func manifestFiles(manifest string) []string {
+	return manifestNames(strings.Split(manifest, "\n"))
+}
+
+// manifestNames returns the names of files in the directory of a manifest,
+// ignoring blank lines, comments, and paths to other directories.
+func manifestNames(lines []string) []string {
+	var names []string
+	for _, line := range lines {
+		name := strings.TrimSpace(line)
+		if name == "" || strings.HasPrefix(name, "#") || name != filepath.Base(name) || name == ".." {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
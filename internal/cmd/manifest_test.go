@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRemoveStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	const generated = "// Code generated by sqlc. DO NOT EDIT.\n\npackage db\n"
+	write("models.manifest", manifestHeader+"models_users.go\nmodels_orders.go\nmodels_edited.go\n")
+	write("models_users.go", generated)
+	write("models_orders.go", generated)
+	write("models_edited.go", "package db\n")
+	write("other.go", generated)
+
+	output := map[string]string{
+		filepath.Join(dir, "models.manifest"): manifestHeader + "models_users.go\n",
+		filepath.Join(dir, "models_users.go"): generated,
+	}
+	if err := removeStaleFiles(diskSink{}, output); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, exists := range map[string]bool{
+		"models_users.go":  true,
+		"models_orders.go": false,
+		"models_edited.go": true,
+		"other.go":         true,
+	} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if exists && err != nil {
+			t.Errorf("%s was removed: %s", name, err)
+		}
+		if !exists && !os.IsNotExist(err) {
+			t.Errorf("%s wasn't removed", name)
+		}
+	}
+}
+
+func TestRemoveStaleManifest(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	const generated = "// Code generated by sqlc. DO NOT EDIT.\n\npackage db\n"
+	write("models.manifest", manifestHeader+"models_users.go\nmodels_edited.go\n")
+	write("models_users.go", generated)
+	write("models_edited.go", "package db\n")
+
+	// The models are no longer generated per table
+	output := map[string]string{
+		filepath.Join(dir, "models.go"): generated,
+	}
+	if err := removeStaleFiles(diskSink{}, output); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, exists := range map[string]bool{
+		"models.manifest":  false,
+		"models_users.go":  false,
+		"models_edited.go": true,
+	} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if exists && err != nil {
+			t.Errorf("%s was removed: %s", name, err)
+		}
+		if !exists && !os.IsNotExist(err) {
+			t.Errorf("%s wasn't removed", name)
+		}
+	}
+}
+
+func TestCheckFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	const generated = "// Code generated by sqlc. DO NOT EDIT.\n\npackage db\n"
+	write(manifestFileName, `{"generator": "sqlc", "files": ["db.go", "models.go", "users.sql.go", "edited.go"]}`)
+	write("db.go", generated)
+	write("models.go", "// Code generated by sqlc. DO NOT EDIT.\n\npackage models\n")
+	write("users.sql.go", generated)
+	write("edited.go", "package db\n")
+
+	output := map[string]string{
+		filepath.Join(dir, manifestFileName): `{"generator": "sqlc", "files": ["db.go", "models.go", "orders.sql.go"]}`,
+		filepath.Join(dir, "db.go"):          generated,
+		filepath.Join(dir, "models.go"):      generated,
+		filepath.Join(dir, "orders.sql.go"):  generated,
+	}
+	checks, err := checkFiles(dir, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []fileCheck{
+		{File: manifestFileName, Status: checkChanged},
+		{File: "models.go", Status: checkChanged},
+		{File: "orders.sql.go", Status: checkMissing},
+		{File: "users.sql.go", Status: checkExtra},
+	}
+	if len(checks) != len(want) {
+		t.Fatalf("checks = %v, want %v", checks, want)
+	}
+	for i := range want {
+		if checks[i] != want[i] {
+			t.Errorf("checks[%d] = %v, want %v", i, checks[i], want[i])
+		}
+	}
+}
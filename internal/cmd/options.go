@@ -4,6 +4,8 @@ import (
 	"io"
 
 	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/debug"
+	"github.com/sqlc-dev/sqlc/internal/opts"
 )
 
 type Options struct {
@@ -12,6 +14,26 @@ type Options struct {
 	// TODO: Move these to a command-specific struct
 	Tags    []string
 	Against string
+	// Jobs is the number of packages processed concurrently. If zero, it
+	// defaults to GOMAXPROCS.
+	Jobs int
+	// Cache, if set, skips packages whose inputs haven't changed since they
+	// were last generated.
+	Cache *GenerateCache
+	// DiffFormat is the output format of Diff, either "text" (the default) or
+	// "json". JSON is written to Stdout.
+	DiffFormat string
+	// DiffPatch includes the unified diff of changed files in the JSON output
+	// of Diff.
+	DiffPatch bool
+	Stdout    io.Writer
+	// QueryFiles and QueryNames restrict compilation to the queries in these
+	// files and with these names. The schema is still loaded in full.
+	QueryFiles []string
+	QueryNames []string
+	// Format is the format problems are reported in, either FormatText (the
+	// default), written to Stderr, or FormatJSON, written to Stdout.
+	Format string
 
 	// Testing only
 	MutateConfig func(*config.Config)
@@ -27,3 +49,12 @@ func (o *Options) ReadConfig(dir, filename string) (string, *config.Config, erro
 	}
 	return path, conf, nil
 }
+
+// parserOpts returns the options for parsing the queries of a package.
+func (o *Options) parserOpts() opts.Parser {
+	return opts.Parser{
+		Debug:   debug.Debug,
+		Files:   o.QueryFiles,
+		Queries: o.QueryNames,
+	}
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// outputSink receives the generated files sqlc generate writes, and the stale
+// files it removes.
+type outputSink interface {
+	WriteFile(filename string, contents []byte) error
+	Remove(filename string) error
+}
+
+// diskSink writes the generated files to disk.
+type diskSink struct{}
+
+func (diskSink) WriteFile(filename string, contents []byte) error {
+	os.MkdirAll(filepath.Dir(filename), 0755)
+	return os.WriteFile(filename, contents, 0644)
+}
+
+func (diskSink) Remove(filename string) error {
+	return os.Remove(filename)
+}
+
+// The statuses of the files a recordingSink records.
+const (
+	outputNew       = "new"
+	outputChanged   = "changed"
+	outputUnchanged = "unchanged"
+	outputRemoved   = "removed"
+)
+
+// outputFile is a file recorded by a recordingSink, as written by --dry-run
+// --format=json. File is relative to the directory of the configuration
+// file, and Size is the size of the generated file in bytes.
+type outputFile struct {
+	Type   string `json:"type"`
+	File   string `json:"file"`
+	Size   int    `json:"size"`
+	Status string `json:"status"`
+}
+
+// recordingSink records the files it's given, comparing them to the files on
+// disk, instead of writing them.
+type recordingSink struct {
+	dir   string
+	files []outputFile
+}
+
+func (s *recordingSink) rel(filename string) string {
+	if rel, err := filepath.Rel(s.dir, filename); err == nil {
+		filename = rel
+	}
+	return filepath.ToSlash(filename)
+}
+
+func (s *recordingSink) WriteFile(filename string, contents []byte) error {
+	status := outputChanged
+	existing, err := os.ReadFile(filename)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		status = outputNew
+	case err != nil:
+		return err
+	case bytes.Equal(existing, contents):
+		status = outputUnchanged
+	}
+	s.files = append(s.files, outputFile{Type: "file", File: s.rel(filename), Size: len(contents), Status: status})
+	return nil
+}
+
+func (s *recordingSink) Remove(filename string) error {
+	s.files = append(s.files, outputFile{Type: "file", File: s.rel(filename), Status: outputRemoved})
+	return nil
+}
+
+// sorted returns the recorded files, sorted by path.
+func (s *recordingSink) sorted() []outputFile {
+	files := append([]outputFile(nil), s.files...)
+	sort.Slice(files, func(i, j int) bool { return files[i].File < files[j].File })
+	return files
+}
+
+// writeDryRun writes the files recorded by s to w, as a line of JSON each in
+// the JSON format, and otherwise as a tree of directories with the size and
+// status of each file.
+func writeDryRun(w io.Writer, format string, s *recordingSink) error {
+	files := s.sorted()
+	if format == FormatJSON {
+		enc := json.NewEncoder(w)
+		for _, f := range files {
+			if err := enc.Encode(f); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	var prev []string
+	for _, f := range files {
+		dirs := strings.Split(f.File, "/")
+		name := dirs[len(dirs)-1]
+		dirs = dirs[:len(dirs)-1]
+		// Directories shared with the previous file are already written
+		common := 0
+		for common < len(dirs) && common < len(prev) && dirs[common] == prev[common] {
+			common++
+		}
+		for i := common; i < len(dirs); i++ {
+			fmt.Fprintf(tw, "%s%s/\n", strings.Repeat("  ", i), dirs[i])
+		}
+		prev = dirs
+		size := fmt.Sprintf("%d B", f.Size)
+		if f.Status == outputRemoved {
+			size = ""
+		}
+		fmt.Fprintf(tw, "%s%s\t%s\t%s\n", strings.Repeat("  ", len(dirs)), name, size, f.Status)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDryRun(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	const generated = "// Code generated by sqlc. DO NOT EDIT.\n\npackage db\n"
+	write("db/models.manifest", manifestHeader+"models_users.go\nmodels_orders.go\n")
+	write("db/models_users.go", generated)
+	write("db/models_orders.go", generated)
+	write("db/query.sql.go", "package db\n")
+
+	output := map[string]string{
+		filepath.Join(dir, "db/models.manifest"):     manifestHeader + "models_users.go\n",
+		filepath.Join(dir, "db/models_users.go"):     generated,
+		filepath.Join(dir, "db/query.sql.go"):        generated,
+		filepath.Join(dir, "db/internal/helpers.go"): generated,
+	}
+	sink := &recordingSink{dir: dir}
+	if err := writeOutput(io.Discard, sink, output); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, contents := range map[string]string{
+		"db/models_orders.go": generated,
+		"db/query.sql.go":     "package db\n",
+	} {
+		if blob, err := os.ReadFile(filepath.Join(dir, name)); err != nil || string(blob) != contents {
+			t.Errorf("%s was modified: %q, %v", name, blob, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "db/internal")); !os.IsNotExist(err) {
+		t.Errorf("db/internal was created")
+	}
+
+	var out bytes.Buffer
+	if err := writeDryRun(&out, FormatText, sink); err != nil {
+		t.Fatal(err)
+	}
+	expected := `db/
+  internal/
+    helpers.go      52 B  new
+  models.manifest   55 B  changed
+  models_orders.go        removed
+  models_users.go   52 B  unchanged
+  query.sql.go      52 B  changed
+`
+	if diff := cmp.Diff(expected, out.String()); diff != "" {
+		t.Errorf("dry run mismatch:\n%s", diff)
+	}
+}
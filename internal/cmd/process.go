@@ -3,18 +3,22 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"path/filepath"
 	"runtime"
 	"runtime/trace"
+	"slices"
+	"sort"
+	"sync"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/sqlc-dev/sqlc/internal/compiler"
 	"github.com/sqlc-dev/sqlc/internal/config"
-	"github.com/sqlc-dev/sqlc/internal/debug"
 	"github.com/sqlc-dev/sqlc/internal/opts"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
 )
 
 type OutputPair struct {
@@ -26,7 +30,9 @@ type OutputPair struct {
 
 type ResultProcessor interface {
 	Pairs(context.Context, *config.Config) []OutputPair
-	ProcessResult(context.Context, config.CombinedSettings, OutputPair, *compiler.Result) error
+	// ProcessResult processes the result of compiling a package. Warnings
+	// are added to rep.
+	ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result, rep *Report) error
 }
 
 func Process(ctx context.Context, rp ResultProcessor, dir, filename string, o *Options) error {
@@ -35,10 +41,21 @@ func Process(ctx context.Context, rp ResultProcessor, dir, filename string, o *O
 
 	configPath, conf, err := o.ReadConfig(dir, filename)
 	if err != nil {
+		writeConfigProblem(o.Stdout, o.Format, configPath, err)
 		return err
 	}
 
 	base := filepath.Base(configPath)
+	if err := validateConfig(conf, e, base, stderr); err != nil {
+		writeConfigProblem(o.Stdout, o.Format, configPath, err)
+		return err
+	}
+
+	return processQuerySets(ctx, rp, conf, dir, o)
+}
+
+// validateConfig checks the configuration, writing any error to stderr.
+func validateConfig(conf *config.Config, e Env, base string, stderr io.Writer) error {
 	if err := config.Validate(conf); err != nil {
 		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
 		return err
@@ -48,87 +65,283 @@ func Process(ctx context.Context, rp ResultProcessor, dir, filename string, o *O
 		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
 		return err
 	}
-
-	return processQuerySets(ctx, rp, conf, dir, o)
+	return nil
 }
 
 func processQuerySets(ctx context.Context, rp ResultProcessor, conf *config.Config, dir string, o *Options) error {
 	stderr := o.Stderr
 
-	errored := false
+	root := newReport(o.Format, dir, stderr).withWarnings(o.Env, conf)
+	// Remote schemas are fetched once, before any package that may
+	// share them is compiled
+	fetchRep := root.forPackage(stderr)
+	if err := fetchRemoteSchemas(ctx, conf, o.Env, fetchRep); err != nil {
+		fetchRep.Error(err, "error fetching schema: %s\n", err)
+		writeProblems(o.Stdout, o.Format, fetchRep)
+		return err
+	}
 
 	pairs := rp.Pairs(ctx, conf)
 	grp, gctx := errgroup.WithContext(ctx)
-	grp.SetLimit(runtime.GOMAXPROCS(0))
+	jobs := o.Jobs
+	if jobs <= 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	grp.SetLimit(jobs)
 
 	stderrs := make([]bytes.Buffer, len(pairs))
+	errored := make([]bool, len(pairs))
+	reports := make([]*Report, len(pairs))
+	for i := range pairs {
+		reports[i] = root.forPackage(&stderrs[i])
+	}
 
-	for i, pair := range pairs {
-		sql := pair
-		errout := &stderrs[i]
+	results := newCompilations(conf, pairs)
 
+	// Packages sharing an output directory may write the same files, so they
+	// are processed serially, in config order, by a single worker
+	for _, group := range groupByOutput(dir, pairs) {
 		grp.Go(func() error {
-			combo := config.Combine(*conf, sql.SQL)
-			if sql.Plugin != nil {
-				combo.Codegen = *sql.Plugin
+			if o.Cache != nil {
+				resolved := make([]OutputPair, len(group))
+				for j, i := range group {
+					resolved[j] = resolvePaths(dir, pairs[i])
+				}
+				if o.Cache.fresh(conf, resolved) {
+					return nil
+				}
 			}
-
-			// TODO: This feels like a hack that will bite us later
-			joined := make([]string, 0, len(sql.Schema))
-			for _, s := range sql.Schema {
-				joined = append(joined, filepath.Join(dir, s))
+			for _, i := range group {
+				errored[i] = !processQuerySet(gctx, rp, conf, dir, pairs[i], o.parserOpts(), results.forPair(i), reports[i])
 			}
-			sql.Schema = joined
+			return nil
+		})
+	}
+	if err := grp.Wait(); err != nil {
+		return err
+	}
+	// Warnings are written even if every package succeeded
+	failed := fetchRep.escalated > 0
+	for i := range stderrs {
+		failed = failed || errored[i]
+		if _, err := io.Copy(stderr, &stderrs[i]); err != nil {
+			return err
+		}
+	}
+	if err := writeProblems(o.Stdout, o.Format, append([]*Report{fetchRep}, reports...)...); err != nil {
+		return err
+	}
+	if failed {
+		return fmt.Errorf("errored")
+	}
+	return nil
+}
 
-			joined = make([]string, 0, len(sql.Queries))
-			for _, q := range sql.Queries {
-				joined = append(joined, filepath.Join(dir, q))
-			}
-			sql.Queries = joined
+// processQuerySet parses and processes a single package, adding any errors to
+// rep. It reports whether the package was processed successfully.
+func processQuerySet(ctx context.Context, rp ResultProcessor, conf *config.Config, dir string, sql OutputPair, parseOpts opts.Parser, comp *sharedCompilation, rep *Report) bool {
+	combo := combine(conf, sql)
+	sql = resolvePaths(dir, sql)
 
-			var name, lang string
-			parseOpts := opts.Parser{
-				Debug: debug.Debug,
-			}
+	var lang string
+	name := packageName(combo, sql)
 
-			switch {
-			case sql.Gen.Go != nil:
-				name = combo.Go.Package
-				lang = "golang"
+	switch {
+	case sql.Gen.Go != nil:
+		lang = "golang"
 
-			case sql.Plugin != nil:
-				lang = fmt.Sprintf("process:%s", sql.Plugin.Plugin)
-				name = sql.Plugin.Plugin
-			}
+	case sql.Plugin != nil:
+		lang = fmt.Sprintf("process:%s", sql.Plugin.Plugin)
+	}
 
-			packageRegion := trace.StartRegion(gctx, "package")
-			trace.Logf(gctx, "", "name=%s dir=%s plugin=%s", name, dir, lang)
+	packageRegion := trace.StartRegion(ctx, "package")
+	defer packageRegion.End()
+	trace.Logf(ctx, "", "name=%s dir=%s plugin=%s", name, dir, lang)
+
+	rep.pkg = name
+	result, failed := comp.parse(ctx, dir, sql.SQL, combo, parseOpts, rep)
+	if failed {
+		return false
+	}
+	if parseOpts.Filtered() && len(result.Queries) == 0 {
+		return true
+	}
+	if err := rp.ProcessResult(ctx, combo, sql, result, rep); err != nil {
+		rep.Printf("# package %s\n", name)
+		rep.Error(err, "error generating code: %s\n", err)
+		return false
+	}
+	// Warnings reported as errors in strict mode fail the package
+	return rep.escalated == 0
+}
 
-			result, failed := parse(gctx, name, dir, sql.SQL, combo, parseOpts, errout)
-			if failed {
-				packageRegion.End()
-				errored = true
-				return nil
+// combine returns the settings a pair is processed with.
+func combine(conf *config.Config, sql OutputPair) config.CombinedSettings {
+	combo := config.Combine(*conf, sql.SQL)
+	if sql.Plugin != nil {
+		combo.Codegen = *sql.Plugin
+	}
+	return combo
+}
+
+// compilations shares the results of compiling packages between the output
+// pairs generated from the same schema and queries, such as the Go and JSON
+// output of a package, or a package repeated to generate Go code with
+// different options, so that each is only compiled once.
+type compilations struct {
+	pairs []*sharedCompilation
+}
+
+// sharedCompilation is the compilation of a package as seen by one of the
+// pairs sharing it.
+type sharedCompilation struct {
+	*compilation
+	// owner is set for the first pair, in config order, sharing the
+	// compilation. Errors and warnings are only reported for it, so they're
+	// reported once and in the same place however the pairs are scheduled.
+	owner bool
+}
+
+type compilation struct {
+	once sync.Once
+	// name is the name of the package the problems are reported under, that
+	// of the owner.
+	name   string
+	result *compiler.Result
+	failed bool
+	// rep and text hold the problems found compiling the package until the
+	// owner reports them.
+	rep  *Report
+	text bytes.Buffer
+}
+
+func newCompilations(conf *config.Config, pairs []OutputPair) *compilations {
+	c := &compilations{}
+	seen := map[string]*compilation{}
+	for _, pair := range pairs {
+		key := compilationKey(conf, pair)
+		if comp, ok := seen[key]; ok {
+			c.pairs = append(c.pairs, &sharedCompilation{compilation: comp})
+			continue
+		}
+		comp := &compilation{name: packageName(combine(conf, pair), pair)}
+		seen[key] = comp
+		c.pairs = append(c.pairs, &sharedCompilation{compilation: comp, owner: true})
+	}
+	return c
+}
+
+func (c *compilations) forPair(i int) *sharedCompilation {
+	return c.pairs[i]
+}
+
+// parse compiles the package, or waits for and returns the result of the
+// pair that compiled it first. The problems found are added to rep if the
+// pair is the owner of the compilation.
+func (c *sharedCompilation) parse(ctx context.Context, dir string, sql config.SQL, combo config.CombinedSettings, parseOpts opts.Parser, rep *Report) (*compiler.Result, bool) {
+	c.once.Do(func() {
+		c.rep = rep.forPackage(&c.text)
+		c.rep.pkg = c.name
+		c.result, c.failed = parse(ctx, c.name, dir, sql, combo, parseOpts, c.rep)
+	})
+	if c.owner {
+		rep.merge(c.rep, c.text.Bytes())
+	}
+	return c.result, c.failed
+}
+
+// compilationKey returns a key that is equal for pairs whose compilation
+// results are the same: those that only differ in the code generated from
+// them. The plugins of the package that contribute query commands are part
+// of the key, as the compiler rejects the commands of the other plugins.
+func compilationKey(conf *config.Config, pair OutputPair) string {
+	sql := pair.SQL
+	sql.Name = ""
+	sql.Gen = config.SQLGen{}
+	var commandPlugins []string
+	for _, cg := range sql.Codegen {
+		for _, p := range conf.Plugins {
+			if p.Name == cg.Plugin && len(p.Commands) > 0 && !slices.Contains(commandPlugins, p.Name) {
+				commandPlugins = append(commandPlugins, p.Name)
 			}
-			if err := rp.ProcessResult(gctx, combo, sql, result); err != nil {
-				fmt.Fprintf(errout, "# package %s\n", name)
-				fmt.Fprintf(errout, "error generating code: %s\n", err)
-				errored = true
+		}
+	}
+	sort.Strings(commandPlugins)
+	sql.Codegen = nil
+	var schemaSource bool
+	if pair.Plugin != nil {
+		for _, p := range conf.Plugins {
+			if p.Name == pair.Plugin.Plugin {
+				schemaSource = p.SchemaSource
 			}
-			packageRegion.End()
-			return nil
-		})
+		}
 	}
-	if err := grp.Wait(); err != nil {
-		return err
+	blob, err := json.Marshal(struct {
+		SQL            config.SQL
+		SchemaSource   bool
+		CommandPlugins []string
+	}{sql, schemaSource, commandPlugins})
+	if err != nil {
+		// Packages that can't be compared are compiled on their own
+		return fmt.Sprintf("%p", &pair)
 	}
-	if errored {
-		for i, _ := range stderrs {
-			if _, err := io.Copy(stderr, &stderrs[i]); err != nil {
-				return err
-			}
+	return string(blob)
+}
+
+// resolvePaths joins the schema and query paths of a package with the
+// directory of the configuration file.
+func resolvePaths(dir string, sql OutputPair) OutputPair {
+	// TODO: This feels like a hack that will bite us later
+	sql.Schema = sqlpath.Join(dir, sql.Schema)
+	sql.Queries = sqlpath.Join(dir, sql.Queries)
+	return sql
+}
+
+// groupByOutput groups the indexes of pairs by output directory, in the order
+// each directory first appears. Pairs without an output directory are each
+// placed in a group of their own.
+func groupByOutput(dir string, pairs []OutputPair) [][]int {
+	var groups [][]int
+	seen := map[string]int{}
+	for i, pair := range pairs {
+		out, ok := outputDir(pair)
+		if !ok {
+			groups = append(groups, []int{i})
+			continue
 		}
-		return fmt.Errorf("errored")
+		out = filepath.Join(dir, out)
+		if g, ok := seen[out]; ok {
+			groups[g] = append(groups[g], i)
+			continue
+		}
+		seen[out] = len(groups)
+		groups = append(groups, []int{i})
 	}
-	return nil
+	return groups
+}
+
+func outputDir(pair OutputPair) (string, bool) {
+	switch {
+	case pair.Plugin != nil:
+		return pair.Plugin.Out, true
+	case pair.Gen.Go != nil:
+		return pair.Gen.Go.Out, true
+	case pair.Gen.JSON != nil:
+		return pair.Gen.JSON.Out, true
+	case pair.Gen.TypeScript != nil:
+		return pair.Gen.TypeScript.Out, true
+	default:
+		return "", false
+	}
+}
+
+// packageName returns the name that errors and diffs report a package under.
+func packageName(combo config.CombinedSettings, sql OutputPair) string {
+	switch {
+	case sql.Gen.Go != nil:
+		return combo.Go.Package
+	case sql.Plugin != nil:
+		return sql.Plugin.Plugin
+	}
+	return ""
 }
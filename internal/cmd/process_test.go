@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+func TestCompilationKey(t *testing.T) {
+	conf := &config.Config{
+		Plugins: []config.Plugin{
+			{Name: "batch", Commands: []string{":stream"}},
+			{Name: "json"},
+		},
+	}
+	pair := func(plugins ...string) OutputPair {
+		sql := config.SQL{Engine: config.EnginePostgreSQL, Schema: []string{"schema.sql"}, Queries: []string{"query.sql"}}
+		for _, p := range plugins {
+			sql.Codegen = append(sql.Codegen, config.Codegen{Plugin: p, Out: p})
+		}
+		return OutputPair{SQL: sql}
+	}
+
+	for _, tc := range []struct {
+		a, b  OutputPair
+		equal bool
+	}{
+		{pair(), pair(), true},
+		{pair("json"), pair(), true},
+		{pair("batch", "json"), pair("json", "batch"), true},
+		{pair("batch"), pair("json"), false},
+		{pair("batch"), pair(), false},
+	} {
+		a, b := compilationKey(conf, tc.a), compilationKey(conf, tc.b)
+		if (a == b) != tc.equal {
+			t.Errorf("compilationKey equal = %v; want %v:\n%s\n%s", a == b, tc.equal, a, b)
+		}
+	}
+}
@@ -56,7 +56,7 @@ func (g *pusher) Pairs(ctx context.Context, conf *config.Config) []OutputPair {
 	return pairs
 }
 
-func (g *pusher) ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) error {
+func (g *pusher) ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result, rep *Report) error {
 	req := codeGenRequest(result, combo)
 	g.m.Lock()
 	g.results = append(g.results, &bundler.QuerySetArchive{
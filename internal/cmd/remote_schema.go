@@ -0,0 +1,317 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/sqlc-dev/sqlc/internal/cache"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/info"
+	"github.com/sqlc-dev/sqlc/internal/quickdb"
+	pb "github.com/sqlc-dev/sqlc/internal/quickdb/v1"
+)
+
+// codeCachedSchema is the code of the warning reported when a schema can't be
+// fetched and its cached copy is used instead.
+const codeCachedSchema = "cached-schema"
+
+// fetchTimeout limits how long fetching a schema may take.
+const fetchTimeout = time.Minute
+
+// cloudSchemaPrefix starts the schema paths that refer to a query set uploaded
+// to the sqlc Cloud project of the configuration.
+const cloudSchemaPrefix = "sqlc://"
+
+// schemaFetcher downloads the schemas of packages given by https:// URLs or
+// sqlc:// cloud references into the cache, so that they are parsed like local
+// files.
+type schemaFetcher struct {
+	client *http.Client
+	dir    string
+	// noRemote disables fetching, so that only cached copies are used.
+	noRemote bool
+	// fetched maps the schemas already fetched to their cached copies.
+	fetched map[string]string
+
+	cloud config.Cloud
+	// quick returns the client of the sqlc Cloud API.
+	quick func(config.Cloud) (pb.QuickClient, error)
+}
+
+func newSchemaFetcher(e Env, cloud config.Cloud) (*schemaFetcher, error) {
+	dir, err := cache.SchemasDir()
+	if err != nil {
+		return nil, err
+	}
+	return &schemaFetcher{
+		client:   http.DefaultClient,
+		dir:      dir,
+		noRemote: e.NoRemote,
+		fetched:  map[string]string{},
+		cloud:    cloud,
+		quick:    quickdb.NewClientFromConfig,
+	}, nil
+}
+
+// isRemoteSchema reports whether a schema path is a URL or a cloud reference
+// to fetch the schema from.
+func isRemoteSchema(p string) bool {
+	return strings.HasPrefix(p, "https://") || strings.HasPrefix(p, cloudSchemaPrefix)
+}
+
+// fetchRemoteSchemas replaces the schema paths of conf that are URLs or cloud
+// references with the paths of their cached copies, fetching them first.
+// Warnings are added to rep. The schema slices are copied before they're
+// changed, as they may be shared with another configuration, such as the one
+// sqlc generate --watch reuses for each generation.
+func fetchRemoteSchemas(ctx context.Context, conf *config.Config, e Env, rep *Report) error {
+	var f *schemaFetcher
+	for i := range conf.SQL {
+		sql := &conf.SQL[i]
+		copied := false
+		for j, p := range sql.Schema {
+			if !isRemoteSchema(p) {
+				continue
+			}
+			if !copied {
+				sql.Schema = append([]string(nil), sql.Schema...)
+				copied = true
+			}
+			if f == nil {
+				var err error
+				if f, err = newSchemaFetcher(e, conf.Cloud); err != nil {
+					return err
+				}
+			}
+			local, err := f.fetch(ctx, p, rep)
+			if err != nil {
+				return err
+			}
+			sql.Schema[j] = local
+		}
+	}
+	return nil
+}
+
+// fetch returns the path of the cached copy of the schema at p, which is
+// either a URL or a cloud reference.
+func (f *schemaFetcher) fetch(ctx context.Context, p string, rep *Report) (string, error) {
+	if local, ok := f.fetched[p]; ok {
+		return local, nil
+	}
+	var local string
+	var err error
+	if ref, ok := strings.CutPrefix(p, cloudSchemaPrefix); ok {
+		local, err = f.fetchCloud(ctx, ref, rep)
+	} else {
+		local, err = f.fetchURL(ctx, p, rep)
+	}
+	if err != nil {
+		return "", err
+	}
+	f.fetched[p] = local
+	return local, nil
+}
+
+// fetchURL returns the path of the cached copy of the schema at rawURL, which
+// may pin its checksum with a #sha256=<hex> fragment.
+func (f *schemaFetcher) fetchURL(ctx context.Context, rawURL string, rep *Report) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid schema URL %s: %w", rawURL, err)
+	}
+	expected, ok := strings.CutPrefix(u.Fragment, "sha256=")
+	if u.Fragment != "" && !ok {
+		return "", fmt.Errorf("invalid schema URL %s: the fragment must be sha256=<checksum>", rawURL)
+	}
+	u.Fragment = ""
+	src := u.String()
+
+	name := path.Base(u.Path)
+	if path.Ext(name) != ".sql" {
+		name = "schema.sql"
+	}
+	local := filepath.Join(f.dir, fmt.Sprintf("%x", sha256.Sum256([]byte(src))), name)
+	cached, cachedErr := os.ReadFile(local)
+	if cachedErr == nil && expected != "" && checksum(cached) != expected {
+		cachedErr = fmt.Errorf("its cached copy has checksum %s, expected %s", checksum(cached), expected)
+	}
+	return local, f.load(ctx, src, expected != "", cachedErr, func(ctx context.Context) (func() error, error) {
+		blob, err := f.download(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			if expected != "" && checksum(blob) != expected {
+				return fmt.Errorf("invalid checksum of schema %s: expected %s, got %s", src, expected, checksum(blob))
+			}
+			return writeCached(local, blob)
+		}, nil
+	}, rep)
+}
+
+// fetchCloud returns the path of the directory with the cached copies of the
+// schema files of a query set uploaded to the cloud project. The reference is
+// the name of the query set, optionally followed by ?tag=<tag> to use the
+// upload with that tag instead of the latest one.
+func (f *schemaFetcher) fetchCloud(ctx context.Context, ref string, rep *Report) (string, error) {
+	src := cloudSchemaPrefix + ref
+	name, rawQuery, _ := strings.Cut(ref, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("invalid schema reference %s: %w", src, err)
+	}
+	if name == "" {
+		return "", fmt.Errorf("invalid schema reference %s: the name of a query set is required", src)
+	}
+	tag := query.Get("tag")
+	if f.cloud.Project == "" {
+		return "", fmt.Errorf("schema %s refers to a cloud project, but the \"project\" field of the \"cloud\" section isn't set", src)
+	}
+
+	key := strings.Join([]string{f.cloud.Hostname, f.cloud.Project, name, tag}, "\x00")
+	local := filepath.Join(f.dir, fmt.Sprintf("%x", sha256.Sum256([]byte(key))))
+	_, cachedErr := os.Stat(local)
+	return local, f.load(ctx, src, tag != "", cachedErr, func(ctx context.Context) (func() error, error) {
+		if f.cloud.AuthToken == "" {
+			return nil, fmt.Errorf("fetching schema %s: the SQLC_AUTH_TOKEN environment variable isn't set", src)
+		}
+		client, err := f.quick(f.cloud)
+		if err != nil {
+			return nil, fmt.Errorf("fetching schema %s: %w", src, err)
+		}
+		resp, err := client.GetQuerySets(ctx, &pb.GetQuerySetsRequest{Tag: tag})
+		if err != nil {
+			return nil, fmt.Errorf("fetching schema %s: %w", src, err)
+		}
+		return func() error {
+			for _, qs := range resp.QuerySets {
+				if qs.Name == name {
+					return writeCachedDir(local, qs.Schema)
+				}
+			}
+			return fmt.Errorf("schema %s: the cloud project has no query set named %s", src, name)
+		}, nil
+	}, rep)
+}
+
+// load makes sure there's a usable cached copy of the schema at src. A pinned
+// schema whose cached copy is usable isn't fetched again. Otherwise download
+// fetches it and returns a function storing it in the cache. If fetching fails,
+// the cached copy is used with a warning. cachedErr tells why the cached copy
+// can't be used, if it can't.
+func (f *schemaFetcher) load(ctx context.Context, src string, pinned bool, cachedErr error, download func(context.Context) (func() error, error), rep *Report) error {
+	switch {
+	case cachedErr == nil && pinned:
+		return nil
+	case f.noRemote:
+		if errors.Is(cachedErr, fs.ErrNotExist) {
+			return fmt.Errorf("schema %s isn't cached, and fetching is disabled by --no-remote", src)
+		}
+		if cachedErr != nil {
+			return fmt.Errorf("schema %s can't be used, as %s, and fetching is disabled by --no-remote", src, cachedErr)
+		}
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+	store, err := download(ctx)
+	if err != nil {
+		if cachedErr != nil {
+			return err
+		}
+		rep.Warn("", Problem{
+			Code:    codeCachedSchema,
+			Message: fmt.Sprintf("%s; using the cached copy", err),
+		})
+		return nil
+	}
+	return store()
+}
+
+func (f *schemaFetcher) download(ctx context.Context, src string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", src, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching schema %s: %w", src, err)
+	}
+	req.Header.Set("User-Agent", fmt.Sprintf("sqlc/%s Go/%s (%s %s)", info.Version, runtime.Version(), runtime.GOOS, runtime.GOARCH))
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching schema %s: %w", src, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching schema %s: %s", src, resp.Status)
+	}
+	blob, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("fetching schema %s: %w", src, err)
+	}
+	return blob, nil
+}
+
+// writeCached replaces the cached copy at local, so that it's never left
+// partly written.
+func writeCached(local string, blob []byte) error {
+	if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(local), ".schema-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(blob)
+	err = errors.Join(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), local)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// writeCachedDir replaces the cached directory at local with the files of a
+// query set. The files are numbered, so that they're read in the order they
+// were uploaded in.
+func writeCachedDir(local string, files []*pb.File) error {
+	tmp, err := os.MkdirTemp(filepath.Dir(local), ".schema-*")
+	if err != nil {
+		return err
+	}
+	for i, file := range files {
+		name := path.Base(filepath.ToSlash(file.Name))
+		if path.Ext(name) != ".sql" {
+			name += ".sql"
+		}
+		name = fmt.Sprintf("%03d_%s", i, name)
+		if err = os.WriteFile(filepath.Join(tmp, name), file.Contents, 0644); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		if err = os.RemoveAll(local); err == nil {
+			err = os.Rename(tmp, local)
+		}
+	}
+	if err != nil {
+		os.RemoveAll(tmp)
+	}
+	return err
+}
+
+func checksum(blob []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(blob))
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	pb "github.com/sqlc-dev/sqlc/internal/quickdb/v1"
+)
+
+const remoteSchema = "CREATE TABLE authors (id BIGSERIAL PRIMARY KEY);\n"
+
+func TestSchemaFetcher(t *testing.T) {
+	t.Setenv("SQLCCACHE", t.TempDir())
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(remoteSchema))
+	}))
+	defer srv.Close()
+
+	fetcher := func(noRemote bool) *schemaFetcher {
+		f, err := newSchemaFetcher(Env{NoRemote: noRemote}, config.Cloud{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.client = srv.Client()
+		return f
+	}
+	var out bytes.Buffer
+	rep := newReport(FormatText, "", &out)
+	ctx := context.Background()
+	url := srv.URL + "/schema.sql"
+
+	if _, err := fetcher(true).fetch(ctx, url, rep); err == nil {
+		t.Errorf("expected an error fetching an uncached schema with --no-remote")
+	}
+
+	local, err := fetcher(false).fetch(ctx, url, rep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blob, err := os.ReadFile(local); err != nil || string(blob) != remoteSchema {
+		t.Errorf("cached copy = %q, %v", blob, err)
+	}
+
+	if _, err := fetcher(false).fetch(ctx, url+"#sha256=00", rep); err == nil || !strings.Contains(err.Error(), "invalid checksum") {
+		t.Errorf("expected a checksum error, got %v", err)
+	}
+	if got, err := fetcher(true).fetch(ctx, url+"#sha256="+checksum([]byte(remoteSchema)), rep); err != nil || got != local {
+		t.Errorf("pinned fetch = %s, %v; want %s", got, err, local)
+	}
+	if _, err := fetcher(true).fetch(ctx, url+"#sha256=00", rep); err == nil || !strings.Contains(err.Error(), "cached copy has checksum") {
+		t.Errorf("expected a checksum error with --no-remote, got %v", err)
+	}
+
+	srv.Close()
+	if got, err := fetcher(false).fetch(ctx, url, rep); err != nil || got != local {
+		t.Errorf("fetch after failure = %s, %v; want %s", got, err, local)
+	}
+	if !strings.Contains(out.String(), "using the cached copy [cached-schema]") {
+		t.Errorf("expected a warning, got %q", out.String())
+	}
+
+	// The schemas of the configuration are replaced without changing the
+	// slices it may share with others
+	schema := []string{"schema.sql", url}
+	conf := &config.Config{SQL: []config.SQL{{Schema: schema}}}
+	if err := fetchRemoteSchemas(ctx, conf, Env{NoRemote: true}, rep); err != nil {
+		t.Fatal(err)
+	}
+	if got := conf.SQL[0].Schema; got[0] != "schema.sql" || got[1] != local {
+		t.Errorf("schema = %v; want [schema.sql %s]", got, local)
+	}
+	if schema[1] != url {
+		t.Errorf("shared schema slice was changed to %v", schema)
+	}
+}
+
+// fakeQuickClient returns the query sets of the uploads with the tag requested.
+type fakeQuickClient struct {
+	pb.QuickClient
+	uploads map[string][]*pb.QuerySet
+	err     error
+}
+
+func (c *fakeQuickClient) GetQuerySets(ctx context.Context, req *pb.GetQuerySetsRequest, opts ...grpc.CallOption) (*pb.GetQuerySetsResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &pb.GetQuerySetsResponse{QuerySets: c.uploads[req.Tag]}, nil
+}
+
+func TestCloudSchemaFetcher(t *testing.T) {
+	t.Setenv("SQLCCACHE", t.TempDir())
+	client := &fakeQuickClient{uploads: map[string][]*pb.QuerySet{
+		"": {{Name: "authors", Schema: []*pb.File{
+			{Name: "schema/2_books.sql", Contents: []byte("CREATE TABLE books (id int);")},
+			{Name: "schema/10_authors.sql", Contents: []byte(remoteSchema)},
+		}}},
+	}}
+	cloud := config.Cloud{Project: "01HA8TWGMYPHK0V2GGMB3R2TP9", AuthToken: "sqlc_token"}
+	fetcher := func(noRemote bool) *schemaFetcher {
+		f, err := newSchemaFetcher(Env{NoRemote: noRemote}, cloud)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.quick = func(config.Cloud) (pb.QuickClient, error) { return client, nil }
+		return f
+	}
+	var out bytes.Buffer
+	rep := newReport(FormatText, "", &out)
+	ctx := context.Background()
+
+	local, err := fetcher(false).fetch(ctx, "sqlc://authors", rep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := os.ReadDir(local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, file := range files {
+		names = append(names, file.Name())
+	}
+	if diff := cmp.Diff([]string{"000_2_books.sql", "001_10_authors.sql"}, names); diff != "" {
+		t.Errorf("cached files mismatch:\n%s", diff)
+	}
+
+	if _, err := fetcher(false).fetch(ctx, "sqlc://books", rep); err == nil || !strings.Contains(err.Error(), "no query set named books") {
+		t.Errorf("expected an error for an unknown query set, got %v", err)
+	}
+	if _, err := fetcher(true).fetch(ctx, "sqlc://authors?tag=v1", rep); err == nil || !strings.Contains(err.Error(), "isn't cached") {
+		t.Errorf("expected an error fetching an uncached tag with --no-remote, got %v", err)
+	}
+
+	client.err = errors.New("unavailable")
+	if got, err := fetcher(false).fetch(ctx, "sqlc://authors", rep); err != nil || got != local {
+		t.Errorf("fetch after failure = %s, %v; want %s", got, err, local)
+	}
+	if !strings.Contains(out.String(), "unavailable; using the cached copy [cached-schema]") {
+		t.Errorf("expected a warning, got %q", out.String())
+	}
+
+	f := fetcher(false)
+	f.cloud.Project = ""
+	if _, err := f.fetch(ctx, "sqlc://authors", rep); err == nil || !strings.Contains(err.Error(), `"project" field`) {
+		t.Errorf("expected an error without a project, got %v", err)
+	}
+}
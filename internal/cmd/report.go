@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Problem is an error or warning, as written by --format=json. Positions are
+// 1-based, and the end is just past the text the problem is about.
+type Problem struct {
+	Type      string `json:"type"`
+	Package   string `json:"package,omitempty"`
+	File      string `json:"file,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Column    int    `json:"column,omitempty"`
+	EndLine   int    `json:"end_line,omitempty"`
+	EndColumn int    `json:"end_column,omitempty"`
+	Query     string `json:"query,omitempty"`
+	Severity  string `json:"severity"`
+	Code      string `json:"code,omitempty"`
+	Message   string `json:"message"`
+}
+
+// Summary follows the problems written by --format=json.
+type Summary struct {
+	Type     string `json:"type"`
+	Errors   int    `json:"errors"`
+	Warnings int    `json:"warnings"`
+}
+
+// Report collects the problems found processing a package. In the text
+// format they're written to w as they're found, as they always have been. In
+// the JSON format they're kept, to be written once all packages are done.
+type Report struct {
+	format   string
+	dir      string
+	pkg      string
+	w        io.Writer
+	problems []Problem
+	// strict turns warnings into errors, other than those whose codes are
+	// suppressed, which aren't reported at all.
+	strict   bool
+	suppress map[string]bool
+	// escalated counts the warnings that were reported as errors.
+	escalated int
+}
+
+func newReport(format, dir string, w io.Writer) *Report {
+	if format == "" {
+		format = FormatText
+	}
+	return &Report{format: format, dir: dir, w: w}
+}
+
+// withWarnings sets how the report treats warnings, as configured by the
+// --strict flag and the strict and suppress_warnings options.
+func (r *Report) withWarnings(e Env, conf *config.Config) *Report {
+	r.strict = strictMode(e, conf)
+	r.suppress = map[string]bool{}
+	for _, code := range conf.SuppressWarnings {
+		r.suppress[code] = true
+	}
+	return r
+}
+
+// forPackage returns a report of the problems in a package, written to w in
+// the text format.
+func (r *Report) forPackage(w io.Writer) *Report {
+	return &Report{format: r.format, dir: r.dir, w: w, strict: r.strict, suppress: r.suppress}
+}
+
+// merge adds the problems recorded by o, a report of the same package whose
+// text format was written to text, to r.
+func (r *Report) merge(o *Report, text []byte) {
+	if r.format == FormatText {
+		r.w.Write(text)
+	}
+	r.problems = append(r.problems, o.problems...)
+	r.escalated += o.escalated
+}
+
+// Printf writes text that's only part of the text format, such as headers.
+func (r *Report) Printf(format string, args ...any) {
+	if r.format == FormatText {
+		fmt.Fprintf(r.w, format, args...)
+	}
+}
+
+// Add records p, which the text format writes as the line format describes.
+func (r *Report) Add(p Problem, format string, args ...any) {
+	if r.format == FormatText {
+		fmt.Fprintf(r.w, format, args...)
+		return
+	}
+	p.Type = "problem"
+	if p.Package == "" {
+		p.Package = r.pkg
+	}
+	if p.Severity == "" {
+		p.Severity = SeverityError
+	}
+	r.problems = append(r.problems, p)
+}
+
+// Warn records a warning from source, such as the name of a plugin, which the
+// text format writes it after. Warnings whose codes are suppressed are left
+// out, and in strict mode warnings are errors. The text format writes the code
+// after the message, so that it can be looked up and suppressed.
+func (r *Report) Warn(source string, p Problem) {
+	if p.Code != "" && r.suppress[p.Code] {
+		return
+	}
+	p.Severity = SeverityWarning
+	if r.strict {
+		p.Severity = SeverityError
+		r.escalated++
+	}
+	prefix := ""
+	if source != "" {
+		prefix = source + ": "
+	}
+	msg := p.Message
+	if p.Code != "" {
+		msg += " [" + p.Code + "]"
+	}
+	r.Add(p, "%s%s: %s\n", prefix, p.Severity, msg)
+}
+
+// Error records an error that isn't about a file.
+func (r *Report) Error(err error, format string, args ...any) {
+	r.Add(Problem{Code: errorCode(err), Message: err.Error()}, format, args...)
+}
+
+// FileError records an error found in a schema or query file.
+func (r *Report) FileError(fileErr *multierr.FileError) {
+	filename := r.relative(fileErr.Filename)
+	r.Add(Problem{
+		File:      filename,
+		Line:      fileErr.Line,
+		Column:    fileErr.Column,
+		EndLine:   fileErr.EndLine,
+		EndColumn: fileErr.EndColumn,
+		Code:      errorCode(fileErr.Err),
+		Message:   fileErr.Err.Error(),
+	}, "%s:%d:%d: %s\n", filename, fileErr.Line, fileErr.Column, fileErr.Err)
+}
+
+// relative returns filename relative to the directory of the configuration
+// file, as problems are reported.
+func (r *Report) relative(filename string) string {
+	rel, err := filepath.Rel(r.dir, filename)
+	if err != nil {
+		return filename
+	}
+	return rel
+}
+
+// writeProblems writes the problems of reports as JSON, one object per line,
+// followed by a summary, if the format is JSON.
+func writeProblems(w io.Writer, format string, reports ...*Report) error {
+	if format != FormatJSON {
+		return nil
+	}
+	enc := json.NewEncoder(w)
+	summary := Summary{Type: "summary"}
+	for _, r := range reports {
+		for _, p := range r.problems {
+			if p.Severity == SeverityError {
+				summary.Errors++
+			} else {
+				summary.Warnings++
+			}
+			if err := enc.Encode(p); err != nil {
+				return err
+			}
+		}
+	}
+	return enc.Encode(summary)
+}
+
+// writeConfigProblem writes err, an error reading or validating the
+// configuration file at path, as JSON if the format is JSON. The text format
+// has written it to stderr already. The path is empty if the file wasn't
+// found.
+func writeConfigProblem(w io.Writer, format, path string, err error) error {
+	if format != FormatJSON {
+		return nil
+	}
+	p := Problem{Message: err.Error()}
+	if path != "" {
+		p.File = filepath.Base(path)
+	}
+	rep := newReport(format, "", nil)
+	rep.Add(p, "")
+	return writeProblems(w, format, rep)
+}
+
+// strictMode reports whether warnings are errors, as they are if either the
+// --strict flag or the strict option is set.
+func strictMode(e Env, conf *config.Config) bool {
+	return e.Strict || (conf != nil && conf.Strict)
+}
+
+// errorCode returns the code of a database error, such as 42703, if err is
+// one.
+func errorCode(err error) string {
+	var serr *sqlerr.Error
+	if errors.As(err, &serr) {
+		return serr.Code
+	}
+	return ""
+}
@@ -1,6 +1,10 @@
 package cmd
 
 import (
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"github.com/sqlc-dev/sqlc/internal/compiler"
 	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/config/convert"
@@ -11,12 +15,24 @@ import (
 
 func pluginSettings(r *compiler.Result, cs config.CombinedSettings) *plugin.Settings {
 	return &plugin.Settings{
-		Version: cs.Global.Version,
-		Engine:  string(cs.Package.Engine),
-		Schema:  []string(cs.Package.Schema),
-		Queries: []string(cs.Package.Queries),
-		Codegen: pluginCodegen(cs, cs.Codegen),
+		Version:      cs.Global.Version,
+		Engine:       string(cs.Package.Engine),
+		Schema:       r.SchemaFiles,
+		Queries:      r.QueryFiles,
+		Codegen:      pluginCodegen(cs, cs.Codegen),
+		SchemaSource: pluginSchemaSource(cs),
+
+		DatabaseAnalysis: r.DatabaseAnalysis,
+	}
+}
+
+func pluginSchemaSource(cs config.CombinedSettings) bool {
+	for _, p := range cs.Global.Plugins {
+		if p.Name == cs.Codegen.Plugin {
+			return p.SchemaSource
+		}
 	}
+	return false
 }
 
 func pluginCodegen(cs config.CombinedSettings, s config.Codegen) *plugin.Codegen {
@@ -59,7 +75,50 @@ func pluginWASM(p config.Plugin) *plugin.Codegen_WASM {
 	return nil
 }
 
+// pluginCompositeColumns returns the fields of a composite type, with the
+// types of domains resolved like those of table columns.
+func pluginCompositeColumns(c *catalog.Catalog, ct *catalog.CompositeType) []*plugin.Column {
+	var columns []*plugin.Column
+	for _, f := range ct.Columns {
+		col := &plugin.Column{
+			Name: f.Name,
+			Type: &plugin.Identifier{
+				Catalog: f.Type.Catalog,
+				Schema:  f.Type.Schema,
+				Name:    f.Type.Name,
+			},
+			IsArray:   f.IsArray,
+			ArrayDims: int32(f.ArrayDims),
+			Length:    -1,
+			Precision: -1,
+			Scale:     -1,
+		}
+		if f.Length != nil {
+			col.Length = int32(*f.Length)
+		}
+		if f.Precision != nil {
+			col.Precision = int32(*f.Precision)
+		}
+		if f.Scale != nil {
+			col.Scale = int32(*f.Scale)
+		}
+		if domain, ok := c.ResolveDomain(&f.Type); ok {
+			col.Domain = col.Type
+			col.Type = &plugin.Identifier{
+				Catalog: domain.BaseType.Catalog,
+				Schema:  domain.BaseType.Schema,
+				Name:    domain.BaseType.Name,
+			}
+			col.IsArray = col.IsArray || domain.IsArray
+			col.ArrayDims += int32(domain.ArrayDims)
+		}
+		columns = append(columns, col)
+	}
+	return columns
+}
+
 func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
+	resolveDomain := c.ResolveDomain
 	var schemas []*plugin.Schema
 	for _, s := range c.Schemas {
 		var enums []*plugin.Enum
@@ -71,11 +130,14 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 					Name:    typ.Name,
 					Comment: typ.Comment,
 					Vals:    typ.Vals,
+					Source:  pluginSource(typ.Source),
 				})
 			case *catalog.CompositeType:
 				cts = append(cts, &plugin.CompositeType{
 					Name:    typ.Name,
 					Comment: typ.Comment,
+					Source:  pluginSource(typ.Source),
+					Columns: pluginCompositeColumns(c, typ),
 				})
 			}
 		}
@@ -87,25 +149,50 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 				if c.Length != nil {
 					l = *c.Length
 				}
-				columns = append(columns, &plugin.Column{
+				p, s := -1, -1
+				if c.Precision != nil {
+					p = *c.Precision
+				}
+				if c.Scale != nil {
+					s = *c.Scale
+				}
+				col := &plugin.Column{
 					Name: c.Name,
 					Type: &plugin.Identifier{
 						Catalog: c.Type.Catalog,
 						Schema:  c.Type.Schema,
 						Name:    c.Type.Name,
 					},
-					Comment:   c.Comment,
-					NotNull:   c.IsNotNull,
-					Unsigned:  c.IsUnsigned,
-					IsArray:   c.IsArray,
-					ArrayDims: int32(c.ArrayDims),
-					Length:    int32(l),
+					Comment:       c.Comment,
+					NotNull:       c.IsNotNull,
+					Unsigned:      c.IsUnsigned,
+					IsArray:       c.IsArray,
+					ArrayDims:     int32(c.ArrayDims),
+					Length:        int32(l),
+					Precision:     int32(p),
+					Scale:         int32(s),
+					HasDefault:    c.HasDefault,
+					DefaultExpr:   c.DefaultExpr,
+					IsGenerated:   c.IsGenerated,
+					GeneratedExpr: c.GeneratedExpr,
+					CheckValues:   c.CheckValues,
 					Table: &plugin.Identifier{
 						Catalog: t.Rel.Catalog,
 						Schema:  t.Rel.Schema,
 						Name:    t.Rel.Name,
 					},
-				})
+				}
+				if domain, ok := resolveDomain(&c.Type); ok {
+					col.Domain = col.Type
+					col.Type = &plugin.Identifier{
+						Catalog: domain.BaseType.Catalog,
+						Schema:  domain.BaseType.Schema,
+						Name:    domain.BaseType.Name,
+					}
+					col.IsArray = col.IsArray || domain.IsArray
+					col.ArrayDims += int32(domain.ArrayDims)
+				}
+				columns = append(columns, col)
 			}
 			tables = append(tables, &plugin.Table{
 				Rel: &plugin.Identifier{
@@ -113,8 +200,30 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 					Schema:  t.Rel.Schema,
 					Name:    t.Rel.Name,
 				},
-				Columns: columns,
-				Comment: t.Comment,
+				Columns:           columns,
+				Comment:           t.Comment,
+				PrimaryKey:        pluginPrimaryKey(t),
+				UniqueConstraints: pluginUniqueConstraints(t),
+				ForeignKeys:       pluginForeignKeys(c, t),
+				IsView:            t.IsView,
+				ViewDefinition:    t.ViewDefinition,
+				PartitionOf:       pluginPartitionOf(t),
+				Indexes:           pluginIndexes(t),
+				Source:            pluginSource(t.Source),
+				Renames:           pluginRenames(t),
+			})
+		}
+		var sequences []*plugin.Sequence
+		for _, seq := range s.Sequences {
+			sequences = append(sequences, &plugin.Sequence{
+				Name: seq.Name,
+				Type: &plugin.Identifier{
+					Catalog: seq.Type.Catalog,
+					Schema:  seq.Type.Schema,
+					Name:    seq.Type.Name,
+				},
+				Start:     seq.Start,
+				Increment: seq.Increment,
 			})
 		}
 		schemas = append(schemas, &plugin.Schema{
@@ -123,14 +232,170 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 			Tables:         tables,
 			Enums:          enums,
 			CompositeTypes: cts,
+			Sequences:      sequences,
 		})
 	}
+	var extensions []string
+	for name := range c.Extensions {
+		extensions = append(extensions, name)
+	}
+	sort.Strings(extensions)
 	return &plugin.Catalog{
 		Name:          c.Name,
 		DefaultSchema: c.DefaultSchema,
 		Comment:       c.Comment,
 		Schemas:       schemas,
+		Extensions:    extensions,
+	}
+}
+
+// hasConstraintColumns reports whether every column in a constraint still
+// exists on the table. Dropping a column drops the constraints that use it.
+func hasConstraintColumns(t *catalog.Table, columns []string) bool {
+	for _, name := range columns {
+		found := false
+		for _, c := range t.Columns {
+			if c.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func pluginPrimaryKey(t *catalog.Table) []string {
+	if t.PrimaryKey == nil || !hasConstraintColumns(t, t.PrimaryKey.Columns) {
+		return nil
+	}
+	return t.PrimaryKey.Columns
+}
+
+func pluginUniqueConstraints(t *catalog.Table) []*plugin.UniqueConstraint {
+	var out []*plugin.UniqueConstraint
+	for _, con := range t.UniqueConstraints {
+		if !hasConstraintColumns(t, con.Columns) {
+			continue
+		}
+		out = append(out, &plugin.UniqueConstraint{
+			Name:    con.Name,
+			Columns: con.Columns,
+		})
+	}
+	return out
+}
+
+// pluginForeignKeys returns the foreign keys of a table. Keys that implicitly
+// reference the primary key list its columns explicitly.
+func pluginForeignKeys(c *catalog.Catalog, t *catalog.Table) []*plugin.ForeignKey {
+	var out []*plugin.ForeignKey
+	for _, fk := range t.ForeignKeys {
+		if fk.RefTable == nil || !hasConstraintColumns(t, fk.Columns) {
+			continue
+		}
+		ref := &plugin.Identifier{
+			Catalog: fk.RefTable.Catalog,
+			Schema:  fk.RefTable.Schema,
+			Name:    fk.RefTable.Name,
+		}
+		if ref.Schema == "" {
+			ref.Schema = c.DefaultSchema
+		}
+		refColumns := fk.RefColumns
+		if len(refColumns) == 0 {
+			refColumns = referencedPrimaryKey(c, ref)
+		}
+		out = append(out, &plugin.ForeignKey{
+			Name:       fk.Name,
+			Columns:    fk.Columns,
+			RefTable:   ref,
+			RefColumns: refColumns,
+			OnDelete:   fk.OnDelete,
+			OnUpdate:   fk.OnUpdate,
+		})
+	}
+	return out
+}
+
+func pluginPartitionOf(t *catalog.Table) *plugin.Identifier {
+	if t.PartitionOf == nil {
+		return nil
+	}
+	return &plugin.Identifier{
+		Catalog: t.PartitionOf.Catalog,
+		Schema:  t.PartitionOf.Schema,
+		Name:    t.PartitionOf.Name,
+	}
+}
+
+func pluginIndexes(t *catalog.Table) []*plugin.Index {
+	var out []*plugin.Index
+	for _, idx := range t.Indexes {
+		var names []string
+		columns := make([]*plugin.IndexColumn, 0, len(idx.Columns))
+		for _, col := range idx.Columns {
+			if col.Name != "" {
+				names = append(names, col.Name)
+			}
+			columns = append(columns, &plugin.IndexColumn{
+				Name: col.Name,
+				Expr: col.Expr,
+			})
+		}
+		if !hasConstraintColumns(t, names) {
+			continue
+		}
+		out = append(out, &plugin.Index{
+			Name:    idx.Name,
+			Unique:  idx.Unique,
+			Columns: columns,
+			Where:   idx.Where,
+			Method:  idx.Method,
+		})
+	}
+	return out
+}
+
+func pluginSource(s *catalog.Source) *plugin.Source {
+	if s == nil {
+		return nil
+	}
+	return &plugin.Source{
+		Text:     s.Text,
+		Filename: s.Filename,
+		Line:     int32(s.Line),
+		Altered:  s.Altered,
+	}
+}
+
+func pluginRenames(t *catalog.Table) []*plugin.Rename {
+	var renames []*plugin.Rename
+	for _, r := range t.Renames {
+		renames = append(renames, &plugin.Rename{
+			Column:   r.Column,
+			OldName:  r.OldName,
+			NewName:  r.NewName,
+			Filename: r.Filename,
+		})
+	}
+	return renames
+}
+
+func referencedPrimaryKey(c *catalog.Catalog, ref *plugin.Identifier) []string {
+	for _, s := range c.Schemas {
+		if s.Name != ref.Schema {
+			continue
+		}
+		for _, t := range s.Tables {
+			if t.Rel.Name == ref.Name {
+				return pluginPrimaryKey(t)
+			}
+		}
 	}
+	return nil
 }
 
 func pluginQueries(r *compiler.Result) []*plugin.Query {
@@ -152,6 +417,13 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 				Name:    q.InsertIntoTable.Name,
 			}
 		}
+		var overrides []*plugin.QueryOverride
+		for _, o := range q.Metadata.Overrides {
+			overrides = append(overrides, &plugin.QueryOverride{
+				Column: o.Column,
+				GoType: o.GoType,
+			})
+		}
 		out = append(out, &plugin.Query{
 			Name:            q.Metadata.Name,
 			Cmd:             q.Metadata.Cmd,
@@ -160,7 +432,13 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 			Columns:         columns,
 			Params:          params,
 			Filename:        q.Metadata.Filename,
+			Line:            int32(q.Metadata.Line),
+			Column:          int32(q.Metadata.Column),
 			InsertIntoTable: iit,
+			Overrides:       overrides,
+			ParamStyle:      q.Metadata.ParamStyle,
+			NotFound:        q.Metadata.NotFound,
+			Deprecated:      q.Metadata.Deprecated,
 		})
 	}
 	return out
@@ -171,18 +449,34 @@ func pluginQueryColumn(c *compiler.Column) *plugin.Column {
 	if c.Length != nil {
 		l = *c.Length
 	}
+	p, s := -1, -1
+	if c.Precision != nil {
+		p = *c.Precision
+	}
+	if c.Scale != nil {
+		s = *c.Scale
+	}
 	out := &plugin.Column{
-		Name:         c.Name,
-		OriginalName: c.OriginalName,
-		Comment:      c.Comment,
-		NotNull:      c.NotNull,
-		Unsigned:     c.Unsigned,
-		IsArray:      c.IsArray,
-		ArrayDims:    int32(c.ArrayDims),
-		Length:       int32(l),
-		IsNamedParam: c.IsNamedParam,
-		IsFuncCall:   c.IsFuncCall,
-		IsSqlcSlice:  c.IsSqlcSlice,
+		Name:          c.Name,
+		OriginalName:  c.OriginalName,
+		Comment:       c.Comment,
+		NotNull:       c.NotNull,
+		Unsigned:      c.Unsigned,
+		IsArray:       c.IsArray,
+		ArrayDims:     int32(c.ArrayDims),
+		Length:        int32(l),
+		Precision:     int32(p),
+		Scale:         int32(s),
+		IsNamedParam:  c.IsNamedParam,
+		IsFuncCall:    c.IsFuncCall,
+		IsSqlcSlice:   c.IsSqlcSlice,
+		HasDefault:    c.HasDefault,
+		DefaultExpr:   c.DefaultExpr,
+		IsGenerated:   c.IsGenerated,
+		GeneratedExpr: c.GeneratedExpr,
+		OrderBy:       c.OrderBy,
+
+		AnalyzedFromDatabase: c.AnalyzedFromDatabase,
 	}
 
 	if c.Type != nil {
@@ -211,6 +505,15 @@ func pluginQueryColumn(c *compiler.Column) *plugin.Column {
 			Schema:  c.EmbedTable.Schema,
 			Name:    c.EmbedTable.Name,
 		}
+		out.EmbedName = c.EmbedName
+	}
+
+	if c.Domain != nil {
+		out.Domain = &plugin.Identifier{
+			Catalog: c.Domain.Catalog,
+			Schema:  c.Domain.Schema,
+			Name:    c.Domain.Name,
+		}
 	}
 
 	return out
@@ -223,11 +526,59 @@ func pluginQueryParam(p compiler.Parameter) *plugin.Parameter {
 	}
 }
 
+// pluginProtocolVersion is sent to plugins as the protocol version of the
+// request. It is increased when the meaning of an existing field changes;
+// new fields are announced as features instead.
+const pluginProtocolVersion = 1
+
+// pluginFeatures maps each feature of the request plugins can require to the
+// fields the shim fills in for it. TestPluginFeatures checks that each field
+// is filled in, and that each field added to the request since features were
+// introduced belongs to one, so that the list can't drift from the shim.
+var pluginFeatures = map[string][]protoreflect.FullName{
+	"analyzed_from_database": {"plugin.Column.analyzed_from_database"},
+	"check_values":           {"plugin.Column.check_values"},
+	"column_defaults":        {"plugin.Column.has_default", "plugin.Column.default_expr"},
+	"column_domains":         {"plugin.Column.domain"},
+	"composite_fields":       {"plugin.CompositeType.columns"},
+	"database_analysis":      {"plugin.Settings.database_analysis"},
+	"deprecated":             {"plugin.Query.deprecated"},
+	"embed_names":            {"plugin.Column.embed_name"},
+	"extensions":             {"plugin.Catalog.extensions"},
+	"foreign_keys":           {"plugin.Table.foreign_keys"},
+	"generated_columns":      {"plugin.Column.is_generated", "plugin.Column.generated_expr"},
+	"index_metadata":         {"plugin.Table.indexes"},
+	"not_found":              {"plugin.Query.not_found"},
+	"numeric_precision":      {"plugin.Column.precision", "plugin.Column.scale"},
+	"order_by":               {"plugin.Column.order_by"},
+	"param_style":            {"plugin.Query.param_style"},
+	"partitions":             {"plugin.Table.partition_of"},
+	"query_overrides":        {"plugin.Query.overrides"},
+	"query_positions":        {"plugin.Query.line", "plugin.Query.column"},
+	"renames":                {"plugin.Table.renames"},
+	"schema_source":          {"plugin.Settings.schema_source", "plugin.Table.source", "plugin.Enum.source", "plugin.CompositeType.source"},
+	"sequences":              {"plugin.Schema.sequences"},
+	"unique_constraints":     {"plugin.Table.primary_key", "plugin.Table.unique_constraints"},
+	"view_definitions":       {"plugin.Table.is_view", "plugin.Table.view_definition"},
+}
+
+// requestFeatures returns the sorted names of the features of the request.
+func requestFeatures() []string {
+	features := make([]string, 0, len(pluginFeatures))
+	for name := range pluginFeatures {
+		features = append(features, name)
+	}
+	sort.Strings(features)
+	return features
+}
+
 func codeGenRequest(r *compiler.Result, settings config.CombinedSettings) *plugin.GenerateRequest {
 	return &plugin.GenerateRequest{
-		Settings:    pluginSettings(r, settings),
-		Catalog:     pluginCatalog(r.Catalog),
-		Queries:     pluginQueries(r),
-		SqlcVersion: info.Version,
+		Settings:        pluginSettings(r, settings),
+		Catalog:         pluginCatalog(r.Catalog),
+		Queries:         pluginQueries(r),
+		SqlcVersion:     info.Version,
+		ProtocolVersion: pluginProtocolVersion,
+		Features:        requestFeatures(),
 	}
 }
@@ -0,0 +1,390 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+const featuresSchema = `
+CREATE EXTENSION hstore;
+
+CREATE DOMAIN email AS text;
+
+CREATE TABLE authors (
+  id BIGSERIAL PRIMARY KEY,
+  name text NOT NULL UNIQUE,
+  email email,
+  royalty numeric(5, 2) NOT NULL DEFAULT 0.1,
+  name_length int GENERATED ALWAYS AS (length(name)) STORED
+);
+
+CREATE INDEX authors_email_idx ON authors (email);
+
+CREATE TABLE books (
+  id BIGSERIAL PRIMARY KEY,
+  author_id bigint NOT NULL REFERENCES authors (id),
+  published date NOT NULL
+) PARTITION BY RANGE (published);
+
+CREATE TABLE books_2024 PARTITION OF books
+  FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+
+CREATE VIEW author_names AS SELECT name FROM authors;
+
+CREATE TABLE tags (label text NOT NULL);
+ALTER TABLE tags RENAME COLUMN label TO name;
+
+CREATE TYPE status AS ENUM ('draft', 'published');
+CREATE TYPE address AS (street text, city text);
+CREATE SEQUENCE invoice_numbers;
+
+CREATE TABLE drafts (
+  status status NOT NULL,
+  kind text NOT NULL CHECK (kind IN ('essay', 'poem')),
+  address address
+);
+`
+
+const featuresQuery = `
+-- name: ListAuthors :many
+-- param_style: struct
+-- sqlc.override: column=royalty go_type=string
+SELECT sqlc.embed(authors, 'author') FROM authors
+ORDER BY sqlc.orderby('name');
+
+-- name: GetAuthor :one
+-- not_found: ErrAuthorNotFound
+-- @deprecated use ListAuthors instead
+SELECT * FROM authors WHERE id = $1;
+`
+
+// generateRequest compiles schema and query and returns the request sent to
+// a plugin that asks for the source of the schema and contributes the :stream
+// command, which another plugin that doesn't generate code declares too.
+func generateRequest(t *testing.T, schema, query string) *plugin.GenerateRequest {
+	t.Helper()
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "schema.sql")
+	queryPath := filepath.Join(dir, "query.sql")
+	if err := os.WriteFile(schemaPath, []byte(schema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(queryPath, []byte(query), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sql := config.SQL{
+		Engine:  config.EnginePostgreSQL,
+		Schema:  []string{schemaPath},
+		Queries: []string{queryPath},
+		Codegen: []config.Codegen{{Plugin: "test"}},
+	}
+	combo := config.CombinedSettings{
+		Global: config.Config{
+			Plugins: []config.Plugin{
+				{Name: "other", Commands: []string{":stream"}},
+				{Name: "test", SchemaSource: true, Commands: []string{":stream"}},
+			},
+		},
+		Package: sql,
+		Codegen: config.Codegen{Plugin: "test"},
+	}
+	c, err := compiler.NewCompiler(sql, combo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ParseCatalog(sql.Schema); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ParseQueries(sql.Queries, opts.Parser{}); err != nil {
+		t.Fatal(err)
+	}
+	return codeGenRequest(c.Result(), combo)
+}
+
+// publicSchema returns the public schema of the catalog of req.
+func publicSchema(t *testing.T, req *plugin.GenerateRequest) *plugin.Schema {
+	t.Helper()
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name == "public" {
+			return schema
+		}
+	}
+	t.Fatal("no public schema")
+	return nil
+}
+
+// databaseFeatures are the features whose fields are only filled in when a
+// database analyzes the queries.
+var databaseFeatures = map[string]bool{
+	"analyzed_from_database": true,
+	"database_analysis":      true,
+}
+
+// baselineFields is the number of the last field of each message of the
+// request before features were introduced. Later fields must belong to a
+// feature, as must every field of messages added since.
+var baselineFields = map[protoreflect.FullName]protoreflect.FieldNumber{
+	"plugin.Catalog":         4,
+	"plugin.Codegen":         6,
+	"plugin.Codegen.Process": 1,
+	"plugin.Codegen.WASM":    2,
+	"plugin.Column":          17,
+	"plugin.CompositeType":   2,
+	"plugin.Enum":            3,
+	"plugin.GenerateRequest": 6,
+	"plugin.Identifier":      3,
+	"plugin.Parameter":       2,
+	"plugin.Query":           8,
+	"plugin.Schema":          5,
+	"plugin.Settings":        12,
+	"plugin.Table":           3,
+}
+
+// protocolFields are the fields of the request describing the protocol
+// itself, which belong to no feature.
+var protocolFields = map[protoreflect.FullName]bool{
+	"plugin.GenerateRequest.features":         true,
+	"plugin.GenerateRequest.protocol_version": true,
+	"plugin.GenerateRequest.strict":           true,
+}
+
+func TestPluginFeatures(t *testing.T) {
+	req := generateRequest(t, featuresSchema, featuresQuery)
+
+	featureFields := map[protoreflect.FullName]bool{}
+	for _, name := range req.Features {
+		for _, fieldName := range pluginFeatures[name] {
+			featureFields[fieldName] = true
+			desc, err := protoregistry.GlobalFiles.FindDescriptorByName(fieldName)
+			if err != nil {
+				t.Errorf("feature %s: %s", name, err)
+				continue
+			}
+			field, ok := desc.(protoreflect.FieldDescriptor)
+			if !ok {
+				t.Errorf("feature %s: %s is not a field", name, fieldName)
+				continue
+			}
+			if !databaseFeatures[name] && !hasField(req.ProtoReflect(), field) {
+				t.Errorf("feature %s: %s is never set", name, field.FullName())
+			}
+		}
+	}
+
+	// The fields of a feature may hold messages added along with it, whose
+	// fields needn't belong to a feature of their own
+	seen := map[protoreflect.FullName]bool{}
+	var walk func(md protoreflect.MessageDescriptor)
+	walk = func(md protoreflect.MessageDescriptor) {
+		if seen[md.FullName()] {
+			return
+		}
+		seen[md.FullName()] = true
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if featureFields[fd.FullName()] || protocolFields[fd.FullName()] {
+				continue
+			}
+			if fd.Number() > baselineFields[md.FullName()] {
+				t.Errorf("%s belongs to no feature", fd.FullName())
+			}
+			if fd.Message() != nil {
+				walk(fd.Message())
+			}
+		}
+	}
+	walk(req.ProtoReflect().Descriptor())
+}
+
+// hasField reports whether field is set on m or any message within it.
+func hasField(m protoreflect.Message, field protoreflect.FieldDescriptor) bool {
+	if m.Descriptor().FullName() == field.ContainingMessage().FullName() && m.Has(field) {
+		return true
+	}
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil || fd.IsMap() {
+			return true
+		}
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len() && !found; i++ {
+				found = hasField(list.Get(i).Message(), field)
+			}
+		} else {
+			found = hasField(v.Message(), field)
+		}
+		return !found
+	})
+	return found
+}
+
+func TestPluginSchemaSource(t *testing.T) {
+	req := generateRequest(t, `CREATE TYPE status AS ENUM ('open', 'closed');
+
+CREATE TYPE point AS (x INT, y INT);
+
+-- Authors write books
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL
+);
+
+CREATE VIEW author_names AS SELECT name FROM authors;
+
+ALTER TABLE authors ADD COLUMN status status NOT NULL DEFAULT 'open';
+ALTER TYPE status ADD VALUE 'archived';
+`, `-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+`)
+	schema := publicSchema(t, req)
+
+	got := map[string]*plugin.Source{}
+	for _, table := range schema.Tables {
+		got[table.Rel.Name] = table.Source
+	}
+	for _, enum := range schema.Enums {
+		got[enum.Name] = enum.Source
+	}
+	for _, ct := range schema.CompositeTypes {
+		got[ct.Name] = ct.Source
+	}
+	for _, tc := range []struct {
+		name    string
+		text    string
+		line    int32
+		altered bool
+	}{
+		{"authors", "-- Authors write books\nCREATE TABLE authors (\n  id   BIGSERIAL PRIMARY KEY,\n  name TEXT NOT NULL\n);\nALTER TABLE authors ADD COLUMN status status NOT NULL DEFAULT 'open'", 5, true},
+		{"author_names", "CREATE VIEW author_names AS SELECT name FROM authors", 11, false},
+		{"status", "CREATE TYPE status AS ENUM ('open', 'closed');\nALTER TYPE status ADD VALUE 'archived'", 1, true},
+		{"point", "CREATE TYPE point AS (x INT, y INT)", 3, false},
+	} {
+		src := got[tc.name]
+		if src == nil {
+			t.Errorf("%s: no source", tc.name)
+			continue
+		}
+		if src.Text != tc.text || src.Line != tc.line || src.Altered != tc.altered || filepath.Base(src.Filename) != "schema.sql" {
+			t.Errorf("%s: got %q at %s:%d (altered %t), want %q at schema.sql:%d (altered %t)",
+				tc.name, src.Text, src.Filename, src.Line, src.Altered, tc.text, tc.line, tc.altered)
+		}
+	}
+}
+
+func TestPluginGeneratedColumns(t *testing.T) {
+	req := generateRequest(t, `CREATE TABLE items (
+  id       BIGSERIAL PRIMARY KEY,
+  price    numeric NOT NULL,
+  quantity integer NOT NULL,
+  total    numeric GENERATED ALWAYS AS ((price * quantity)::numeric(12, 2)) STORED,
+  label    text NOT NULL GENERATED ALWAYS AS ('item ' || id) STORED
+);
+`, `-- name: ListItems :many
+SELECT * FROM items;
+`)
+	want := map[string]string{
+		"id":       "",
+		"price":    "",
+		"quantity": "",
+		"total":    "(price * quantity)::numeric(12, 2)",
+		"label":    "'item ' || id",
+	}
+	for _, table := range publicSchema(t, req).Tables {
+		for _, col := range table.Columns {
+			if col.GeneratedExpr != want[col.Name] {
+				t.Errorf("%s: got generated expression %q, want %q", col.Name, col.GeneratedExpr, want[col.Name])
+			}
+		}
+	}
+}
+
+func TestPluginEmbedNames(t *testing.T) {
+	req := generateRequest(t, `CREATE TABLE users (
+  id   integer NOT NULL PRIMARY KEY,
+  name text    NOT NULL
+);
+
+CREATE TABLE messages (
+  id          integer NOT NULL PRIMARY KEY,
+  sender_id   integer NOT NULL,
+  receiver_id integer
+);
+`, `-- name: GetMessage :one
+SELECT sqlc.embed(messages), sqlc.embed(s, 'sender'), sqlc.embed(r, 'receiver')
+FROM messages
+JOIN users s ON s.id = messages.sender_id
+LEFT JOIN users r ON r.id = messages.receiver_id
+WHERE messages.id = $1;
+`)
+	var got []string
+	for _, col := range req.Queries[0].Columns {
+		got = append(got, col.EmbedTable.GetName()+":"+col.EmbedName)
+	}
+	want := []string{"messages:", "users:sender", "users:receiver"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got embeds %v, want %v", got, want)
+	}
+}
+
+func TestPluginSequences(t *testing.T) {
+	req := generateRequest(t, `CREATE SEQUENCE order_seq;
+CREATE SEQUENCE users_id_seq AS integer START WITH 100 INCREMENT BY 5;
+CREATE TABLE users (
+  id   integer PRIMARY KEY DEFAULT nextval('users_id_seq'),
+  name text NOT NULL
+);
+ALTER SEQUENCE users_id_seq OWNED BY users.id;
+ALTER SEQUENCE order_seq INCREMENT BY -1;
+`, `-- name: NextUserID :one
+SELECT nextval('users_id_seq');
+`)
+	var got []string
+	for _, seq := range publicSchema(t, req).Sequences {
+		start, increment := "-", "-"
+		if seq.Start != nil {
+			start = strconv.FormatInt(*seq.Start, 10)
+		}
+		if seq.Increment != nil {
+			increment = strconv.FormatInt(*seq.Increment, 10)
+		}
+		got = append(got, fmt.Sprintf("%s %s.%s %s %s", seq.Name, seq.Type.Schema, seq.Type.Name, start, increment))
+	}
+	want := []string{"order_seq pg_catalog.int8 - -1", "users_id_seq pg_catalog.int4 100 5"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got sequences %q, want %q", got, want)
+	}
+}
+
+func TestPluginCommands(t *testing.T) {
+	req := generateRequest(t, "CREATE TABLE authors (id bigint NOT NULL, name text NOT NULL);", `
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: StreamAuthors :stream
+SELECT * FROM authors ORDER BY name;
+`)
+	cmds := map[string]string{}
+	for _, q := range req.Queries {
+		cmds[q.Name] = q.Cmd
+	}
+	want := map[string]string{"GetAuthor": ":one", "StreamAuthors": ":stream"}
+	if diff := cmp.Diff(want, cmds); diff != "" {
+		t.Errorf("commands mismatch:\n%s", diff)
+	}
+}
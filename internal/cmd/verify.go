@@ -54,6 +54,10 @@ func Verify(ctx context.Context, dir, filename string, opts *Options) error {
 	if err != nil {
 		return err
 	}
+	rep := newReport(FormatText, dir, stderr)
+	if err := fetchRemoteSchemas(ctx, conf, opts.Env, rep); err != nil {
+		return fmt.Errorf("error fetching schema: %w", err)
+	}
 
 	client, err := quickdb.NewClientFromConfig(conf.Cloud)
 	if err != nil {
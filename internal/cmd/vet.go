@@ -14,6 +14,7 @@ import (
 	"runtime/trace"
 	"slices"
 	"strings"
+	"text/template"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -23,13 +24,14 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/sqlc-dev/sqlc/internal/compiler"
 	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/dbmanager"
 	"github.com/sqlc-dev/sqlc/internal/debug"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/migrations"
 	"github.com/sqlc-dev/sqlc/internal/opts"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
-	"github.com/sqlc-dev/sqlc/internal/quickdb"
 	"github.com/sqlc-dev/sqlc/internal/shfmt"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
 	"github.com/sqlc-dev/sqlc/internal/vet"
@@ -40,7 +42,7 @@ var ErrFailedChecks = errors.New("failed checks")
 var pjson = protojson.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true}
 
 func NewCmdVet() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "vet",
 		Short: "Vet examines queries",
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -49,10 +51,19 @@ func NewCmdVet() *cobra.Command {
 			opts := &Options{
 				Env:    ParseEnv(cmd),
 				Stderr: stderr,
+				Stdout: cmd.OutOrStdout(),
+			}
+			if err := setFormat(cmd, opts); err != nil {
+				fmt.Fprintln(stderr, err)
+				os.Exit(1)
+			}
+			if err := setQueryFilter(cmd, opts); err != nil {
+				fmt.Fprintln(stderr, err)
+				os.Exit(1)
 			}
 			dir, name := getConfigPath(stderr, cmd.Flag("file"))
 			if err := Vet(cmd.Context(), dir, name, opts); err != nil {
-				if !errors.Is(err, ErrFailedChecks) {
+				if !errors.Is(err, ErrFailedChecks) && opts.Format != FormatJSON {
 					fmt.Fprintf(stderr, "%s\n", err)
 				}
 				os.Exit(1)
@@ -60,9 +71,25 @@ func NewCmdVet() *cobra.Command {
 			return nil
 		},
 	}
+	addQueryFilterFlags(cmd)
+	addFormatFlag(cmd)
+	return cmd
 }
 
 func Vet(ctx context.Context, dir, filename string, opts *Options) error {
+	rep := newReport(opts.Format, dir, opts.Stderr)
+	err := runVet(ctx, dir, filename, opts, rep)
+	// The text format has the caller write errors other than failed checks
+	if err != nil && !errors.Is(err, ErrFailedChecks) && opts.Format == FormatJSON {
+		rep.Error(err, "")
+	}
+	if werr := writeProblems(opts.Stdout, opts.Format, rep); werr != nil {
+		return werr
+	}
+	return err
+}
+
+func runVet(ctx context.Context, dir, filename string, opts *Options, rep *Report) error {
 	e := opts.Env
 	stderr := opts.Stderr
 	configPath, conf, err := readConfig(stderr, dir, filename)
@@ -70,14 +97,11 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 		return err
 	}
 
-	base := filepath.Base(configPath)
-	if err := config.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
+	if err := validateConfig(conf, e, filepath.Base(configPath), stderr); err != nil {
 		return err
 	}
 
-	if err := e.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
+	if err := fetchRemoteSchemas(ctx, conf, e, rep); err != nil {
 		return err
 	}
 
@@ -110,11 +134,27 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 	rules := map[string]rule{
 		constants.QueryRuleDbPrepare: {NeedsPrepare: true},
 	}
+	for name, b := range builtinRules {
+		r, err := newBuiltinRule(name, b, nil)
+		if err != nil {
+			return err
+		}
+		rules[name] = r
+	}
 
 	for _, c := range conf.Rules {
 		if c.Name == "" {
 			return fmt.Errorf("rules require a name")
 		}
+		// Declaring a built-in rule without an expression configures it
+		if b, ok := builtinRules[c.Name]; ok && c.Rule == "" {
+			r, err := newBuiltinRule(c.Name, b, &c)
+			if err != nil {
+				return err
+			}
+			rules[c.Name] = r
+			continue
+		}
 		if _, found := rules[c.Name]; found {
 			return fmt.Errorf("type-check error: a rule with the name '%s' already exists", c.Name)
 		}
@@ -147,14 +187,22 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 		Dir:           dir,
 		Env:           env,
 		Stderr:        stderr,
+		Report:        rep,
 		OnlyManagedDB: e.Debug.OnlyManagedDatabases,
 		Replacer:      shfmt.NewReplacer(nil),
+		ParseOpts:     opts.parserOpts(),
 	}
+	// Managed MySQL databases are dropped when the client is closed
+	defer func() {
+		if c.Client != nil {
+			c.Client.Close(ctx)
+		}
+	}()
 	errored := false
 	for _, sql := range conf.SQL {
 		if err := c.checkSQL(ctx, sql); err != nil {
 			if !errors.Is(err, ErrFailedChecks) {
-				fmt.Fprintf(stderr, "%s\n", err)
+				rep.Error(err, "%s\n", err)
 			}
 			errored = true
 		}
@@ -371,11 +419,44 @@ func (me *mysqlExplainer) Explain(ctx context.Context, query string, args ...*pl
 	return &vetEngineOutput{MySQL: &vet.MySQL{Explain: &explain}}, nil
 }
 
+// unexplainable returns why a query can't be passed to EXPLAIN as is, or an
+// empty string if it can.
+func unexplainable(q *plugin.Query) string {
+	if q.Cmd == metadata.CmdCopyFrom {
+		return "uses :copyfrom"
+	}
+	for _, p := range q.Params {
+		if p.Column != nil && p.Column.IsSqlcSlice {
+			return "uses sqlc.slice"
+		}
+	}
+	return ""
+}
+
+// boundParams returns the parameters that have a placeholder in the text of a
+// query. The parameter of sqlc.orderby is written into the text instead.
+func boundParams(q *plugin.Query) []*plugin.Parameter {
+	var params []*plugin.Parameter
+	for _, p := range q.Params {
+		if p.Column != nil && len(p.Column.OrderBy) > 0 {
+			continue
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
 type rule struct {
 	Program      *cel.Program
 	Message      string
 	NeedsPrepare bool
 	NeedsExplain bool
+
+	// Set for built-in rules, which have a message template instead of a
+	// message
+	Builtin  *builtinRule
+	Template *template.Template
+	Options  ruleOptions
 }
 
 type checker struct {
@@ -384,9 +465,11 @@ type checker struct {
 	Dir           string
 	Env           *cel.Env
 	Stderr        io.Writer
+	Report        *Report
 	OnlyManagedDB bool
 	Client        dbmanager.Client
 	Replacer      *shfmt.Replacer
+	ParseOpts     opts.Parser
 }
 
 func (c *checker) fetchDatabaseUri(ctx context.Context, s config.SQL) (string, func() error, error) {
@@ -398,7 +481,7 @@ func (c *checker) fetchDatabaseUri(ctx context.Context, s config.SQL) (string, f
 		panic("fetch database URI called with nil database")
 	}
 	if !s.Database.Managed {
-		uri, err := c.DSN(s.Database.URI)
+		uri, err := s.Database.ResolveURI(c.Replacer)
 		return uri, cleanup, err
 	}
 
@@ -429,23 +512,7 @@ func (c *checker) fetchDatabaseUri(ctx context.Context, s config.SQL) (string, f
 		return "", cleanup, fmt.Errorf("managed: create database: %w", err)
 	}
 
-	var uri string
-	switch s.Engine {
-	case config.EngineMySQL:
-		dburi, err := quickdb.MySQLReformatURI(resp.Uri)
-		if err != nil {
-			return "", cleanup, fmt.Errorf("reformat uri: %w", err)
-		}
-		uri = dburi
-	default:
-		uri = resp.Uri
-	}
-
-	return uri, cleanup, nil
-}
-
-func (c *checker) DSN(dsn string) (string, error) {
-	return c.Replacer.Replace(dsn), nil
+	return resp.Uri, cleanup, nil
 }
 
 func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
@@ -453,24 +520,13 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 	combo := config.Combine(*c.Conf, s)
 
 	// TODO: This feels like a hack that will bite us later
-	joined := make([]string, 0, len(s.Schema))
-	for _, s := range s.Schema {
-		joined = append(joined, filepath.Join(c.Dir, s))
-	}
-	s.Schema = joined
-
-	joined = make([]string, 0, len(s.Queries))
-	for _, q := range s.Queries {
-		joined = append(joined, filepath.Join(c.Dir, q))
-	}
-	s.Queries = joined
+	s.Schema = sqlpath.Join(c.Dir, s.Schema)
+	s.Queries = sqlpath.Join(c.Dir, s.Queries)
 
 	var name string
-	parseOpts := opts.Parser{
-		Debug: debug.Debug,
-	}
+	parseOpts := c.ParseOpts
 
-	result, failed := parse(ctx, name, c.Dir, s, combo, parseOpts, c.Stderr)
+	result, failed := parse(ctx, name, c.Dir, s, combo, parseOpts, c.Report)
 	if failed {
 		return ErrFailedChecks
 	}
@@ -537,6 +593,9 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 	req := codeGenRequest(result, combo)
 	cfg := vetConfig(req)
 	for i, query := range req.Queries {
+		if !parseOpts.MatchQuery(query.Name) {
+			continue
+		}
 		md := result.Queries[i].Metadata
 		if md.Flags[constants.QueryFlagSqlcVetDisable] {
 			// If the vet disable flag is specified without any rules listed, all rules are ignored.
@@ -550,7 +609,8 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 			// Rules which are listed to be disabled but not declared in the config file are rejected.
 			for r := range md.RuleSkiplist {
 				if !slices.Contains(s.Rules, r) {
-					fmt.Fprintf(c.Stderr, "%s: %s: rule-check error: rule %q does not exist in the config file\n", query.Filename, query.Name, r)
+					msg := fmt.Sprintf("rule-check error: rule %q does not exist in the config file", r)
+					c.queryProblem(result.Queries[i], Problem{Message: msg}, "%s: %s: %s\n", query.Filename, query.Name, msg)
 					errored = true
 				}
 			}
@@ -574,18 +634,36 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 
 				if rule.NeedsPrepare {
 					if prep == nil {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: error preparing query: database connection required\n", query.Filename, query.Name, name)
+						msg := "error preparing query: database connection required"
+						c.queryProblem(result.Queries[i], Problem{Code: name, Message: msg}, "%s: %s: %s: %s\n", query.Filename, query.Name, name, msg)
 						errored = true
 						continue
 					}
 					prepName := fmt.Sprintf("sqlc_vet_%d_%d", time.Now().Unix(), i)
 					if err := prep.Prepare(ctx, prepName, query.Text); err != nil {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: error preparing query: %s\n", query.Filename, query.Name, name, err)
+						msg := fmt.Sprintf("error preparing query: %s", err)
+						c.queryProblem(result.Queries[i], Problem{Code: name, Message: msg}, "%s: %s: %s: %s\n", query.Filename, query.Name, name, msg)
 						errored = true
 						continue
 					}
 				}
 
+				if rule.Builtin != nil {
+					opts := rule.Options
+					opts.DefaultSchema = req.Catalog.DefaultSchema
+					for _, v := range rule.Builtin.Check(result.Queries[i], opts) {
+						v.Query = query.Name
+						var msg strings.Builder
+						if err := rule.Template.Execute(&msg, v); err != nil {
+							c.queryProblem(result.Queries[i], Problem{Code: name, Message: err.Error()}, "%s\n", err)
+						} else {
+							c.queryProblem(result.Queries[i], Problem{Code: name, Message: msg.String()}, "%s: %s: %s: %s\n", query.Filename, query.Name, name, msg.String())
+						}
+						errored = true
+					}
+					continue
+				}
+
 				// short-circuit for "sqlc/db-prepare" rule which doesn't have a CEL program
 				if rule.Program == nil {
 					continue
@@ -595,14 +673,21 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 				_, pgsqlOK := evalMap["postgresql"]
 				_, mysqlOK := evalMap["mysql"]
 				if rule.NeedsExplain && !(pgsqlOK || mysqlOK) {
+					if reason := unexplainable(query); reason != "" {
+						msg := fmt.Sprintf("skipping rule, query can't be explained: %s", reason)
+						c.queryProblem(result.Queries[i], Problem{Severity: SeverityWarning, Code: name, Message: msg}, "%s: %s: %s: warning: %s\n", query.Filename, query.Name, name, msg)
+						continue
+					}
 					if expl == nil {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: error explaining query: database connection required\n", query.Filename, query.Name, name)
+						msg := "error explaining query: database connection required"
+						c.queryProblem(result.Queries[i], Problem{Code: name, Message: msg}, "%s: %s: %s: %s\n", query.Filename, query.Name, name, msg)
 						errored = true
 						continue
 					}
-					engineOutput, err := expl.Explain(ctx, query.Text, query.Params...)
+					engineOutput, err := expl.Explain(ctx, query.Text, boundParams(query)...)
 					if err != nil {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: error explaining query: %s\n", query.Filename, query.Name, name, err)
+						msg := fmt.Sprintf("error explaining query: %s", err)
+						c.queryProblem(result.Queries[i], Problem{Code: name, Message: msg}, "%s: %s: %s: %s\n", query.Filename, query.Name, name, msg)
 						errored = true
 						continue
 					}
@@ -625,11 +710,10 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 					return fmt.Errorf("expression returned non-bool value: %v", out.Value())
 				}
 				if tripped {
-					// TODO: Get line numbers in the output
 					if rule.Message == "" {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s\n", query.Filename, query.Name, name)
+						c.queryProblem(result.Queries[i], Problem{Code: name, Message: name}, "%s: %s: %s\n", query.Filename, query.Name, name)
 					} else {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: %s\n", query.Filename, query.Name, name, rule.Message)
+						c.queryProblem(result.Queries[i], Problem{Code: name, Message: rule.Message}, "%s: %s: %s: %s\n", query.Filename, query.Name, name, rule.Message)
 					}
 					errored = true
 				}
@@ -643,12 +727,29 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 	return nil
 }
 
+// queryProblem adds a problem with the query q, written in the text format as
+// the line format describes.
+func (c *checker) queryProblem(q *compiler.Query, p Problem, format string, args ...any) {
+	p.File = c.Report.relative(q.Path)
+	p.Line, p.Column = q.Line, q.Column
+	p.EndLine, p.EndColumn = q.EndLine, q.EndColumn
+	p.Query = q.Metadata.Name
+	c.Report.Add(p, format, args...)
+}
+
 func vetConfig(req *plugin.GenerateRequest) *vet.Config {
+	var deprecated []string
+	for _, q := range req.Queries {
+		if q.Deprecated != "" {
+			deprecated = append(deprecated, q.Name)
+		}
+	}
 	return &vet.Config{
-		Version: req.Settings.Version,
-		Engine:  req.Settings.Engine,
-		Schema:  req.Settings.Schema,
-		Queries: req.Settings.Queries,
+		Version:           req.Settings.Version,
+		Engine:            req.Settings.Engine,
+		Schema:            req.Settings.Schema,
+		Queries:           req.Settings.Queries,
+		DeprecatedQueries: deprecated,
 	}
 }
 
@@ -660,10 +761,11 @@ func vetQuery(q *plugin.Query) *vet.Query {
 		})
 	}
 	return &vet.Query{
-		Sql:    q.Text,
-		Name:   q.Name,
-		Cmd:    strings.TrimPrefix(q.Cmd, ":"),
-		Params: params,
+		Sql:        q.Text,
+		Name:       q.Name,
+		Cmd:        strings.TrimPrefix(q.Cmd, ":"),
+		Params:     params,
+		Deprecated: q.Deprecated,
 	}
 }
 
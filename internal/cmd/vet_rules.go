@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"text/template"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/constants"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+)
+
+// builtinRule is a vet rule that sqlc implements by inspecting the compiled
+// query, rather than by evaluating a CEL expression.
+type builtinRule struct {
+	// Message is the text/template of the message reported for each
+	// violation, which is passed the violation.
+	Message string
+	Check   func(q *compiler.Query, opts ruleOptions) []violation
+}
+
+// ruleOptions configure a built-in rule.
+type ruleOptions struct {
+	DefaultSchema  string
+	AllowedSchemas []string
+}
+
+// violation is an instance of a query breaking a built-in rule. Table and
+// Schema are set by the rules that report them.
+type violation struct {
+	Query  string
+	Table  string
+	Schema string
+}
+
+var builtinRules = map[string]builtinRule{
+	constants.QueryRuleNoSelectStar: {
+		Message: "selects {{if .Table}}{{.Table}}.*{{else}}*{{end}}; list the columns instead",
+		Check:   checkNoSelectStar,
+	},
+	constants.QueryRuleManyRequiresLimit: {
+		Message: "returns many rows without a LIMIT",
+		Check:   checkManyRequiresLimit,
+	},
+	constants.QueryRuleNoDeleteWithoutWhere: {
+		Message: "deletes every row of {{.Table}}; add a WHERE clause",
+		Check:   checkNoDeleteWithoutWhere,
+	},
+	constants.QueryRuleNoCrossSchema: {
+		Message: "accesses {{.Table}} in schema {{.Schema}}, which isn't allowed",
+		Check:   checkNoCrossSchema,
+	},
+}
+
+// newBuiltinRule returns the rule with the given built-in name. A declared
+// rule of the same name without an expression replaces its message and sets
+// its options.
+func newBuiltinRule(name string, b builtinRule, declared *config.Rule) (rule, error) {
+	msg := b.Message
+	r := rule{Builtin: &b}
+	if declared != nil {
+		if declared.Msg != "" {
+			msg = declared.Msg
+		}
+		r.Options.AllowedSchemas = declared.AllowedSchemas
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(msg)
+	if err != nil {
+		return rule{}, fmt.Errorf("type-check error: %s message: %s", name, err)
+	}
+	r.Template = tmpl
+	return r, nil
+}
+
+// skipExists doesn't descend into EXISTS subqueries, whose target list is
+// ignored.
+type skipExists func(ast.Node)
+
+func (f skipExists) Visit(node ast.Node) astutils.Visitor {
+	if n, ok := node.(*ast.SubLink); ok && n.SubLinkType == ast.EXISTS_SUBLINK {
+		return nil
+	}
+	f(node)
+	return f
+}
+
+func checkNoSelectStar(q *compiler.Query, opts ruleOptions) []violation {
+	var found []violation
+	astutils.Walk(skipExists(func(node ast.Node) {
+		stmt, ok := node.(*ast.SelectStmt)
+		if !ok || stmt.TargetList == nil {
+			return
+		}
+		for _, item := range stmt.TargetList.Items {
+			res, ok := item.(*ast.ResTarget)
+			if !ok {
+				continue
+			}
+			ref, ok := res.Val.(*ast.ColumnRef)
+			if !ok || ref.Fields == nil || len(ref.Fields.Items) == 0 {
+				continue
+			}
+			if _, star := ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.A_Star); !star {
+				continue
+			}
+			// sqlc.embed is rewritten to table.*
+			if _, embed := q.Embeds.Find(ref); embed {
+				continue
+			}
+			found = append(found, violation{
+				Table: astutils.Join(&ast.List{Items: ref.Fields.Items[:len(ref.Fields.Items)-1]}, "."),
+			})
+		}
+	}), q.RawStmt)
+	return found
+}
+
+func checkManyRequiresLimit(q *compiler.Query, opts ruleOptions) []violation {
+	if q.Metadata.Cmd != metadata.CmdMany {
+		return nil
+	}
+	stmt, ok := q.RawStmt.Stmt.(*ast.SelectStmt)
+	if !ok || astutils.IsSet(stmt.LimitCount) {
+		return nil
+	}
+	return []violation{{}}
+}
+
+func checkNoDeleteWithoutWhere(q *compiler.Query, opts ruleOptions) []violation {
+	stmt, ok := q.RawStmt.Stmt.(*ast.DeleteStmt)
+	if !ok || astutils.IsSet(stmt.WhereClause) || stmt.Relations == nil {
+		return nil
+	}
+	var found []violation
+	for _, item := range stmt.Relations.Items {
+		if rv, ok := item.(*ast.RangeVar); ok && rv.Relname != nil {
+			found = append(found, violation{Table: *rv.Relname})
+		}
+	}
+	return found
+}
+
+func checkNoCrossSchema(q *compiler.Query, opts ruleOptions) []violation {
+	var found []violation
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		rv, ok := node.(*ast.RangeVar)
+		if !ok || rv.Relname == nil || rv.Schemaname == nil {
+			return
+		}
+		schema := *rv.Schemaname
+		if schema == "" || schema == opts.DefaultSchema || slices.Contains(opts.AllowedSchemas, schema) {
+			return
+		}
+		found = append(found, violation{Table: *rv.Relname, Schema: schema})
+	}), q.RawStmt)
+	return found
+}
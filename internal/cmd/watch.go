@@ -0,0 +1,331 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/migrations"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// watchDebounce is how long to wait after the last change to a watched file
+// before regenerating, so that a burst of saves results in a single run.
+const watchDebounce = 100 * time.Millisecond
+
+// Watch generates code for all packages and then regenerates the packages
+// affected by changes to the configuration file or to their schema and query
+// files, until ctx is cancelled or the process is interrupted. It returns an
+// error if the most recent generation of any package failed.
+func Watch(ctx context.Context, dir, filename string, o *Options, stdout io.Writer) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	w := &watch{
+		dir:      dir,
+		filename: filename,
+		o:        o,
+		stdout:   stdout,
+		watcher:  watcher,
+		debounce: watchDebounce,
+	}
+	return w.run(ctx, watcher.Events, watcher.Errors)
+}
+
+// run generates every package, and then regenerates the packages affected by
+// the changes received from events once no change has been received for the
+// debounce period, until ctx is cancelled.
+func (w *watch) run(ctx context.Context, events <-chan fsnotify.Event, errs <-chan error) error {
+	w.reload()
+
+	var timer *time.Timer
+	var fire <-chan time.Time
+	changed := map[string]struct{}{}
+	for {
+		select {
+		case <-ctx.Done():
+			if w.failed() {
+				return errors.New("last generation failed")
+			}
+			return nil
+
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			if event.Has(fsnotify.Create) {
+				w.created(event.Name)
+			}
+			changed[filepath.Clean(event.Name)] = struct{}{}
+			if timer == nil {
+				timer = time.NewTimer(w.debounce)
+				fire = timer.C
+			} else {
+				timer.Reset(w.debounce)
+			}
+
+		case err, ok := <-errs:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(w.o.Stderr, "error watching files: %s\n", err)
+
+		case <-fire:
+			timer, fire = nil, nil
+			w.regenerate(changed)
+			changed = map[string]struct{}{}
+		}
+	}
+}
+
+type watch struct {
+	dir      string
+	filename string
+	o        *Options
+	stdout   io.Writer
+	watcher  *fsnotify.Watcher
+	debounce time.Duration
+
+	configPath string
+	conf       *config.Config
+	// trees are the directories whose subdirectories are watched too, as
+	// the patterns of the packages match files in them.
+	trees []string
+	// errored records whether the last generation of each package, keyed by
+	// its index in conf.SQL, failed.
+	errored map[int]bool
+	// configErr is set if the configuration file could not be loaded.
+	configErr bool
+}
+
+func (w *watch) failed() bool {
+	if w.configErr {
+		return true
+	}
+	for _, errored := range w.errored {
+		if errored {
+			return true
+		}
+	}
+	return false
+}
+
+// reload reads the configuration file, watches all paths it references and
+// regenerates every package.
+func (w *watch) reload() {
+	stderr := w.o.Stderr
+	w.conf = nil
+	w.trees = nil
+	w.errored = map[int]bool{}
+
+	configPath, conf, err := w.o.ReadConfig(w.dir, w.filename)
+	if configPath != "" {
+		w.configPath = configPath
+	}
+	if w.configPath == "" {
+		// The configuration file could not be found, so watch the
+		// directory it is expected to be created in.
+		w.add(w.dir)
+	} else {
+		w.add(filepath.Dir(w.configPath))
+	}
+	if err != nil {
+		w.configErr = true
+		return
+	}
+	base := filepath.Base(configPath)
+	if err := config.Validate(conf); err != nil {
+		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
+		w.configErr = true
+		return
+	}
+	if err := w.o.Env.Validate(conf); err != nil {
+		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
+		w.configErr = true
+		return
+	}
+	w.configErr = false
+	w.conf = conf
+
+	for _, sql := range conf.SQL {
+		for _, path := range w.paths(sql) {
+			if strings.HasPrefix(path, "!") {
+				continue
+			}
+			root := watchRoot(path)
+			if !isPattern(filepath.Dir(path)) {
+				w.add(root)
+				continue
+			}
+			// Patterns such as queries/**/*.sql match files in
+			// subdirectories, which are watched separately
+			w.trees = append(w.trees, root)
+			w.addTree(root)
+		}
+	}
+	for i := range conf.SQL {
+		w.generate(i)
+	}
+}
+
+// regenerate regenerates the packages affected by the changed files.
+func (w *watch) regenerate(changed map[string]struct{}) {
+	if w.configPath != "" {
+		if _, ok := changed[filepath.Clean(w.configPath)]; ok {
+			w.reload()
+			return
+		}
+	}
+	if w.conf == nil {
+		// Without a valid configuration only the configuration file
+		// itself is of interest.
+		if w.configPath == "" {
+			w.reload()
+		}
+		return
+	}
+	for i, sql := range w.conf.SQL {
+		paths := w.paths(sql)
+		for path := range changed {
+			if affects(paths, path) {
+				w.generate(i)
+				break
+			}
+		}
+	}
+}
+
+// generate generates the package at index i of conf.SQL and writes its output
+// files, printing a single line reporting success or failure.
+func (w *watch) generate(i int) {
+	sql := w.conf.SQL[i]
+	conf := *w.conf
+	conf.SQL = []config.SQL{sql}
+
+	start := time.Now()
+	output, _, err := generateConfig(context.Background(), w.dir, w.configPath, &conf, w.o)
+	if err == nil {
+		err = writeOutput(w.o.Stderr, diskSink{}, output)
+	}
+	w.errored[i] = err != nil
+	if err != nil {
+		fmt.Fprintf(w.stdout, "FAIL\t%s\n", watchLabel(sql))
+		return
+	}
+	fmt.Fprintf(w.stdout, "ok\t%s\t%s\n", watchLabel(sql), time.Since(start).Round(time.Millisecond))
+}
+
+// paths returns the schema and query paths of a package, relative to the
+// working directory. Schemas given by URLs or cloud references aren't watched.
+func (w *watch) paths(sql config.SQL) []string {
+	var paths []string
+	for _, p := range sql.Schema {
+		if !isRemoteSchema(p) {
+			paths = append(paths, p)
+		}
+	}
+	return sqlpath.Join(w.dir, append(paths, sql.Queries...))
+}
+
+func (w *watch) add(path string) {
+	if _, err := os.Stat(path); err != nil {
+		return
+	}
+	if err := w.watcher.Add(path); err != nil {
+		fmt.Fprintf(w.o.Stderr, "error watching %s: %s\n", path, err)
+	}
+}
+
+// addTree watches root and its subdirectories, other than hidden ones, which
+// sqlpath.Glob doesn't search.
+func (w *watch) addTree(root string) {
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+		if path != root && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		w.add(path)
+		return nil
+	})
+}
+
+// created watches a directory created in one of the trees, so that the files
+// later created in it are seen.
+func (w *watch) created(path string) {
+	if strings.HasPrefix(filepath.Base(path), ".") {
+		return
+	}
+	if f, err := os.Stat(path); err != nil || !f.IsDir() {
+		return
+	}
+	for _, root := range w.trees {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			w.addTree(path)
+			return
+		}
+	}
+}
+
+// watchRoot returns the directory to watch for changes to path. For a pattern,
+// this is the longest leading directory without wildcards.
+func watchRoot(path string) string {
+	if isPattern(path) {
+		for isPattern(path) {
+			path = filepath.Dir(path)
+		}
+		return path
+	}
+	if f, err := os.Stat(path); err == nil && f.IsDir() {
+		return path
+	}
+	return filepath.Dir(path)
+}
+
+func isPattern(path string) bool {
+	return strings.ContainsAny(path, "*?[]")
+}
+
+// affects reports whether a change to the file at path affects a package with
+// the given schema and query paths. It mirrors the files selected by
+// sqlpath.Glob without requiring the file to still exist.
+func affects(paths []string, path string) bool {
+	base := filepath.Base(path)
+	if !strings.HasSuffix(base, ".sql") || strings.HasPrefix(base, ".") || migrations.IsDown(base) {
+		return false
+	}
+	return sqlpath.Match(paths, path)
+}
+
+// watchLabel returns the name used to report on a package in watch mode.
+func watchLabel(sql config.SQL) string {
+	switch {
+	case sql.Name != "":
+		return sql.Name
+	case sql.Gen.Go != nil && sql.Gen.Go.Package != "":
+		return sql.Gen.Go.Package
+	default:
+		return strings.Join(sql.Queries, ",")
+	}
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAffects(t *testing.T) {
+	dir := t.TempDir()
+	paths := []string{
+		filepath.Join(dir, "schema"),
+		filepath.Join(dir, "queries", "*.sql"),
+		"!" + filepath.Join(dir, "schema", "legacy"),
+	}
+	for name, expected := range map[string]bool{
+		"schema/1_authors.sql":      true,
+		"schema/1_authors.down.sql": false,
+		"schema/.1_authors.sql":     false,
+		"schema/README.md":          false,
+		"schema/legacy/old.sql":     false,
+		"queries/authors.sql":       true,
+		"queries/authors.sql.swp":   false,
+		"other/authors.sql":         false,
+	} {
+		if got := affects(paths, filepath.Join(dir, name)); got != expected {
+			t.Errorf("affects(%s) = %v; want %v", name, got, expected)
+		}
+	}
+}
+
+func TestWatchRoot(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "schema"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for path, expected := range map[string]string{
+		"schema":                  "schema",
+		"schema/authors.sql":      "schema",
+		"queries/*.sql":           "queries",
+		"queries/**/*.sql":        "queries",
+		"queries/[ab]*/books.sql": "queries",
+		"missing.sql":             ".",
+	} {
+		if got := watchRoot(filepath.Join(dir, path)); got != filepath.Join(dir, expected) {
+			t.Errorf("watchRoot(%s) = %s; want %s", path, got, filepath.Join(dir, expected))
+		}
+	}
+}
+
+func TestWatchTree(t *testing.T) {
+	dir := t.TempDir()
+	for _, d := range []string{"queries/authors/v2", "queries/.cache", "other"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+	w := &watch{dir: dir, o: &Options{Stderr: io.Discard}, watcher: watcher}
+	root := filepath.Join(dir, "queries")
+	w.trees = []string{root}
+	w.addTree(root)
+
+	for _, d := range []string{"queries/books", "other/books"} {
+		path := filepath.Join(dir, d)
+		if err := os.Mkdir(path, 0755); err != nil {
+			t.Fatal(err)
+		}
+		w.created(path)
+	}
+
+	var watched []string
+	for _, path := range watcher.WatchList() {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		watched = append(watched, filepath.ToSlash(rel))
+	}
+	sort.Strings(watched)
+	expected := []string{"queries", "queries/authors", "queries/authors/v2", "queries/books"}
+	if diff := cmp.Diff(expected, watched); diff != "" {
+		t.Errorf("watched directories mismatch:\n%s", diff)
+	}
+}
+
+// syncBuffer is a bytes.Buffer that's safe to write while it's read.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// lines returns the lines written so far, without the durations of the
+// packages that were generated.
+func (b *syncBuffer) lines() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		if fields := strings.Split(line, "\t"); len(fields) > 2 {
+			line = strings.Join(fields[:2], "\t")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestWatchRun(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("sqlc.yaml", `version: "2"
+sql:
+  - name: authors
+    engine: postgresql
+    schema: schema.sql
+    queries: authors.sql
+    gen:
+      go:
+        package: authors
+        out: authors
+  - name: books
+    engine: postgresql
+    schema: schema.sql
+    queries: books.sql
+    gen:
+      go:
+        package: books
+        out: books
+`)
+	write("schema.sql", "CREATE TABLE authors (id int PRIMARY KEY);\nCREATE TABLE books (id int PRIMARY KEY);\n")
+	write("authors.sql", "-- name: ListAuthors :many\nSELECT id FROM authors;\n")
+	write("books.sql", "-- name: ListBooks :many\nSELECT id FROM books;\n")
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+	stdout := &syncBuffer{}
+	w := &watch{
+		dir:      dir,
+		o:        &Options{Stderr: io.Discard},
+		stdout:   stdout,
+		watcher:  watcher,
+		debounce: 20 * time.Millisecond,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan fsnotify.Event)
+	done := make(chan error)
+	go func() { done <- w.run(ctx, events, make(chan error)) }()
+
+	// wait waits for stdout to have n lines, and a while longer to give
+	// unexpected generations the time to show up.
+	wait := func(n int) {
+		t.Helper()
+		deadline := time.Now().Add(10 * time.Second)
+		for len(stdout.lines()) < n && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		time.Sleep(5 * w.debounce)
+	}
+	send := func(name string, op fsnotify.Op) {
+		events <- fsnotify.Event{Name: filepath.Join(dir, name), Op: op}
+	}
+
+	// A burst of changes regenerates the affected package once, and changes
+	// to other files are ignored
+	send("authors.sql", fsnotify.Write)
+	send("authors.sql", fsnotify.Write)
+	send("authors.sql", fsnotify.Chmod)
+	send("README.md", fsnotify.Write)
+	wait(3)
+
+	write("books.sql", "-- name: ListBooks :many\nSELECT title FROM books;\n")
+	send("books.sql", fsnotify.Write)
+	wait(4)
+	cancel()
+	if err := <-done; err == nil {
+		t.Errorf("expected an error after the last generation of books failed")
+	}
+
+	expected := []string{
+		"ok\tauthors",
+		"ok\tbooks",
+		"ok\tauthors",
+		"FAIL\tbooks",
+	}
+	if diff := cmp.Diff(expected, stdout.lines()); diff != "" {
+		t.Errorf("output mismatch:\n%s", diff)
+	}
+}
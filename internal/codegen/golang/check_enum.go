@@ -0,0 +1,95 @@
+package golang
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// buildCheckEnums returns an enum for each table column restricted to a list
+// of values by a CHECK constraint, unless the column is overridden.
+func buildCheckEnums(req *plugin.GenerateRequest, options *opts.Options) []Enum {
+	var enums []Enum
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, table := range schema.Tables {
+			for _, col := range table.Columns {
+				if !isCheckEnum(req, options, col) {
+					continue
+				}
+				enumName := checkEnumName(req, schema.Name, table.Rel.Name, col.Name)
+				enums = append(enums, buildEnum(options, enumName, "", col.CheckValues))
+			}
+		}
+	}
+	return enums
+}
+
+// checkEnumType returns the type of the enum generated for the table column
+// col refers to, if any.
+func checkEnumType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) (string, bool) {
+	if !options.EmitCheckConstraintEnums || col.Table == nil {
+		return "", false
+	}
+	schemaName := col.Table.Schema
+	if schemaName == "" {
+		schemaName = req.Catalog.DefaultSchema
+	}
+	colName := col.Name
+	if col.OriginalName != "" {
+		colName = col.OriginalName
+	}
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Rel.Name != col.Table.Name {
+				continue
+			}
+			for _, c := range table.Columns {
+				if c.Name != colName || !isCheckEnum(req, options, c) {
+					continue
+				}
+				name := typeName(checkEnumName(req, schema.Name, table.Rel.Name, c.Name), options)
+				if col.NotNull {
+					return name, true
+				}
+				if options.EmitPointersForNullTypes {
+					return "*" + name, true
+				}
+				return "Null" + name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// isCheckEnum reports whether an enum is generated for a table column. Column
+// overrides suppress it, and columns typed as an enum already have one.
+func isCheckEnum(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) bool {
+	if len(col.CheckValues) == 0 || col.IsArray {
+		return false
+	}
+	if columnOverride(req, options, col) != nil {
+		return false
+	}
+	for _, schema := range req.Catalog.Schemas {
+		for _, enum := range schema.Enums {
+			if col.Type.GetName() == enum.Name && (col.Type.GetSchema() == "" || col.Type.GetSchema() == schema.Name) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// checkEnumName returns the name of the enum of a column, made of the names
+// of its table and the column like the enums of MySQL ENUM columns.
+func checkEnumName(req *plugin.GenerateRequest, schema, table, column string) string {
+	if schema == req.Catalog.DefaultSchema {
+		return table + "_" + column
+	}
+	return schema + "_" + table + "_" + column
+}
@@ -0,0 +1,137 @@
+package golang
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+var commentTagKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// parseCommentTags parses a column comment made up of key:value pairs,
+// separated by spaces. Values containing spaces must be quoted, as in
+// validate:"required,min=1". It returns false if the comment is anything
+// else, and an error if a key is repeated.
+func parseCommentTags(comment string) (map[string]string, bool, error) {
+	rest := strings.TrimSpace(comment)
+	if rest == "" {
+		return nil, false, nil
+	}
+	tags := map[string]string{}
+	for rest != "" {
+		key, value, ok := strings.Cut(rest, ":")
+		if !ok || !commentTagKey.MatchString(key) {
+			return nil, false, nil
+		}
+		if strings.HasPrefix(value, `"`) {
+			quoted, err := strconv.QuotedPrefix(value)
+			if err != nil {
+				return nil, false, nil
+			}
+			rest = value[len(quoted):]
+			value, _ = strconv.Unquote(quoted)
+		} else {
+			end := strings.IndexAny(value, " \t\n")
+			if end < 0 {
+				end = len(value)
+			}
+			value, rest = value[:end], value[end:]
+		}
+		if value == "" || (rest != "" && !strings.ContainsAny(rest[:1], " \t\n")) {
+			return nil, false, nil
+		}
+		if _, ok := tags[key]; ok {
+			return nil, true, fmt.Errorf("duplicate struct tag key in comment: %s", key)
+		}
+		tags[key] = value
+		rest = strings.TrimSpace(rest)
+	}
+	return tags, true, nil
+}
+
+// addCommentTags adds the struct tags in the comment of a column, unless a
+// tag with the same key has already been set. Columns returned by queries
+// don't carry comments, so the comment of the table column is used.
+func addCommentTags(tags map[string]string, req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) {
+	if !options.EmitCommentTags {
+		return
+	}
+	parsed, ok, err := parseCommentTags(columnComment(req, col))
+	if !ok || err != nil {
+		return
+	}
+	for k, v := range parsed {
+		if _, ok := tags[k]; !ok {
+			tags[k] = v
+		}
+	}
+}
+
+// columnComment returns the comment of col, falling back to the comment of
+// the table column it was selected from.
+func columnComment(req *plugin.GenerateRequest, col *plugin.Column) string {
+	if col.Comment != "" || col.Table == nil {
+		return col.Comment
+	}
+	name := col.Name
+	if col.OriginalName != "" {
+		name = col.OriginalName
+	}
+	for _, schema := range req.Catalog.Schemas {
+		for _, table := range schema.Tables {
+			if !sameTable(table.Rel, col.Table, req.Catalog.DefaultSchema) {
+				continue
+			}
+			for _, c := range table.Columns {
+				if c.Name == name {
+					return c.Comment
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func sameTable(a, b *plugin.Identifier, defaultSchema string) bool {
+	schemaA, schemaB := a.Schema, b.Schema
+	if schemaA == "" {
+		schemaA = defaultSchema
+	}
+	if schemaB == "" {
+		schemaB = defaultSchema
+	}
+	return a.Name == b.Name && schemaA == schemaB
+}
+
+// fieldComment returns the doc comment of the field of a column. Comments
+// that only hold struct tags are left out.
+func fieldComment(options *opts.Options, col *plugin.Column) string {
+	if options.EmitCommentTags {
+		if _, ok, _ := parseCommentTags(col.Comment); ok {
+			return ""
+		}
+	}
+	return col.Comment
+}
+
+// validateCommentTags checks that no column comment holding struct tags
+// repeats a key.
+func validateCommentTags(req *plugin.GenerateRequest, options *opts.Options) error {
+	if !options.EmitCommentTags {
+		return nil
+	}
+	for _, schema := range req.Catalog.Schemas {
+		for _, table := range schema.Tables {
+			for _, col := range table.Columns {
+				if _, _, err := parseCommentTags(col.Comment); err != nil {
+					return fmt.Errorf("column %s.%s: %w", table.Rel.Name, col.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
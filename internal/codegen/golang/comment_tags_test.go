@@ -0,0 +1,41 @@
+package golang
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseCommentTags(t *testing.T) {
+	for _, tc := range []struct {
+		comment string
+		tags    map[string]string
+		ok      bool
+		err     string
+	}{
+		{comment: "json:emailAddress", tags: map[string]string{"json": "emailAddress"}, ok: true},
+		{comment: ` validate:"required,min=1"  xml:name `, tags: map[string]string{"validate": "required,min=1", "xml": "name"}, ok: true},
+		{comment: `doc:"a \"quoted\" value"`, tags: map[string]string{"doc": `a "quoted" value`}, ok: true},
+		{comment: "json:a json:b", ok: true, err: "duplicate struct tag key in comment: json"},
+		{comment: "The name of the user"},
+		{comment: "Note: not a tag"},
+		{comment: "json:"},
+		{comment: `json:"a"b:c`},
+		{comment: `json:"unterminated`},
+		{comment: ""},
+	} {
+		tags, ok, err := parseCommentTags(tc.comment)
+		if ok != tc.ok {
+			t.Errorf("%q: expected ok to be %v", tc.comment, tc.ok)
+		}
+		if err != nil || tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("%q: expected error %q, got %v", tc.comment, tc.err, err)
+			}
+			continue
+		}
+		if diff := cmp.Diff(tc.tags, tags); diff != "" {
+			t.Errorf("%q: tags mismatch:\n%s", tc.comment, diff)
+		}
+	}
+}
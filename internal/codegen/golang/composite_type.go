@@ -0,0 +1,134 @@
+package golang
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// usesCompositeStructs reports whether columns of composite types are
+// generated as structs. Only pgx/v5 can scan composite values into structs, so
+// they're strings otherwise.
+func usesCompositeStructs(options *opts.Options) bool {
+	return parseDriver(options.SqlPackage) == opts.SQLDriverPGXV5
+}
+
+func compositeTypeName(req *plugin.GenerateRequest, options *opts.Options, schema, name string) string {
+	if schema != req.Catalog.DefaultSchema {
+		name = schema + "_" + name
+	}
+	return typeName(name, options)
+}
+
+// buildCompositeTypes returns a struct for each composite type, with a field
+// for each of its fields. The fields of composite types are always nullable.
+func buildCompositeTypes(req *plugin.GenerateRequest, options *opts.Options) []Struct {
+	if !usesCompositeStructs(options) {
+		return nil
+	}
+	var structs []Struct
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, ct := range schema.CompositeTypes {
+			s := Struct{
+				Name: compositeTypeName(req, options, schema.Name, ct.Name),
+			}
+			s.Comment = dbComment(options, s.Name, ct.Comment)
+			for _, column := range ct.Columns {
+				tags := map[string]string{}
+				if options.EmitDbTags {
+					tags["db"] = column.Name
+				}
+				if options.EmitJsonTags {
+					tags["json"] = JSONTagName(column.Name, options)
+				}
+				s.Fields = append(s.Fields, Field{
+					Name:   StructName(column.Name, options),
+					Type:   goType(req, options, column),
+					Tags:   tags,
+					Column: column,
+				})
+			}
+			structs = append(structs, s)
+		}
+	}
+	return structs
+}
+
+// compositeTypeRegistrations returns the names of the types that must be
+// registered with a pgx/v5 connection to scan composite values into their
+// structs, in the order they must be registered: the enums and composite
+// types used by the fields of a composite type come before it, and each type
+// comes before its array type.
+func compositeTypeRegistrations(req *plugin.GenerateRequest, options *opts.Options) []string {
+	if !usesCompositeStructs(options) {
+		return nil
+	}
+	qualified := func(schema, name string) string {
+		if schema == req.Catalog.DefaultSchema {
+			return name
+		}
+		return schema + "." + name
+	}
+	// The composite types and enums, keyed by qualified name
+	composites := map[string]*plugin.CompositeType{}
+	enums := map[string]bool{}
+	var names []string
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, ct := range schema.CompositeTypes {
+			name := qualified(schema.Name, ct.Name)
+			composites[name] = ct
+			names = append(names, name)
+		}
+		for _, enum := range schema.Enums {
+			enums[qualified(schema.Name, enum.Name)] = true
+		}
+	}
+	sort.Strings(names)
+
+	var out []string
+	done := map[string]bool{}
+	var register func(name string, isArray bool)
+	register = func(name string, isArray bool) {
+		if !done[name] {
+			done[name] = true
+			if ct, ok := composites[name]; ok {
+				for _, col := range ct.Columns {
+					schema := col.Type.Schema
+					if schema == "" {
+						schema = req.Catalog.DefaultSchema
+					}
+					field := qualified(schema, col.Type.Name)
+					if composites[field] != nil || enums[field] {
+						register(field, col.IsArray)
+					}
+				}
+			}
+			out = append(out, name)
+		}
+		if array := arrayTypeName(name); isArray && !done[array] {
+			done[array] = true
+			out = append(out, array)
+		}
+	}
+	for _, name := range names {
+		register(name, true)
+	}
+	return out
+}
+
+// arrayTypeName returns the name of the array type of a type, which
+// PostgreSQL names after the type with a leading underscore.
+func arrayTypeName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i+1] + "_" + name[i+1:]
+	}
+	return "_" + name
+}
@@ -0,0 +1,135 @@
+package golang
+
+import (
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// FieldType describes how the type and name of a generated struct field were
+// chosen. Override and Rename are empty if no override or rename applied.
+type FieldType struct {
+	Struct   string `json:"struct"`
+	Field    string `json:"field"`
+	Column   string `json:"column"`
+	DBType   string `json:"db_type"`
+	Nullable bool   `json:"nullable"`
+	Override string `json:"override,omitempty"`
+	GoType   string `json:"go_type"`
+	Rename   string `json:"rename,omitempty"`
+}
+
+// DebugTypes returns the fields of the structs generated for req, the models
+// followed by the parameter and row structs of the queries, along with the
+// override and rename that applied to each.
+func DebugTypes(req *plugin.GenerateRequest) ([]FieldType, error) {
+	options, err := opts.Parse(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.ValidateOpts(options); err != nil {
+		return nil, err
+	}
+	structs, err := buildStructs(req, options)
+	if err != nil {
+		return nil, err
+	}
+	queries, err := buildQueries(req, options, structs)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []FieldType
+	for _, s := range structs {
+		for _, f := range s.Fields {
+			fields = append(fields, debugField(req, options, 0, s, s.Table, f.Column.Name, f))
+		}
+	}
+	for _, q := range queries {
+		qopts := options
+		if len(q.Overrides) > 0 {
+			o := *options
+			o.Overrides = append(append([]opts.Override{}, q.Overrides...), options.Overrides...)
+			qopts = &o
+		}
+		for _, v := range []QueryValue{q.Arg, q.Ret} {
+			if !v.EmitStruct() || v.Struct == nil {
+				continue
+			}
+			for _, f := range v.Struct.Fields {
+				// Embedded structs are listed with the models
+				if f.Column == nil || len(f.EmbedFields) > 0 {
+					continue
+				}
+				fields = append(fields, debugField(req, qopts, len(q.Overrides), *v.Struct, f.Column.Table, f.DBName, f))
+			}
+		}
+	}
+	return fields, nil
+}
+
+func debugField(req *plugin.GenerateRequest, options *opts.Options, queryOverrides int, s Struct, table *plugin.Identifier, column string, f Field) FieldType {
+	ft := FieldType{
+		Struct:   s.Name,
+		Field:    f.Name,
+		Column:   column,
+		DBType:   sdk.DataType(f.Column.Type),
+		Nullable: !f.Column.NotNull,
+		GoType:   f.Type,
+		Rename:   renameKey(req, options, table, column),
+	}
+	if table != nil && table.Name != "" {
+		ft.Column = table.Name + "." + column
+		if table.Schema != "" && table.Schema != req.Catalog.DefaultSchema {
+			ft.Column = table.Schema + "." + ft.Column
+		}
+	}
+	if override := resolveGoType(req, options, f.Column).Override; override != nil {
+		ft.Override = overrideName(options, queryOverrides, override)
+	}
+	return ft
+}
+
+// renameKey returns the key of the rename that named the field of a column,
+// or "" if the name was derived from the column name.
+func renameKey(req *plugin.GenerateRequest, options *opts.Options, table *plugin.Identifier, column string) string {
+	if _, ok := qualifiedRename(req, options, table, column); ok {
+		return qualifiedRenameKey(req, table, column)
+	}
+	if options.Rename[column] != "" {
+		return column
+	}
+	return ""
+}
+
+// overrideName describes an override by where it's declared and what it
+// matches, such as `overrides[2] column "authors.id"`. The overrides of a
+// query come first in options, followed by the global overrides and those of
+// the package.
+func overrideName(options *opts.Options, queryOverrides int, override *opts.Override) string {
+	index := -1
+	var globals int
+	for i := range options.Overrides {
+		if &options.Overrides[i] == override {
+			index = i
+		}
+		if i >= queryOverrides && options.Overrides[i].Global {
+			globals++
+		}
+	}
+	var name string
+	switch {
+	case index < queryOverrides:
+		name = "sqlc.override"
+	case override.Global:
+		name = fmt.Sprintf("global overrides[%d]", index-queryOverrides)
+	default:
+		name = fmt.Sprintf("overrides[%d]", index-queryOverrides-globals)
+	}
+	if override.Column != "" {
+		return fmt.Sprintf("%s column %q", name, override.Column)
+	}
+	return fmt.Sprintf("%s db_type %q", name, override.DBType)
+}
@@ -0,0 +1,28 @@
+package golang
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+)
+
+func TestOverrideName(t *testing.T) {
+	options := &opts.Options{
+		Overrides: []opts.Override{
+			{Column: "elapsed_ms"},
+			{DBType: "uuid", Global: true},
+			{Column: "jobs.id"},
+			{DBType: "pg_catalog.int8"},
+		},
+	}
+	for i, want := range []string{
+		`sqlc.override column "elapsed_ms"`,
+		`global overrides[0] db_type "uuid"`,
+		`overrides[0] column "jobs.id"`,
+		`overrides[1] db_type "pg_catalog.int8"`,
+	} {
+		if got := overrideName(options, 1, &options.Overrides[i]); got != want {
+			t.Errorf("overrideName(%d) = %s, want %s", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package golang
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// The codes of the warnings the Go generator reports, which can be listed in
+// suppress_warnings.
+const (
+	codeUnusedOverride   = "unused-override"
+	codeDeprecatedOption = "deprecated-option"
+	codeRenamedOverride  = "renamed-override"
+)
+
+// deprecatedOverrides returns a warning for each use of a deprecated field of
+// an override.
+func deprecatedOverrides(options *opts.Options) []*plugin.Diagnostic {
+	var diags []*plugin.Diagnostic
+	for _, o := range options.Overrides {
+		if o.Deprecated_PostgresType != "" {
+			diags = append(diags, &plugin.Diagnostic{
+				Code:    codeDeprecatedOption,
+				Message: `"postgres_type" is deprecated. Instead, use "db_type" to specify a type override.`,
+			})
+		}
+		if o.Deprecated_Null {
+			diags = append(diags, &plugin.Diagnostic{
+				Code:    codeDeprecatedOption,
+				Message: `"null" is deprecated. Instead, use the "nullable" field.`,
+			})
+		}
+	}
+	return diags
+}
+
+// renamedOverrides returns a warning for each override and rename key that
+// follow_renames rewrote, as they should name the column by its current name.
+func renamedOverrides(options *opts.Options) []*plugin.Diagnostic {
+	var diags []*plugin.Diagnostic
+	for _, o := range options.Overrides {
+		if o.RenamedFrom == "" {
+			continue
+		}
+		diags = append(diags, &plugin.Diagnostic{
+			Code:    codeRenamedOverride,
+			Message: fmt.Sprintf("override for column %q matches the column renamed to %q, which it should name instead", o.RenamedFrom, o.Column),
+		})
+	}
+	keys := make([]string, 0, len(options.RenamedKeys))
+	for key := range options.RenamedKeys {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		diags = append(diags, &plugin.Diagnostic{
+			Code:    codeRenamedOverride,
+			Message: fmt.Sprintf("rename of %q matches the column renamed to %q, which it should be keyed by instead", key, options.RenamedKeys[key]),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,117 @@
+package golang
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+)
+
+// dbComment returns the doc comment of the declaration name, taken from the
+// comment of a table, column or enum in the database.
+func dbComment(options *opts.Options, name, comment string) string {
+	if !*options.EmitDbComments {
+		return ""
+	}
+	comment = strings.TrimSpace(normalizeNewlines(comment))
+	if comment == "" {
+		return ""
+	}
+	lines := strings.Split(comment, "\n")
+	if options.DocCommentNamePrefix {
+		lines[0] = namePrefix(name, "is", lines[0])
+	}
+	var out []string
+	for _, line := range lines {
+		out = append(out, wrapComment(line, options.DocCommentWrap-len("// "))...)
+	}
+	return strings.Join(out, "\n")
+}
+
+// queryComments formats the comments above a query, which are emitted as
+// "//" followed by each line, so lines keep their leading space.
+func queryComments(options *opts.Options, name string, comments []string) []string {
+	if len(comments) == 0 {
+		return comments
+	}
+	var out []string
+	for i, line := range comments {
+		line = sanitizeComment(line)
+		if i == 0 && options.DocCommentNamePrefix {
+			line = " " + namePrefix(name, "", strings.TrimLeft(line, " "))
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " "))]
+		for _, wrapped := range wrapComment(strings.TrimLeft(line, " "), options.DocCommentWrap-len("//")-len(indent)) {
+			out = append(out, indent+wrapped)
+		}
+	}
+	return out
+}
+
+// deprecatedComment ends the comments above a deprecated query with a
+// "Deprecated:" paragraph, which staticcheck and gopls report the uses of.
+func deprecatedComment(options *opts.Options, comments []string, message string) []string {
+	if len(comments) > 0 {
+		comments = append(comments, "")
+	}
+	for _, wrapped := range wrapComment("Deprecated: "+message, options.DocCommentWrap-len("// ")) {
+		comments = append(comments, " "+wrapped)
+	}
+	return comments
+}
+
+// namePrefix starts a comment with the name of the declaration it documents,
+// as golint expects, joined by verb if it's set. Comments that already start
+// with the name are left alone.
+func namePrefix(name, verb, comment string) string {
+	if comment == name || strings.HasPrefix(comment, name+" ") {
+		return comment
+	}
+	// Lower the first letter of a sentence, but not of an acronym
+	if r, size := utf8.DecodeRuneInString(comment); unicode.IsUpper(r) {
+		if next, _ := utf8.DecodeRuneInString(comment[size:]); !unicode.IsUpper(next) {
+			comment = string(unicode.ToLower(r)) + comment[size:]
+		}
+	}
+	if verb != "" {
+		return name + " " + verb + " " + comment
+	}
+	return name + " " + comment
+}
+
+// wrapComment splits a line of a comment into lines of at most width
+// characters, breaking at spaces. Words longer than width aren't broken. A
+// width of zero or less leaves the line as is.
+func wrapComment(line string, width int) []string {
+	line = sanitizeComment(line)
+	if width <= 0 || utf8.RuneCountInString(line) <= width {
+		return []string{line}
+	}
+	var out []string
+	var current string
+	for _, word := range strings.Fields(line) {
+		switch {
+		case current == "":
+			current = word
+		case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width:
+			out = append(out, current)
+			current = word
+		default:
+			current += " " + word
+		}
+	}
+	return append(out, current)
+}
+
+// sanitizeComment removes what can't appear in a line comment: line breaks,
+// and "*/", which would end a comment the line is pasted into.
+func sanitizeComment(s string) string {
+	s = strings.ReplaceAll(normalizeNewlines(s), "\n", " ")
+	return strings.ReplaceAll(s, "*/", "* /")
+}
+
+func normalizeNewlines(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\r", "\n")
+}
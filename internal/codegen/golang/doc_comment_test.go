@@ -0,0 +1,44 @@
+package golang
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+)
+
+func TestDbComment(t *testing.T) {
+	emit := true
+	for _, tc := range []struct {
+		name    string
+		comment string
+		prefix  bool
+		wrap    int
+		want    string
+	}{
+		{name: "Email", comment: "The address of the user", want: "The address of the user"},
+		{name: "Email", comment: "The address of the user", prefix: true, want: "Email is the address of the user"},
+		{name: "Email", comment: "Email of the user", prefix: true, want: "Email of the user"},
+		{name: "AvatarURL", comment: "URL of the avatar", prefix: true, want: "AvatarURL is URL of the avatar"},
+		{name: "User", comment: "First line\r\nSecond line\n", want: "First line\nSecond line"},
+		{name: "User", comment: "Ends a /* block */ comment", want: "Ends a /* block * / comment"},
+		{name: "User", comment: "one two three four five", wrap: 16, want: "one two three\nfour five"},
+		{name: "User", comment: "averyveryverylongword short", wrap: 8, want: "averyveryverylongword\nshort"},
+		{name: "User", comment: "  "},
+	} {
+		options := &opts.Options{EmitDbComments: &emit, DocCommentNamePrefix: tc.prefix, DocCommentWrap: tc.wrap}
+		if diff := cmp.Diff(tc.want, dbComment(options, tc.name, tc.comment)); diff != "" {
+			t.Errorf("%q: comment differed (-want +got):\n%s", tc.comment, diff)
+		}
+	}
+}
+
+func TestQueryComments(t *testing.T) {
+	options := &opts.Options{DocCommentNamePrefix: true, DocCommentWrap: 20}
+	got := queryComments(options, "GetUser", []string{" Returns a user by id", "   indented line"})
+	want := []string{" GetUser returns a", " user by id", "   indented line"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("comments differed (-want +got):\n%s", diff)
+	}
+}
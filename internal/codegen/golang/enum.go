@@ -13,6 +13,7 @@ type Constant struct {
 
 type Enum struct {
 	Name      string
+	DBName    string // Name of the enum in the database, qualified with its schema outside of the default schema
 	Comment   string
 	Constants []Constant
 	NameTags  map[string]string
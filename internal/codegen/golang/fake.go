@@ -0,0 +1,168 @@
+package golang
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+)
+
+const (
+	// fakeFileName is the name of the file declaring FakeQuerier, written
+	// with emit_fake.
+	fakeFileName    = "querier_fake.go"
+	fakeQuerierName = "FakeQuerier"
+	errNotStubbed   = "ErrNotStubbed"
+)
+
+// FakeMethod is a method of the Querier interface implemented by FakeQuerier,
+// which calls the function in the field named after the method.
+type FakeMethod struct {
+	Name string
+	// Params are the parameters of the method, and Args the arguments it
+	// passes them on as.
+	Params  string
+	Args    string
+	Results string
+	// NotStubbed returns the error of a method whose function isn't set,
+	// named err.
+	NotStubbed string
+}
+
+// FakeMethods returns the methods of FakeQuerier, one for each method the
+// Querier interface declares.
+func (t *tmplCtx) FakeMethods() []FakeMethod {
+	var methods []FakeMethod
+	for _, q := range t.GoQueries {
+		methods = append(methods, t.fakeMethods(q)...)
+	}
+	return methods
+}
+
+func (t *tmplCtx) fakeMethods(q Query) []FakeMethod {
+	var params []Argument
+	if !t.EmitMethodsWithoutContext {
+		params = append(params, Argument{Name: "ctx", Type: "context.Context"})
+	}
+	if t.EmitMethodsWithDBArgument {
+		params = append(params, Argument{Name: "db", Type: "DBTX"})
+	}
+	args := slices.Clone(params)
+	switch q.Cmd {
+	case metadata.CmdCopyFrom, metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne:
+		if !q.Arg.isEmpty() {
+			args = append(args, Argument{Name: q.Arg.Name, Type: "[]" + q.Arg.DefineType()})
+		}
+	default:
+		args = append(args, q.Arg.Pairs()...)
+	}
+
+	method := FakeMethod{Name: q.MethodName, Params: joinParams(args), Args: joinArgs(args)}
+	switch q.Cmd {
+	case metadata.CmdOne:
+		method.Results = fmt.Sprintf("(%s, error)", q.Ret.DefineType())
+		method.NotStubbed = fmt.Sprintf("var zero %s\nreturn zero, err", q.Ret.DefineType())
+	case metadata.CmdMany:
+		method.Results = fmt.Sprintf("([]%s, error)", q.Ret.DefineType())
+		method.NotStubbed = "return nil, err"
+	case metadata.CmdExec:
+		method.Results = "error"
+		method.NotStubbed = "return err"
+	case metadata.CmdExecRows, metadata.CmdExecLastId, metadata.CmdCopyFrom:
+		method.Results = "(int64, error)"
+		method.NotStubbed = "return 0, err"
+	case metadata.CmdExecResult:
+		if t.SQLDriver.IsPGX() {
+			method.Results = "(pgconn.CommandTag, error)"
+			method.NotStubbed = "return pgconn.CommandTag{}, err"
+		} else {
+			method.Results = "(sql.Result, error)"
+			method.NotStubbed = "return nil, err"
+		}
+	case metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne:
+		// Batch methods can't return an error, so their results report it
+		// for each item instead.
+		method.Results = "*" + q.BatchResults
+		items := "0"
+		if !q.Arg.isEmpty() {
+			items = "len(" + q.Arg.Name + ")"
+		}
+		if t.SQLDriver.IsPGX() {
+			method.NotStubbed = fmt.Sprintf("return &%s{br: fakeBatchResults{err}, tot: %s}", method.Results[1:], items)
+		} else {
+			method.NotStubbed = fmt.Sprintf("return &%s{ctx: ctx, err: err, vals: make([][]interface{}, %s)}", method.Results[1:], items)
+		}
+	}
+	methods := []FakeMethod{method}
+
+	if q.Cmd == metadata.CmdMany && t.EmitIteratorQueries {
+		methods = append(methods, FakeMethod{
+			Name:    q.MethodName + "Iter",
+			Params:  method.Params,
+			Args:    method.Args,
+			Results: fmt.Sprintf("iter.Seq2[%s, error]", q.Ret.DefineType()),
+			NotStubbed: fmt.Sprintf("return func(yield func(%s, error) bool) {\nvar zero %s\nyield(zero, err)\n}",
+				q.Ret.DefineType(), q.Ret.DefineType()),
+		})
+	}
+	if q.Cmd == metadata.CmdMany && q.Pagination != nil {
+		paginated := append(slices.Clone(params), Argument{Name: "page", Type: "Page"})
+		for _, f := range q.Pagination.fields {
+			if f.DBName == "limit" || f.DBName == "offset" {
+				continue
+			}
+			paginated = append(paginated, Argument{Name: escape(toLowerCase(f.Name)), Type: f.Type})
+		}
+		methods = append(methods, FakeMethod{
+			Name:       q.MethodName + "Paginated",
+			Params:     joinParams(paginated),
+			Args:       joinArgs(paginated),
+			Results:    fmt.Sprintf("([]%s, bool, error)", q.Ret.DefineType()),
+			NotStubbed: "return nil, false, err",
+		})
+	}
+	return methods
+}
+
+func joinParams(args []Argument) string {
+	var out []string
+	for _, arg := range args {
+		out = append(out, arg.Name+" "+arg.Type)
+	}
+	return strings.Join(out, ", ")
+}
+
+func joinArgs(args []Argument) string {
+	var out []string
+	for _, arg := range args {
+		out = append(out, arg.Name)
+	}
+	return strings.Join(out, ", ")
+}
+
+// validateFake checks that the fields and methods of FakeQuerier don't
+// conflict with each other or with the other generated identifiers.
+func validateFake(queries []Query, names map[string]struct{}) error {
+	for _, name := range []string{fakeQuerierName, errNotStubbed} {
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("%s conflicts with type name: %s", fakeQuerierName, name)
+		}
+	}
+	methods := map[string]struct{}{}
+	for _, q := range queries {
+		methods[q.MethodName] = struct{}{}
+	}
+	for _, q := range queries {
+		if q.MethodName == "Calls" {
+			return fmt.Errorf("query %s: conflicts with %s.Calls", q.MethodName, fakeQuerierName)
+		}
+		if _, ok := methods[q.MethodName+"Func"]; ok {
+			return fmt.Errorf("query %sFunc: conflicts with %s.%sFunc", q.MethodName, fakeQuerierName, q.MethodName)
+		}
+		if q.ConstantName == "fakeBatchResults" {
+			return fmt.Errorf("query constant name conflicts with %s helper: %s", fakeQuerierName, q.ConstantName)
+		}
+	}
+	return nil
+}
@@ -19,6 +19,13 @@ type Field struct {
 	Column  *plugin.Column
 	// EmbedFields contains the embedded fields that require scanning.
 	EmbedFields []Field
+	// EmbedPointer is set if the embedded table comes from the nullable side
+	// of an outer join and Type is a pointer to the model, which is left nil
+	// when all of its columns are NULL.
+	EmbedPointer bool
+	// EncodeAs is set for a parameter that's passed to database/sql as a
+	// string or as bytes, which its type is converted to by an adapter.
+	EncodeAs string
 }
 
 func (gf Field) Tag() string {
@@ -29,6 +36,12 @@ func (gf Field) HasSqlcSlice() bool {
 	return gf.Column.IsSqlcSlice
 }
 
+// HasSqlcOrderBy reports whether the field holds the sort order chosen for
+// sqlc.orderby(), which is written into the query instead of being bound.
+func (gf Field) HasSqlcOrderBy() bool {
+	return gf.Column != nil && len(gf.Column.OrderBy) > 0
+}
+
 func TagsToString(tags map[string]string) string {
 	if len(tags) == 0 {
 		return ""
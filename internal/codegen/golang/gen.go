@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"go/format"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -16,10 +18,18 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/plugin"
 )
 
+const (
+	// queriesByNameFileName is the name of the file declaring the map of
+	// queries by name.
+	queriesByNameFileName = "queries.go"
+	queriesByNameVar      = "QueriesByName"
+)
+
 type tmplCtx struct {
 	Q           string
 	Package     string
 	SQLDriver   opts.SQLDriver
+	Engine      string
 	Enums       []Enum
 	Structs     []Struct
 	GoQueries   []Query
@@ -35,12 +45,32 @@ type tmplCtx struct {
 	EmitInterface             bool
 	EmitEmptySlices           bool
 	EmitMethodsWithDBArgument bool
+	EmitMethodsWithoutContext bool
 	EmitEnumValidMethod       bool
 	EmitAllEnumValues         bool
-	UsesCopyFrom              bool
-	UsesBatch                 bool
-	OmitSqlcVersion           bool
-	BuildTags                 string
+	EmitIteratorQueries       bool
+	EmitScanTargets           bool
+	EmitOtelTracing           bool
+	EmitOtelDbStatement       bool
+	EmitQueryHooks            bool
+	// EmitOptions is set if New takes options, which configure tracing and
+	// query hooks
+	EmitOptions  bool
+	UsesCopyFrom bool
+	// CopyFromChunkSize is the number of rows a MySQL :copyfrom query
+	// inserts with each statement when it doesn't use LOAD DATA
+	CopyFromChunkSize int
+	UsesBatch         bool
+	UsesPagination    bool
+	// ParamEncodings are the adapters that pass parameters to database/sql
+	ParamEncodings ParamEncodings
+	// CompositeTypes are the types RegisterCompositeTypes registers with a
+	// pgx/v5 connection
+	CompositeTypes []string
+	// NotFoundErrors are the errors returned by queries that find no row
+	NotFoundErrors  []NotFoundError
+	OmitSqlcVersion bool
+	BuildTags       string
 }
 
 func (t *tmplCtx) OutputQuery(sourceName string) bool {
@@ -54,36 +84,80 @@ func (t *tmplCtx) codegenDbarg() string {
 	return ""
 }
 
+// codegenCtxarg returns the context parameter of a query method, which
+// emit_methods_without_context leaves out.
+func (t *tmplCtx) codegenCtxarg() string {
+	if t.EmitMethodsWithoutContext {
+		return ""
+	}
+	return "ctx context.Context, "
+}
+
+// codegenCtxval returns the context argument a query method passes on.
+func (t *tmplCtx) codegenCtxval() string {
+	if t.EmitMethodsWithoutContext {
+		return ""
+	}
+	return "ctx, "
+}
+
+// codegenBackgroundCtx returns the code that declares the context of a query
+// method without a context parameter. pgx has no methods without a context, so
+// they are called with context.Background().
+func (t *tmplCtx) codegenBackgroundCtx() string {
+	if !t.EmitMethodsWithoutContext {
+		return ""
+	}
+	return "\nctx := context.Background()"
+}
+
+// Called as a global method since subtemplate notFoundCode does not have
+// access to the toplevel tmplCtx
+func (t *tmplCtx) codegenNoRowsError() string {
+	return noRowsError(t.SQLDriver)
+}
+
 // Called as a global method since subtemplate queryCodeStdExec does not have
 // access to the toplevel tmplCtx
 func (t *tmplCtx) codegenEmitPreparedQueries() bool {
 	return t.EmitPreparedQueries
 }
 
+// Called as a global method since subtemplate queryCodeSlicesDollar does not
+// have access to the toplevel tmplCtx
+func (t *tmplCtx) codegenEngine() string {
+	return t.Engine
+}
+
 func (t *tmplCtx) codegenQueryMethod(q Query) string {
 	db := "q.db"
 	if t.EmitMethodsWithDBArgument {
 		db = "db"
 	}
 
+	suffix := "Context"
+	if t.EmitMethodsWithoutContext {
+		suffix = ""
+	}
+
 	switch q.Cmd {
 	case ":one":
 		if t.EmitPreparedQueries {
 			return "q.queryRow"
 		}
-		return db + ".QueryRowContext"
+		return db + ".QueryRow" + suffix
 
 	case ":many":
 		if t.EmitPreparedQueries {
 			return "q.query"
 		}
-		return db + ".QueryContext"
+		return db + ".Query" + suffix
 
 	default:
 		if t.EmitPreparedQueries {
 			return "q.exec"
 		}
-		return db + ".ExecContext"
+		return db + ".Exec" + suffix
 	}
 }
 
@@ -104,6 +178,177 @@ func (t *tmplCtx) codegenQueryRetval(q Query) (string, error) {
 	}
 }
 
+// codegenTraceQuery returns the code that starts a query method when
+// emit_otel_tracing is set. If the Queries has a tracer, the method runs on a
+// copy without one inside a span, so untraced calls only pay for a nil check.
+func (t *tmplCtx) codegenTraceQuery(q Query) string {
+	if !t.EmitOtelTracing {
+		return ""
+	}
+	call := fmt.Sprintf("untraced.%s(%s)", q.MethodName, t.methodArgs(q))
+
+	var b strings.Builder
+	b.WriteString("\nif q.tracer != nil {\n")
+	// :copyfrom queries don't run their SQL, so there's no statement to record
+	query := q.ConstantName
+	if q.Cmd == metadata.CmdCopyFrom {
+		query = `""`
+	}
+	fmt.Fprintf(&b, "ctx, span := q.startSpan(ctx, %q, %s)\n", q.MethodName, query)
+	b.WriteString("untraced := *q\n")
+	b.WriteString("untraced.tracer = nil\n")
+	switch q.Cmd {
+	case metadata.CmdExec:
+		fmt.Fprintf(&b, "err := %s\n", call)
+		b.WriteString("endSpan(span, err)\n")
+		b.WriteString("return err\n")
+	case metadata.CmdExecRows, metadata.CmdCopyFrom:
+		fmt.Fprintf(&b, "n, err := %s\n", call)
+		b.WriteString("if err == nil {\n")
+		b.WriteString("spanRowsAffected(span, n)\n")
+		b.WriteString("}\n")
+		b.WriteString("endSpan(span, err)\n")
+		b.WriteString("return n, err\n")
+	case metadata.CmdExecResult:
+		fmt.Fprintf(&b, "result, err := %s\n", call)
+		b.WriteString("if err == nil {\n")
+		if t.SQLDriver.IsPGX() {
+			b.WriteString("spanRowsAffected(span, result.RowsAffected())\n")
+		} else {
+			b.WriteString("if n, err := result.RowsAffected(); err == nil {\n")
+			b.WriteString("spanRowsAffected(span, n)\n")
+			b.WriteString("}\n")
+		}
+		b.WriteString("}\n")
+		b.WriteString("endSpan(span, err)\n")
+		b.WriteString("return result, err\n")
+	default:
+		fmt.Fprintf(&b, "result, err := %s\n", call)
+		b.WriteString("endSpan(span, err)\n")
+		b.WriteString("return result, err\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// methodArgs returns the arguments a query method is called with, to run it
+// again on a copy of the Queries.
+func (t *tmplCtx) methodArgs(q Query) string {
+	args := []string{"ctx"}
+	if t.EmitMethodsWithDBArgument {
+		args = append(args, "db")
+	}
+	if q.Cmd == metadata.CmdCopyFrom {
+		args = append(args, q.Arg.Name)
+	} else {
+		for _, p := range q.Arg.Pairs() {
+			args = append(args, p.Name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// codegenHookQuery returns the code that starts a query method when
+// emit_query_hooks is set. If the Queries has a hook, the method runs on a copy
+// without one between the calls to the hook, so calls without a hook only pay
+// for a nil check. AfterQuery is deferred, so that it runs however the method
+// returns.
+func (t *tmplCtx) codegenHookQuery(q Query) string {
+	if !t.EmitQueryHooks {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\nif q.hook != nil {\n")
+	t.writeBeforeQuery(&b, q)
+	call := fmt.Sprintf("unhooked.%s(%s)", q.MethodName, t.methodArgs(q))
+	if q.Cmd == metadata.CmdExec {
+		fmt.Fprintf(&b, "err = %s\n", call)
+		b.WriteString("return err\n")
+	} else {
+		fmt.Fprintf(&b, "result, err := %s\n", call)
+		b.WriteString("return result, err\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// codegenHookIterQuery is like codegenHookQuery, for the iterator of a :many
+// query. The hook is called around the iteration, with the error it ended
+// with, if any. The iterator is called directly rather than ranged over, as
+// range-over-func needs Go 1.23.
+func (t *tmplCtx) codegenHookIterQuery(q Query) string {
+	if !t.EmitQueryHooks {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\nif q.hook != nil {\n")
+	fmt.Fprintf(&b, "return func(yield func(%s, error) bool) {\n", q.Ret.DefineType())
+	t.writeBeforeQuery(&b, q)
+	fmt.Fprintf(&b, "unhooked.%sIter(%s)(func(item %s, itemErr error) bool {\n", q.MethodName, t.methodArgs(q), q.Ret.DefineType())
+	b.WriteString("if itemErr != nil {\n")
+	b.WriteString("err = itemErr\n")
+	b.WriteString("}\n")
+	b.WriteString("return yield(item, itemErr)\n")
+	b.WriteString("})\n")
+	b.WriteString("}\n")
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func (t *tmplCtx) writeBeforeQuery(b *strings.Builder, q Query) {
+	// :copyfrom queries don't run their SQL, so there's no statement to pass
+	query := q.ConstantName
+	if q.Cmd == metadata.CmdCopyFrom {
+		query = `""`
+	}
+	fmt.Fprintf(b, "ctx, after := q.beforeQuery(ctx, %q, %s)\n", q.MethodName, query)
+	b.WriteString("var err error\n")
+	b.WriteString("defer func() {\n")
+	b.WriteString("after(err)\n")
+	b.WriteString("}()\n")
+	b.WriteString("unhooked := *q\n")
+	b.WriteString("unhooked.hook = nil\n")
+}
+
+// codegenScanTargets returns the body of the ScanTargets method of a struct.
+func (t *tmplCtx) codegenScanTargets(s Struct) string {
+	var b strings.Builder
+	prefixes, fields := s.columnFields()
+	targets := make([]string, len(fields))
+	for i, f := range fields {
+		targets[i] = "&r." + prefixes[i] + f.Name
+		if f.isArray() && !t.SQLDriver.IsPGX() {
+			targets[i] = "pq.Array(" + targets[i] + ")"
+		}
+	}
+	fmt.Fprintf(&b, "\nreturn []any{%s}", joinValues(targets))
+	return b.String()
+}
+
+// codegenColumnNames returns the names of the columns of a struct as a Go
+// slice literal.
+func (t *tmplCtx) codegenColumnNames(s Struct) string {
+	_, fields := s.columnFields()
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		name := f.DBName
+		if name == "" && f.Column != nil {
+			name = f.Column.Name
+		}
+		names[i] = strconv.Quote(name)
+	}
+	return "[]string{" + joinValues(names) + "}"
+}
+
+// joinValues joins the values of a composite literal, putting each on a line
+// of its own if there are more than three.
+func joinValues(values []string) string {
+	if len(values) <= 3 {
+		return strings.Join(values, ", ")
+	}
+	return "\n" + strings.Join(values, ",\n") + ",\n"
+}
+
 func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.GenerateResponse, error) {
 	options, err := opts.Parse(req)
 	if err != nil {
@@ -113,13 +358,30 @@ func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.Generat
 	if err := opts.ValidateOpts(options); err != nil {
 		return nil, err
 	}
+	if err := validateCommentTags(req, options); err != nil {
+		return nil, err
+	}
 
 	enums := buildEnums(req, options)
-	structs := buildStructs(req, options)
+	structs, err := buildStructs(req, options)
+	if err != nil {
+		return nil, err
+	}
 	queries, err := buildQueries(req, options, structs)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkTypeNames(req, options, enums, structs, queries); err != nil {
+		return nil, err
+	}
+	if nullable := nullableEmbedStructs(structs, queries); len(nullable) > 0 {
+		structs = append(structs, nullable...)
+		sort.Slice(structs, func(i, j int) bool { return structs[i].Name < structs[j].Name })
+	}
+	if composites := buildCompositeTypes(req, options); len(composites) > 0 {
+		structs = append(structs, composites...)
+		sort.Slice(structs, func(i, j int) bool { return structs[i].Name < structs[j].Name })
+	}
 
 	if options.OmitUnusedStructs {
 		enums, structs = filterUnusedStructs(enums, structs, queries)
@@ -129,7 +391,14 @@ func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.Generat
 		return nil, err
 	}
 
-	return generate(req, options, enums, structs, queries)
+	resp, err := generate(req, options, enums, structs, queries)
+	if err != nil {
+		return nil, err
+	}
+	resp.Diagnostics = append(resp.Diagnostics, deprecatedOverrides(options)...)
+	resp.Diagnostics = append(resp.Diagnostics, renamedOverrides(options)...)
+	resp.Diagnostics = append(resp.Diagnostics, unusedOverrides(req, options)...)
+	return resp, nil
 }
 
 func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Query) error {
@@ -145,6 +414,29 @@ func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Q
 		}
 		structNames[struckt.Name] = struct{}{}
 	}
+	if options.EmitTaggedInterfaces {
+		if err := validateInterfaces(queries, generatedTypes(enumNames, structNames, queries)); err != nil {
+			return err
+		}
+	}
+	if options.EmitPaginationHelpers {
+		if err := validatePagination(queries, generatedTypes(enumNames, structNames, queries)); err != nil {
+			return err
+		}
+	}
+	if options.EmitExportedQueryConstants || options.EmitQueriesByName {
+		if err := validateQueryConstants(options, enums, queries, generatedTypes(enumNames, structNames, queries)); err != nil {
+			return err
+		}
+	}
+	if err := validateNotFoundErrors(queries, generatedTypes(enumNames, structNames, queries)); err != nil {
+		return err
+	}
+	if options.EmitFake {
+		if err := validateFake(queries, generatedTypes(enumNames, structNames, queries)); err != nil {
+			return err
+		}
+	}
 	if !options.EmitExportedQueries {
 		return nil
 	}
@@ -159,9 +451,35 @@ func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Q
 	return nil
 }
 
+// validateQueryConstants checks that the exported query constants and the
+// QueriesByName map don't conflict with other generated identifiers.
+func validateQueryConstants(options *opts.Options, enums []Enum, queries []Query, names map[string]struct{}) error {
+	for _, enum := range enums {
+		for _, c := range enum.Constants {
+			names[c.Name] = struct{}{}
+		}
+	}
+	if options.EmitQueriesByName {
+		if _, ok := names[queriesByNameVar]; ok {
+			return fmt.Errorf("queries by name map conflicts with type name: %s", queriesByNameVar)
+		}
+		names[queriesByNameVar] = struct{}{}
+	}
+	if !options.EmitExportedQueryConstants {
+		return nil
+	}
+	for _, query := range queries {
+		if _, ok := names[query.ConstantName]; ok {
+			return fmt.Errorf("query constant name conflicts with generated name: %s", query.ConstantName)
+		}
+	}
+	return nil
+}
+
 func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum, structs []Struct, queries []Query) (*plugin.GenerateResponse, error) {
 	i := &importer{
 		Options: options,
+		Engine:  req.Settings.Engine,
 		Queries: queries,
 		Enums:   enums,
 		Structs: structs,
@@ -175,11 +493,24 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		EmitPreparedQueries:       options.EmitPreparedQueries,
 		EmitEmptySlices:           options.EmitEmptySlices,
 		EmitMethodsWithDBArgument: options.EmitMethodsWithDbArgument,
+		EmitMethodsWithoutContext: options.EmitMethodsWithoutContext,
 		EmitEnumValidMethod:       options.EmitEnumValidMethod,
 		EmitAllEnumValues:         options.EmitAllEnumValues,
+		EmitIteratorQueries:       options.EmitIteratorQueries,
+		EmitScanTargets:           options.EmitScanTargets,
+		EmitOtelTracing:           options.EmitOtelTracing,
+		EmitOtelDbStatement:       options.EmitOtelDbStatement,
+		EmitQueryHooks:            options.EmitQueryHooks,
+		EmitOptions:               options.EmitOtelTracing || options.EmitQueryHooks,
 		UsesCopyFrom:              usesCopyFrom(queries),
+		CopyFromChunkSize:         options.CopyfromChunkSize,
 		UsesBatch:                 usesBatch(queries),
+		UsesPagination:            usesPagination(queries),
+		ParamEncodings:            usesParamEncodings(queries),
+		CompositeTypes:            compositeTypeRegistrations(req, options),
+		NotFoundErrors:            notFoundErrors(queries),
 		SQLDriver:                 parseDriver(options.SqlPackage),
+		Engine:                    req.Settings.Engine,
 		Q:                         "`",
 		Package:                   options.Package,
 		Enums:                     enums,
@@ -189,8 +520,16 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		OmitSqlcVersion:           options.OmitSqlcVersion,
 	}
 
-	if tctx.UsesCopyFrom && !tctx.SQLDriver.IsPGX() && options.SqlDriver != opts.SQLDriverGoSQLDriverMySQL {
-		return nil, errors.New(":copyfrom is only supported by pgx and github.com/go-sql-driver/mysql")
+	// Without github.com/go-sql-driver/mysql, MySQL :copyfrom queries insert
+	// their rows with multi-row INSERT statements
+	if tctx.UsesCopyFrom && !tctx.SQLDriver.IsPGX() && options.SqlDriver != opts.SQLDriverGoSQLDriverMySQL && req.Settings.Engine != "mysql" {
+		return nil, errors.New(":copyfrom is only supported by pgx and MySQL")
+	}
+
+	if !tctx.SQLDriver.IsPGX() {
+		if err := checkNoMultidimensionalArrays(structs, queries); err != nil {
+			return nil, err
+		}
 	}
 
 	if tctx.UsesCopyFrom && options.SqlDriver == opts.SQLDriverGoSQLDriverMySQL {
@@ -200,8 +539,20 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		tctx.SQLDriver = opts.SQLDriverGoSQLDriverMySQL
 	}
 
-	if tctx.UsesBatch && !tctx.SQLDriver.IsPGX() {
-		return nil, errors.New(":batch* commands are only supported by pgx")
+	for _, q := range queries {
+		if q.OrderBy != nil && usesBatch([]Query{q}) {
+			return nil, fmt.Errorf("query %s: sqlc.orderby is not supported in :batch* commands", q.MethodName)
+		}
+	}
+
+	// database/sql :batch* and :copyfrom queries are built on the context
+	// methods, which DBTX doesn't have with emit_methods_without_context
+	if options.EmitMethodsWithoutContext && !tctx.SQLDriver.IsPGX() {
+		for _, q := range queries {
+			if q.Cmd == metadata.CmdCopyFrom || usesBatch([]Query{q}) {
+				return nil, fmt.Errorf("query %s: %s is not supported by emit_methods_without_context with database/sql", q.MethodName, q.Cmd)
+			}
+		}
 	}
 
 	funcMap := template.FuncMap{
@@ -215,9 +566,19 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		// These methods are Go specific, they do not belong in the codegen package
 		// (as that is language independent)
 		"dbarg":               tctx.codegenDbarg,
+		"ctxarg":              tctx.codegenCtxarg,
+		"ctxval":              tctx.codegenCtxval,
+		"backgroundCtx":       tctx.codegenBackgroundCtx,
 		"emitPreparedQueries": tctx.codegenEmitPreparedQueries,
+		"engine":              tctx.codegenEngine,
 		"queryMethod":         tctx.codegenQueryMethod,
 		"queryRetval":         tctx.codegenQueryRetval,
+		"scanTargets":         tctx.codegenScanTargets,
+		"columnNames":         tctx.codegenColumnNames,
+		"traceQuery":          tctx.codegenTraceQuery,
+		"hookQuery":           tctx.codegenHookQuery,
+		"hookIterQuery":       tctx.codegenHookIterQuery,
+		"noRowsError":         tctx.codegenNoRowsError,
 	}
 
 	tmpl := template.Must(
@@ -287,14 +648,38 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 	if err := execute(dbFileName, "dbFile"); err != nil {
 		return nil, err
 	}
-	if err := execute(modelsFileName, "modelsFile"); err != nil {
-		return nil, err
+	if options.OutputModelsFileMode == opts.ModelsFileModePerTable {
+		modelFiles, err := splitModels(modelsFileName, enums, structs)
+		if err != nil {
+			return nil, err
+		}
+		i.ModelFiles = map[string]modelFile{}
+		for _, f := range modelFiles {
+			i.ModelFiles[f.Name] = f
+		}
+		for _, f := range modelFiles {
+			tctx.Enums, tctx.Structs = f.Enums, f.Structs
+			if err := execute(f.Name, "modelsFile"); err != nil {
+				return nil, err
+			}
+		}
+		tctx.Enums, tctx.Structs = enums, structs
+		output[strings.TrimSuffix(modelsFileName, ".go")+".manifest"] = modelsManifest(modelFiles)
+	} else {
+		if err := execute(modelsFileName, "modelsFile"); err != nil {
+			return nil, err
+		}
 	}
 	if options.EmitInterface {
 		if err := execute(querierFileName, "interfaceFile"); err != nil {
 			return nil, err
 		}
 	}
+	if options.EmitFake {
+		if err := execute(fakeFileName, "fakeFile"); err != nil {
+			return nil, err
+		}
+	}
 	if tctx.UsesCopyFrom {
 		if err := execute(copyfromFileName, "copyfromFile"); err != nil {
 			return nil, err
@@ -305,6 +690,16 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 			return nil, err
 		}
 	}
+	if options.EmitQueriesByName {
+		if err := execute(queriesByNameFileName, "queriesByNameFile"); err != nil {
+			return nil, err
+		}
+	}
+	if len(tctx.NotFoundErrors) > 0 {
+		if err := execute(notFoundFileName, "notFoundFile"); err != nil {
+			return nil, err
+		}
+	}
 
 	files := map[string]struct{}{}
 	for _, gq := range queries {
@@ -316,6 +711,26 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 			return nil, err
 		}
 	}
+	if options.EmitJsonSchema != "" {
+		schemas, err := jsonSchemaFiles(req, options, enums, queries)
+		if err != nil {
+			return nil, err
+		}
+		for name, contents := range schemas {
+			output[name] = contents
+		}
+	}
+	if options.EmitManifest {
+		version := req.SqlcVersion
+		if options.OmitSqlcVersion {
+			version = ""
+		}
+		contents, err := buildManifest(output, version)
+		if err != nil {
+			return nil, err
+		}
+		output[manifestFileName] = contents
+	}
 	resp := plugin.GenerateResponse{}
 
 	for filename, code := range output {
@@ -337,6 +752,15 @@ func usesCopyFrom(queries []Query) bool {
 	return false
 }
 
+func usesMany(queries []Query) bool {
+	for _, q := range queries {
+		if q.Cmd == metadata.CmdMany {
+			return true
+		}
+	}
+	return false
+}
+
 func usesBatch(queries []Query) bool {
 	for _, q := range queries {
 		for _, cmd := range []string{metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne} {
@@ -362,6 +786,39 @@ func checkNoTimesForMySQLCopyFrom(queries []Query) error {
 	return nil
 }
 
+// checkNoMultidimensionalArrays returns an error for columns that would be
+// scanned into nested slices, which only pgx supports: database/sql can't
+// scan multidimensional arrays. Columns overridden with a Go type of their
+// own are left to that type.
+func checkNoMultidimensionalArrays(structs []Struct, queries []Query) error {
+	nested := func(f Field) bool {
+		col := f.Column
+		return col != nil && col.ArrayDims > 1 && strings.HasPrefix(f.Type, strings.Repeat("[]", int(col.ArrayDims)))
+	}
+	const unsupported = "multidimensional arrays (%s) are only supported by pgx, set sql_package to pgx/v5"
+	for _, s := range structs {
+		for _, f := range s.Fields {
+			if nested(f) {
+				return fmt.Errorf("table %s: column %s: "+unsupported, s.Table.Name, f.Column.Name, f.Type)
+			}
+		}
+	}
+	for _, q := range queries {
+		for _, v := range []QueryValue{q.Arg, q.Ret} {
+			fields := []Field{{Type: v.Typ, Column: v.Column}}
+			if v.Struct != nil {
+				fields = v.Struct.Fields
+			}
+			for _, f := range fields {
+				if nested(f) {
+					return fmt.Errorf("query %s: column %s: "+unsupported, q.MethodName, f.Column.Name, f.Type)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func filterUnusedStructs(enums []Enum, structs []Struct, queries []Query) ([]Enum, []Struct) {
 	keepTypes := make(map[string]struct{})
 
@@ -378,7 +835,7 @@ func filterUnusedStructs(enums []Enum, structs []Struct, queries []Query) ([]Enu
 			keepTypes[query.Ret.Type()] = struct{}{}
 			if query.Ret.IsStruct() {
 				for _, field := range query.Ret.Struct.Fields {
-					keepTypes[field.Type] = struct{}{}
+					keepTypes[strings.TrimPrefix(field.Type, "*")] = struct{}{}
 					for _, embedField := range field.EmbedFields {
 						keepTypes[embedField.Type] = struct{}{}
 					}
@@ -387,6 +844,29 @@ func filterUnusedStructs(enums []Enum, structs []Struct, queries []Query) ([]Enu
 		}
 	}
 
+	// The types of the fields of kept structs are kept too, as are the
+	// element types of slices and pointers, such as those of composite types
+	for changed := true; changed; {
+		changed = false
+		keep := func(typ string) {
+			typ = strings.TrimLeft(typ, "[]*")
+			if _, ok := keepTypes[typ]; !ok {
+				keepTypes[typ] = struct{}{}
+				changed = true
+			}
+		}
+		for typ := range keepTypes {
+			keep(typ)
+		}
+		for _, st := range structs {
+			if _, ok := keepTypes[st.Name]; ok {
+				for _, field := range st.Fields {
+					keep(field.Type)
+				}
+			}
+		}
+	}
+
 	keepEnums := make([]Enum, 0, len(enums))
 	for _, enum := range enums {
 		_, keep := keepTypes[enum.Name]
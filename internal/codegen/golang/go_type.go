@@ -1,6 +1,7 @@
 package golang
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
@@ -9,84 +10,173 @@ import (
 )
 
 func addExtraGoStructTags(tags map[string]string, req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) {
-	for _, override := range options.Overrides {
-		oride := override.ShimOverride
-		if oride.GoType.StructTags == nil {
+	var matches []*opts.Override
+	for i := range options.Overrides {
+		override := &options.Overrides[i]
+		if override.ShimOverride.GoType.StructTags == nil {
 			continue
 		}
-		if !override.Matches(col.Table, req.Catalog.DefaultSchema) {
-			// Different table.
-			continue
-		}
-		cname := col.Name
-		if col.OriginalName != "" {
-			cname = col.OriginalName
-		}
-		if !sdk.MatchString(oride.ColumnName, cname) {
-			// Different column.
+		if !matchesColumn(req, override, col) {
 			continue
 		}
+		matches = append(matches, override)
+	}
+	// Apply the least specific overrides first so that tags from more
+	// specific overrides take precedence.
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Wildcards > matches[j].Wildcards
+	})
+	for _, override := range matches {
 		// Add the extra tags.
-		for k, v := range oride.GoType.StructTags {
+		for k, v := range override.ShimOverride.GoType.StructTags {
 			tags[k] = v
 		}
 	}
 }
 
-func goType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
-	// Check if the column's type has been overridden
-	for _, override := range options.Overrides {
-		oride := override.ShimOverride
+// matchesColumn reports whether a column override applies to col.
+func matchesColumn(req *plugin.GenerateRequest, override *opts.Override, col *plugin.Column) bool {
+	if override.ShimOverride.Column == "" {
+		return false
+	}
+	if override.Query && sdk.MatchString(override.ShimOverride.ColumnName, col.Name) {
+		return true
+	}
+	if !override.Query && !override.Matches(col.Table, req.Catalog.DefaultSchema) {
+		// Different table.
+		return false
+	}
+	cname := col.Name
+	if col.OriginalName != "" {
+		cname = col.OriginalName
+	}
+	// Different column.
+	return sdk.MatchString(override.ShimOverride.ColumnName, cname)
+}
 
-		if oride.GoType.TypeName == "" {
+// columnOverride returns the most specific column override for col, or nil if
+// none apply. The overrides of the query's sqlc.override annotations win over
+// the configured ones. An exact column name wins over a wildcard, and a
+// wildcard with fewer wildcard characters wins over one with more. Ties are
+// broken by the order of the overrides in the configuration.
+func columnOverride(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) *opts.Override {
+	if match := mostSpecificOverride(req, options, col, true); match != nil {
+		return match
+	}
+	return mostSpecificOverride(req, options, col, false)
+}
+
+// mostSpecificOverride returns the most specific column override for col
+// among those declared by annotations of the query, or among the configured
+// ones.
+func mostSpecificOverride(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column, query bool) *opts.Override {
+	var match *opts.Override
+	for i := range options.Overrides {
+		override := &options.Overrides[i]
+		if override.Query != query || override.ShimOverride.GoType.TypeName == "" {
 			continue
 		}
-		cname := col.Name
-		if col.OriginalName != "" {
-			cname = col.OriginalName
+		if !matchesColumn(req, override, col) {
+			continue
 		}
-		sameTable := override.Matches(col.Table, req.Catalog.DefaultSchema)
-		if oride.Column != "" && sdk.MatchString(oride.ColumnName, cname) && sameTable {
-			if col.IsSqlcSlice {
-				return "[]" + oride.GoType.TypeName
-			}
-			return oride.GoType.TypeName
+		if match == nil || override.Wildcards < match.Wildcards {
+			match = override
 		}
 	}
-	typ := goInnerType(req, options, col)
-	if col.IsSqlcSlice {
-		return "[]" + typ
+	return match
+}
+
+// goTypeResolution is the Go type of a column, and the override that chose
+// it, if any.
+type goTypeResolution struct {
+	Type     string
+	Override *opts.Override
+}
+
+func goType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
+	return resolveGoType(req, options, col).Type
+}
+
+func resolveGoType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) goTypeResolution {
+	// Check if the column's type has been overridden
+	if override := columnOverride(req, options, col); override != nil {
+		typ := override.ShimOverride.GoType.TypeName
+		if col.IsSqlcSlice {
+			typ = "[]" + typ
+		}
+		return goTypeResolution{Type: typ, Override: override}
 	}
-	if col.IsArray {
-		return strings.Repeat("[]", int(col.ArrayDims)) + typ
+	res := resolveInnerType(req, options, col)
+	if col.IsSqlcSlice {
+		res.Type = "[]" + res.Type
+	} else if col.IsArray {
+		res.Type = strings.Repeat("[]", int(col.ArrayDims)) + res.Type
 	}
-	return typ
+	return res
 }
 
-func goInnerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
+// dbTypeOverride returns the db_type override for the type of col, or nil if
+// none apply.
+func dbTypeOverride(options *opts.Options, col *plugin.Column) *opts.Override {
 	columnType := sdk.DataType(col.Type)
 	notNull := col.NotNull || col.IsArray
 
-	// package overrides have a higher precedence
-	for _, override := range options.Overrides {
-		oride := override.ShimOverride
-		if oride.GoType.TypeName == "" {
-			continue
-		}
-		if oride.DbType != "" && oride.DbType == columnType && oride.Nullable != notNull && oride.Unsigned == col.Unsigned {
-			return oride.GoType.TypeName
+	// package overrides have a higher precedence, and overrides of a domain
+	// win over overrides of the type it is based on
+	dbTypes := []string{columnType}
+	if col.Domain != nil {
+		dbTypes = []string{sdk.DataType(col.Domain), columnType}
+	}
+	for _, dbType := range dbTypes {
+		for i := range options.Overrides {
+			override := &options.Overrides[i]
+			oride := override.ShimOverride
+			if oride.GoType.TypeName == "" {
+				continue
+			}
+			if oride.DbType != "" && oride.DbType == dbType && oride.Nullable != notNull && oride.Unsigned == col.Unsigned {
+				return override
+			}
 		}
 	}
+	return nil
+}
+
+func resolveInnerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) goTypeResolution {
+	if typ, ok := checkEnumType(req, options, col); ok {
+		return goTypeResolution{Type: typ}
+	}
+	if override := dbTypeOverride(options, col); override != nil {
+		return goTypeResolution{Type: override.ShimOverride.GoType.TypeName, Override: override}
+	}
 
 	// TODO: Extend the engine interface to handle types
+	var typ string
 	switch req.Settings.Engine {
 	case "mysql":
-		return mysqlType(req, options, col)
+		typ = mysqlType(req, options, col)
 	case "postgresql":
-		return postgresType(req, options, col)
+		typ = postgresType(req, options, col)
 	case "sqlite":
-		return sqliteType(req, options, col)
+		typ = sqliteType(req, options, col)
 	default:
-		return "interface{}"
+		typ = "interface{}"
+	}
+	return goTypeResolution{Type: typ}
+}
+
+// jsonType returns the Go type of json values set with json_type, or "" for
+// the default type of the driver. database/sql can't scan NULL into a
+// json.RawMessage, so nullable values keep the default type there.
+func jsonType(options *opts.Options, notNull bool) string {
+	switch options.JsonType {
+	case opts.JSONTypeRawMessage:
+		if !notNull && !parseDriver(options.SqlPackage).IsPGX() {
+			return ""
+		}
+		return "json.RawMessage"
+	case opts.JSONTypeBytes:
+		return "[]byte"
 	}
+	return ""
 }
@@ -59,9 +59,13 @@ func mergeImports(imps ...fileImports) [][]ImportSpec {
 
 type importer struct {
 	Options *opts.Options
+	Engine  string
 	Queries []Query
 	Enums   []Enum
 	Structs []Struct
+	// ModelFiles are the files of the models, keyed by name, if they're
+	// split into a file per table.
+	ModelFiles map[string]modelFile
 }
 
 func (i *importer) usesType(typ string) bool {
@@ -102,6 +106,13 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 		batchFileName = i.Options.OutputBatchFileName
 	}
 
+	if f, ok := i.ModelFiles[filename]; ok {
+		models := *i
+		models.Enums = f.Enums
+		models.Structs = f.Structs
+		return mergeImports(models.modelImports())
+	}
+
 	switch filename {
 	case dbFileName:
 		return mergeImports(i.dbImports())
@@ -113,6 +124,10 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 		return mergeImports(i.copyfromImports())
 	case batchFileName:
 		return mergeImports(i.batchImports())
+	case fakeFileName:
+		return mergeImports(i.fakeImports())
+	case queriesByNameFileName, notFoundFileName:
+		return nil
 	default:
 		return mergeImports(i.queryImports(filename))
 	}
@@ -120,11 +135,12 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 
 func (i *importer) dbImports() fileImports {
 	var pkg []ImportSpec
-	std := []ImportSpec{
-		{Path: "context"},
-	}
+	var std []ImportSpec
 
 	sqlpkg := parseDriver(i.Options.SqlPackage)
+	if sqlpkg.IsPGX() || !i.Options.EmitMethodsWithoutContext {
+		std = append(std, ImportSpec{Path: "context"})
+	}
 	switch sqlpkg {
 	case opts.SQLDriverPGXV4:
 		pkg = append(pkg, ImportSpec{Path: "github.com/jackc/pgconn"})
@@ -134,10 +150,29 @@ func (i *importer) dbImports() fileImports {
 		pkg = append(pkg, ImportSpec{Path: "github.com/jackc/pgx/v5"})
 	default:
 		std = append(std, ImportSpec{Path: "database/sql"})
-		if i.Options.EmitPreparedQueries {
+		enc := usesParamEncodings(i.Queries)
+		if enc.Any() {
+			std = append(std, ImportSpec{Path: "database/sql/driver"})
+		}
+		if enc.Bytes {
+			std = append(std, ImportSpec{Path: "encoding"})
+		}
+		if i.Options.EmitPreparedQueries || enc.String {
 			std = append(std, ImportSpec{Path: "fmt"})
 		}
 	}
+	if i.Options.EmitQueryHooks {
+		std = append(std, ImportSpec{Path: "time"})
+	}
+	if usesPagination(i.Queries) {
+		std = append(std, ImportSpec{Path: "errors"})
+		std = append(std, ImportSpec{Path: "math"})
+	}
+	if i.Options.EmitOtelTracing {
+		pkg = append(pkg, ImportSpec{Path: "go.opentelemetry.io/otel/attribute"})
+		pkg = append(pkg, ImportSpec{Path: "go.opentelemetry.io/otel/codes"})
+		pkg = append(pkg, ImportSpec{Path: "go.opentelemetry.io/otel/trace"})
+	}
 
 	sort.Slice(std, func(i, j int) bool { return std[i].Path < std[j].Path })
 	sort.Slice(pkg, func(i, j int) bool { return pkg[i].Path < pkg[j].Path })
@@ -203,8 +238,14 @@ func buildImports(options *opts.Options, queries []Query, uses func(string) bool
 		}
 	}
 
+	var overrides []opts.Override
+	overrides = append(overrides, options.Overrides...)
+	for _, q := range queries {
+		overrides = append(overrides, q.Overrides...)
+	}
+
 	overrideTypes := map[string]string{}
-	for _, override := range options.Overrides {
+	for _, override := range overrides {
 		o := override.ShimOverride
 		if o.GoType.BasicType || o.GoType.TypeName == "" {
 			continue
@@ -216,6 +257,10 @@ func buildImports(options *opts.Options, queries []Query, uses func(string) bool
 	if uses("pq.NullTime") && !overrideNullTime {
 		pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
 	}
+	_, overrideHstore := overrideTypes["hstore.Hstore"]
+	if uses("hstore.Hstore") && !overrideHstore {
+		pkg[ImportSpec{Path: "github.com/lib/pq/hstore"}] = struct{}{}
+	}
 	_, overrideUUID := overrideTypes["uuid.UUID"]
 	if uses("uuid.UUID") && !overrideUUID {
 		pkg[ImportSpec{Path: "github.com/google/uuid"}] = struct{}{}
@@ -230,7 +275,7 @@ func buildImports(options *opts.Options, queries []Query, uses func(string) bool
 	}
 
 	// Custom imports
-	for _, override := range options.Overrides {
+	for _, override := range overrides {
 		o := override.ShimOverride
 
 		if o.GoType.BasicType || o.GoType.TypeName == "" {
@@ -247,12 +292,16 @@ func buildImports(options *opts.Options, queries []Query, uses func(string) bool
 }
 
 func (i *importer) interfaceImports() fileImports {
+	return sortedImports(i.querierImports())
+}
+
+// querierImports returns the imports of the types the methods of the Querier
+// interface use.
+func (i *importer) querierImports() (map[string]struct{}, map[ImportSpec]struct{}) {
 	std, pkg := buildImports(i.Options, i.Queries, func(name string) bool {
 		for _, q := range i.Queries {
-			if q.hasRetType() {
-				if usesBatch([]Query{q}) {
-					continue
-				}
+			// The results of batch queries are only used in the batch file
+			if q.hasRetType() && !usesBatch([]Query{q}) {
 				if hasPrefixIgnoringSliceAndPointerPrefix(q.Ret.Type(), name) {
 					return true
 				}
@@ -266,7 +315,31 @@ func (i *importer) interfaceImports() fileImports {
 		return false
 	})
 
-	std["context"] = struct{}{}
+	if !i.Options.EmitMethodsWithoutContext {
+		std["context"] = struct{}{}
+	}
+	if i.Options.EmitIteratorQueries && usesMany(i.Queries) {
+		std["iter"] = struct{}{}
+	}
+
+	return std, pkg
+}
+
+func (i *importer) fakeImports() fileImports {
+	std, pkg := i.querierImports()
+	std["errors"] = struct{}{}
+	std["fmt"] = struct{}{}
+	std["sync"] = struct{}{}
+	if usesBatch(i.Queries) {
+		switch parseDriver(i.Options.SqlPackage) {
+		case opts.SQLDriverPGXV4:
+			pkg[ImportSpec{Path: "github.com/jackc/pgconn"}] = struct{}{}
+			pkg[ImportSpec{Path: "github.com/jackc/pgx/v4"}] = struct{}{}
+		case opts.SQLDriverPGXV5:
+			pkg[ImportSpec{Path: "github.com/jackc/pgx/v5/pgconn"}] = struct{}{}
+			pkg[ImportSpec{Path: "github.com/jackc/pgx/v5"}] = struct{}{}
+		}
+	}
 
 	return sortedImports(std, pkg)
 }
@@ -277,6 +350,18 @@ func (i *importer) modelImports() fileImports {
 	if len(i.Enums) > 0 {
 		std["fmt"] = struct{}{}
 		std["database/sql/driver"] = struct{}{}
+		if i.Options.EmitJsonTags {
+			std["encoding/json"] = struct{}{}
+		}
+	}
+
+	if i.Options.EmitScanTargets && !parseDriver(i.Options.SqlPackage).IsPGX() {
+		for _, s := range i.Structs {
+			if s.HasScanTargets() && s.hasArrayColumns() {
+				pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
+				break
+			}
+		}
 	}
 
 	return sortedImports(std, pkg)
@@ -324,6 +409,9 @@ func (i *importer) queryImports(filename string) fileImports {
 				if hasPrefixIgnoringSliceAndPointerPrefix(q.Ret.Type(), name) {
 					return true
 				}
+				if usesNullableEmbedType(q, name) {
+					return true
+				}
 			}
 			// Check the fields of the argument struct if it's emitted
 			if q.Arg.EmitStruct() {
@@ -343,43 +431,6 @@ func (i *importer) queryImports(filename string) fileImports {
 		return false
 	})
 
-	sliceScan := func() bool {
-		for _, q := range gq {
-			if q.hasRetType() {
-				if q.Ret.IsStruct() {
-					for _, f := range q.Ret.Struct.Fields {
-						if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
-							return true
-						}
-						for _, embed := range f.EmbedFields {
-							if strings.HasPrefix(embed.Type, "[]") && embed.Type != "[]byte" {
-								return true
-							}
-						}
-					}
-				} else {
-					if strings.HasPrefix(q.Ret.Type(), "[]") && q.Ret.Type() != "[]byte" {
-						return true
-					}
-				}
-			}
-			if !q.Arg.isEmpty() {
-				if q.Arg.IsStruct() {
-					for _, f := range q.Arg.Struct.Fields {
-						if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !f.HasSqlcSlice() {
-							return true
-						}
-					}
-				} else {
-					if strings.HasPrefix(q.Arg.Type(), "[]") && q.Arg.Type() != "[]byte" && !q.Arg.HasSqlcSlices() {
-						return true
-					}
-				}
-			}
-		}
-		return false
-	}
-
 	// Search for sqlc.slice() calls
 	sqlcSliceScan := func() bool {
 		for _, q := range gq {
@@ -390,21 +441,100 @@ func (i *importer) queryImports(filename string) fileImports {
 		return false
 	}
 
-	if anyNonCopyFrom {
+	// Without a context parameter, pgx is called with context.Background()
+	sqlpkg := parseDriver(i.Options.SqlPackage)
+	if anyNonCopyFrom && (sqlpkg.IsPGX() || !i.Options.EmitMethodsWithoutContext) {
 		std["context"] = struct{}{}
 	}
+	if i.Options.EmitIteratorQueries && usesMany(gq) {
+		std["iter"] = struct{}{}
+	}
 
-	sqlpkg := parseDriver(i.Options.SqlPackage)
-	if sqlcSliceScan() && !sqlpkg.IsPGX() {
-		std["strings"] = struct{}{}
+	if sqlcSliceScan() {
+		if i.Engine == "postgresql" {
+			std["strconv"] = struct{}{}
+			std["strings"] = struct{}{}
+		} else if !sqlpkg.IsPGX() {
+			std["strings"] = struct{}{}
+		}
 	}
-	if sliceScan() && !sqlpkg.IsPGX() {
+	for _, q := range gq {
+		if q.OrderBy != nil {
+			std["strings"] = struct{}{}
+		}
+		if q.UseNamedArgs {
+			pkg[ImportSpec{Path: "github.com/jackc/pgx/v5"}] = struct{}{}
+		}
+	}
+	if usesArrays(gq) && !sqlpkg.IsPGX() {
 		pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
 	}
+	if usesNotFound(gq) {
+		std["errors"] = struct{}{}
+		std["fmt"] = struct{}{}
+		switch sqlpkg {
+		case opts.SQLDriverPGXV4:
+			pkg[ImportSpec{Path: "github.com/jackc/pgx/v4"}] = struct{}{}
+		case opts.SQLDriverPGXV5:
+			pkg[ImportSpec{Path: "github.com/jackc/pgx/v5"}] = struct{}{}
+		default:
+			std["database/sql"] = struct{}{}
+		}
+	}
 
 	return sortedImports(std, pkg)
 }
 
+// usesNotFound reports whether any of the queries wraps the error of the driver
+// when it finds no row.
+func usesNotFound(queries []Query) bool {
+	for _, q := range queries {
+		if q.NotFound != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// usesArrays reports whether any of the queries passes or returns an array,
+// which database/sql drivers need lib/pq to handle.
+func usesArrays(queries []Query) bool {
+	for _, q := range queries {
+		if q.hasRetType() {
+			if q.Ret.IsStruct() {
+				for _, f := range q.Ret.Struct.Fields {
+					if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
+						return true
+					}
+					for _, embed := range f.EmbedFields {
+						if strings.HasPrefix(embed.Type, "[]") && embed.Type != "[]byte" {
+							return true
+						}
+					}
+				}
+			} else {
+				if strings.HasPrefix(q.Ret.Type(), "[]") && q.Ret.Type() != "[]byte" {
+					return true
+				}
+			}
+		}
+		if !q.Arg.isEmpty() {
+			if q.Arg.IsStruct() {
+				for _, f := range q.Arg.Struct.Fields {
+					if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !f.HasSqlcSlice() {
+						return true
+					}
+				}
+			} else {
+				if strings.HasPrefix(q.Arg.Type(), "[]") && q.Arg.Type() != "[]byte" && !q.Arg.HasSqlcSlices() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func (i *importer) copyfromImports() fileImports {
 	copyFromQueries := make([]Query, 0, len(i.Queries))
 	for _, q := range i.Queries {
@@ -435,6 +565,8 @@ func (i *importer) copyfromImports() fileImports {
 		std["sync/atomic"] = struct{}{}
 		pkg[ImportSpec{Path: "github.com/go-sql-driver/mysql"}] = struct{}{}
 		pkg[ImportSpec{Path: "github.com/hexon/mysqltsv"}] = struct{}{}
+	} else if i.Engine == "mysql" {
+		std["strings"] = struct{}{}
 	}
 
 	return sortedImports(std, pkg)
@@ -460,6 +592,9 @@ func (i *importer) batchImports() fileImports {
 				if hasPrefixIgnoringSliceAndPointerPrefix(q.Ret.Type(), name) {
 					return true
 				}
+				if usesNullableEmbedType(q, name) {
+					return true
+				}
 			}
 			if q.Arg.EmitStruct() {
 				for _, f := range q.Arg.Struct.Fields {
@@ -485,11 +620,41 @@ func (i *importer) batchImports() fileImports {
 		pkg[ImportSpec{Path: "github.com/jackc/pgx/v4"}] = struct{}{}
 	case opts.SQLDriverPGXV5:
 		pkg[ImportSpec{Path: "github.com/jackc/pgx/v5"}] = struct{}{}
+	default:
+		std["database/sql"] = struct{}{}
+		if usesArrays(batchQueries) {
+			pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
+		}
+	}
+	if i.Options.EmitOtelTracing {
+		pkg[ImportSpec{Path: "go.opentelemetry.io/otel/trace"}] = struct{}{}
+	}
+	if usesNotFound(batchQueries) {
+		std["fmt"] = struct{}{}
 	}
 
 	return sortedImports(std, pkg)
 }
 
+// usesNullableEmbedType reports whether the variables that the pointer embeds
+// of a query are scanned into use the type.
+func usesNullableEmbedType(q Query, name string) bool {
+	if !q.Ret.IsStruct() {
+		return false
+	}
+	for _, f := range q.Ret.Struct.Fields {
+		if !f.EmbedPointer {
+			continue
+		}
+		for _, embed := range f.EmbedFields {
+			if hasPrefixIgnoringSliceAndPointerPrefix(embed.Type, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func trimSliceAndPointerPrefix(v string) string {
 	v = strings.TrimPrefix(v, "[]")
 	v = strings.TrimPrefix(v, "*")
@@ -0,0 +1,122 @@
+package golang
+
+import (
+	"fmt"
+	"go/token"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+)
+
+const querierName = "Querier"
+
+// QuerierInterface is an interface generated in querier.go. The default
+// Querier embeds the interfaces of all tags, and declares the methods of the
+// queries without tags.
+type QuerierInterface struct {
+	Name    string
+	Embeds  []string
+	Queries []Query
+}
+
+// queryTags splits the "tags: a, b" line off the comments of a query.
+func queryTags(comments []string) ([]string, []string) {
+	var tags, rest []string
+	for _, line := range comments {
+		after, ok := strings.CutPrefix(strings.TrimSpace(line), "tags:")
+		if !ok {
+			rest = append(rest, line)
+			continue
+		}
+		for _, tag := range strings.Split(after, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags, rest
+}
+
+// tagInterfaces returns the names of the interfaces for the tags of a query.
+func tagInterfaces(tags []string, options *opts.Options) ([]string, error) {
+	var names []string
+	for _, tag := range tags {
+		if !token.IsIdentifier(tag) {
+			return nil, fmt.Errorf("invalid tag %q: must be a Go identifier", tag)
+		}
+		names = append(names, StructName(tag, options)+querierName)
+	}
+	return names, nil
+}
+
+// QuerierInterfaces returns the interfaces to generate for the queries of the
+// current file.
+func (t *tmplCtx) QuerierInterfaces() []QuerierInterface {
+	tagged := map[string]*QuerierInterface{}
+	querier := QuerierInterface{Name: querierName}
+	for _, q := range t.GoQueries {
+		if len(q.Interfaces) == 0 {
+			querier.Queries = append(querier.Queries, q)
+			continue
+		}
+		for _, name := range q.Interfaces {
+			iface, ok := tagged[name]
+			if !ok {
+				iface = &QuerierInterface{Name: name}
+				tagged[name] = iface
+			}
+			iface.Queries = append(iface.Queries, q)
+		}
+	}
+
+	var out []QuerierInterface
+	for _, iface := range tagged {
+		out = append(out, *iface)
+		querier.Embeds = append(querier.Embeds, iface.Name)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	sort.Strings(querier.Embeds)
+	return append(out, querier)
+}
+
+// generatedTypes returns the names of all types declared in the generated
+// package.
+func generatedTypes(enumNames, structNames map[string]struct{}, queries []Query) map[string]struct{} {
+	types := map[string]struct{}{
+		"DBTX":      {},
+		"Queries":   {},
+		querierName: {},
+	}
+	maps.Copy(types, enumNames)
+	maps.Copy(types, structNames)
+	for _, q := range queries {
+		if q.Arg.EmitStruct() {
+			types[q.Arg.Type()] = struct{}{}
+		}
+		if q.hasRetType() && q.Ret.EmitStruct() {
+			types[q.Ret.Type()] = struct{}{}
+		}
+		if strings.HasPrefix(q.Cmd, ":batch") {
+			types[q.MethodName+"BatchResults"] = struct{}{}
+		}
+		if q.OrderBy != nil {
+			types[q.OrderBy.Type] = struct{}{}
+		}
+	}
+	return types
+}
+
+// validateInterfaces checks that the interfaces generated for tags don't
+// conflict with the other generated types.
+func validateInterfaces(queries []Query, types map[string]struct{}) error {
+	for _, q := range queries {
+		for _, name := range q.Interfaces {
+			if _, ok := types[name]; ok {
+				return fmt.Errorf("query %s: tagged interface conflicts with type name: %s", q.MethodName, name)
+			}
+		}
+	}
+	return nil
+}
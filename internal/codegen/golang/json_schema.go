@@ -0,0 +1,227 @@
+package golang
+
+import (
+	"encoding/json"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaFiles returns a JSON Schema document for each query, named after
+// its method and placed in the emit_json_schema directory. The document
+// defines the parameters of the query as "params" and, if the query returns
+// rows, a row as "result".
+func jsonSchemaFiles(req *plugin.GenerateRequest, options *opts.Options, enums []Enum, queries []Query) (map[string]string, error) {
+	enumValues := map[string][]any{}
+	for _, enum := range enums {
+		var values []any
+		for _, c := range enum.Constants {
+			values = append(values, c.Value)
+		}
+		enumValues[enum.Name] = values
+	}
+
+	files := map[string]string{}
+	for _, q := range queries {
+		s := jsonSchemaBuilder{
+			req:        req,
+			options:    options,
+			enumValues: enumValues,
+		}
+		if len(q.Overrides) > 0 {
+			o := *options
+			o.Overrides = append(append([]opts.Override{}, q.Overrides...), options.Overrides...)
+			s.options = &o
+		}
+
+		defs := map[string]any{
+			"params": s.params(q.Arg),
+		}
+		if q.hasRetType() {
+			defs["result"] = s.value(q.Ret)
+		}
+		doc := map[string]any{
+			"$schema": jsonSchemaDialect,
+			"title":   q.MethodName,
+			"$defs":   defs,
+		}
+		if len(q.Comments) > 0 {
+			doc["description"] = strings.TrimSpace(strings.Join(q.Comments, "\n"))
+		}
+
+		// Maps are marshaled with sorted keys, so the output is stable
+		contents, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		files[path.Join(options.EmitJsonSchema, q.MethodName+".json")] = string(contents) + "\n"
+	}
+	return files, nil
+}
+
+type jsonSchemaBuilder struct {
+	req        *plugin.GenerateRequest
+	options    *opts.Options
+	enumValues map[string][]any
+}
+
+// params returns the schema of the parameters of a query, which is always an
+// object: a query with a single parameter has it as the only property.
+func (s *jsonSchemaBuilder) params(arg QueryValue) map[string]any {
+	if arg.isEmpty() {
+		return s.object(nil)
+	}
+	if arg.IsStruct() {
+		return s.object(arg.Struct.Fields)
+	}
+	return s.object([]Field{{Name: arg.Name, Type: arg.Typ, Column: arg.Column}})
+}
+
+func (s *jsonSchemaBuilder) value(v QueryValue) map[string]any {
+	if v.IsStruct() {
+		return s.object(v.Struct.Fields)
+	}
+	return s.field(Field{Type: v.Typ, Column: v.Column})
+}
+
+// object returns the schema of a struct with the given fields, keyed by the
+// names encoding/json uses for them.
+func (s *jsonSchemaBuilder) object(fields []Field) map[string]any {
+	properties := map[string]any{}
+	required := []string{}
+	for _, f := range fields {
+		name, omitEmpty, ok := jsonFieldName(f)
+		if !ok {
+			continue
+		}
+		properties[name] = s.field(f)
+		if !omitEmpty {
+			required = append(required, name)
+		}
+	}
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// jsonFieldName returns the name encoding/json uses for a field and whether
+// it's left out when empty. It reports false if the field isn't encoded.
+func jsonFieldName(f Field) (name string, omitEmpty, ok bool) {
+	name = f.Name
+	if tag, ok := f.Tags["json"]; ok {
+		tagName, rest, _ := strings.Cut(tag, ",")
+		if tagName == "-" && rest == "" {
+			return "", false, false
+		}
+		if tagName != "" {
+			name = tagName
+		}
+		omitEmpty = strings.Contains(","+rest+",", ",omitempty,")
+	}
+	return name, omitEmpty, true
+}
+
+func (s *jsonSchemaBuilder) field(f Field) map[string]any {
+	if len(f.EmbedFields) > 0 {
+		schema := s.object(f.EmbedFields)
+		if f.EmbedPointer {
+			schema = jsonSchemaNullable(schema)
+		}
+		return schema
+	}
+
+	col := f.Column
+	if col != nil && (columnOverride(s.req, s.options, col) != nil || dbTypeOverride(s.options, col) != nil) {
+		schema := s.defaultSchema()
+		if col.IsSqlcSlice {
+			schema = map[string]any{"type": "array", "items": schema}
+		}
+		return schema
+	}
+
+	typ := strings.TrimPrefix(f.Type, "*")
+	var dims int
+	for typ != "[]byte" && strings.HasPrefix(typ, "[]") {
+		typ = strings.TrimPrefix(typ, "[]")
+		dims++
+	}
+
+	schema := s.goTypeSchema(typ)
+	for i := 0; i < dims; i++ {
+		schema = map[string]any{"type": "array", "items": schema}
+	}
+	if col != nil && !col.NotNull && !col.IsSqlcSlice {
+		schema = jsonSchemaNullable(schema)
+	}
+	return schema
+}
+
+// goTypeSchema returns the schema of the values of a Go type, regardless of
+// whether the type can hold NULL.
+func (s *jsonSchemaBuilder) goTypeSchema(typ string) map[string]any {
+	if values, ok := s.enumValues[strings.TrimPrefix(typ, "Null")]; ok {
+		return map[string]any{"type": "string", "enum": slices.Clone(values)}
+	}
+	switch typ {
+	case "string", "sql.NullString", "pgtype.Text", "pgtype.Varchar", "pgtype.BPChar",
+		"netip.Addr", "netip.Prefix", "net.HardwareAddr", "pgtype.Inet", "pgtype.CIDR", "pgtype.Macaddr":
+		return map[string]any{"type": "string"}
+	case "bool", "sql.NullBool", "pgtype.Bool":
+		return map[string]any{"type": "boolean"}
+	case "int", "int8", "int16", "int32", "int64",
+		"sql.NullInt16", "sql.NullInt32", "sql.NullInt64",
+		"pgtype.Int2", "pgtype.Int4", "pgtype.Int8":
+		return map[string]any{"type": "integer"}
+	case "uint", "uint8", "uint16", "uint32", "uint64", "sql.NullByte", "pgtype.Uint32":
+		return map[string]any{"type": "integer", "minimum": 0}
+	case "float32", "float64", "sql.NullFloat64", "pgtype.Float4", "pgtype.Float8", "pgtype.Numeric":
+		return map[string]any{"type": "number"}
+	case "time.Time", "sql.NullTime", "pgtype.Timestamp", "pgtype.Timestamptz":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "pgtype.Date":
+		return map[string]any{"type": "string", "format": "date"}
+	case "pgtype.Time":
+		return map[string]any{"type": "string", "format": "time"}
+	case "uuid.UUID", "uuid.NullUUID", "pgtype.UUID":
+		return map[string]any{"type": "string", "format": "uuid"}
+	case "[]byte":
+		return map[string]any{"type": "string", "contentEncoding": "base64"}
+	case "json.RawMessage", "pqtype.NullRawMessage", "interface{}", "any":
+		return map[string]any{}
+	}
+	return s.defaultSchema()
+}
+
+// defaultSchema returns the json_schema_default option, used for values of
+// types without a known schema, such as overridden Go types.
+func (s *jsonSchemaBuilder) defaultSchema() map[string]any {
+	schema := map[string]any{}
+	for k, v := range s.options.JsonSchemaDefault {
+		schema[k] = v
+	}
+	return schema
+}
+
+// jsonSchemaNullable returns schema extended to also accept null.
+func jsonSchemaNullable(schema map[string]any) map[string]any {
+	if len(schema) == 0 {
+		return schema
+	}
+	typ, ok := schema["type"].(string)
+	if !ok {
+		return map[string]any{"anyOf": []any{schema, map[string]any{"type": "null"}}}
+	}
+	schema["type"] = []string{typ, "null"}
+	if values, ok := schema["enum"].([]any); ok {
+		schema["enum"] = append(values, nil)
+	}
+	return schema
+}
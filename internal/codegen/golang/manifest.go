@@ -0,0 +1,37 @@
+package golang
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// manifestFileName is the name of the manifest listing the files generated
+// in the output directory, written with emit_manifest.
+const manifestFileName = ".sqlc-manifest.json"
+
+// manifest lists the files sqlc generated in an output directory, so that
+// those no longer generated can be found and removed.
+type manifest struct {
+	Generator string   `json:"generator"`
+	Version   string   `json:"version,omitempty"`
+	Files     []string `json:"files"`
+}
+
+// buildManifest returns the contents of the manifest of the files in output,
+// sorted by name so that it only changes when the files do. The version of
+// sqlc is left out with omit_sqlc_version.
+func buildManifest(output map[string]string, version string) (string, error) {
+	m := manifest{Generator: "sqlc", Version: version, Files: []string{}}
+	for name := range output {
+		if name == manifestFileName {
+			continue
+		}
+		m.Files = append(m.Files, name)
+	}
+	sort.Strings(m.Files)
+	blob, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(blob) + "\n", nil
+}
@@ -0,0 +1,182 @@
+package golang
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// modelFile is a file of models, when output_models_file_mode is per_table.
+type modelFile struct {
+	Name    string
+	Enums   []Enum
+	Structs []Struct
+}
+
+// splitModels splits the models into a file per table, named after the models
+// file: models_users.go for the users table. An enum is written to the file of
+// the table if its model is the only one using it, and otherwise to a shared
+// file, models_enums.go, as are the structs that belong to no table. The files
+// are returned in order of their names.
+func splitModels(modelsFileName string, enums []Enum, structs []Struct) ([]modelFile, error) {
+	prefix := strings.TrimSuffix(modelsFileName, ".go")
+	shared := prefix + "_enums.go"
+
+	// The structs of each table, keyed by schema and name
+	tables := map[string]*plugin.Identifier{}
+	tableOf := map[string]string{}
+	for _, s := range structs {
+		if s.Table == nil {
+			continue
+		}
+		key := s.Table.Schema + "." + s.Table.Name
+		tables[key] = s.Table
+		tableOf[s.Name] = key
+	}
+	// Nullable embeds of a table's struct belong with it
+	for _, s := range structs {
+		if s.Table == nil {
+			if key, ok := tableOf[strings.TrimPrefix(s.Name, "Nullable")]; ok {
+				tableOf[s.Name] = key
+			}
+		}
+	}
+
+	names, err := modelFileNames(prefix, shared, tables)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*modelFile{}
+	file := func(name string) *modelFile {
+		if files[name] == nil {
+			files[name] = &modelFile{Name: name}
+		}
+		return files[name]
+	}
+	for _, e := range enums {
+		users := map[string]struct{}{}
+		for _, s := range structs {
+			key, ok := tableOf[s.Name]
+			if !ok {
+				continue
+			}
+			for _, f := range s.Fields {
+				typ := strings.TrimLeft(f.Type, "[]*")
+				if typ == e.Name || typ == "Null"+e.Name {
+					users[key] = struct{}{}
+				}
+			}
+		}
+		name := shared
+		if len(users) == 1 {
+			for key := range users {
+				name = names[key]
+			}
+		}
+		f := file(name)
+		f.Enums = append(f.Enums, e)
+	}
+	for _, s := range structs {
+		name := shared
+		if key, ok := tableOf[s.Name]; ok {
+			name = names[key]
+		}
+		f := file(name)
+		f.Structs = append(f.Structs, s)
+	}
+
+	out := make([]modelFile, 0, len(files))
+	for _, f := range files {
+		out = append(out, *f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// modelFileNames returns the names of the model files of the tables. A table
+// is named by its schema as well if another table has the same name, or if
+// the name of its file would otherwise be that of the shared file.
+func modelFileNames(prefix, shared string, tables map[string]*plugin.Identifier) (map[string]string, error) {
+	count := map[string]int{}
+	for _, t := range tables {
+		count[modelFileName(prefix, t.Name)]++
+	}
+	names := map[string]string{}
+	owner := map[string]string{}
+	keys := make([]string, 0, len(tables))
+	for key := range tables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		t := tables[key]
+		name := modelFileName(prefix, t.Name)
+		if count[name] > 1 || name == shared {
+			name = modelFileName(prefix, t.Schema+"_"+t.Name)
+		}
+		if other, ok := owner[name]; ok {
+			return nil, fmt.Errorf("the models of %s and %s would both be written to %s", other, key, name)
+		}
+		owner[name] = key
+		names[key] = name
+	}
+	return names, nil
+}
+
+// modelFileName returns the name of the file of the models of a table. As
+// file systems may not tell case apart, the name is lower case. A name ending
+// in what Go takes as a build constraint, such as _test or _linux, is
+// suffixed, so that the file is always compiled.
+func modelFileName(prefix, table string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z', '0' <= r && r <= '9', r == '_':
+			return r
+		case 'A' <= r && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return '_'
+	}, table)
+	name = prefix + "_" + name
+	if i := strings.LastIndex(name, "_"); i >= 0 && buildSuffixes[name[i+1:]] {
+		name += "_model"
+	}
+	return name + ".go"
+}
+
+// buildSuffixes are the suffixes of file names Go takes as build constraints:
+// _test, and the known operating systems and architectures.
+var buildSuffixes = map[string]bool{
+	"test": true,
+
+	"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true,
+	"hurd": true, "illumos": true, "ios": true, "js": true, "linux": true, "nacl": true,
+	"netbsd": true, "openbsd": true, "plan9": true, "solaris": true, "wasip1": true,
+	"windows": true, "zos": true,
+
+	"386": true, "amd64": true, "amd64p32": true, "arm": true, "armbe": true,
+	"arm64": true, "arm64be": true, "loong64": true, "mips": true, "mipsle": true,
+	"mips64": true, "mips64le": true, "mips64p32": true, "mips64p32le": true,
+	"ppc": true, "ppc64": true, "ppc64le": true, "riscv": true, "riscv64": true,
+	"s390": true, "s390x": true, "sparc": true, "sparc64": true, "wasm": true,
+}
+
+// modelsManifest returns the contents of the manifest of the model files,
+// which lists them, so that those no longer generated can be removed.
+func modelsManifest(files []modelFile) string {
+	var b strings.Builder
+	b.WriteString(manifestHeader)
+	for _, f := range files {
+		b.WriteString(f.Name)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// manifestHeader starts a manifest of generated files. sqlc generate removes
+// the files listed by a manifest it has written before that are no longer
+// listed.
+const manifestHeader = "# Code generated by sqlc. DO NOT EDIT.\n"
@@ -13,6 +13,7 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 	columnType := sdk.DataType(col.Type)
 	notNull := col.NotNull || col.IsArray
 	unsigned := col.Unsigned
+	emitPointersForNull := options.EmitPointersForNullTypes
 
 	switch columnType {
 
@@ -20,6 +21,9 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 		if notNull {
 			return "string"
 		}
+		if emitPointersForNull {
+			return "*string"
+		}
 		return "sql.NullString"
 
 	case "tinyint":
@@ -27,6 +31,9 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 			if notNull {
 				return "bool"
 			}
+			if emitPointersForNull {
+				return "*bool"
+			}
 			return "sql.NullBool"
 		} else {
 			if notNull {
@@ -35,6 +42,15 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 				}
 				return "int8"
 			}
+			if unsigned && options.EmitExactUnsignedTypes {
+				return "*uint8"
+			}
+			if emitPointersForNull {
+				if unsigned {
+					return "*uint8"
+				}
+				return "*int8"
+			}
 			// The database/sql package does not have a sql.NullInt8 type, so we
 			// use the smallest type they have which is NullInt16
 			return "sql.NullInt16"
@@ -44,6 +60,9 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 		if notNull {
 			return "int16"
 		}
+		if emitPointersForNull {
+			return "*int16"
+		}
 		return "sql.NullInt16"
 
 	case "smallint":
@@ -53,6 +72,15 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 			}
 			return "int16"
 		}
+		if unsigned && options.EmitExactUnsignedTypes {
+			return "*uint16"
+		}
+		if emitPointersForNull {
+			if unsigned {
+				return "*uint16"
+			}
+			return "*int16"
+		}
 		return "sql.NullInt16"
 
 	case "int", "integer", "mediumint":
@@ -62,6 +90,15 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 			}
 			return "int32"
 		}
+		if unsigned && options.EmitExactUnsignedTypes {
+			return "*uint32"
+		}
+		if emitPointersForNull {
+			if unsigned {
+				return "*uint32"
+			}
+			return "*int32"
+		}
 		return "sql.NullInt32"
 
 	case "bigint":
@@ -71,10 +108,19 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 			}
 			return "int64"
 		}
+		if unsigned && options.EmitExactUnsignedTypes {
+			return "*uint64"
+		}
+		if emitPointersForNull {
+			if unsigned {
+				return "*uint64"
+			}
+			return "*int64"
+		}
 		return "sql.NullInt64"
 
 	case "blob", "binary", "varbinary", "tinyblob", "mediumblob", "longblob":
-		if notNull {
+		if notNull || emitPointersForNull {
 			return "[]byte"
 		}
 		return "sql.NullString"
@@ -83,12 +129,18 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 		if notNull {
 			return "float64"
 		}
+		if emitPointersForNull {
+			return "*float64"
+		}
 		return "sql.NullFloat64"
 
 	case "decimal", "dec", "fixed":
 		if notNull {
 			return "string"
 		}
+		if emitPointersForNull {
+			return "*string"
+		}
 		return "sql.NullString"
 
 	case "enum":
@@ -99,15 +151,24 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 		if notNull {
 			return "time.Time"
 		}
+		if emitPointersForNull {
+			return "*time.Time"
+		}
 		return "sql.NullTime"
 
 	case "boolean", "bool":
 		if notNull {
 			return "bool"
 		}
+		if emitPointersForNull {
+			return "*bool"
+		}
 		return "sql.NullBool"
 
 	case "json":
+		if typ := jsonType(options, notNull); typ != "" {
+			return typ
+		}
 		return "json.RawMessage"
 
 	case "any":
@@ -119,14 +180,18 @@ func mysqlType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.C
 				if enum.Name == columnType {
 					if notNull {
 						if schema.Name == req.Catalog.DefaultSchema {
-							return StructName(enum.Name, options)
+							return typeName(enum.Name, options)
 						}
-						return StructName(schema.Name+"_"+enum.Name, options)
+						return typeName(schema.Name+"_"+enum.Name, options)
 					} else {
+						prefix := "Null"
+						if emitPointersForNull {
+							prefix = "*"
+						}
 						if schema.Name == req.Catalog.DefaultSchema {
-							return "Null" + StructName(enum.Name, options)
+							return prefix + typeName(enum.Name, options)
 						}
-						return "Null" + StructName(schema.Name+"_"+enum.Name, options)
+						return prefix + typeName(schema.Name+"_"+enum.Name, options)
 					}
 				}
 			}
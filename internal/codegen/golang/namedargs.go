@@ -0,0 +1,186 @@
+package golang
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// namedArgsSQL returns the text of a query with each numbered parameter
+// replaced by the name it was given, as @name, so that it can be run with
+// pgx.NamedArgs. It reports false if the query can't be run that way: when any
+// of its parameters isn't named, or its text has something the pgx lexer
+// doesn't understand.
+func namedArgsSQL(options *opts.Options, query *plugin.Query) (string, bool) {
+	if !options.UseNamedArgs || parseDriver(options.SqlPackage) != opts.SQLDriverPGXV5 {
+		return "", false
+	}
+	if strings.HasPrefix(query.Cmd, ":batch") || query.Cmd == metadata.CmdCopyFrom {
+		return "", false
+	}
+	names := map[int32]string{}
+	for _, p := range query.Params {
+		c := p.Column
+		if c == nil || len(c.OrderBy) > 0 {
+			continue
+		}
+		if !c.IsNamedParam || c.IsSqlcSlice || !isNamedArg(c.Name) {
+			return "", false
+		}
+		names[p.Number] = c.Name
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+	return replaceNumberedParams(query.Text, names)
+}
+
+// isNamedArg reports whether pgx reads all of name after an @.
+func isNamedArg(name string) bool {
+	if name == "" || !isLetter(name[0]) {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if !isIdentChar(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isLetter(c) || (c >= '0' && c <= '9') || c == '_'
+}
+
+// replaceNumberedParams replaces the $N placeholders in sql by @name. Quoted
+// strings, quoted identifiers and comments are copied as they are, following
+// the lexer pgx uses for named arguments. It reports false for text that lexer
+// would read differently: an @ followed by a letter that isn't one of the
+// parameters, or a dollar-quoted string.
+func replaceNumberedParams(sql string, names map[int32]string) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"':
+			escapes := c == '\'' && i > 0 && (sql[i-1] == 'e' || sql[i-1] == 'E')
+			end := skipQuoted(sql, i, c, escapes)
+			b.WriteString(sql[i:end])
+			i = end
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			b.WriteString(sql[i : i+end])
+			i += end
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			end := skipComment(sql, i)
+			b.WriteString(sql[i:end])
+			i = end
+		case c == '@' && i+1 < len(sql) && isLetter(sql[i+1]):
+			return "", false
+		case c == '$' && (i == 0 || !isIdentChar(sql[i-1])):
+			end := i + 1
+			for end < len(sql) && sql[end] >= '0' && sql[end] <= '9' {
+				end++
+			}
+			if end == i+1 {
+				if end < len(sql) && (sql[end] == '$' || isIdentChar(sql[end])) {
+					return "", false
+				}
+				b.WriteByte(c)
+				i++
+				continue
+			}
+			n, err := strconv.ParseInt(sql[i+1:end], 10, 32)
+			if err != nil {
+				return "", false
+			}
+			name, ok := names[int32(n)]
+			if !ok {
+				return "", false
+			}
+			b.WriteString("@" + name)
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String(), true
+}
+
+// skipQuoted returns the index after the string or identifier starting at
+// start and quoted with q. A doubled quote is part of the text, as is a quote
+// following a backslash in an escape string.
+func skipQuoted(sql string, start int, q byte, escapes bool) int {
+	for i := start + 1; i < len(sql); i++ {
+		switch {
+		case escapes && sql[i] == '\\':
+			i++
+		case sql[i] == q:
+			if i+1 < len(sql) && sql[i+1] == q {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(sql)
+}
+
+// skipComment returns the index after the, possibly nested, block comment
+// starting at start.
+func skipComment(sql string, start int) int {
+	depth := 0
+	for i := start; i < len(sql)-1; i++ {
+		switch {
+		case sql[i] == '/' && sql[i+1] == '*':
+			depth++
+			i++
+		case sql[i] == '*' && sql[i+1] == '/':
+			depth--
+			i++
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(sql)
+}
+
+// NamedArgs returns the pgx.NamedArgs passed in place of Params, for queries
+// run with named arguments.
+func (v QueryValue) NamedArgs() string {
+	var out []string
+	seen := map[string]bool{}
+	add := func(c *plugin.Column, expr string) {
+		if c == nil || len(c.OrderBy) > 0 || seen[c.Name] {
+			return
+		}
+		seen[c.Name] = true
+		out = append(out, strconv.Quote(c.Name)+": "+expr)
+	}
+	switch {
+	case v.isEmpty():
+	case v.Struct == nil:
+		add(v.Column, escape(v.Name))
+	default:
+		for _, f := range v.Struct.Fields {
+			add(f.Column, v.ParamForField(f))
+		}
+	}
+	if len(out) <= 3 {
+		return "pgx.NamedArgs{" + strings.Join(out, ", ") + "}"
+	}
+	out = append(out, "")
+	return "pgx.NamedArgs{\n" + strings.Join(out, ",\n") + "}"
+}
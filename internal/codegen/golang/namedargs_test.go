@@ -0,0 +1,31 @@
+package golang
+
+import "testing"
+
+func TestReplaceNumberedParams(t *testing.T) {
+	names := map[int32]string{1: "id", 2: "name"}
+	for _, tc := range []struct {
+		sql  string
+		want string
+		ok   bool
+	}{
+		{sql: "SELECT * FROM t WHERE id = $1 AND name = $2", want: "SELECT * FROM t WHERE id = @id AND name = @name", ok: true},
+		{sql: "SELECT $2::text, $2", want: "SELECT @name::text, @name", ok: true},
+		{sql: "SELECT '$1', \"$2\", E'\\'$1' FROM t WHERE id = $1", want: "SELECT '$1', \"$2\", E'\\'$1' FROM t WHERE id = @id", ok: true},
+		{sql: "SELECT 'it''s $1' -- $2\n, $1 /* $2 /* $1 */ $2 */", want: "SELECT 'it''s $1' -- $2\n, @id /* $2 /* $1 */ $2 */", ok: true},
+		{sql: "SELECT a$1 FROM t WHERE id = $1", want: "SELECT a$1 FROM t WHERE id = @id", ok: true},
+		{sql: "SELECT $3"},
+		{sql: "SELECT $$ $1 $$"},
+		{sql: "SELECT $tag$ $1 $tag$"},
+		{sql: "SELECT @other WHERE id = $1"},
+	} {
+		got, ok := replaceNumberedParams(tc.sql, names)
+		if ok != tc.ok {
+			t.Errorf("%q: expected ok to be %v", tc.sql, tc.ok)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%q: expected %q, got %q", tc.sql, tc.want, got)
+		}
+	}
+}
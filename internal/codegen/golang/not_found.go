@@ -0,0 +1,122 @@
+package golang
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// notFoundFileName is the name of the file declaring the errors returned by
+// queries that find no row.
+const notFoundFileName = "errors.go"
+
+// NotFoundError is a sentinel error returned, along with the error of the
+// driver, by the :one and :batchone queries naming it when they find no row.
+type NotFoundError struct {
+	Name    string
+	Message string
+}
+
+// notFoundName returns the name of the error a query returns when it finds no
+// row: the one named by its not_found annotation or, with
+// emit_not_found_errors, one named after the query. It is empty if the query
+// doesn't have one.
+func notFoundName(options *opts.Options, query *plugin.Query) string {
+	if query.Cmd != metadata.CmdOne && query.Cmd != metadata.CmdBatchOne {
+		return ""
+	}
+	if query.NotFound != "" {
+		return query.NotFound
+	}
+	if options.EmitNotFoundErrors {
+		return "Err" + query.Name + "NotFound"
+	}
+	return ""
+}
+
+// notFoundErrors returns the errors declared by queries, sorted by name.
+// Queries may share an error by naming the same one.
+func notFoundErrors(queries []Query) []NotFoundError {
+	seen := map[string]struct{}{}
+	var errs []NotFoundError
+	for _, q := range queries {
+		if q.NotFound == "" {
+			continue
+		}
+		if _, ok := seen[q.NotFound]; ok {
+			continue
+		}
+		seen[q.NotFound] = struct{}{}
+		errs = append(errs, NotFoundError{Name: q.NotFound, Message: notFoundMessage(q.NotFound)})
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Name < errs[j].Name })
+	return errs
+}
+
+// notFoundMessage returns the message of an error, made of the words of its
+// name without the Err prefix, e.g. "author not found" for ErrAuthorNotFound.
+func notFoundMessage(name string) string {
+	name = strings.TrimPrefix(strings.TrimPrefix(name, "Err"), "err")
+	var words []string
+	var word []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if r == '_' {
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		}
+		// A word starts at an upper case letter following a lower case one,
+		// or at the last letter of a run of upper case letters followed by a
+		// lower case one, as in "HTTPServer"
+		if unicode.IsUpper(r) && len(word) > 0 {
+			prev := runes[i-1]
+			next := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || next {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	for i, w := range words {
+		// Keep initialisms such as ID as they are
+		if strings.ToUpper(w) != w || len(w) == 1 {
+			words[i] = strings.ToLower(w)
+		}
+	}
+	if len(words) == 0 {
+		return "not found"
+	}
+	return strings.Join(words, " ")
+}
+
+// validateNotFoundErrors checks that the errors returned by queries that find
+// no row don't conflict with other generated identifiers.
+func validateNotFoundErrors(queries []Query, names map[string]struct{}) error {
+	for _, e := range notFoundErrors(queries) {
+		if _, ok := names[e.Name]; ok {
+			return fmt.Errorf("not found error name conflicts with generated name: %s", e.Name)
+		}
+	}
+	return nil
+}
+
+// noRowsError returns the expression for the error the driver returns when a
+// query finds no row.
+func noRowsError(driver opts.SQLDriver) string {
+	if driver.IsPGX() {
+		return "pgx.ErrNoRows"
+	}
+	return "sql.ErrNoRows"
+}
@@ -23,6 +23,20 @@ func validatePackage(sqlPackage string) error {
 	return nil
 }
 
+// The modes of output_models_file_mode: all models in one file, or each
+// table's in a file of its own.
+const (
+	ModelsFileModeSingle   = "single"
+	ModelsFileModePerTable = "per_table"
+)
+
+// The Go types of json_type, which json and jsonb values are generated as
+// instead of the default type of the driver.
+const (
+	JSONTypeRawMessage = "encoding/json.RawMessage"
+	JSONTypeBytes      = "[]byte"
+)
+
 const (
 	SQLDriverPGXV4            SQLDriver = "github.com/jackc/pgx/v4"
 	SQLDriverPGXV5                      = "github.com/jackc/pgx/v5"
@@ -18,6 +18,10 @@ type GoType struct {
 	Slice   bool   `json:"slice" yaml:"slice"`
 	Spec    string `json:"-"`
 	BuiltIn bool   `json:"-"`
+	// EncodeAs is how a parameter of the type is passed to database/sql if
+	// the type doesn't implement driver.Valuer: as the string it formats as,
+	// or as its binary encoding.
+	EncodeAs string `json:"encode_as,omitempty" yaml:"encode_as"`
 }
 
 type ParsedGoType struct {
@@ -26,6 +30,7 @@ type ParsedGoType struct {
 	TypeName   string
 	BasicType  bool
 	StructTag  string
+	EncodeAs   string
 }
 
 func (o *GoType) MarshalJSON() ([]byte, error) {
@@ -66,6 +71,13 @@ func (o *GoType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// The encodings of encode_as. Parameters are passed as the string returned by
+// the String method of their type, or as the bytes returned by MarshalBinary.
+const (
+	EncodeAsString = "string"
+	EncodeAsBytes  = "bytes"
+)
+
 var validIdentifier = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
 var versionNumber = regexp.MustCompile(`^v[0-9]+$`)
 var invalidIdentifier = regexp.MustCompile(`[^a-zA-Z0-9_]`)
@@ -106,8 +118,18 @@ func (gt GoType) parse() (*ParsedGoType, error) {
 			o.Package = gt.Package
 		}
 
+		switch gt.EncodeAs {
+		case "", EncodeAsString, EncodeAsBytes:
+		default:
+			return nil, fmt.Errorf("Package override `go_type` encode_as %q must be %q or %q", gt.EncodeAs, EncodeAsString, EncodeAsBytes)
+		}
+		if gt.EncodeAs != "" && gt.Slice {
+			return nil, fmt.Errorf("Package override `go_type` encode_as can't be used with slice")
+		}
+
 		o.ImportPath = gt.Path
 		o.TypeName = gt.Name
+		o.EncodeAs = gt.EncodeAs
 		o.BasicType = gt.Path == "" && gt.Package == ""
 		if pkg != "" {
 			o.TypeName = pkg + "." + o.TypeName
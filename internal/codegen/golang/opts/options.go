@@ -14,30 +14,61 @@ type Options struct {
 	EmitJsonTags                bool              `json:"emit_json_tags" yaml:"emit_json_tags"`
 	JsonTagsIdUppercase         bool              `json:"json_tags_id_uppercase" yaml:"json_tags_id_uppercase"`
 	EmitDbTags                  bool              `json:"emit_db_tags" yaml:"emit_db_tags"`
+	EmitCommentTags             bool              `json:"emit_comment_tags,omitempty" yaml:"emit_comment_tags"`
 	EmitPreparedQueries         bool              `json:"emit_prepared_queries" yaml:"emit_prepared_queries"`
 	EmitExactTableNames         bool              `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
 	EmitEmptySlices             bool              `json:"emit_empty_slices,omitempty" yaml:"emit_empty_slices"`
 	EmitExportedQueries         bool              `json:"emit_exported_queries" yaml:"emit_exported_queries"`
+	EmitExportedQueryConstants  bool              `json:"emit_exported_query_constants,omitempty" yaml:"emit_exported_query_constants"`
+	EmitQueriesByName           bool              `json:"emit_queries_by_name,omitempty" yaml:"emit_queries_by_name"`
+	EmitNotFoundErrors          bool              `json:"emit_not_found_errors,omitempty" yaml:"emit_not_found_errors"`
+	EmitManifest                bool              `json:"emit_manifest,omitempty" yaml:"emit_manifest"`
+	EmitFake                    bool              `json:"emit_fake,omitempty" yaml:"emit_fake"`
 	EmitResultStructPointers    bool              `json:"emit_result_struct_pointers" yaml:"emit_result_struct_pointers"`
 	EmitParamsStructPointers    bool              `json:"emit_params_struct_pointers" yaml:"emit_params_struct_pointers"`
 	EmitMethodsWithDbArgument   bool              `json:"emit_methods_with_db_argument,omitempty" yaml:"emit_methods_with_db_argument"`
+	EmitMethodsWithoutContext   bool              `json:"emit_methods_without_context,omitempty" yaml:"emit_methods_without_context"`
 	EmitPointersForNullTypes    bool              `json:"emit_pointers_for_null_types" yaml:"emit_pointers_for_null_types"`
 	EmitEnumValidMethod         bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues           bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
+	EmitCheckConstraintEnums    bool              `json:"emit_check_constraint_enums,omitempty" yaml:"emit_check_constraint_enums"`
 	EmitSqlAsComment            bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
+	EmitSourceLineComments      bool              `json:"emit_source_line_comments,omitempty" yaml:"emit_source_line_comments"`
+	EmitIteratorQueries         bool              `json:"emit_iterator_queries,omitempty" yaml:"emit_iterator_queries"`
+	EmitPaginationHelpers       bool              `json:"emit_pagination_helpers,omitempty" yaml:"emit_pagination_helpers"`
+	EmitScanTargets             bool              `json:"emit_scan_targets,omitempty" yaml:"emit_scan_targets"`
+	EmitOtelTracing             bool              `json:"emit_otel_tracing,omitempty" yaml:"emit_otel_tracing"`
+	EmitOtelDbStatement         bool              `json:"emit_otel_db_statement,omitempty" yaml:"emit_otel_db_statement"`
+	EmitQueryHooks              bool              `json:"emit_query_hooks,omitempty" yaml:"emit_query_hooks"`
+	EmitJsonSchema              string            `json:"emit_json_schema,omitempty" yaml:"emit_json_schema"`
+	JsonSchemaDefault           map[string]any    `json:"json_schema_default,omitempty" yaml:"json_schema_default"`
+	EmitDbComments              *bool             `json:"emit_db_comments,omitempty" yaml:"emit_db_comments"`
+	DocCommentNamePrefix        bool              `json:"doc_comment_name_prefix,omitempty" yaml:"doc_comment_name_prefix"`
+	DocCommentWrap              int               `json:"doc_comment_wrap,omitempty" yaml:"doc_comment_wrap"`
+	EmbedPointerForNullable     bool              `json:"embed_pointer_for_nullable,omitempty" yaml:"embed_pointer_for_nullable"`
+	EmitTaggedInterfaces        bool              `json:"emit_tagged_interfaces,omitempty" yaml:"emit_tagged_interfaces"`
+	EmitExactUnsignedTypes      bool              `json:"emit_exact_unsigned_types,omitempty" yaml:"emit_exact_unsigned_types"`
+	UseNamedArgs                bool              `json:"use_named_args,omitempty" yaml:"use_named_args"`
 	JsonTagsCaseStyle           string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	Package                     string            `json:"package" yaml:"package"`
 	Out                         string            `json:"out" yaml:"out"`
 	Overrides                   []Override        `json:"overrides,omitempty" yaml:"overrides"`
+	StrictOverrides             bool              `json:"strict_overrides,omitempty" yaml:"strict_overrides"`
+	FollowRenames               bool              `json:"follow_renames,omitempty" yaml:"follow_renames"`
 	Rename                      map[string]string `json:"rename,omitempty" yaml:"rename"`
 	SqlPackage                  string            `json:"sql_package" yaml:"sql_package"`
 	SqlDriver                   string            `json:"sql_driver" yaml:"sql_driver"`
+	JsonType                    string            `json:"json_type,omitempty" yaml:"json_type"`
 	OutputBatchFileName         string            `json:"output_batch_file_name,omitempty" yaml:"output_batch_file_name"`
 	OutputDbFileName            string            `json:"output_db_file_name,omitempty" yaml:"output_db_file_name"`
 	OutputModelsFileName        string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
+	OutputModelsFileMode        string            `json:"output_models_file_mode,omitempty" yaml:"output_models_file_mode"`
 	OutputQuerierFileName       string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
 	OutputCopyfromFileName      string            `json:"output_copyfrom_file_name,omitempty" yaml:"output_copyfrom_file_name"`
+	CopyfromChunkSize           int               `json:"copyfrom_chunk_size,omitempty" yaml:"copyfrom_chunk_size"`
 	OutputFilesSuffix           string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
+	OutputStructNamePrefix      string            `json:"output_struct_name_prefix,omitempty" yaml:"output_struct_name_prefix"`
+	OutputStructNameSuffix      string            `json:"output_struct_name_suffix,omitempty" yaml:"output_struct_name_suffix"`
 	InflectionExcludeTableNames []string          `json:"inflection_exclude_table_names,omitempty" yaml:"inflection_exclude_table_names"`
 	QueryParameterLimit         *int32            `json:"query_parameter_limit,omitempty" yaml:"query_parameter_limit"`
 	OmitSqlcVersion             bool              `json:"omit_sqlc_version,omitempty" yaml:"omit_sqlc_version"`
@@ -46,6 +77,9 @@ type Options struct {
 	Initialisms                 *[]string         `json:"initialisms,omitempty" yaml:"initialisms"`
 
 	InitialismsMap map[string]struct{} `json:"-" yaml:"-"`
+	// RenamedKeys maps the rename keys that follow_renames rewrote to the
+	// keys they were rewritten to.
+	RenamedKeys map[string]string `json:"-" yaml:"-"`
 }
 
 type GlobalOptions struct {
@@ -71,6 +105,11 @@ func Parse(req *plugin.GenerateRequest) (*Options, error) {
 		}
 		maps.Copy(options.Rename, global.Rename)
 	}
+	if options.FollowRenames {
+		if err := followRenames(req, options); err != nil {
+			return nil, err
+		}
+	}
 	return options, nil
 }
 
@@ -114,6 +153,15 @@ func parseOpts(req *plugin.GenerateRequest) (*Options, error) {
 		*options.QueryParameterLimit = 1
 	}
 
+	if options.CopyfromChunkSize == 0 {
+		options.CopyfromChunkSize = 1000
+	}
+
+	if options.EmitDbComments == nil {
+		options.EmitDbComments = new(bool)
+		*options.EmitDbComments = true
+	}
+
 	if options.Initialisms == nil {
 		options.Initialisms = new([]string)
 		*options.Initialisms = []string{"id"}
@@ -139,6 +187,7 @@ func parseGlobalOpts(req *plugin.GenerateRequest) (*GlobalOptions, error) {
 		if err := options.Overrides[i].parse(req); err != nil {
 			return nil, err
 		}
+		options.Overrides[i].Global = true
 	}
 	return &options, nil
 }
@@ -147,9 +196,48 @@ func ValidateOpts(opts *Options) error {
 	if opts.EmitMethodsWithDbArgument && opts.EmitPreparedQueries {
 		return fmt.Errorf("invalid options: emit_methods_with_db_argument and emit_prepared_queries options are mutually exclusive")
 	}
+	if opts.EmitMethodsWithoutContext {
+		if opts.EmitPreparedQueries {
+			return fmt.Errorf("invalid options: emit_methods_without_context and emit_prepared_queries options are mutually exclusive")
+		}
+		if opts.EmitOtelTracing {
+			return fmt.Errorf("invalid options: emit_methods_without_context and emit_otel_tracing options are mutually exclusive")
+		}
+		if opts.EmitQueryHooks {
+			return fmt.Errorf("invalid options: emit_methods_without_context and emit_query_hooks options are mutually exclusive")
+		}
+	}
+	if opts.EmitTaggedInterfaces && !opts.EmitInterface {
+		return fmt.Errorf("invalid options: emit_tagged_interfaces requires emit_interface")
+	}
+	if opts.EmitFake && !opts.EmitInterface {
+		return fmt.Errorf("invalid options: emit_fake requires emit_interface")
+	}
+	if opts.EmitOtelDbStatement && !opts.EmitOtelTracing {
+		return fmt.Errorf("invalid options: emit_otel_db_statement requires emit_otel_tracing")
+	}
+	if opts.UseNamedArgs && opts.SqlPackage != SQLPackagePGXV5 {
+		return fmt.Errorf("invalid options: use_named_args requires sql_package pgx/v5")
+	}
+	if opts.DocCommentWrap < 0 {
+		return fmt.Errorf("invalid options: doc_comment_wrap must not be negative")
+	}
 	if *opts.QueryParameterLimit < 0 {
 		return fmt.Errorf("invalid options: query parameter limit must not be negative")
 	}
+	if opts.CopyfromChunkSize < 0 {
+		return fmt.Errorf("invalid options: copyfrom_chunk_size must not be negative")
+	}
+	switch opts.OutputModelsFileMode {
+	case "", ModelsFileModeSingle, ModelsFileModePerTable:
+	default:
+		return fmt.Errorf("invalid options: output_models_file_mode must be %s or %s", ModelsFileModeSingle, ModelsFileModePerTable)
+	}
+	switch opts.JsonType {
+	case "", JSONTypeRawMessage, JSONTypeBytes:
+	default:
+		return fmt.Errorf("invalid options: json_type must be %s or %s", JSONTypeRawMessage, JSONTypeBytes)
+	}
 
 	return nil
 }
@@ -2,9 +2,10 @@ package opts
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/sqlc-dev/sqlc/internal/pattern"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
 )
@@ -36,7 +37,15 @@ type Override struct {
 	// fully qualified name of the column, e.g. `accounts.id`
 	Column string `json:"column" yaml:"column"`
 
-	ColumnName   *pattern.Match `json:"-"`
+	// position of the override in the configuration file, which diagnostics
+	// about it point to
+	Position *Position `json:"position,omitempty" yaml:"-"`
+
+	ColumnName *pattern.Match `json:"-"`
+	Wildcards  int            `json:"-"`
+	// Query is set for the override of a sqlc.override annotation, which
+	// matches a column of any table and wins over the configured overrides
+	Query        bool           `json:"-"`
 	TableCatalog *pattern.Match `json:"-"`
 	TableSchema  *pattern.Match `json:"-"`
 	TableRel     *pattern.Match `json:"-"`
@@ -44,12 +53,33 @@ type Override struct {
 	GoPackage    string         `json:"-"`
 	GoTypeName   string         `json:"-"`
 	GoBasicType  bool           `json:"-"`
+	GoEncodeAs   string         `json:"-"`
+	Global       bool           `json:"-"`
+	// RenamedFrom is the column the override was configured for, if
+	// follow_renames rewrote it to the current name of the column
+	RenamedFrom string `json:"-"`
 
 	// Parsed form of GoStructTag, e.g. {"validate:", "required"}
 	GoStructTags map[string]string `json:"-"`
 	ShimOverride *ShimOverride     `json:"-"`
 }
 
+// Position is a line and column of the configuration file.
+type Position struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// UnmarshalYAML decodes the override, recording its position.
+func (o *Override) UnmarshalYAML(node *yaml.Node) error {
+	type override Override
+	if err := node.Decode((*override)(o)); err != nil {
+		return err
+	}
+	o.Position = &Position{Line: node.Line, Column: node.Column}
+	return nil
+}
+
 func (o *Override) Matches(n *plugin.Identifier, defaultSchema string) bool {
 	if n == nil {
 		return false
@@ -79,7 +109,6 @@ func (o *Override) Matches(n *plugin.Identifier, defaultSchema string) bool {
 func (o *Override) parse(req *plugin.GenerateRequest) (err error) {
 	// validate deprecated postgres_type field
 	if o.Deprecated_PostgresType != "" {
-		fmt.Fprintf(os.Stderr, "WARNING: \"postgres_type\" is deprecated. Instead, use \"db_type\" to specify a type override.\n")
 		if o.DBType != "" {
 			return fmt.Errorf(`Type override configurations cannot have "db_type" and "postres_type" together. Use "db_type" alone`)
 		}
@@ -88,7 +117,6 @@ func (o *Override) parse(req *plugin.GenerateRequest) (err error) {
 
 	// validate deprecated null field
 	if o.Deprecated_Null {
-		fmt.Fprintf(os.Stderr, "WARNING: \"null\" is deprecated. Instead, use the \"nullable\" field.\n")
 		o.Nullable = true
 	}
 
@@ -145,6 +173,7 @@ func (o *Override) parse(req *plugin.GenerateRequest) (err error) {
 		default:
 			return fmt.Errorf("Override `column` specifier %q is not the proper format, expected '[catalog.][schema.]tablename.colname'", o.Column)
 		}
+		o.Wildcards = countWildcards(o.Column)
 	}
 
 	// validate GoType
@@ -156,6 +185,7 @@ func (o *Override) parse(req *plugin.GenerateRequest) (err error) {
 	o.GoPackage = parsed.Package
 	o.GoTypeName = parsed.TypeName
 	o.GoBasicType = parsed.BasicType
+	o.GoEncodeAs = parsed.EncodeAs
 
 	// validate GoStructTag
 	tags, err := o.GoStructTag.parse()
@@ -167,3 +197,51 @@ func (o *Override) parse(req *plugin.GenerateRequest) (err error) {
 	o.ShimOverride = shimOverride(req, o)
 	return nil
 }
+
+// NewQueryOverride returns the override declared by a sqlc.override annotation
+// on a single query. Unlike a configured override, it matches the column by
+// its name in the query, regardless of the table it belongs to.
+func NewQueryOverride(column, goType string) (*Override, error) {
+	o := &Override{
+		Column:    column,
+		GoType:    GoType{Spec: goType},
+		Wildcards: countWildcards(column),
+		Query:     true,
+	}
+	var err error
+	if o.ColumnName, err = pattern.MatchCompile(column); err != nil {
+		return nil, err
+	}
+	parsed, err := o.GoType.parse()
+	if err != nil {
+		return nil, err
+	}
+	o.GoImportPath = parsed.ImportPath
+	o.GoPackage = parsed.Package
+	o.GoTypeName = parsed.TypeName
+	o.GoBasicType = parsed.BasicType
+	o.ShimOverride = &ShimOverride{
+		Column:     column,
+		ColumnName: column,
+		GoType:     shimGoType(o),
+	}
+	return o, nil
+}
+
+// countWildcards returns the number of unescaped wildcard characters in a
+// column specifier. Overrides with fewer wildcards are more specific.
+func countWildcards(column string) int {
+	var n int
+	escaped := false
+	for _, c := range column {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '*' || c == '?':
+			n++
+		}
+	}
+	return n
+}
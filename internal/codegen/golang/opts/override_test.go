@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/plugin"
 )
 
 func TestTypeOverrides(t *testing.T) {
@@ -86,6 +88,13 @@ func TestTypeOverrides(t *testing.T) {
 			},
 			"Package override `go_type` specifier \"untyped rune\" is not a Go basic type e.g. 'string'",
 		},
+		{
+			Override{
+				DBType: "uuid",
+				GoType: GoType{Path: "github.com/google/uuid", Name: "UUID", EncodeAs: "text"},
+			},
+			"Package override `go_type` encode_as \"text\" must be \"string\" or \"bytes\"",
+		},
 	} {
 		tt := test
 		t.Run(tt.override.GoType.Spec, func(t *testing.T) {
@@ -115,3 +124,24 @@ func FuzzOverride(f *testing.F) {
 		o.parse(nil)
 	})
 }
+
+func TestColumnWildcards(t *testing.T) {
+	req := &plugin.GenerateRequest{Catalog: &plugin.Catalog{DefaultSchema: "public"}}
+	for column, want := range map[string]int{
+		"authors.id":       0,
+		"authors.*":        1,
+		"*.created_at":     1,
+		"public.*.*_at":    2,
+		"authors.na?e":     1,
+		`authors.\*weird`:  0,
+		"*.*.*.created_at": 3,
+	} {
+		o := Override{Column: column, GoType: GoType{Spec: "string"}}
+		if err := o.parse(req); err != nil {
+			t.Fatalf("override parsing failed; %s", err)
+		}
+		if o.Wildcards != want {
+			t.Errorf("%s: expected %d wildcards, got %d", column, want, o.Wildcards)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package opts
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// followRenames rewrites the column overrides and the qualified rename keys
+// that name a table or a column by a name a schema file renamed, so that they
+// keep matching it by its current name. The names they had are recorded, to
+// be reported as deprecated.
+func followRenames(req *plugin.GenerateRequest, options *Options) error {
+	for i := range options.Overrides {
+		o := &options.Overrides[i]
+		if o.Column == "" || o.Wildcards > 0 {
+			continue
+		}
+		column, ok := RenamedColumn(req, o.Column)
+		if !ok {
+			continue
+		}
+		o.RenamedFrom = o.Column
+		o.Column = column
+		if err := o.parse(req); err != nil {
+			return err
+		}
+	}
+
+	keys := make([]string, 0, len(options.Rename))
+	for key := range options.Rename {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if !strings.Contains(key, ".") {
+			continue
+		}
+		renamed, ok := RenamedColumn(req, key)
+		if !ok {
+			continue
+		}
+		if _, exists := options.Rename[renamed]; exists {
+			continue
+		}
+		options.Rename[renamed] = options.Rename[key]
+		delete(options.Rename, key)
+		if options.RenamedKeys == nil {
+			options.RenamedKeys = map[string]string{}
+		}
+		options.RenamedKeys[key] = renamed
+	}
+	return nil
+}
+
+// RenamedColumn returns the current name of the column named by the
+// qualified name column, such as "users.email", if the table or the column
+// was renamed since.
+func RenamedColumn(req *plugin.GenerateRequest, column string) (string, bool) {
+	if req == nil || req.Catalog == nil {
+		return "", false
+	}
+	parts := strings.Split(column, ".")
+	if len(parts) < 2 || len(parts) > 4 {
+		return "", false
+	}
+	colName := parts[len(parts)-1]
+	tableName := parts[len(parts)-2]
+	schemaName := req.Catalog.DefaultSchema
+	if len(parts) > 2 {
+		schemaName = parts[len(parts)-3]
+	}
+
+	var schema *plugin.Schema
+	for _, s := range req.Catalog.Schemas {
+		if s.Name == schemaName {
+			schema = s
+		}
+	}
+	if schema == nil {
+		return "", false
+	}
+
+	// A table or column that has the name now is the one the name refers to
+	table := findTable(schema, tableName)
+	if table == nil {
+		for _, t := range schema.Tables {
+			if followRename(t, false, tableName) == t.Rel.Name {
+				table = t
+				break
+			}
+		}
+	}
+	if table == nil {
+		return "", false
+	}
+	current := colName
+	if !hasColumn(table, colName) {
+		current = followRename(table, true, colName)
+		if !hasColumn(table, current) {
+			return "", false
+		}
+	}
+	if table.Rel.Name == tableName && current == colName {
+		return "", false
+	}
+	parts[len(parts)-2] = table.Rel.Name
+	parts[len(parts)-1] = current
+	return strings.Join(parts, "."), true
+}
+
+// followRename follows the renames of the table, or of its columns, from name
+// to the name it was last renamed to.
+func followRename(table *plugin.Table, column bool, name string) string {
+	for _, r := range table.Renames {
+		if r.Column == column && r.OldName == name {
+			name = r.NewName
+		}
+	}
+	return name
+}
+
+func findTable(schema *plugin.Schema, name string) *plugin.Table {
+	for _, t := range schema.Tables {
+		if t.Rel.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func hasColumn(table *plugin.Table, name string) bool {
+	return slices.ContainsFunc(table.Columns, func(c *plugin.Column) bool {
+		return c.Name == name
+	})
+}
@@ -60,6 +60,9 @@ type ShimGoType struct {
 	TypeName   string
 	BasicType  bool
 	StructTags map[string]string
+	// EncodeAs is how parameters of the type are passed to database/sql,
+	// either EncodeAsString or EncodeAsBytes, or "" if they're passed as is.
+	EncodeAs string
 }
 
 func shimGoType(o *Override) *ShimGoType {
@@ -73,5 +76,6 @@ func shimGoType(o *Override) *ShimGoType {
 		TypeName:   o.GoTypeName,
 		BasicType:  o.GoBasicType,
 		StructTags: o.GoStructTags,
+		EncodeAs:   o.GoEncodeAs,
 	}
 }
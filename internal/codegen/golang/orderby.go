@@ -0,0 +1,77 @@
+package golang
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+)
+
+// orderByMarker precedes the column a query is sorted by in the text of a
+// query using sqlc.orderby(). This sequence is also replicated in
+// internal/sql/rewrite.
+const orderByMarker = "/*ORDERBY*/"
+
+// OrderBy describes the type generated for the parameter of sqlc.orderby().
+// Its values sort by one of the listed columns in either direction, and can
+// only be created inside the generated package, so that the text written into
+// the query is always one of them.
+type OrderBy struct {
+	Type string
+	// Var is the expression holding the value passed by the caller.
+	Var string
+	// Marker is replaced by the column and direction in the query text.
+	Marker string
+	// Default is used for the zero value.
+	Default string
+	Values  []OrderByValue
+}
+
+type OrderByValue struct {
+	Name string
+	SQL  string
+}
+
+// newOrderBy returns the sort order of a query using sqlc.orderby(), and sets
+// the type of the parameter holding it. It returns nil for other queries.
+func newOrderBy(options *opts.Options, methodName string, arg *QueryValue) *OrderBy {
+	var columns []string
+	var typ *string
+	var name string
+	switch {
+	case arg.Struct == nil:
+		if arg.Column == nil || len(arg.Column.OrderBy) == 0 {
+			return nil
+		}
+		columns = arg.Column.OrderBy
+		typ = &arg.Typ
+		name = escape(arg.Name)
+	default:
+		for i, f := range arg.Struct.Fields {
+			if !f.HasSqlcOrderBy() {
+				continue
+			}
+			columns = f.Column.OrderBy
+			typ = &arg.Struct.Fields[i].Type
+			name = escape(arg.VariableForField(f))
+		}
+		if typ == nil {
+			return nil
+		}
+	}
+
+	o := &OrderBy{
+		Type:    affixTypeName(methodName+"OrderBy", options),
+		Var:     name,
+		Marker:  orderByMarker + columns[0],
+		Default: columns[0] + " ASC",
+	}
+	*typ = o.Type
+	for _, col := range columns {
+		base := methodName + "OrderBy" + StructName(strings.ReplaceAll(col, ".", "_"), options)
+		o.Values = append(o.Values,
+			OrderByValue{Name: base + "Asc", SQL: col + " ASC"},
+			OrderByValue{Name: base + "Desc", SQL: col + " DESC"},
+		)
+	}
+	return o
+}
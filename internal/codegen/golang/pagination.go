@@ -0,0 +1,139 @@
+package golang
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pageName is the name of the struct passed to paginated methods.
+const pageName = "Page"
+
+// paginationReserved holds the names used inside paginated methods, which
+// the remaining parameters of a query must not shadow.
+var paginationReserved = map[string]struct{}{
+	"ctx":     {},
+	"db":      {},
+	"page":    {},
+	"items":   {},
+	"limit":   {},
+	"hasMore": {},
+	"err":     {},
+}
+
+// Pagination describes the <Query>Paginated method generated for a :many
+// query with both a limit and an offset parameter.
+type Pagination struct {
+	arg    QueryValue
+	fields []Field
+	limit  Field
+	offset Field
+}
+
+// newPagination returns the pagination of a query with the given arguments,
+// or nil if the query doesn't take an integer limit and offset.
+func newPagination(arg QueryValue) *Pagination {
+	if !arg.IsStruct() {
+		return nil
+	}
+	p := &Pagination{arg: arg, fields: arg.Struct.Fields}
+	if arg.EmitStruct() {
+		p.fields = arg.UniqueFields()
+	}
+	var hasLimit, hasOffset bool
+	for _, f := range p.fields {
+		switch f.DBName {
+		case "limit":
+			p.limit, hasLimit = f, true
+		case "offset":
+			p.offset, hasOffset = f, true
+		default:
+			if _, ok := paginationReserved[toLowerCase(f.Name)]; ok {
+				return nil
+			}
+		}
+	}
+	if !hasLimit || !hasOffset || !isPageInt(p.limit.Type) || !isPageInt(p.offset.Type) {
+		return nil
+	}
+	return p
+}
+
+func isPageInt(typ string) bool {
+	switch typ {
+	case "int", "int32", "int64":
+		return true
+	}
+	return false
+}
+
+func pageValue(typ, value string) string {
+	if typ == "int32" {
+		return value
+	}
+	return fmt.Sprintf("%s(%s)", typ, value)
+}
+
+// Pair returns the parameters of the paginated method that follow the page.
+func (p *Pagination) Pair() string {
+	var out []string
+	for _, f := range p.fields {
+		if f.DBName == "limit" || f.DBName == "offset" {
+			continue
+		}
+		out = append(out, escape(toLowerCase(f.Name))+" "+f.Type)
+	}
+	return strings.Join(out, ", ")
+}
+
+// Args returns the arguments passed to the base method. The limit is the one
+// returned by the fetchLimit method of the page.
+func (p *Pagination) Args() string {
+	var out []string
+	for _, f := range p.fields {
+		var value string
+		switch f.DBName {
+		case "limit":
+			value = pageValue(f.Type, "limit")
+		case "offset":
+			value = pageValue(f.Type, "page.Offset")
+		default:
+			value = escape(toLowerCase(f.Name))
+		}
+		if p.arg.EmitStruct() {
+			value = f.Name + ": " + value
+		}
+		out = append(out, value)
+	}
+	if !p.arg.EmitStruct() {
+		return strings.Join(out, ", ")
+	}
+	lit := p.arg.Type() + "{\n" + strings.Join(out, ",\n") + ",\n}"
+	if p.arg.IsPointer() {
+		lit = "&" + lit
+	}
+	return lit
+}
+
+// validatePagination checks that the Page struct doesn't conflict with the
+// other generated types.
+func validatePagination(queries []Query, types map[string]struct{}) error {
+	for _, q := range queries {
+		if q.Pagination == nil {
+			continue
+		}
+		if _, ok := types[pageName]; ok {
+			return fmt.Errorf("query %s: pagination struct conflicts with type name: %s", q.MethodName, pageName)
+		}
+		return nil
+	}
+	return nil
+}
+
+func usesPagination(queries []Query) bool {
+	for _, q := range queries {
+		if q.Pagination != nil {
+			return true
+		}
+	}
+	return false
+}
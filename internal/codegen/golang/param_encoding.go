@@ -0,0 +1,76 @@
+package golang
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// paramEncoding returns how a parameter of col is passed to database/sql, as
+// set by the encode_as of the override of its type, or "" if it's passed as
+// is. pgx encodes these types itself, so their parameters are always passed as
+// is there, as are slices, which database/sql can't take either way. The
+// elements of sqlc.slice parameters are passed one by one, so they're encoded
+// like any other parameter.
+func paramEncoding(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
+	if parseDriver(options.SqlPackage).IsPGX() || col == nil {
+		return ""
+	}
+	res := resolveGoType(req, options, col)
+	if res.Override == nil || (strings.HasPrefix(res.Type, "[]") && !col.IsSqlcSlice) {
+		return ""
+	}
+	return res.Override.ShimOverride.GoType.EncodeAs
+}
+
+// encodedParam wraps the expression of a parameter of type typ in the adapter
+// that passes it to database/sql as encodeAs describes.
+func encodedParam(encodeAs, typ, expr string) string {
+	adapter := "stringParam"
+	if encodeAs == opts.EncodeAsBytes {
+		adapter = "bytesParam"
+	}
+	if strings.HasPrefix(typ, "*") {
+		return adapter + "OrNull(" + expr + ")"
+	}
+	return adapter + "{" + expr + "}"
+}
+
+// ParamEncodings lists the parameter adapters used by the queries of a
+// package, which are generated along with the DBTX interface.
+type ParamEncodings struct {
+	String     bool
+	StringNull bool
+	Bytes      bool
+	BytesNull  bool
+}
+
+func (e ParamEncodings) Any() bool {
+	return e.String || e.StringNull || e.Bytes || e.BytesNull
+}
+
+func usesParamEncodings(queries []Query) ParamEncodings {
+	var e ParamEncodings
+	use := func(encodeAs, typ string) {
+		null := strings.HasPrefix(strings.TrimPrefix(typ, "[]"), "*")
+		switch encodeAs {
+		case opts.EncodeAsString:
+			e.String = true
+			e.StringNull = e.StringNull || null
+		case opts.EncodeAsBytes:
+			e.Bytes = true
+			e.BytesNull = e.BytesNull || null
+		}
+	}
+	for _, q := range queries {
+		if q.Arg.Struct == nil {
+			use(q.Arg.EncodeAs, q.Arg.Typ)
+			continue
+		}
+		for _, f := range q.Arg.Struct.Fields {
+			use(f.EncodeAs, f.Type)
+		}
+	}
+	return e
+}
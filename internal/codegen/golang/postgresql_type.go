@@ -3,6 +3,7 @@ package golang
 import (
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
@@ -34,11 +35,62 @@ func parseIdentifierString(name string) (*plugin.Identifier, error) {
 	}
 }
 
+// extensionTypes are the types created by extensions that map to Go types.
+// Extensions are usually created in the default schema, so references to these
+// types may be qualified with it.
+var extensionTypes = map[string]struct{}{
+	"citext":    {},
+	"hstore":    {},
+	"ltree":     {},
+	"lquery":    {},
+	"ltxtquery": {},
+}
+
+// rangeTypes are the built-in range types, with their pgtype names in pgx/v4
+// and the pgtype types of their elements in pgx/v5.
+var rangeTypes = map[string]struct{ pgxV4, element string }{
+	"daterange": {"pgtype.Daterange", "pgtype.Date"},
+	"tsrange":   {"pgtype.Tsrange", "pgtype.Timestamp"},
+	"tstzrange": {"pgtype.Tstzrange", "pgtype.Timestamptz"},
+	"numrange":  {"pgtype.Numrange", "pgtype.Numeric"},
+	"int4range": {"pgtype.Int4range", "pgtype.Int4"},
+	"int8range": {"pgtype.Int8range", "pgtype.Int8"},
+}
+
+// postgresRangeType returns the Go type of a range or multirange type. pgx/v5
+// has generic range types, and pgx/v4 has a type for each range type but none
+// for multiranges. database/sql drivers scan ranges as their text
+// representation.
+func postgresRangeType(driver opts.SQLDriver, columnType string, notNull, emitPointersForNull bool) string {
+	rangeType := strings.Replace(columnType, "multirange", "range", 1)
+	multi := rangeType != columnType
+	typ := rangeTypes[rangeType]
+	switch {
+	case driver == opts.SQLDriverPGXV5 && multi:
+		return "pgtype.Multirange[pgtype.Range[" + typ.element + "]]"
+	case driver == opts.SQLDriverPGXV5:
+		return "pgtype.Range[" + typ.element + "]"
+	case driver == opts.SQLDriverPGXV4 && multi:
+		return "interface{}"
+	case driver == opts.SQLDriverPGXV4:
+		return typ.pgxV4
+	case notNull:
+		return "string"
+	case emitPointersForNull:
+		return "*string"
+	default:
+		return "sql.NullString"
+	}
+}
+
 func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
 	columnType := sdk.DataType(col.Type)
+	if _, ok := extensionTypes[col.Type.GetName()]; ok && col.Type.GetSchema() == req.Catalog.DefaultSchema {
+		columnType = col.Type.GetName()
+	}
 	notNull := col.NotNull || col.IsArray
 	driver := parseDriver(options.SqlPackage)
-	emitPointersForNull := driver.IsPGX() && options.EmitPointersForNullTypes
+	emitPointersForNull := options.EmitPointersForNullTypes
 
 	switch columnType {
 	case "serial", "serial4", "pg_catalog.serial4":
@@ -166,6 +218,9 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		return "sql.NullBool"
 
 	case "json":
+		if typ := jsonType(options, notNull); typ != "" {
+			return typ
+		}
 		switch driver {
 		case opts.SQLDriverPGXV5:
 			return "[]byte"
@@ -182,6 +237,9 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		}
 
 	case "jsonb":
+		if typ := jsonType(options, notNull); typ != "" {
+			return typ
+		}
 		switch driver {
 		case opts.SQLDriverPGXV5:
 			return "[]byte"
@@ -212,7 +270,7 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		}
 		return "sql.NullTime"
 
-	case "pg_catalog.time":
+	case "pg_catalog.time", "time without time zone":
 		if driver == opts.SQLDriverPGXV5 {
 			return "pgtype.Time"
 		}
@@ -224,7 +282,7 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		}
 		return "sql.NullTime"
 
-	case "pg_catalog.timetz":
+	case "pg_catalog.timetz", "time with time zone":
 		if notNull {
 			return "time.Time"
 		}
@@ -233,7 +291,7 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		}
 		return "sql.NullTime"
 
-	case "pg_catalog.timestamp":
+	case "pg_catalog.timestamp", "timestamp without time zone":
 		if driver == opts.SQLDriverPGXV5 {
 			return "pgtype.Timestamp"
 		}
@@ -245,7 +303,7 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		}
 		return "sql.NullTime"
 
-	case "pg_catalog.timestamptz", "timestamptz":
+	case "pg_catalog.timestamptz", "timestamptz", "timestamp with time zone":
 		if driver == opts.SQLDriverPGXV5 {
 			return "pgtype.Timestamptz"
 		}
@@ -257,7 +315,7 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		}
 		return "sql.NullTime"
 
-	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string", "citext", "name":
+	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "character varying", "character", "string", "citext", "name", "regclass":
 		if notNull {
 			return "string"
 		}
@@ -352,118 +410,19 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 		}
 		return "sql.NullInt64"
 
-	case "daterange":
-		switch driver {
-		case opts.SQLDriverPGXV4:
-			return "pgtype.Daterange"
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Range[pgtype.Date]"
-		default:
-			return "interface{}"
-		}
-
-	case "datemultirange":
-		switch driver {
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Multirange[pgtype.Range[pgtype.Date]]"
-		default:
-			return "interface{}"
-		}
-
-	case "tsrange":
-		switch driver {
-		case opts.SQLDriverPGXV4:
-			return "pgtype.Tsrange"
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Range[pgtype.Timestamp]"
-		default:
-			return "interface{}"
-		}
-
-	case "tsmultirange":
-		switch driver {
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Multirange[pgtype.Range[pgtype.Timestamp]]"
-		default:
-			return "interface{}"
-		}
-
-	case "tstzrange":
-		switch driver {
-		case opts.SQLDriverPGXV4:
-			return "pgtype.Tstzrange"
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Range[pgtype.Timestamptz]"
-		default:
-			return "interface{}"
-		}
-
-	case "tstzmultirange":
-		switch driver {
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Multirange[pgtype.Range[pgtype.Timestamptz]]"
-		default:
-			return "interface{}"
-		}
-
-	case "numrange":
-		switch driver {
-		case opts.SQLDriverPGXV4:
-			return "pgtype.Numrange"
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Range[pgtype.Numeric]"
-		default:
-			return "interface{}"
-		}
-
-	case "nummultirange":
-		switch driver {
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Multirange[pgtype.Range[pgtype.Numeric]]"
-		default:
-			return "interface{}"
-		}
-
-	case "int4range":
-		switch driver {
-		case opts.SQLDriverPGXV4:
-			return "pgtype.Int4range"
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Range[pgtype.Int4]"
-		default:
-			return "interface{}"
-		}
-
-	case "int4multirange":
-		switch driver {
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Multirange[pgtype.Range[pgtype.Int4]]"
-		default:
-			return "interface{}"
-		}
-
-	case "int8range":
-		switch driver {
-		case opts.SQLDriverPGXV4:
-			return "pgtype.Int8range"
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Range[pgtype.Int8]"
-		default:
-			return "interface{}"
-		}
-
-	case "int8multirange":
-		switch driver {
-		case opts.SQLDriverPGXV5:
-			return "pgtype.Multirange[pgtype.Range[pgtype.Int8]]"
-		default:
-			return "interface{}"
-		}
+	case "daterange", "tsrange", "tstzrange", "numrange", "int4range", "int8range",
+		"datemultirange", "tsmultirange", "tstzmultirange", "nummultirange", "int4multirange", "int8multirange":
+		return postgresRangeType(driver, columnType, notNull, emitPointersForNull)
 
 	case "hstore":
 		if driver.IsPGX() {
 			return "pgtype.Hstore"
 		}
+		// The hstore type only exists once the extension is created. A NULL
+		// hstore scans into an Hstore with a nil Map
+		if slices.Contains(req.Catalog.Extensions, "hstore") {
+			return "hstore.Hstore"
+		}
 		return "interface{}"
 
 	case "bit", "varbit", "pg_catalog.bit", "pg_catalog.varbit":
@@ -573,20 +532,31 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 				if rel.Name == enum.Name && rel.Schema == schema.Name {
 					if notNull {
 						if schema.Name == req.Catalog.DefaultSchema {
-							return StructName(enum.Name, options)
+							return typeName(enum.Name, options)
 						}
-						return StructName(schema.Name+"_"+enum.Name, options)
+						return typeName(schema.Name+"_"+enum.Name, options)
 					} else {
+						prefix := "Null"
+						if emitPointersForNull {
+							prefix = "*"
+						}
 						if schema.Name == req.Catalog.DefaultSchema {
-							return "Null" + StructName(enum.Name, options)
+							return prefix + typeName(enum.Name, options)
 						}
-						return "Null" + StructName(schema.Name+"_"+enum.Name, options)
+						return prefix + typeName(schema.Name+"_"+enum.Name, options)
 					}
 				}
 			}
 
 			for _, ct := range schema.CompositeTypes {
 				if rel.Name == ct.Name && rel.Schema == schema.Name {
+					if usesCompositeStructs(options) {
+						name := compositeTypeName(req, options, schema.Name, ct.Name)
+						if notNull {
+							return name
+						}
+						return "*" + name
+					}
 					if notNull {
 						return "string"
 					}
@@ -21,6 +21,9 @@ type QueryValue struct {
 	// Column is kept so late in the generation process around to differentiate
 	// between mysql slices and pg arrays
 	Column *plugin.Column
+	// EncodeAs is set if the parameter is converted by an adapter when passed
+	// to database/sql. Only set if Struct==nil.
+	EncodeAs string
 }
 
 func (v QueryValue) EmitStruct() bool {
@@ -129,18 +132,22 @@ func (v QueryValue) Params() string {
 	}
 	var out []string
 	if v.Struct == nil {
+		if len(v.Column.GetOrderBy()) > 0 {
+			return ""
+		}
 		if !v.Column.IsSqlcSlice && strings.HasPrefix(v.Typ, "[]") && v.Typ != "[]byte" && !v.SQLDriver.IsPGX() {
 			out = append(out, "pq.Array("+escape(v.Name)+")")
+		} else if v.EncodeAs != "" {
+			out = append(out, encodedParam(v.EncodeAs, v.Typ, escape(v.Name)))
 		} else {
 			out = append(out, escape(v.Name))
 		}
 	} else {
 		for _, f := range v.Struct.Fields {
-			if !f.HasSqlcSlice() && strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !v.SQLDriver.IsPGX() {
-				out = append(out, "pq.Array("+escape(v.VariableForField(f))+")")
-			} else {
-				out = append(out, escape(v.VariableForField(f)))
+			if f.HasSqlcOrderBy() {
+				continue
 			}
+			out = append(out, v.ParamForField(f))
 		}
 	}
 	if len(out) <= 3 {
@@ -150,6 +157,45 @@ func (v QueryValue) Params() string {
 	return "\n" + strings.Join(out, ",\n")
 }
 
+// ItemParams returns the parameters of a batch item, held in the variable
+// name, in the same form as Params.
+func (v QueryValue) ItemParams(name string) string {
+	item := v
+	item.Name = name
+	item.Emit = true
+	return item.Params()
+}
+
+// ParamForField returns the expression used to pass a field of the struct as
+// a query parameter.
+func (v QueryValue) ParamForField(f Field) string {
+	if !f.HasSqlcSlice() && strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !v.SQLDriver.IsPGX() {
+		return "pq.Array(" + escape(v.VariableForField(f)) + ")"
+	}
+	if f.EncodeAs != "" {
+		return encodedParam(f.EncodeAs, f.Type, escape(v.VariableForField(f)))
+	}
+	return escape(v.VariableForField(f))
+}
+
+// SliceElem returns the expression used to pass elem, an element of the
+// sqlc.slice parameter, as a query parameter.
+func (v QueryValue) SliceElem(elem string) string {
+	if v.EncodeAs != "" {
+		return encodedParam(v.EncodeAs, strings.TrimPrefix(v.Typ, "[]"), elem)
+	}
+	return elem
+}
+
+// SliceElemForField returns the expression used to pass elem, an element of
+// the sqlc.slice field f of the struct, as a query parameter.
+func (v QueryValue) SliceElemForField(f Field, elem string) string {
+	if f.EncodeAs != "" {
+		return encodedParam(f.EncodeAs, strings.TrimPrefix(f.Type, "[]"), elem)
+	}
+	return elem
+}
+
 func (v QueryValue) ColumnNames() []string {
 	if v.Struct == nil {
 		return []string{v.DBName}
@@ -203,11 +249,17 @@ func (v QueryValue) Scan() string {
 
 			// append any embedded fields
 			if len(f.EmbedFields) > 0 {
+				// a pointer embed is scanned into a temporary, see
+				// DeclareNullableEmbeds
+				prefix := v.Name + "." + f.Name
+				if f.EmbedPointer {
+					prefix = embedVarName(f)
+				}
 				for _, embed := range f.EmbedFields {
-					if strings.HasPrefix(embed.Type, "[]") && embed.Type != "[]byte" && !v.SQLDriver.IsPGX() {
-						out = append(out, "pq.Array(&"+v.Name+"."+f.Name+"."+embed.Name+")")
+					if v.isPQArray(embed) {
+						out = append(out, "pq.Array(&"+prefix+"."+embed.Name+")")
 					} else {
-						out = append(out, "&"+v.Name+"."+f.Name+"."+embed.Name)
+						out = append(out, "&"+prefix+"."+embed.Name)
 					}
 				}
 				continue
@@ -227,9 +279,77 @@ func (v QueryValue) Scan() string {
 	return "\n" + strings.Join(out, ",\n")
 }
 
+// DeclareNullableEmbeds returns the declarations of the variables that the
+// columns of pointer embeds are scanned into. Each field is a pointer, so that
+// a NULL column can be told apart from a zero value.
+func (v QueryValue) DeclareNullableEmbeds() string {
+	if v.Struct == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, f := range v.Struct.Fields {
+		if !f.EmbedPointer {
+			continue
+		}
+		fmt.Fprintf(&b, "\nvar %s struct {\n", embedVarName(f))
+		for _, embed := range f.EmbedFields {
+			typ := "*" + embed.Type
+			if v.isPQArray(embed) {
+				// pq.Array requires a slice, which is nil for NULL
+				typ = embed.Type
+			}
+			fmt.Fprintf(&b, "%s %s\n", embed.Name, typ)
+		}
+		b.WriteString("}")
+	}
+	return b.String()
+}
+
+// AssignNullableEmbeds returns the statements that copy the scanned columns of
+// pointer embeds into their models. A model is only allocated if at least one
+// of its columns is not NULL.
+func (v QueryValue) AssignNullableEmbeds() string {
+	if v.Struct == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, f := range v.Struct.Fields {
+		if !f.EmbedPointer {
+			continue
+		}
+		tmp := embedVarName(f)
+		dst := v.Name + "." + f.Name
+		var conds []string
+		for _, embed := range f.EmbedFields {
+			conds = append(conds, tmp+"."+embed.Name+" != nil")
+		}
+		fmt.Fprintf(&b, "\nif %s {\n", strings.Join(conds, " || "))
+		fmt.Fprintf(&b, "%s = &%s{}\n", dst, strings.TrimPrefix(f.Type, "*"))
+		for _, embed := range f.EmbedFields {
+			if v.isPQArray(embed) {
+				fmt.Fprintf(&b, "%s.%s = %s.%s\n", dst, embed.Name, tmp, embed.Name)
+				continue
+			}
+			fmt.Fprintf(&b, "if %s.%s != nil {\n", tmp, embed.Name)
+			fmt.Fprintf(&b, "%s.%s = *%s.%s\n", dst, embed.Name, tmp, embed.Name)
+			b.WriteString("}\n")
+		}
+		b.WriteString("}")
+	}
+	return b.String()
+}
+
+func (v QueryValue) isPQArray(f Field) bool {
+	return strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" && !v.SQLDriver.IsPGX()
+}
+
+func embedVarName(f Field) string {
+	return "embed" + f.Name
+}
+
 // Deprecated: This method does not respect the Emit field set on the
-// QueryValue. It's used by the go-sql-driver-mysql/copyfromCopy.tmpl and should
-// not be used other places.
+// QueryValue. It's used by the go-sql-driver-mysql/copyfromCopy.tmpl and
+// stdlib/copyfromCopy.tmpl and should not be used other places.
 func (v QueryValue) CopyFromMySQLFields() []Field {
 	// fmt.Printf("%#v\n", v)
 	if v.Struct != nil {
@@ -237,13 +357,31 @@ func (v QueryValue) CopyFromMySQLFields() []Field {
 	}
 	return []Field{
 		{
-			Name:   v.Name,
-			DBName: v.DBName,
-			Type:   v.Typ,
+			Name:     v.Name,
+			DBName:   v.DBName,
+			Type:     v.Typ,
+			EncodeAs: v.EncodeAs,
 		},
 	}
 }
 
+// CopyFromMySQLParams returns the parameters of a row, held in the variable
+// row, inserted by a MySQL :copyfrom query.
+func (v QueryValue) CopyFromMySQLParams(row string) string {
+	var out []string
+	for _, f := range v.CopyFromMySQLFields() {
+		expr := row
+		if v.Struct != nil {
+			expr = row + "." + f.Name
+		}
+		if f.EncodeAs != "" {
+			expr = encodedParam(f.EncodeAs, f.Type, expr)
+		}
+		out = append(out, expr)
+	}
+	return strings.Join(out, ", ")
+}
+
 func (v QueryValue) VariableForField(f Field) string {
 	if !v.IsStruct() {
 		return v.Name
@@ -256,9 +394,11 @@ func (v QueryValue) VariableForField(f Field) string {
 
 // A struct used to generate methods and fields on the Queries struct
 type Query struct {
-	Cmd          string
-	Comments     []string
-	MethodName   string
+	Cmd        string
+	Comments   []string
+	MethodName string
+	// Name of the type returned by the method of a batch query
+	BatchResults string
 	FieldName    string
 	ConstantName string
 	SQL          string
@@ -267,6 +407,28 @@ type Query struct {
 	Arg          QueryValue
 	// Used for :copyfrom
 	Table *plugin.Identifier
+	// Declared with sqlc.override annotations on the query
+	Overrides []opts.Override
+	// Names of the tagged interfaces declaring the method
+	Interfaces []string
+	// Set for :many queries with a limit and offset parameter
+	Pagination *Pagination
+	// Set for queries using sqlc.orderby()
+	OrderBy *OrderBy
+	// Set for queries run with pgx.NamedArgs, whose SQL names each parameter
+	UseNamedArgs bool
+	// Name of the error returned, along with the error of the driver, when a
+	// :one or :batchone query finds no row
+	NotFound string
+}
+
+// SQLText returns the expression for the text the query is run with: its
+// constant, with the sort order chosen for sqlc.orderby() written in.
+func (q Query) SQLText() string {
+	if q.OrderBy == nil {
+		return q.ConstantName
+	}
+	return q.OrderBy.Var + ".apply(" + q.ConstantName + ")"
 }
 
 func (q Query) hasRetType() bool {
@@ -285,6 +447,26 @@ func (q Query) TableIdentifierAsGoSlice() string {
 	return "[]string{" + strings.Join(escapedNames, ", ") + "}"
 }
 
+// mysqlMaxPlaceholders is the number of placeholders MySQL allows in a
+// prepared statement.
+const mysqlMaxPlaceholders = 65535
+
+// CopyFromChunkRows returns the number of rows a MySQL :copyfrom query inserts
+// with each multi-row INSERT: size, lowered so that a statement has no more
+// than the placeholders MySQL allows.
+func (q Query) CopyFromChunkRows(size int) int {
+	if limit := mysqlMaxPlaceholders / len(q.Arg.CopyFromMySQLFields()); size > limit {
+		return limit
+	}
+	return size
+}
+
+// CopyFromMySQLRow returns the placeholders of a row inserted by a MySQL
+// :copyfrom query.
+func (q Query) CopyFromMySQLRow() string {
+	return "(" + strings.TrimSuffix(strings.Repeat("?, ", len(q.Arg.CopyFromMySQLFields())), ", ") + ")"
+}
+
 func (q Query) TableIdentifierForMySQL() string {
 	escapedNames := make([]string, 0, 3)
 	for _, p := range []string{q.Table.Catalog, q.Table.Schema, q.Table.Name} {
@@ -6,6 +6,8 @@ import (
 	"sort"
 	"strings"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
 	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
 	"github.com/sqlc-dev/sqlc/internal/inflection"
@@ -26,41 +28,48 @@ func buildEnums(req *plugin.GenerateRequest, options *opts.Options) []Enum {
 			} else {
 				enumName = schema.Name + "_" + enum.Name
 			}
-
-			e := Enum{
-				Name:      StructName(enumName, options),
-				Comment:   enum.Comment,
-				NameTags:  map[string]string{},
-				ValidTags: map[string]string{},
-			}
-			if options.EmitJsonTags {
-				e.NameTags["json"] = JSONTagName(enumName, options)
-				e.ValidTags["json"] = JSONTagName("valid", options)
-			}
-
-			seen := make(map[string]struct{}, len(enum.Vals))
-			for i, v := range enum.Vals {
-				value := EnumReplace(v)
-				if _, found := seen[value]; found || value == "" {
-					value = fmt.Sprintf("value_%d", i)
-				}
-				e.Constants = append(e.Constants, Constant{
-					Name:  StructName(enumName+"_"+value, options),
-					Value: v,
-					Type:  e.Name,
-				})
-				seen[value] = struct{}{}
-			}
-			enums = append(enums, e)
+			enums = append(enums, buildEnum(options, enumName, enum.Comment, enum.Vals))
 		}
 	}
+	if options.EmitCheckConstraintEnums {
+		enums = append(enums, buildCheckEnums(req, options)...)
+	}
 	if len(enums) > 0 {
 		sort.Slice(enums, func(i, j int) bool { return enums[i].Name < enums[j].Name })
 	}
 	return enums
 }
 
-func buildStructs(req *plugin.GenerateRequest, options *opts.Options) []Struct {
+func buildEnum(options *opts.Options, enumName, comment string, vals []string) Enum {
+	e := Enum{
+		Name:      typeName(enumName, options),
+		DBName:    enumName,
+		NameTags:  map[string]string{},
+		ValidTags: map[string]string{},
+	}
+	e.Comment = dbComment(options, e.Name, comment)
+	if options.EmitJsonTags {
+		e.NameTags["json"] = JSONTagName(enumName, options)
+		e.ValidTags["json"] = JSONTagName("valid", options)
+	}
+
+	seen := make(map[string]struct{}, len(vals))
+	for i, v := range vals {
+		value := EnumReplace(v)
+		if _, found := seen[value]; found || value == "" {
+			value = fmt.Sprintf("value_%d", i)
+		}
+		e.Constants = append(e.Constants, Constant{
+			Name:  StructName(enumName+"_"+value, options),
+			Value: v,
+			Type:  e.Name,
+		})
+		seen[value] = struct{}{}
+	}
+	return e
+}
+
+func buildStructs(req *plugin.GenerateRequest, options *opts.Options) ([]Struct, error) {
 	var structs []Struct
 	for _, schema := range req.Catalog.Schemas {
 		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
@@ -81,11 +90,17 @@ func buildStructs(req *plugin.GenerateRequest, options *opts.Options) []Struct {
 				})
 			}
 			s := Struct{
-				Table:   &plugin.Identifier{Schema: schema.Name, Name: table.Rel.Name},
-				Name:    StructName(structName, options),
-				Comment: table.Comment,
+				Table: &plugin.Identifier{Schema: schema.Name, Name: table.Rel.Name},
+				Name:  typeName(structName, options),
 			}
+			s.Comment = dbComment(options, s.Name, table.Comment)
+			renamed := map[string]string{}
 			for _, column := range table.Columns {
+				name := StructName(column.Name, options)
+				if rename, ok := qualifiedRename(req, options, s.Table, column.Name); ok {
+					name = rename
+					renamed[name] = column.Name
+				}
 				tags := map[string]string{}
 				if options.EmitDbTags {
 					tags["db"] = column.Name
@@ -93,21 +108,26 @@ func buildStructs(req *plugin.GenerateRequest, options *opts.Options) []Struct {
 				if options.EmitJsonTags {
 					tags["json"] = JSONTagName(column.Name, options)
 				}
+				addCommentTags(tags, req, options, column)
 				addExtraGoStructTags(tags, req, options, column)
 				s.Fields = append(s.Fields, Field{
-					Name:    StructName(column.Name, options),
+					Name:    name,
 					Type:    goType(req, options, column),
 					Tags:    tags,
-					Comment: column.Comment,
+					Comment: dbComment(options, name, fieldComment(options, column)),
+					Column:  column,
 				})
 			}
+			if err := checkRenamedFields(s, renamed); err != nil {
+				return nil, err
+			}
 			structs = append(structs, s)
 		}
 	}
 	if len(structs) > 0 {
 		sort.Slice(structs, func(i, j int) bool { return structs[i].Name < structs[j].Name })
 	}
-	return structs
+	return structs, nil
 }
 
 type goColumn struct {
@@ -120,6 +140,7 @@ type goEmbed struct {
 	modelType string
 	modelName string
 	fields    []Field
+	pointer   bool
 }
 
 // look through all the structs and attempt to find a matching one to embed
@@ -155,6 +176,94 @@ func newGoEmbed(embed *plugin.Identifier, structs []Struct, defaultSchema string
 	return nil
 }
 
+// checkEmbedNames returns an error if two embedded tables would have fields of
+// the same name, and at least one of them is named by sqlc.embed. Tables
+// embedded more than once without a name keep getting numbered fields.
+func checkEmbedNames(options *opts.Options, columns []goColumn) error {
+	named := map[string]bool{}
+	for _, c := range columns {
+		if c.embed == nil {
+			continue
+		}
+		name := c.embed.modelName
+		if c.EmbedName != "" {
+			name = c.EmbedName
+		}
+		field := StructName(name, options)
+		if prev, ok := named[field]; ok && (prev || c.EmbedName != "") {
+			return fmt.Errorf("two embedded tables have the field name %s; name them apart with the second parameter of sqlc.embed", field)
+		}
+		named[field] = named[field] || c.EmbedName != ""
+	}
+	return nil
+}
+
+// nullable returns the embed for a table from the nullable side of an outer
+// join. It is either a pointer to the model, or a Nullable<Model> struct whose
+// fields all use the nullable variant of their type.
+func (e *goEmbed) nullable(req *plugin.GenerateRequest, options *opts.Options) *goEmbed {
+	if options.EmbedPointerForNullable {
+		return &goEmbed{
+			modelType: "*" + e.modelType,
+			modelName: e.modelName,
+			fields:    e.fields,
+			pointer:   true,
+		}
+	}
+
+	changed := false
+	fields := make([]Field, len(e.fields))
+	for i, f := range e.fields {
+		fields[i] = f
+		if f.Column == nil || !f.Column.NotNull {
+			continue
+		}
+		col := proto.Clone(f.Column).(*plugin.Column)
+		col.NotNull = false
+		fields[i].Type = goType(req, options, col)
+		fields[i].Column = col
+		changed = changed || fields[i].Type != f.Type
+	}
+	if !changed {
+		return e
+	}
+	return &goEmbed{
+		modelType: "Nullable" + e.modelType,
+		modelName: e.modelName,
+		fields:    fields,
+	}
+}
+
+// nullableEmbedStructs returns the Nullable<Model> structs of the tables that
+// queries embed from the nullable side of an outer join.
+func nullableEmbedStructs(structs []Struct, queries []Query) []Struct {
+	seen := map[string]struct{}{}
+	for _, s := range structs {
+		seen[s.Name] = struct{}{}
+	}
+	var out []Struct
+	for _, q := range queries {
+		if !q.hasRetType() || !q.Ret.IsStruct() {
+			continue
+		}
+		for _, f := range q.Ret.Struct.Fields {
+			if len(f.EmbedFields) == 0 || f.EmbedPointer {
+				continue
+			}
+			if _, ok := seen[f.Type]; ok {
+				continue
+			}
+			seen[f.Type] = struct{}{}
+			out = append(out, Struct{
+				Name:    f.Type,
+				Fields:  f.EmbedFields,
+				Comment: fmt.Sprintf("%s is %s as embedded from the nullable side of an outer join.", f.Type, strings.TrimPrefix(f.Type, "Nullable")),
+			})
+		}
+	}
+	return out
+}
+
 func columnName(c *plugin.Column, pos int) string {
 	if c.Name != "" {
 		return c.Name
@@ -192,15 +301,42 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 		if query.Cmd == "" {
 			continue
 		}
+		// Commands contributed by plugins are left to them
+		if !metadata.IsBuiltinCmd(query.Cmd) {
+			continue
+		}
+
+		overrides, err := queryOverrides(query)
+		if err != nil {
+			return nil, err
+		}
+		qopts := options
+		if len(overrides) > 0 {
+			o := *options
+			o.Overrides = append(overrides, options.Overrides...)
+			qopts = &o
+		}
 
 		var constantName string
-		if options.EmitExportedQueries {
+		if options.EmitExportedQueryConstants {
+			constantName = sdk.Title(query.Name) + "SQL"
+		} else if options.EmitExportedQueries {
 			constantName = sdk.Title(query.Name)
 		} else {
 			constantName = sdk.LowerTitle(query.Name)
 		}
 
 		comments := query.Comments
+		var interfaces []string
+		if options.EmitTaggedInterfaces {
+			var tags []string
+			tags, comments = queryTags(comments)
+			interfaces, err = tagInterfaces(tags, options)
+			if err != nil {
+				return nil, fmt.Errorf("query %s: %w", query.Name, err)
+			}
+		}
+		comments = queryComments(options, query.Name, comments)
 		if options.EmitSqlAsComment {
 			if len(comments) == 0 {
 				comments = append(comments, query.Name)
@@ -215,29 +351,46 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 				return nil, err
 			}
 		}
+		if options.EmitSourceLineComments {
+			comments = append(comments, fmt.Sprintf(" source: %s:%d", query.Filename, query.Line))
+		}
+		if query.Deprecated != "" {
+			comments = deprecatedComment(options, comments, query.Deprecated)
+		}
 
 		gq := Query{
 			Cmd:          query.Cmd,
 			ConstantName: constantName,
 			FieldName:    sdk.LowerTitle(query.Name) + "Stmt",
 			MethodName:   query.Name,
+			BatchResults: affixTypeName(query.Name+"BatchResults", options),
 			SourceName:   query.Filename,
 			SQL:          query.Text,
 			Comments:     comments,
 			Table:        query.InsertIntoTable,
+			Overrides:    overrides,
+			Interfaces:   interfaces,
+			NotFound:     notFoundName(options, query),
 		}
 		sqlpkg := parseDriver(options.SqlPackage)
 
 		qpl := int(*options.QueryParameterLimit)
+		switch query.ParamStyle {
+		case metadata.ParamStyleStruct:
+			qpl = 0
+		case metadata.ParamStylePositional:
+			qpl = len(query.Params)
+		}
 
 		if len(query.Params) == 1 && qpl != 0 {
 			p := query.Params[0]
 			gq.Arg = QueryValue{
 				Name:      escape(paramName(p)),
 				DBName:    p.Column.GetName(),
-				Typ:       goType(req, options, p.Column),
+				Typ:       goType(req, qopts, p.Column),
 				SQLDriver: sqlpkg,
 				Column:    p.Column,
+				EncodeAs:  paramEncoding(req, qopts, p.Column),
 			}
 		} else if len(query.Params) >= 1 {
 			var cols []goColumn
@@ -247,10 +400,13 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 					Column: p.Column,
 				})
 			}
-			s, err := columnsToStruct(req, options, gq.MethodName+"Params", cols, false)
+			s, err := columnsToStruct(req, qopts, affixTypeName(gq.MethodName+"Params", options), cols, false)
 			if err != nil {
 				return nil, err
 			}
+			for i := range s.Fields {
+				s.Fields[i].EncodeAs = paramEncoding(req, qopts, s.Fields[i].Column)
+			}
 			gq.Arg = QueryValue{
 				Emit:        true,
 				Name:        "arg",
@@ -273,8 +429,9 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 			gq.Ret = QueryValue{
 				Name:      escape(name),
 				DBName:    name,
-				Typ:       goType(req, options, c),
+				Typ:       goType(req, qopts, c),
 				SQLDriver: sqlpkg,
+				Column:    c,
 			}
 		} else if putOutColumns(query) {
 			var gs *Struct
@@ -287,8 +444,8 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 				same := true
 				for i, f := range s.Fields {
 					c := query.Columns[i]
-					sameName := f.Name == StructName(columnName(c, i), options)
-					sameType := f.Type == goType(req, options, c)
+					sameName := f.Name == fieldName(req, options, c.Table, columnName(c, i))
+					sameType := f.Type == goType(req, qopts, c)
 					sameTable := sdk.SameTableName(c.Table, s.Table, req.Catalog.DefaultSchema)
 					if !sameName || !sameType || !sameTable {
 						same = false
@@ -303,14 +460,21 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 			if gs == nil {
 				var columns []goColumn
 				for i, c := range query.Columns {
+					embed := newGoEmbed(c.EmbedTable, structs, req.Catalog.DefaultSchema)
+					if embed != nil && !c.NotNull {
+						embed = embed.nullable(req, qopts)
+					}
 					columns = append(columns, goColumn{
 						id:     i,
 						Column: c,
-						embed:  newGoEmbed(c.EmbedTable, structs, req.Catalog.DefaultSchema),
+						embed:  embed,
 					})
 				}
+				if err := checkEmbedNames(options, columns); err != nil {
+					return nil, fmt.Errorf("query %s: %w", query.Name, err)
+				}
 				var err error
-				gs, err = columnsToStruct(req, options, gq.MethodName+"Row", columns, true)
+				gs, err = columnsToStruct(req, qopts, affixTypeName(gq.MethodName+"Row", options), columns, true)
 				if err != nil {
 					return nil, err
 				}
@@ -325,12 +489,37 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 			}
 		}
 
+		gq.OrderBy = newOrderBy(options, gq.MethodName, &gq.Arg)
+
+		if sql, ok := namedArgsSQL(options, query); ok {
+			gq.SQL = sql
+			gq.UseNamedArgs = true
+		}
+
+		if options.EmitPaginationHelpers && gq.Cmd == metadata.CmdMany {
+			gq.Pagination = newPagination(gq.Arg)
+		}
+
 		qs = append(qs, gq)
 	}
 	sort.Slice(qs, func(i, j int) bool { return qs[i].MethodName < qs[j].MethodName })
 	return qs, nil
 }
 
+// queryOverrides returns the overrides declared with sqlc.override annotations
+// on the query. They take precedence over the configured overrides.
+func queryOverrides(query *plugin.Query) ([]opts.Override, error) {
+	var overrides []opts.Override
+	for _, o := range query.Overrides {
+		override, err := opts.NewQueryOverride(o.Column, o.GoType)
+		if err != nil {
+			return nil, fmt.Errorf("query %s: invalid sqlc.override for column %q: %w", query.Name, o.Column, err)
+		}
+		overrides = append(overrides, *override)
+	}
+	return overrides, nil
+}
+
 var cmdReturnsData = map[string]struct{}{
 	metadata.CmdBatchMany: {},
 	metadata.CmdBatchOne:  {},
@@ -357,6 +546,7 @@ func columnsToStruct(req *plugin.GenerateRequest, options *opts.Options, name st
 	}
 	seen := map[string][]int{}
 	suffixes := map[int]int{}
+	renamed := map[string]string{}
 	for i, c := range columns {
 		colName := columnName(c.Column, i)
 		tagName := colName
@@ -364,10 +554,19 @@ func columnsToStruct(req *plugin.GenerateRequest, options *opts.Options, name st
 		// override col/tag with expected model name
 		if c.embed != nil {
 			colName = c.embed.modelName
+			if c.EmbedName != "" {
+				colName = c.EmbedName
+			}
 			tagName = SetCaseStyle(colName, "snake")
 		}
 
 		fieldName := StructName(colName, options)
+		if c.embed == nil {
+			if rename, ok := qualifiedRename(req, options, c.Table, colName); ok {
+				fieldName = rename
+				renamed[fieldName] = colName
+			}
+		}
 		baseFieldName := fieldName
 		// Track suffixes by the ID of the column, so that columns referring to the same numbered parameter can be
 		// reused.
@@ -389,6 +588,7 @@ func columnsToStruct(req *plugin.GenerateRequest, options *opts.Options, name st
 		if options.EmitJsonTags {
 			tags["json"] = JSONTagName(tagName, options)
 		}
+		addCommentTags(tags, req, options, c.Column)
 		addExtraGoStructTags(tags, req, options, c.Column)
 		f := Field{
 			Name:   fieldName,
@@ -401,6 +601,7 @@ func columnsToStruct(req *plugin.GenerateRequest, options *opts.Options, name st
 		} else {
 			f.Type = c.embed.modelType
 			f.EmbedFields = c.embed.fields
+			f.EmbedPointer = c.embed.pointer
 		}
 
 		gs.Fields = append(gs.Fields, f)
@@ -429,6 +630,16 @@ func columnsToStruct(req *plugin.GenerateRequest, options *opts.Options, name st
 		}
 	}
 
+	// Fields that share a name are given a suffix, unless they refer to the
+	// same parameter, but a renamed field must not clash with other columns
+	for name, column := range renamed {
+		for _, j := range seen[name] {
+			if c := columns[j]; c.embed != nil || columnName(c.Column, j) != column {
+				return nil, fmt.Errorf("struct %s: renaming column %s to %s conflicts with another field", gs.Name, column, name)
+			}
+		}
+	}
+
 	err := checkIncompatibleFieldTypes(gs.Fields)
 	if err != nil {
 		return nil, err
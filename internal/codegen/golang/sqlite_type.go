@@ -15,7 +15,13 @@ func sqliteType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.
 	notNull := col.NotNull || col.IsArray
 	emitPointersForNull := options.EmitPointersForNullTypes
 
-	switch dt {
+	// The size of a type, as in VARCHAR(255), doesn't change how it's mapped
+	name := dt
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		name = name[:i]
+	}
+
+	switch name {
 
 	case "int", "integer", "tinyint", "smallint", "mediumint", "bigint", "unsignedbigint", "int2", "int8":
 		if notNull {
@@ -61,16 +67,22 @@ func sqliteType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.
 
 	}
 
+	// Other types are mapped by the affinity SQLite gives them
+	// https://www.sqlite.org/datatype3.html#determination_of_column_affinity
 	switch {
 
-	case strings.HasPrefix(dt, "character"),
-		strings.HasPrefix(dt, "varchar"),
-		strings.HasPrefix(dt, "varyingcharacter"),
-		strings.HasPrefix(dt, "nchar"),
-		strings.HasPrefix(dt, "nativecharacter"),
-		strings.HasPrefix(dt, "nvarchar"),
-		dt == "text",
-		dt == "clob":
+	case strings.Contains(name, "int"):
+		if notNull {
+			return "int64"
+		}
+		if emitPointersForNull {
+			return "*int64"
+		}
+		return "sql.NullInt64"
+
+	case strings.Contains(name, "char"),
+		strings.Contains(name, "clob"),
+		strings.Contains(name, "text"):
 		if notNull {
 			return "string"
 		}
@@ -79,7 +91,14 @@ func sqliteType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.
 		}
 		return "sql.NullString"
 
-	case strings.HasPrefix(dt, "decimal"), dt == "numeric":
+	case strings.Contains(name, "blob"):
+		return "[]byte"
+
+	case strings.Contains(name, "real"),
+		strings.Contains(name, "floa"),
+		strings.Contains(name, "doub"),
+		name == "decimal",
+		name == "numeric":
 		if notNull {
 			return "float64"
 		}
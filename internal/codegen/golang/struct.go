@@ -1,11 +1,13 @@
 package golang
 
 import (
+	"fmt"
 	"strings"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
 )
 
@@ -47,3 +49,174 @@ func StructName(name string, options *opts.Options) string {
 		return out
 	}
 }
+
+// typeName returns the name of the generated type for a table or enum. Names
+// set by a rename are used as they are, while others get the configured prefix
+// and suffix.
+func typeName(name string, options *opts.Options) string {
+	if rename := options.Rename[name]; rename != "" {
+		return rename
+	}
+	return affixTypeName(StructName(name, options), options)
+}
+
+// affixTypeName adds the configured prefix and suffix to the name of a
+// generated type.
+func affixTypeName(name string, options *opts.Options) string {
+	return options.OutputStructNamePrefix + name + options.OutputStructNameSuffix
+}
+
+// checkTypeNames returns an error if the prefix or suffix of the generated
+// types gives two of them the same name, such as the prefixed name of a table
+// and the name another table is renamed to.
+func checkTypeNames(req *plugin.GenerateRequest, options *opts.Options, enums []Enum, structs []Struct, queries []Query) error {
+	if options.OutputStructNamePrefix == "" && options.OutputStructNameSuffix == "" {
+		return nil
+	}
+	sources := map[string]string{}
+	add := func(name, source string) error {
+		if prev, ok := sources[name]; ok {
+			first, second := prev, source
+			if second < first {
+				first, second = second, first
+			}
+			return fmt.Errorf("the generated types of %s and %s are both named %s", first, second, name)
+		}
+		sources[name] = source
+		return nil
+	}
+	for _, e := range enums {
+		source := fmt.Sprintf("enum %q", e.DBName)
+		if err := add(e.Name, source); err != nil {
+			return err
+		}
+		if err := add("Null"+e.Name, source); err != nil {
+			return err
+		}
+	}
+	for _, s := range structs {
+		table := s.Table.Name
+		if s.Table.Schema != "" && s.Table.Schema != req.Catalog.DefaultSchema {
+			table = s.Table.Schema + "." + table
+		}
+		if err := add(s.Name, fmt.Sprintf("table %q", table)); err != nil {
+			return err
+		}
+	}
+	for _, q := range queries {
+		if q.Arg.EmitStruct() && q.Arg.Struct != nil {
+			if err := add(q.Arg.Struct.Name, fmt.Sprintf("the parameters of query %s", q.MethodName)); err != nil {
+				return err
+			}
+		}
+		if q.Ret.EmitStruct() && q.Ret.Struct != nil {
+			if err := add(q.Ret.Struct.Name, fmt.Sprintf("the rows of query %s", q.MethodName)); err != nil {
+				return err
+			}
+		}
+		switch q.Cmd {
+		case metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne:
+			if err := add(q.BatchResults, fmt.Sprintf("the results of query %s", q.MethodName)); err != nil {
+				return err
+			}
+		}
+		if q.OrderBy != nil {
+			if err := add(q.OrderBy.Type, fmt.Sprintf("the sort order of query %s", q.MethodName)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// qualifiedRename returns the rename of a column keyed by its table, such as
+// "sessions.ip". Tables outside of the default schema are qualified with their
+// schema as well, such as "audit.sessions.ip".
+func qualifiedRename(req *plugin.GenerateRequest, options *opts.Options, table *plugin.Identifier, column string) (string, bool) {
+	rename := options.Rename[qualifiedRenameKey(req, table, column)]
+	return rename, rename != ""
+}
+
+func qualifiedRenameKey(req *plugin.GenerateRequest, table *plugin.Identifier, column string) string {
+	if table == nil || table.Name == "" {
+		return ""
+	}
+	key := table.Name + "." + column
+	if table.Schema != "" && table.Schema != req.Catalog.DefaultSchema {
+		key = table.Schema + "." + key
+	}
+	return key
+}
+
+// fieldName returns the name of the field for a column of table, which may be
+// nil. A rename keyed by the table takes precedence over one keyed by the
+// column name alone.
+func fieldName(req *plugin.GenerateRequest, options *opts.Options, table *plugin.Identifier, column string) string {
+	if rename, ok := qualifiedRename(req, options, table, column); ok {
+		return rename
+	}
+	return StructName(column, options)
+}
+
+// checkRenamedFields returns an error if a field renamed by a qualified rename
+// has the same name as another field of the struct. renamed maps the names of
+// the renamed fields to their columns.
+func checkRenamedFields(s Struct, renamed map[string]string) error {
+	seen := map[string]bool{}
+	for _, f := range s.Fields {
+		if column, ok := renamed[f.Name]; ok && seen[f.Name] {
+			return fmt.Errorf("struct %s: renaming column %s to %s conflicts with another field", s.Name, column, f.Name)
+		}
+		seen[f.Name] = true
+	}
+	return nil
+}
+
+// isArray reports whether a field is a slice that database/sql can only scan
+// through pq.Array.
+func (gf Field) isArray() bool {
+	return strings.HasPrefix(gf.Type, "[]") && gf.Type != "[]byte"
+}
+
+// columnFields returns the fields that columns are scanned into, in column
+// order. Fields of embedded structs are listed in place of the struct, with
+// the name of the struct as their prefix.
+func (s Struct) columnFields() (prefixes []string, fields []Field) {
+	for _, f := range s.Fields {
+		if len(f.EmbedFields) == 0 {
+			prefixes = append(prefixes, "")
+			fields = append(fields, f)
+			continue
+		}
+		for _, embed := range f.EmbedFields {
+			prefixes = append(prefixes, f.Name+".")
+			fields = append(fields, embed)
+		}
+	}
+	return prefixes, fields
+}
+
+// HasScanTargets reports whether the ScanTargets and ColumnNames methods are
+// generated for the struct. They aren't for structs with pointer embeds, see
+// embed_pointer_for_nullable, which are only set to nil after scanning, when
+// all the columns of the embed turn out to be NULL.
+func (s Struct) HasScanTargets() bool {
+	for _, f := range s.Fields {
+		if f.EmbedPointer {
+			return false
+		}
+	}
+	return true
+}
+
+// hasArrayColumns reports whether any column of the struct is scanned into a
+// slice.
+func (s Struct) hasArrayColumns() bool {
+	_, fields := s.columnFields()
+	for _, f := range fields {
+		if f.isArray() {
+			return true
+		}
+	}
+	return false
+}
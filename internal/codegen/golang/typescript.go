@@ -0,0 +1,270 @@
+package golang
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// typeScriptOptions are the options of the TypeScript generator. Go holds the
+// options of the Go code generated for the same package, if any, whose
+// structs the interfaces mirror.
+type typeScriptOptions struct {
+	Out      string          `json:"out"`
+	Filename string          `json:"filename,omitempty"`
+	Bigint   string          `json:"bigint,omitempty"`
+	Go       json.RawMessage `json:"go,omitempty"`
+}
+
+// GenerateTypeScript generates a TypeScript file declaring an interface for
+// each struct of the Go code generated for the request: the models, and the
+// params and row structs of the queries. Properties are named as encoding/json
+// names the fields of the structs, and enums are unions of their values, so
+// that the interfaces describe the structs as they're encoded to JSON.
+func GenerateTypeScript(ctx context.Context, req *plugin.GenerateRequest) (*plugin.GenerateResponse, error) {
+	var tsOptions typeScriptOptions
+	if len(req.PluginOptions) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(req.PluginOptions))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&tsOptions); err != nil {
+			return nil, fmt.Errorf("unmarshalling options: %w", err)
+		}
+	}
+	switch tsOptions.Bigint {
+	case "":
+		tsOptions.Bigint = "number"
+	case "number", "string":
+	default:
+		return nil, fmt.Errorf("invalid options: bigint must be number or string, not %q", tsOptions.Bigint)
+	}
+	filename := "models.ts"
+	if tsOptions.Filename != "" {
+		filename = tsOptions.Filename
+	}
+
+	// The structs are built as they are for Go, from the Go options of the
+	// package or, if it has none, the defaults
+	goReq := proto.Clone(req).(*plugin.GenerateRequest)
+	goReq.PluginOptions = tsOptions.Go
+	if len(goReq.PluginOptions) == 0 {
+		goOptions, err := json.Marshal(map[string]string{"out": tsOptions.Out})
+		if err != nil {
+			return nil, err
+		}
+		goReq.PluginOptions = goOptions
+	}
+	options, err := opts.Parse(goReq)
+	if err != nil {
+		return nil, err
+	}
+
+	enums := buildEnums(goReq, options)
+	structs, err := buildStructs(goReq, options)
+	if err != nil {
+		return nil, err
+	}
+	queries, err := buildQueries(goReq, options, structs)
+	if err != nil {
+		return nil, err
+	}
+	if nullable := nullableEmbedStructs(structs, queries); len(nullable) > 0 {
+		structs = append(structs, nullable...)
+		sort.Slice(structs, func(i, j int) bool { return structs[i].Name < structs[j].Name })
+	}
+	if options.OmitUnusedStructs {
+		enums, structs = filterUnusedStructs(enums, structs, queries)
+	}
+
+	b := typeScriptBuilder{
+		req:     goReq,
+		options: options,
+		bigint:  tsOptions.Bigint,
+		enums:   map[string]bool{},
+	}
+	for _, enum := range enums {
+		b.enums[enum.Name] = true
+	}
+	return &plugin.GenerateResponse{
+		Files: []*plugin.File{
+			{
+				Name:     filename,
+				Contents: b.file(enums, structs, queries),
+			},
+		},
+	}, nil
+}
+
+type typeScriptBuilder struct {
+	req     *plugin.GenerateRequest
+	options *opts.Options
+	bigint  string
+	enums   map[string]bool
+	buf     bytes.Buffer
+}
+
+// file returns the contents of the TypeScript file: the enums and models in
+// order of their names, followed by the params and row structs of each query,
+// in the order of the queries.
+func (b *typeScriptBuilder) file(enums []Enum, structs []Struct, queries []Query) []byte {
+	b.buf.WriteString("// Code generated by sqlc. DO NOT EDIT.\n")
+	if !b.options.OmitSqlcVersion {
+		fmt.Fprintf(&b.buf, "// versions:\n//   sqlc %s\n", b.req.SqlcVersion)
+	}
+	for _, enum := range enums {
+		b.buf.WriteString("\n")
+		b.comment("", enum.Comment)
+		values := make([]string, 0, len(enum.Constants))
+		for _, c := range enum.Constants {
+			values = append(values, strconv.Quote(c.Value))
+		}
+		if len(values) == 0 {
+			values = append(values, "never")
+		}
+		fmt.Fprintf(&b.buf, "export type %s = %s;\n", enum.Name, strings.Join(values, " | "))
+	}
+	for _, s := range structs {
+		b.iface(s.Name, s.Comment, s.Fields)
+	}
+	for _, q := range queries {
+		if q.Arg.EmitStruct() && q.Arg.IsStruct() {
+			b.iface(q.Arg.Struct.Name, q.Arg.Struct.Comment, q.Arg.Struct.Fields)
+		}
+		if q.Ret.EmitStruct() && q.Ret.IsStruct() {
+			b.iface(q.Ret.Struct.Name, q.Ret.Struct.Comment, q.Ret.Struct.Fields)
+		}
+	}
+	return b.buf.Bytes()
+}
+
+func (b *typeScriptBuilder) iface(name, comment string, fields []Field) {
+	b.buf.WriteString("\n")
+	b.comment("", comment)
+	fmt.Fprintf(&b.buf, "export interface %s {\n", name)
+	for _, f := range fields {
+		prop, omitEmpty, ok := jsonFieldName(f)
+		if !ok {
+			continue
+		}
+		b.comment("  ", f.Comment)
+		optional := ""
+		if omitEmpty {
+			optional = "?"
+		}
+		fmt.Fprintf(&b.buf, "  %s%s: %s;\n", typeScriptProperty(prop), optional, b.fieldType(f))
+	}
+	b.buf.WriteString("}\n")
+}
+
+func (b *typeScriptBuilder) comment(indent, comment string) {
+	if comment == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimRight(comment, "\n"), "\n") {
+		fmt.Fprintf(&b.buf, "%s// %s\n", indent, strings.TrimSpace(strings.TrimPrefix(line, "//")))
+	}
+}
+
+// sqlNullValueFields maps the nullable types of database/sql to the field
+// holding their value. They don't implement json.Marshaler, so encoding/json
+// encodes them as objects with the value and a Valid field.
+var sqlNullValueFields = map[string]string{
+	"sql.NullString":  "String",
+	"sql.NullBool":    "Bool",
+	"sql.NullByte":    "Byte",
+	"sql.NullInt16":   "Int16",
+	"sql.NullInt32":   "Int32",
+	"sql.NullInt64":   "Int64",
+	"sql.NullFloat64": "Float64",
+	"sql.NullTime":    "Time",
+}
+
+// fieldType returns the TypeScript type of a field, which includes null if
+// the column is nullable and its Go type encodes NULL as null.
+func (b *typeScriptBuilder) fieldType(f Field) string {
+	if len(f.EmbedFields) > 0 {
+		typ := strings.TrimPrefix(f.Type, "*")
+		if f.EmbedPointer {
+			typ += " | null"
+		}
+		return typ
+	}
+
+	col := f.Column
+	if col != nil && (columnOverride(b.req, b.options, col) != nil || dbTypeOverride(b.options, col) != nil) {
+		if col.IsSqlcSlice {
+			return "unknown[]"
+		}
+		return "unknown"
+	}
+
+	typ := strings.TrimPrefix(f.Type, "*")
+	var dims int
+	for typ != "[]byte" && strings.HasPrefix(typ, "[]") {
+		typ = strings.TrimPrefix(typ, "[]")
+		dims++
+	}
+	ts := b.goType(typ)
+	if field, ok := sqlNullValueFields[typ]; ok {
+		ts = fmt.Sprintf("{ %s: %s; Valid: boolean }", field, ts)
+		if dims == 0 && !strings.HasPrefix(f.Type, "*") {
+			return ts
+		}
+	}
+	if dims > 0 && strings.Contains(ts, " ") {
+		ts = "(" + ts + ")"
+	}
+	ts += strings.Repeat("[]", dims)
+	if col != nil && !col.NotNull && !col.IsSqlcSlice && ts != "unknown" {
+		ts += " | null"
+	}
+	return ts
+}
+
+// goType returns the TypeScript type of the values of a Go type, regardless
+// of whether the type can hold NULL. Types without a known encoding, such as
+// overridden Go types, are unknown.
+func (b *typeScriptBuilder) goType(typ string) string {
+	if name := strings.TrimPrefix(typ, "Null"); b.enums[name] {
+		return name
+	}
+	switch typ {
+	case "string", "sql.NullString", "pgtype.Text", "pgtype.Varchar", "pgtype.BPChar",
+		"netip.Addr", "netip.Prefix", "net.HardwareAddr", "pgtype.Inet", "pgtype.CIDR", "pgtype.Macaddr",
+		"time.Time", "sql.NullTime", "pgtype.Timestamp", "pgtype.Timestamptz", "pgtype.Date", "pgtype.Time",
+		"uuid.UUID", "uuid.NullUUID", "pgtype.UUID", "[]byte":
+		return "string"
+	case "bool", "sql.NullBool", "pgtype.Bool":
+		return "boolean"
+	case "int64", "uint64", "sql.NullInt64", "pgtype.Int8":
+		return b.bigint
+	case "int", "int8", "int16", "int32", "uint", "uint8", "uint16", "uint32",
+		"sql.NullInt16", "sql.NullInt32", "sql.NullByte", "pgtype.Int2", "pgtype.Int4", "pgtype.Uint32",
+		"float32", "float64", "sql.NullFloat64", "pgtype.Float4", "pgtype.Float8", "pgtype.Numeric":
+		return "number"
+	}
+	return "unknown"
+}
+
+// typeScriptProperty returns name as a property name, quoted unless it's a
+// valid identifier.
+func typeScriptProperty(name string) string {
+	for i, r := range name {
+		if r == '_' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || (i > 0 && '0' <= r && r <= '9') {
+			continue
+		}
+		return strconv.Quote(name)
+	}
+	if name == "" {
+		return `""`
+	}
+	return name
+}
@@ -0,0 +1,85 @@
+package golang
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// unusedOverrides returns a diagnostic for each override of the package that
+// matches nothing: a column override that matches no column of a table or a
+// query, or a db_type override that no column or parameter has the type of.
+// Wildcard overrides are used if they match any column. Global overrides are
+// left out, as they may only apply to some of the packages.
+func unusedOverrides(req *plugin.GenerateRequest, options *opts.Options) []*plugin.Diagnostic {
+	severity := plugin.Diagnostic_WARNING
+	if options.StrictOverrides {
+		severity = plugin.Diagnostic_ERROR
+	}
+
+	var columns []*plugin.Column
+	for _, schema := range req.Catalog.Schemas {
+		for _, table := range schema.Tables {
+			columns = append(columns, table.Columns...)
+		}
+	}
+	for _, query := range req.Queries {
+		columns = append(columns, query.Columns...)
+		for _, param := range query.Params {
+			if param.Column != nil {
+				columns = append(columns, param.Column)
+			}
+		}
+	}
+	types := map[string]bool{}
+	for _, col := range columns {
+		if col.Type != nil {
+			types[sdk.DataType(col.Type)] = true
+		}
+		if col.Domain != nil {
+			types[sdk.DataType(col.Domain)] = true
+		}
+	}
+
+	var diags []*plugin.Diagnostic
+	for i := range options.Overrides {
+		override := &options.Overrides[i]
+		if override.Global {
+			continue
+		}
+		var message string
+		switch {
+		case override.Column != "":
+			if slices.ContainsFunc(columns, func(col *plugin.Column) bool {
+				return matchesColumn(req, override, col)
+			}) {
+				continue
+			}
+			message = fmt.Sprintf("override for column %q matches no column", override.Column)
+			if renamed, ok := opts.RenamedColumn(req, override.Column); ok {
+				message += fmt.Sprintf("; the column was renamed to %q", renamed)
+			}
+		case override.DBType != "":
+			if types[override.DBType] {
+				continue
+			}
+			message = fmt.Sprintf("override for db_type %q matches no column or parameter type", override.DBType)
+		default:
+			continue
+		}
+		diag := &plugin.Diagnostic{
+			Severity: severity,
+			Code:     codeUnusedOverride,
+			Message:  message,
+		}
+		if override.Position != nil {
+			diag.Line = int32(override.Position.Line)
+			diag.Column = int32(override.Position.Column)
+		}
+		diags = append(diags, diag)
+	}
+	return diags
+}
@@ -18,6 +18,8 @@ type analysis struct {
 	Parameters []Parameter
 	Named      *named.ParamSet
 	Query      string
+	OrderBy    *rewrite.OrderBy
+	Embeds     rewrite.EmbedSet
 }
 
 func convertTableName(id *analyzer.Identifier) *ast.TableName {
@@ -79,9 +81,7 @@ func combineAnalysis(prev *analysis, a *analyzer.Analysis) *analysis {
 	}
 	if len(prev.Columns) == len(cols) {
 		for i := range prev.Columns {
-			prev.Columns[i].DataType = cols[i].DataType
-			prev.Columns[i].IsArray = cols[i].IsArray
-			prev.Columns[i].ArrayDims = cols[i].ArrayDims
+			mergeAnalyzedColumn(prev.Columns[i], cols[i], true)
 		}
 	} else {
 		embedding := false
@@ -91,21 +91,45 @@ func combineAnalysis(prev *analysis, a *analyzer.Analysis) *analysis {
 			}
 		}
 		if !embedding {
+			for _, col := range cols {
+				col.AnalyzedFromDatabase = true
+			}
 			prev.Columns = cols
 		}
 	}
 	if len(prev.Parameters) == len(params) {
 		for i := range prev.Parameters {
-			prev.Parameters[i].Column.DataType = params[i].Column.DataType
-			prev.Parameters[i].Column.IsArray = params[i].Column.IsArray
-			prev.Parameters[i].Column.ArrayDims = params[i].Column.ArrayDims
+			mergeAnalyzedColumn(prev.Parameters[i].Column, params[i].Column, false)
 		}
 	} else {
+		for _, p := range params {
+			p.Column.AnalyzedFromDatabase = true
+		}
 		prev.Parameters = params
 	}
 	return prev
 }
 
+// mergeAnalyzedColumn replaces the type the compiler inferred for col with the
+// type the database analyzed. The type name is dropped if the database
+// disagrees with it, as plugins prefer it to the data type.
+//
+// The database can't tell whether expressions and parameters can be NULL, or
+// whether the columns of an outer join can, so nullability is only merged for
+// result columns of tables, which are NOT NULL only if both agree.
+func mergeAnalyzedColumn(col, analyzed *Column, result bool) {
+	if col.Type != nil && dataType(col.Type) != analyzed.DataType {
+		col.Type = analyzed.Type
+	}
+	col.DataType = analyzed.DataType
+	col.IsArray = analyzed.IsArray
+	col.ArrayDims = analyzed.ArrayDims
+	if result && analyzed.Table != nil {
+		col.NotNull = col.NotNull && analyzed.NotNull
+	}
+	col.AnalyzedFromDatabase = true
+}
+
 func (c *Compiler) analyzeQuery(raw *ast.RawStmt, query string) (*analysis, error) {
 	return c._analyzeQuery(raw, query, true)
 }
@@ -132,6 +156,17 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 	}
 
 	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw, numbers, dollar)
+	if c.conf.InferNullableParams == nil || *c.conf.InferNullableParams {
+		for _, number := range optionalParams(raw.Stmt) {
+			namedParams.SetNullable(number)
+		}
+	}
+
+	raw, orderBy, orderByEdits, err := rewrite.OrderByColumns(raw, query)
+	if err := check(err); err != nil {
+		return nil, err
+	}
+	edits = append(edits, orderByEdits...)
 
 	var table *ast.TableName
 	switch n := raw.Stmt.(type) {
@@ -139,6 +174,9 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 		if err := check(validate.InsertStmt(n)); err != nil {
 			return nil, err
 		}
+		if err := check(c.validateInsertColumns(n)); err != nil {
+			return nil, err
+		}
 		var err error
 		table, err = ParseTableName(n.Relation)
 		if err := check(err); err != nil {
@@ -146,6 +184,10 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 		}
 	}
 
+	if err := check(validate.GeneratedColumns(c.catalog, raw.Stmt)); err != nil {
+		return nil, err
+	}
+
 	if err := check(validate.FuncCall(c.catalog, c.combo, raw)); err != nil {
 		return nil, err
 	}
@@ -153,6 +195,9 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 	if err := check(validate.In(c.catalog, raw)); err != nil {
 		return nil, err
 	}
+	if n, ok := raw.Stmt.(*ast.InsertStmt); ok {
+		c.fillInsertColumns(n)
+	}
 	rvs := rangeVars(raw.Stmt)
 	refs, errs := findParameters(raw.Stmt)
 	if len(errs) > 0 {
@@ -173,7 +218,15 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 		return nil, err
 	}
 
-	params, err := c.resolveCatalogRefs(qc, rvs, refs, namedParams, embeds)
+	// In an ON CONFLICT clause, EXCLUDED refers to the row proposed for
+	// insertion, which has the columns of the target table.
+	var excluded *ast.TableName
+	if n, ok := raw.Stmt.(*ast.InsertStmt); ok && n.OnConflictClause != nil {
+		excluded = table
+	}
+
+	subselects := c.subselectTables(qc, raw.Stmt)
+	params, err := c.resolveCatalogRefs(qc, rvs, subselects, excluded, refs, namedParams, embeds)
 	if err := check(err); err != nil {
 		return nil, err
 	}
@@ -181,6 +234,9 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 	if err := check(err); err != nil {
 		return nil, err
 	}
+	if err := check(c.validateOrderBy(qc, rvs, cols, orderBy)); err != nil {
+		return nil, err
+	}
 
 	expandEdits, err := c.expand(qc, raw)
 	if check(err); err != nil {
@@ -203,5 +259,7 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 		Parameters: params,
 		Query:      expanded,
 		Named:      namedParams,
+		OrderBy:    orderBy,
+		Embeds:     embeds,
 	}, rerr
 }
@@ -0,0 +1,72 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// callColumnsTable is the name of the table the column definitions of a
+// @columns annotation are parsed into.
+const callColumnsTable = "sqlc_call_columns"
+
+// returnsRows reports whether a query command scans rows.
+func returnsRows(cmd string) bool {
+	switch cmd {
+	case metadata.CmdMany, metadata.CmdOne, metadata.CmdBatchMany, metadata.CmdBatchOne:
+		return true
+	}
+	return false
+}
+
+// callResultColumns returns the result columns of a query: those declared by
+// its @columns annotation if it calls a procedure, as procedures don't declare
+// the rows they return, and the analyzed columns otherwise.
+func (c *Compiler) callResultColumns(stmt ast.Node, md metadata.Metadata, cols []*Column) ([]*Column, error) {
+	call, ok := stmt.(*ast.CallStmt)
+	if !ok {
+		if md.Columns != "" {
+			return nil, fmt.Errorf("query %q has a @columns annotation, which is only supported by CALL statements", md.Name)
+		}
+		return cols, nil
+	}
+	if md.Columns != "" {
+		return c.callColumns(md.Columns)
+	}
+	if returnsRows(md.Cmd) && len(cols) == 0 {
+		return nil, fmt.Errorf("query %q calls procedure %q, which doesn't declare the columns it returns; add a @columns annotation, such as \"-- @columns id BIGINT NOT NULL\"", md.Name, call.FuncCall.Func.Name)
+	}
+	return cols, nil
+}
+
+// callColumns returns the columns declared by the column definitions of a
+// @columns annotation, which are written as in a CREATE TABLE statement.
+func (c *Compiler) callColumns(defs string) ([]*Column, error) {
+	src := fmt.Sprintf("CREATE TABLE %s (%s);", callColumnsTable, defs)
+	stmts, err := c.parser.Parse(strings.NewReader(src))
+	if err != nil || len(stmts) != 1 {
+		return nil, fmt.Errorf("invalid columns: %q isn't a list of column definitions", defs)
+	}
+	if _, ok := stmts[0].Raw.Stmt.(*ast.CreateTableStmt); !ok {
+		return nil, fmt.Errorf("invalid columns: %q isn't a list of column definitions", defs)
+	}
+	// The columns are defined in a catalog of their own, so that they don't
+	// change the schema
+	scratch := catalog.New(c.catalog.DefaultSchema)
+	if err := scratch.Build(stmts); err != nil {
+		return nil, fmt.Errorf("invalid columns: %w", err)
+	}
+	table, err := scratch.GetTable(&ast.TableName{Name: callColumnsTable})
+	if err != nil {
+		return nil, errors.New("invalid columns: no column definitions")
+	}
+	var cols []*Column
+	for _, col := range table.Columns {
+		cols = append(cols, ConvertColumn(nil, col))
+	}
+	return cols, nil
+}
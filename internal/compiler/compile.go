@@ -7,7 +7,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"unicode"
 
+	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/migrations"
 	"github.com/sqlc-dev/sqlc/internal/multierr"
 	"github.com/sqlc-dev/sqlc/internal/opts"
@@ -30,6 +32,7 @@ func (c *Compiler) parseCatalog(schemas []string) error {
 	if err != nil {
 		return err
 	}
+	c.schemaFiles = files
 	merr := multierr.New()
 	for _, filename := range files {
 		blob, err := os.ReadFile(filename)
@@ -45,10 +48,15 @@ func (c *Compiler) parseCatalog(schemas []string) error {
 			continue
 		}
 		for i := range stmts {
+			setViewDefinition(contents, stmts[i])
 			if err := c.catalog.Update(stmts[i], c); err != nil {
 				merr.Add(filename, contents, stmts[i].Pos(), err)
 				continue
 			}
+			c.catalog.RecordRename(stmts[i], filepath.Base(filename))
+			if c.schemaSource {
+				c.setSource(filename, contents, stmts[i])
+			}
 		}
 	}
 	if len(merr.Errs()) > 0 {
@@ -57,6 +65,53 @@ func (c *Compiler) parseCatalog(schemas []string) error {
 	return nil
 }
 
+// setSource records the text of stmt as the source of the table or type it
+// created or changed.
+func (c *Compiler) setSource(filename, contents string, stmt ast.Statement) {
+	if stmt.Raw == nil {
+		return
+	}
+	text, err := source.Pluck(contents, stmt.Raw.StmtLocation, stmt.Raw.StmtLen)
+	if err != nil {
+		return
+	}
+	// The text starts with the comments before the statement, so the line is
+	// that of the first of them
+	start := stmt.Raw.StmtLocation + len(text) - len(strings.TrimLeftFunc(text, unicode.IsSpace))
+	line := strings.Count(contents[:start], "\n") + 1
+	text = strings.TrimSuffix(strings.TrimSpace(text), ";")
+	c.catalog.SetSource(stmt, text, filepath.Base(filename), line)
+}
+
+// setViewDefinition records the text of CREATE VIEW and CREATE MATERIALIZED
+// VIEW statements, so that the catalog can expose how a view is defined.
+func setViewDefinition(contents string, stmt ast.Statement) {
+	if stmt.Raw == nil {
+		return
+	}
+	var definition *string
+	switch n := stmt.Raw.Stmt.(type) {
+	case *ast.ViewStmt:
+		definition = &n.Definition
+	case *ast.CreateTableAsStmt:
+		if !n.IsMatview {
+			return
+		}
+		definition = &n.Definition
+	default:
+		return
+	}
+	text, err := source.Pluck(contents, stmt.Raw.StmtLocation, stmt.Raw.StmtLen)
+	if err != nil {
+		return
+	}
+	text, _, err = source.StripComments(text)
+	if err != nil {
+		return
+	}
+	*definition = strings.TrimSuffix(text, ";")
+}
+
 func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 	var q []*Query
 	merr := multierr.New()
@@ -66,55 +121,122 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 		return nil, err
 	}
 	for _, filename := range files {
+		if !o.MatchFile(filename) {
+			continue
+		}
 		blob, err := os.ReadFile(filename)
 		if err != nil {
 			merr.Add(filename, "", 0, err)
 			continue
 		}
-		src := string(blob)
-		stmts, err := c.parser.Parse(strings.NewReader(src))
+		queries, err := c.parseQueryFile(filename, string(blob), o, set, merr)
 		if err != nil {
-			merr.Add(filename, src, 0, err)
-			continue
-		}
-		for _, stmt := range stmts {
-			query, err := c.parseQuery(stmt.Raw, src, o)
-			if err != nil {
-				var e *sqlerr.Error
-				loc := stmt.Raw.Pos()
-				if errors.As(err, &e) && e.Location != 0 {
-					loc = e.Location
-				}
-				merr.Add(filename, src, loc, err)
-				// If this rpc unauthenticated error bubbles up, then all future parsing/analysis will fail
-				if errors.Is(err, rpc.ErrUnauthenticated) {
-					return nil, merr
-				}
-				continue
-			}
-			if query == nil {
-				continue
-			}
-			query.Metadata.Filename = filepath.Base(filename)
-			queryName := query.Metadata.Name
-			if queryName != "" {
-				if _, exists := set[queryName]; exists {
-					merr.Add(filename, src, stmt.Raw.Pos(), fmt.Errorf("duplicate query name: %s", queryName))
-					continue
-				}
-				set[queryName] = struct{}{}
-			}
-			q = append(q, query)
+			return nil, merr
 		}
+		q = append(q, queries...)
 	}
 	if len(merr.Errs()) > 0 {
 		return nil, merr
 	}
-	if len(q) == 0 {
+	var warnings []Warning
+	if c.conf.GenerateCRUD != nil {
+		src, warns, err := c.crudQueries(c.conf.GenerateCRUD.Tables, set)
+		if err != nil {
+			return nil, err
+		}
+		warnings = warns
+		if src != "" {
+			queries, _ := c.parseQueryFile(crudFilename, src, o, set, merr)
+			q = append(q, queries...)
+			if len(merr.Errs()) > 0 {
+				return nil, merr
+			}
+		}
+	}
+	// A filter may leave a package without queries, which is then skipped
+	if len(q) == 0 && !o.Filtered() {
 		return nil, fmt.Errorf("no queries contained in paths %s", strings.Join(c.conf.Queries, ","))
 	}
 	return &Result{
-		Catalog: c.catalog,
-		Queries: q,
+		Catalog:     c.catalog,
+		Queries:     q,
+		SchemaFiles: c.schemaFiles,
+		QueryFiles:  files,
+		Warnings:    warnings,
+
+		DatabaseAnalysis: c.analyzer != nil,
 	}, nil
 }
+
+// parseQueryFile parses the queries in src, adding their names to set. Errors
+// are added to merr, and the error that stops all further parsing is returned.
+func (c *Compiler) parseQueryFile(filename, src string, o opts.Parser, set map[string]struct{}, merr *multierr.Error) ([]*Query, error) {
+	var q []*Query
+	stmts, err := c.parser.Parse(strings.NewReader(src))
+	if err != nil {
+		merr.Add(filename, src, 0, err)
+		return nil, nil
+	}
+	// The generated code of a query file holds all of its queries, so a
+	// query filter selects the files that contain the queries
+	if !c.containsQuery(stmts, src, o) {
+		return nil, nil
+	}
+	for _, stmt := range stmts {
+		query, err := c.parseQuery(stmt.Raw, src, o)
+		if err != nil {
+			c.renameHint(stmt.Raw, err)
+			var e *sqlerr.Error
+			loc := stmt.Raw.Pos()
+			if errors.As(err, &e) && e.Location != 0 {
+				loc = e.Location
+			} else if e != nil && e.Line != 0 {
+				loc = 0
+			}
+			// Errors without a position of their own are about the statement
+			merr.AddRange(filename, src, loc, stmt.Raw.StmtLocation+stmt.Raw.StmtLen, err)
+			// If this rpc unauthenticated error bubbles up, then all future parsing/analysis will fail
+			if errors.Is(err, rpc.ErrUnauthenticated) {
+				return nil, err
+			}
+			continue
+		}
+		if query == nil {
+			continue
+		}
+		query.Metadata.Filename = filepath.Base(filename)
+		query.Path = filename
+		query.Line, query.Column = source.LineNumber(src, stmt.Raw.Pos())
+		query.EndLine, query.EndColumn = source.EndPosition(src, stmt.Raw.StmtLocation+stmt.Raw.StmtLen)
+		queryName := query.Metadata.Name
+		if queryName != "" {
+			if _, exists := set[queryName]; exists {
+				merr.Add(filename, src, stmt.Raw.Pos(), fmt.Errorf("duplicate query name: %s", queryName))
+				continue
+			}
+			set[queryName] = struct{}{}
+		}
+		q = append(q, query)
+	}
+	return q, nil
+}
+
+// containsQuery reports whether one of the statements is a query matched by
+// the filter.
+func (c *Compiler) containsQuery(stmts []ast.Statement, src string, o opts.Parser) bool {
+	if len(o.Queries) == 0 {
+		return true
+	}
+	for _, stmt := range stmts {
+		rawSQL, err := source.Pluck(src, stmt.Raw.StmtLocation, stmt.Raw.StmtLen)
+		if err != nil {
+			// Let parseQuery report the error
+			return true
+		}
+		name, _, err := metadata.ParseQueryNameAndType(rawSQL, metadata.CommentSyntax(c.parser.CommentSyntax()), c.pluginCommands()...)
+		if err != nil || o.MatchQuery(name) {
+			return true
+		}
+	}
+	return false
+}
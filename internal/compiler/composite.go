@@ -0,0 +1,126 @@
+package compiler
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// compositeFields returns the column reference a field selection such as
+// (addr).city or ((loc).home).city starts from, and the names of the fields
+// it selects. It reports false if n selects anything else, such as array
+// elements.
+func compositeFields(n *ast.A_Indirection) (*ast.ColumnRef, []string, bool) {
+	var ref *ast.ColumnRef
+	var fields []string
+	switch arg := n.Arg.(type) {
+	case *ast.ColumnRef:
+		ref = arg
+	case *ast.A_Indirection:
+		var ok bool
+		if ref, fields, ok = compositeFields(arg); !ok {
+			return nil, nil, false
+		}
+	default:
+		return nil, nil, false
+	}
+	if n.Indirection == nil {
+		return nil, nil, false
+	}
+	for _, item := range n.Indirection.Items {
+		field, ok := item.(*ast.String)
+		if !ok {
+			return nil, nil, false
+		}
+		fields = append(fields, field.Str)
+	}
+	return ref, fields, len(fields) > 0
+}
+
+// rowColumnRef returns a reference to the column name of the table ref names,
+// if ref is the name or alias of one of tables.
+func rowColumnRef(tables []*Table, ref *ast.ColumnRef, name string) (*ast.ColumnRef, bool) {
+	parts := stringSlice(ref.Fields)
+	if len(parts) != 1 {
+		return nil, false
+	}
+	for _, t := range tables {
+		if t.Rel.Name == parts[0] {
+			return &ast.ColumnRef{
+				Fields:   &ast.List{Items: []ast.Node{&ast.String{Str: parts[0]}, &ast.String{Str: name}}},
+				Location: ref.Location,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// compositeField returns the field selected by fields from a value of type
+// typ, following fields of nested composite types.
+func (c *Compiler) compositeField(typ *ast.TypeName, isArray bool, fields []string, location int) (*catalog.Column, error) {
+	var col *catalog.Column
+	for _, name := range fields {
+		if isArray {
+			typ = &ast.TypeName{Name: typ.Name + "[]"}
+		}
+		field, err := c.catalog.GetCompositeField(typ, name)
+		if err != nil {
+			if e, ok := err.(*sqlerr.Error); ok {
+				e.Location = location
+			}
+			return nil, err
+		}
+		col = field
+		typ, isArray = &field.Type, field.IsArray
+	}
+	return col, nil
+}
+
+// compositeFieldColumn returns the output column of a field selected from a
+// column of a composite type. Fields are always nullable, since the fields of
+// composite types can't be declared NOT NULL. A field selected from a whole
+// row, such as (a).name for a table aliased a, is the column of the table. It
+// reports false if the selection can't be resolved, so that the column is
+// typed as any.
+func (c *Compiler) compositeFieldColumn(res *ast.ResTarget, tables []*Table, n *ast.A_Indirection) (*Column, bool, error) {
+	ref, fields, ok := compositeFields(n)
+	if !ok {
+		return nil, false, nil
+	}
+	refs, err := outputColumnRefs(res, tables, ref)
+	if err != nil {
+		row, ok := rowColumnRef(tables, ref, fields[0])
+		if !ok {
+			return nil, false, nil
+		}
+		if refs, err = outputColumnRefs(res, tables, row); err != nil {
+			return nil, false, nil
+		}
+		if fields = fields[1:]; len(fields) == 0 {
+			return refs[0], true, nil
+		}
+	}
+	base := refs[0]
+	if base.Type == nil {
+		return nil, false, nil
+	}
+	field, err := c.compositeField(base.Type, base.IsArray, fields, res.Location)
+	if err != nil {
+		return nil, false, err
+	}
+	name := field.Name
+	if res.Name != nil {
+		name = *res.Name
+	}
+	return &Column{
+		Name:         name,
+		OriginalName: field.Name,
+		DataType:     dataType(&field.Type),
+		Type:         &field.Type,
+		IsArray:      field.IsArray,
+		ArrayDims:    field.ArrayDims,
+		Length:       field.Length,
+		Precision:    field.Precision,
+		Scale:        field.Scale,
+	}, true, nil
+}
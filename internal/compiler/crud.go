@@ -0,0 +1,170 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/inflection"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// crudFilename is the query file the queries synthesized for generate_crud
+// belong to.
+const crudFilename = "crud.sql"
+
+// crudQueries returns the source of the standard queries of each of the
+// tables: one creating a row, one getting a row by its primary key, one
+// listing rows a page at a time, and ones updating and deleting a row by its
+// primary key. Tables without a primary key are skipped with a warning. It is
+// an error for one of the queries to have the name of a query in names.
+func (c *Compiler) crudQueries(tables []string, names map[string]struct{}) (string, []Warning, error) {
+	var b strings.Builder
+	var warnings []Warning
+	for _, name := range tables {
+		rel := &ast.TableName{Name: name}
+		if schema, table, ok := strings.Cut(name, "."); ok {
+			rel = &ast.TableName{Schema: schema, Name: table}
+		}
+		table, err := c.catalog.GetTable(rel)
+		if err != nil {
+			return "", nil, fmt.Errorf("generate_crud: table %q not found", name)
+		}
+		if table.IsView || table.PrimaryKey == nil {
+			warnings = append(warnings, Warning{
+				Code:    "crud-no-primary-key",
+				Message: fmt.Sprintf("generate_crud: skipping table %q without a primary key", name),
+			})
+			continue
+		}
+		queries := c.tableCRUDQueries(table)
+		for _, q := range queries {
+			if _, exists := names[q.name]; exists {
+				return "", nil, fmt.Errorf("generate_crud: query %s for table %q conflicts with a query of the same name", q.name, name)
+			}
+		}
+		for _, q := range queries {
+			fmt.Fprintf(&b, "-- name: %s %s\n%s;\n\n", q.name, q.cmd, q.sql)
+		}
+	}
+	return b.String(), warnings, nil
+}
+
+type crudQuery struct {
+	name string
+	cmd  string
+	sql  string
+}
+
+func (c *Compiler) tableCRUDQueries(table catalog.Table) []crudQuery {
+	singular := crudName(inflection.Singular(inflection.SingularParams{Name: table.Rel.Name}))
+	plural := crudName(table.Rel.Name)
+	rel := c.quoteIdent(table.Rel.Name)
+	if table.Rel.Schema != "" {
+		rel = c.quoteIdent(table.Rel.Schema) + "." + rel
+	}
+
+	primaryKey := map[string]bool{}
+	for _, name := range table.PrimaryKey.Columns {
+		primaryKey[name] = true
+	}
+	// Columns the database fills in, such as serial and auto-increment
+	// columns, are left out of the insert, and generated columns of both the
+	// insert and the update.
+	var insert, update []string
+	for _, col := range table.Columns {
+		if col.IsGenerated {
+			continue
+		}
+		if !col.HasDefault || col.DefaultExpr != "" {
+			insert = append(insert, col.Name)
+		}
+		if !primaryKey[col.Name] {
+			update = append(update, col.Name)
+		}
+	}
+
+	var params int
+	param := func() string {
+		params++
+		if c.conf.Engine == config.EnginePostgreSQL {
+			return fmt.Sprintf("$%d", params)
+		}
+		return "?"
+	}
+	where := func() string {
+		var conds []string
+		for _, name := range table.PrimaryKey.Columns {
+			conds = append(conds, c.quoteIdent(name)+" = "+param())
+		}
+		return "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var queries []crudQuery
+
+	var create string
+	switch {
+	case len(insert) > 0:
+		var cols, values []string
+		for _, name := range insert {
+			cols = append(cols, c.quoteIdent(name))
+			values = append(values, param())
+		}
+		create = fmt.Sprintf("INSERT INTO %s (%s)\nVALUES (%s)", rel, strings.Join(cols, ", "), strings.Join(values, ", "))
+	case c.conf.Engine == config.EngineMySQL:
+		create = fmt.Sprintf("INSERT INTO %s () VALUES ()", rel)
+	default:
+		create = fmt.Sprintf("INSERT INTO %s DEFAULT VALUES", rel)
+	}
+	// MySQL can't return the inserted row
+	if c.conf.Engine == config.EngineMySQL {
+		queries = append(queries, crudQuery{"Create" + singular, ":execresult", create})
+	} else {
+		queries = append(queries, crudQuery{"Create" + singular, ":one", create + "\nRETURNING *"})
+	}
+
+	params = 0
+	queries = append(queries, crudQuery{"Get" + singular, ":one", fmt.Sprintf("SELECT * FROM %s\n%s", rel, where())})
+
+	var order []string
+	for _, name := range table.PrimaryKey.Columns {
+		order = append(order, c.quoteIdent(name))
+	}
+	params = 0
+	queries = append(queries, crudQuery{"List" + plural, ":many", fmt.Sprintf("SELECT * FROM %s\nORDER BY %s\nLIMIT %s OFFSET %s", rel, strings.Join(order, ", "), param(), param())})
+
+	if len(update) > 0 {
+		params = 0
+		var set []string
+		for _, name := range update {
+			set = append(set, c.quoteIdent(name)+" = "+param())
+		}
+		queries = append(queries, crudQuery{"Update" + singular, ":exec", fmt.Sprintf("UPDATE %s\nSET %s\n%s", rel, strings.Join(set, ", "), where())})
+	}
+
+	params = 0
+	queries = append(queries, crudQuery{"Delete" + singular, ":exec", fmt.Sprintf("DELETE FROM %s\n%s", rel, where())})
+
+	return queries
+}
+
+// crudName turns the name of a table into the CamelCase form used in the names
+// of its queries, e.g. book_reviews into BookReviews.
+func crudName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
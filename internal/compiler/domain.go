@@ -0,0 +1,55 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// resolveDomains replaces the type of columns typed as a domain with the
+// type the domain is based on. The domain itself is kept in Column.Domain, so
+// that overrides can still refer to it.
+func (c *Compiler) resolveDomains(cols []*Column) {
+	for _, col := range cols {
+		if col == nil || col.Domain != nil {
+			continue
+		}
+		typ := col.Type
+		if typ == nil {
+			typ = typeNameFromDataType(col.DataType)
+		}
+		if typ == nil {
+			continue
+		}
+		domain, ok := c.catalog.ResolveDomain(typ)
+		if !ok {
+			continue
+		}
+		base := domain.BaseType
+		col.Domain = &ast.TypeName{
+			Catalog: typ.Catalog,
+			Schema:  typ.Schema,
+			Name:    typ.Name,
+		}
+		col.Type = &base
+		col.DataType = dataType(&base)
+		if domain.IsArray {
+			col.IsArray = true
+			col.ArrayDims += domain.ArrayDims
+		}
+	}
+}
+
+func typeNameFromDataType(dt string) *ast.TypeName {
+	if dt == "" || dt == "any" {
+		return nil
+	}
+	schema, name, ok := strings.Cut(dt, ".")
+	if !ok {
+		return &ast.TypeName{Name: dt}
+	}
+	if strings.Contains(name, ".") {
+		return nil
+	}
+	return &ast.TypeName{Schema: schema, Name: name}
+}
@@ -3,6 +3,8 @@ package compiler
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/sqlc-dev/sqlc/internal/analyzer"
 	"github.com/sqlc-dev/sqlc/internal/config"
@@ -11,6 +13,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/engine/postgresql"
 	pganalyze "github.com/sqlc-dev/sqlc/internal/engine/postgresql/analyzer"
 	"github.com/sqlc-dev/sqlc/internal/engine/sqlite"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/opts"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 )
@@ -24,15 +27,68 @@ type Compiler struct {
 	analyzer analyzer.Analyzer
 	client   dbmanager.Client
 
-	schema []string
+	schema      []string
+	schemaFiles []string
+	// schemaSource is set if the plugin generating code needs the source of
+	// the tables and types in the catalog.
+	schemaSource bool
+	// commands maps the query commands contributed by plugins, keyed by the
+	// plugin declaring them, to whether that plugin generates code for the
+	// package, so that every query has code generated for it.
+	commands map[pluginCommand]bool
+}
+
+// pluginCommand is a query command contributed by a plugin.
+type pluginCommand struct {
+	plugin string
+	cmd    string
+}
+
+// pluginCommands returns the query commands contributed by plugins.
+func (c *Compiler) pluginCommands() []string {
+	var cmds []string
+	for pc := range c.commands {
+		if !slices.Contains(cmds, pc.cmd) {
+			cmds = append(cmds, pc.cmd)
+		}
+	}
+	return cmds
+}
+
+// commandPlugins returns the plugins declaring cmd, sorted, and whether one
+// of them generates code for the package.
+func (c *Compiler) commandPlugins(cmd string) ([]string, bool) {
+	var plugins []string
+	var consumed bool
+	for pc, ok := range c.commands {
+		if pc.cmd == cmd {
+			plugins = append(plugins, pc.plugin)
+			consumed = consumed || ok
+		}
+	}
+	sort.Strings(plugins)
+	return plugins, consumed
 }
 
 func NewCompiler(conf config.SQL, combo config.CombinedSettings) (*Compiler, error) {
 	c := &Compiler{conf: conf, combo: combo}
 
-	if conf.Database != nil && conf.Database.Managed {
-		client := dbmanager.NewClient(combo.Global.Servers)
-		c.client = client
+	for _, p := range combo.Global.Plugins {
+		if p.Name == combo.Codegen.Plugin {
+			c.schemaSource = p.SchemaSource
+		}
+		for _, cmd := range p.Commands {
+			if metadata.IsBuiltinCmd(cmd) {
+				continue
+			}
+			if c.commands == nil {
+				c.commands = map[pluginCommand]bool{}
+			}
+			key := pluginCommand{plugin: p.Name, cmd: cmd}
+			c.commands[key] = c.commands[key] || slices.ContainsFunc(conf.Codegen, func(cg config.Codegen) bool {
+				return cg.Plugin == p.Name
+			})
+		}
 	}
 
 	switch conf.Engine {
@@ -47,6 +103,9 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings) (*Compiler, err
 		c.catalog = postgresql.NewCatalog()
 		if conf.Database != nil {
 			if conf.Analyzer.Database == nil || *conf.Analyzer.Database {
+				if conf.Database.Managed {
+					c.client = dbmanager.NewClient(combo.Global.Servers)
+				}
 				c.analyzer = analyzer.Cached(
 					pganalyze.New(c.client, *conf.Database),
 					combo.Global,
@@ -57,6 +116,11 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings) (*Compiler, err
 	default:
 		return nil, fmt.Errorf("unknown engine: %s", conf.Engine)
 	}
+	// Managed databases of other engines are only used by vet
+	if c.analyzer == nil && conf.Database != nil && conf.Analyzer.Database != nil && *conf.Analyzer.Database {
+		return nil, fmt.Errorf("analyzer: database analysis isn't supported for %s", conf.Engine)
+	}
+	c.catalog.SetSearchPath(conf.SearchPath)
 	return c, nil
 }
 
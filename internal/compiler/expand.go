@@ -123,6 +123,7 @@ func (c *Compiler) expandStmt(qc *QueryCatalog, raw *ast.RawStmt, node ast.Node)
 			}
 		}
 		scope := astutils.Join(ref.Fields, ".")
+		embed, isEmbed := qc.embeds.Find(ref)
 		counts := map[string]int{}
 		if scope == "" {
 			for _, t := range tables {
@@ -149,6 +150,11 @@ func (c *Compiler) expandStmt(qc *QueryCatalog, raw *ast.RawStmt, node ast.Node)
 				if counts[cname] > 1 {
 					cname = tableName + "." + cname
 				}
+				// The columns of a named embed are prefixed with its name,
+				// which tells them apart from those of other embeds
+				if isEmbed && embed.Name != "" {
+					cname += " AS " + c.quoteIdent(embed.Name+"_"+column.Name)
+				}
 				cols = append(cols, cname)
 			}
 		}
@@ -164,9 +170,9 @@ func (c *Compiler) expandStmt(qc *QueryCatalog, raw *ast.RawStmt, node ast.Node)
 		var oldString string
 		var oldFunc func(string) int
 
-		// use the sqlc.embed string instead
-		if embed, ok := qc.embeds.Find(ref); ok {
-			oldString = embed.Orig()
+		// replace the sqlc.embed call instead
+		if isEmbed {
+			oldFunc = embedCallLen
 		} else {
 			oldFunc = func(s string) int {
 				length := 0
@@ -196,3 +202,21 @@ func (c *Compiler) expandStmt(qc *QueryCatalog, raw *ast.RawStmt, node ast.Node)
 
 	return edits, nil
 }
+
+// embedCallLen returns the length of the sqlc.embed call s starts with.
+func embedCallLen(s string) int {
+	var quote rune
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == ')':
+			return i + 1
+		}
+	}
+	return len(s)
+}
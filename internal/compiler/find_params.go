@@ -24,11 +24,16 @@ type paramRef struct {
 	rv     *ast.RangeVar
 	ref    *ast.ParamRef
 	name   string // Named parameter support
+
+	// scope is the innermost SELECT with a FROM clause that contains the
+	// parameter, if any
+	scope *ast.SelectStmt
 }
 
 type paramSearch struct {
 	parent   ast.Node
 	rangeVar *ast.RangeVar
+	scope    *ast.SelectStmt
 	refs     *[]paramRef
 	seen     map[int]struct{}
 	errs     *[]error
@@ -140,6 +145,9 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 		p.parent = node
 
 	case *ast.SelectStmt:
+		if n.FromClause != nil && len(n.FromClause.Items) > 0 {
+			p.scope = n
+		}
 		if n.LimitCount != nil {
 			p.limitCount = n.LimitCount
 		}
@@ -186,7 +194,7 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 		}
 
 		if set {
-			*p.refs = append(*p.refs, paramRef{parent: parent, ref: n, rv: p.rangeVar})
+			*p.refs = append(*p.refs, paramRef{parent: parent, ref: n, rv: p.rangeVar, scope: p.scope})
 			p.seen[n.Location] = struct{}{}
 		}
 		return nil
@@ -0,0 +1,230 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// insertTable returns the table an INSERT statement inserts into, or nil if
+// it isn't in the catalog, which is reported elsewhere.
+func (c *Compiler) insertTable(n *ast.InsertStmt) *catalog.Table {
+	if n.Relation == nil {
+		return nil
+	}
+	fqn, err := ParseTableName(n.Relation)
+	if err != nil {
+		return nil
+	}
+	table, err := c.catalog.GetTable(fqn)
+	if err != nil {
+		return nil
+	}
+	return &table
+}
+
+// validateInsertColumns checks that the values of an INSERT without a column
+// list, or the rows returned by the SELECT of an INSERT ... SELECT, match the
+// columns they are inserted into. PostgreSQL fills in the trailing columns of
+// a table with their defaults if an INSERT without a column list has fewer
+// values, while MySQL and SQLite require a value for every column.
+func (c *Compiler) validateInsertColumns(n *ast.InsertStmt) error {
+	sel, ok := n.SelectStmt.(*ast.SelectStmt)
+	if !ok {
+		return nil
+	}
+	table := c.insertTable(n)
+	if table == nil {
+		return nil
+	}
+	explicit := n.Cols != nil && len(n.Cols.Items) > 0
+	var targets []*catalog.Column
+	if explicit {
+		for _, item := range n.Cols.Items {
+			target, ok := item.(*ast.ResTarget)
+			if !ok || target.Name == nil {
+				return nil
+			}
+			targets = append(targets, findCatalogColumn(table, *target.Name))
+		}
+	} else {
+		targets = table.Columns
+	}
+
+	if sel.ValuesLists != nil && len(sel.ValuesLists.Items) > 0 {
+		// Explicit column lists are checked by validate.InsertStmt
+		if explicit {
+			return nil
+		}
+		for _, item := range sel.ValuesLists.Items {
+			// MySQL inserts the defaults of all columns for an empty row
+			row, ok := item.(*ast.List)
+			if !ok || len(row.Items) == 0 {
+				continue
+			}
+			if err := c.checkInsertCount(n, len(targets), len(row.Items), explicit, ""); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if sel.TargetList == nil || len(sel.TargetList.Items) == 0 {
+		return nil
+	}
+
+	var with ast.Node = n
+	if sel.WithClause != nil {
+		with = sel
+	}
+	qc, err := c.buildQueryCatalog(c.catalog, with, nil)
+	if err != nil {
+		return err
+	}
+	cols, err := c.outputColumns(qc, sel)
+	if err != nil {
+		return err
+	}
+	from := selectSourceName(sel)
+	if err := c.checkInsertCount(n, len(targets), len(cols), explicit, from); err != nil {
+		return err
+	}
+	if c.conf.Engine != config.EnginePostgreSQL {
+		return nil
+	}
+	if from == "" {
+		from = "the SELECT"
+	}
+	for i, col := range cols {
+		if i >= len(targets) {
+			break
+		}
+		target := targets[i]
+		if target == nil || isUntypedTarget(sel, len(cols), i) {
+			continue
+		}
+		want := typeCategory(dataType(&target.Type))
+		got := typeCategory(col.DataType)
+		if want == "" || got == "" || want == got || want == "string" || target.IsArray != col.IsArray {
+			continue
+		}
+		return &sqlerr.Error{
+			Code:     "42804",
+			Message:  fmt.Sprintf("column %q of %s is of type %s, but %s returns %s", target.Name, *n.Relation.Relname, shortTypeName(dataType(&target.Type)), from, shortTypeName(col.DataType)),
+			Location: n.Relation.Location,
+		}
+	}
+	return nil
+}
+
+// isUntypedTarget reports whether the i-th of the n columns returned by sel
+// is a literal, which PostgreSQL coerces to the type of the column it's
+// inserted into, like the columns whose type isn't known.
+func isUntypedTarget(sel *ast.SelectStmt, n, i int) bool {
+	if len(sel.TargetList.Items) != n {
+		return false
+	}
+	res, ok := sel.TargetList.Items[i].(*ast.ResTarget)
+	if !ok {
+		return false
+	}
+	_, ok = res.Val.(*ast.A_Const)
+	return ok
+}
+
+// checkInsertCount checks the number of values inserted into the targets,
+// which the SELECT named by from returns. If from is empty, the values are a
+// row of VALUES or a SELECT without a table, and the error doesn't name them.
+func (c *Compiler) checkInsertCount(n *ast.InsertStmt, targets, values int, explicit bool, from string) error {
+	if values == targets || (values < targets && !explicit && c.conf.Engine == config.EnginePostgreSQL) {
+		return nil
+	}
+	switch {
+	case from != "":
+		return &sqlerr.Error{
+			Code:     "42601",
+			Message:  fmt.Sprintf("INSERT INTO %s has %d target columns, but %s returns %d", *n.Relation.Relname, targets, from, values),
+			Location: n.Relation.Location,
+		}
+	case values > targets:
+		return &sqlerr.Error{
+			Code:    "42601",
+			Message: "INSERT has more expressions than target columns",
+		}
+	default:
+		return &sqlerr.Error{
+			Code:    "42601",
+			Message: "INSERT has more target columns than expressions",
+		}
+	}
+}
+
+// fillInsertColumns gives an INSERT without a column list the columns of its
+// table, so that its values can be matched to the columns by position.
+func (c *Compiler) fillInsertColumns(n *ast.InsertStmt) {
+	if n.Cols != nil && len(n.Cols.Items) > 0 {
+		return
+	}
+	table := c.insertTable(n)
+	if table == nil {
+		return
+	}
+	cols := &ast.List{}
+	for _, col := range table.Columns {
+		name := col.Name
+		cols.Items = append(cols.Items, &ast.ResTarget{Name: &name})
+	}
+	n.Cols = cols
+}
+
+// selectSourceName returns a description of the SELECT naming the first
+// table it selects from, or an empty string if it doesn't select from one.
+func selectSourceName(sel *ast.SelectStmt) string {
+	var tv tableVisitor
+	astutils.Walk(&tv, sel.FromClause)
+	for _, item := range tv.list.Items {
+		if rv, ok := item.(*ast.RangeVar); ok && rv.Relname != nil {
+			return "the SELECT from " + *rv.Relname
+		}
+	}
+	return ""
+}
+
+func findCatalogColumn(table *catalog.Table, name string) *catalog.Column {
+	for _, col := range table.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// shortTypeName strips the pg_catalog schema from a type name.
+func shortTypeName(name string) string {
+	return strings.TrimPrefix(name, "pg_catalog.")
+}
+
+// typeCategory returns the category of a PostgreSQL type whose values can be
+// assigned to columns of other types in the same category, or an empty
+// string if the type isn't known. Any value can be assigned to a string.
+func typeCategory(name string) string {
+	switch strings.ToLower(shortTypeName(name)) {
+	case "smallint", "int2", "integer", "int", "int4", "bigint", "int8",
+		"smallserial", "serial2", "serial", "serial4", "bigserial", "serial8",
+		"numeric", "decimal", "real", "float4", "double precision", "float8":
+		return "numeric"
+	case "text", "varchar", "character varying", "bpchar", "char", "character", "name", "citext":
+		return "string"
+	case "bool", "boolean":
+		return "boolean"
+	case "date", "timestamp", "timestamptz", "timestamp without time zone", "timestamp with time zone":
+		return "datetime"
+	case "uuid":
+		return "uuid"
+	}
+	return ""
+}
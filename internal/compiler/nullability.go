@@ -0,0 +1,294 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/lang"
+)
+
+// exprNotNull reports whether an expression can never evaluate to NULL. It is
+// conservative: expressions it doesn't understand, such as parameters, are
+// assumed to be nullable.
+//
+// from is the FROM clause of the statement, used to find columns of tables on
+// the nullable side of an outer join. It may be nil. grouped is set if the
+// statement has a GROUP BY clause.
+func (c *Compiler) exprNotNull(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, from *ast.List, grouped bool, node ast.Node) bool {
+	notNull := func(n ast.Node) bool {
+		return c.exprNotNull(qc, res, tables, from, grouped, n)
+	}
+	switch n := node.(type) {
+	case *ast.A_Const:
+		_, isNull := n.Val.(*ast.Null)
+		return !isNull
+
+	case *ast.ColumnRef:
+		if hasStarRef(n) {
+			return false
+		}
+		columns, err := outputColumnRefs(res, tables, n)
+		if err != nil || len(columns) == 0 {
+			return false
+		}
+		for _, col := range columns {
+			if !col.NotNull {
+				return false
+			}
+			if col.Table == nil || from == nil {
+				continue
+			}
+			for _, f := range from.Items {
+				if req := isTableRequired(f, col, tableRequired); req != tableNotFound {
+					if req == tableOptional {
+						return false
+					}
+					break
+				}
+			}
+		}
+		return true
+
+	case *ast.TypeCast:
+		return notNull(n.Arg)
+
+	case *ast.CoalesceExpr:
+		// COALESCE returns the first argument that isn't NULL
+		for _, arg := range n.Args.Items {
+			if notNull(arg) {
+				return true
+			}
+		}
+		return false
+
+	case *ast.CaseExpr:
+		// Without an ELSE, CASE returns NULL when no branch matches
+		if n.Defresult == nil || !notNull(n.Defresult) {
+			return false
+		}
+		for _, item := range n.Args.Items {
+			when, ok := item.(*ast.CaseWhen)
+			if !ok || !notNull(when.Result) {
+				return false
+			}
+		}
+		return true
+
+	case *ast.A_Expr:
+		if n.Kind == ast.A_Expr_Kind_NULLIF {
+			return false
+		}
+		op := astutils.Join(n.Name, "")
+		if !lang.IsComparisonOperator(op) && !lang.IsMathematicalOperator(op) {
+			return false
+		}
+		return (n.Lexpr == nil || notNull(n.Lexpr)) && notNull(n.Rexpr)
+
+	case *ast.NullTest:
+		return true
+
+	case *ast.BoolExpr:
+		switch n.Boolop {
+		case ast.BoolExprTypeIsNull, ast.BoolExprTypeIsNotNull:
+			return true
+		}
+		return false
+
+	case *ast.FuncCall:
+		if strings.EqualFold(n.Func.Name, "nullif") {
+			return false
+		}
+		if isNullableAggregate(n) {
+			return !c.aggregateNullable(qc, res, tables, from, grouped, n)
+		}
+		if returnsNull(n) {
+			return !c.callNullable(qc, res, tables, from, grouped, n)
+		}
+		fun, err := qc.catalog.ResolveFuncCall(n)
+		if err != nil {
+			return false
+		}
+		return !fun.ReturnTypeNullable
+
+	case *ast.SubLink:
+		return n.SubLinkType == ast.EXISTS_SUBLINK
+
+	default:
+		return false
+	}
+}
+
+// emptyNullAggregates are the aggregates that return NULL, rather than an
+// empty value, when they aggregate no rows.
+var emptyNullAggregates = map[string]bool{
+	"array_agg":        true,
+	"avg":              true,
+	"bool_and":         true,
+	"bool_or":          true,
+	"every":            true,
+	"group_concat":     true,
+	"json_agg":         true,
+	"json_object_agg":  true,
+	"jsonb_agg":        true,
+	"jsonb_object_agg": true,
+	"json_arrayagg":    true,
+	"json_objectagg":   true,
+	"max":              true,
+	"min":              true,
+	"string_agg":       true,
+	"sum":              true,
+}
+
+// nullSkippingAggregates are the aggregates that ignore NULL arguments, so
+// that they return NULL when all the rows they aggregate have a NULL argument.
+var nullSkippingAggregates = map[string]bool{
+	"avg":          true,
+	"bool_and":     true,
+	"bool_or":      true,
+	"every":        true,
+	"group_concat": true,
+	"max":          true,
+	"min":          true,
+	"string_agg":   true,
+	"sum":          true,
+}
+
+// nullableFunctions are the window functions that return NULL when the row
+// they read falls outside of the window frame or partition, and the
+// ordered-set aggregates, which return NULL when they aggregate no rows.
+var nullableFunctions = map[string]bool{
+	"first_value":     true,
+	"lag":             true,
+	"last_value":      true,
+	"lead":            true,
+	"mode":            true,
+	"nth_value":       true,
+	"percentile_cont": true,
+	"percentile_disc": true,
+}
+
+// returnsNull reports whether call is one of nullableFunctions, whose
+// nullability PostgreSQL's catalog doesn't record, and which callNullable
+// works out.
+func returnsNull(call *ast.FuncCall) bool {
+	return nullableFunctions[strings.ToLower(call.Func.Name)]
+}
+
+// callNullable reports whether a call of one of nullableFunctions may return
+// NULL. Only lag and lead never do, when given a default that isn't NULL for
+// a value that isn't either.
+func (c *Compiler) callNullable(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, from *ast.List, grouped bool, call *ast.FuncCall) bool {
+	switch strings.ToLower(call.Func.Name) {
+	case "lag", "lead":
+		if call.Args == nil || len(call.Args.Items) < 3 {
+			return true
+		}
+		args := call.Args.Items
+		return !c.exprNotNull(qc, res, tables, from, grouped, args[0]) || !c.exprNotNull(qc, res, tables, from, grouped, args[2])
+	}
+	return true
+}
+
+// isNullableAggregate reports whether call is an aggregate whose nullability
+// depends on the rows it aggregates, which aggregateNullable works out.
+func isNullableAggregate(call *ast.FuncCall) bool {
+	return emptyNullAggregates[strings.ToLower(call.Func.Name)]
+}
+
+// nullOnNoRows reports whether a call of an aggregate in the target list of a
+// statement may aggregate zero rows and return NULL. Without GROUP BY, a query
+// matching no rows still returns a row, while groups and window frames are
+// never empty unless a FILTER clause leaves them so.
+func nullOnNoRows(call *ast.FuncCall, grouped bool) bool {
+	if !isNullableAggregate(call) {
+		return false
+	}
+	if !grouped && call.Over == nil {
+		return true
+	}
+	return astutils.IsSet(call.AggFilter)
+}
+
+// aggregateNullable reports whether a call of an aggregate in the target list
+// of a statement may return NULL, either because it aggregates no rows or
+// because it ignores the NULL arguments of all the rows it aggregates.
+func (c *Compiler) aggregateNullable(qc *QueryCatalog, res *ast.ResTarget, tables []*Table, from *ast.List, grouped bool, call *ast.FuncCall) bool {
+	if nullOnNoRows(call, grouped) {
+		return true
+	}
+	if !nullSkippingAggregates[strings.ToLower(call.Func.Name)] || call.Args == nil {
+		return false
+	}
+	for _, arg := range call.Args.Items {
+		if !c.exprNotNull(qc, res, tables, from, grouped, arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// exprColumn returns a column with the type of an expression, if it can be
+// determined without resolving functions or operators.
+func exprColumn(res *ast.ResTarget, tables []*Table, node ast.Node) *Column {
+	switch n := node.(type) {
+	case *ast.A_Const:
+		switch n.Val.(type) {
+		case *ast.String:
+			return &Column{DataType: "text"}
+		case *ast.Integer:
+			return &Column{DataType: "int"}
+		case *ast.Float:
+			return &Column{DataType: "float"}
+		case *ast.Boolean:
+			return &Column{DataType: "bool"}
+		}
+	case *ast.ColumnRef:
+		if hasStarRef(n) {
+			return nil
+		}
+		columns, err := outputColumnRefs(res, tables, n)
+		if err != nil || len(columns) != 1 {
+			return nil
+		}
+		return columns[0]
+	case *ast.TypeCast:
+		if n.TypeName != nil {
+			return toColumn(n.TypeName)
+		}
+	}
+	return nil
+}
+
+// coalesceConstColumn returns the type of a COALESCE whose arguments are all
+// literals or casts, as long as they agree on it.
+func coalesceConstColumn(n *ast.CoalesceExpr) *Column {
+	var col *Column
+	for _, arg := range n.Args.Items {
+		switch a := arg.(type) {
+		case *ast.TypeCast:
+			if a.TypeName == nil {
+				return nil
+			}
+			if typ := toColumn(a.TypeName); col == nil || col.DataType == typ.DataType {
+				col = typ
+				continue
+			}
+			return nil
+		case *ast.A_Const:
+			if _, ok := a.Val.(*ast.Null); ok {
+				continue
+			}
+			typ := exprColumn(nil, nil, a)
+			if typ == nil || (col != nil && col.DataType != typ.DataType) {
+				return nil
+			}
+			if col == nil {
+				col = typ
+			}
+		default:
+			return nil
+		}
+	}
+	return col
+}
@@ -0,0 +1,75 @@
+package compiler
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+)
+
+// optionalParams returns the numbers of the parameters that a statement
+// compares with NULL, which callers must be able to pass NULL for:
+//
+//   - a parameter tested with IS NULL in a branch of an OR, as in
+//     col = $1 OR $1 IS NULL
+//   - a parameter compared with IS NOT DISTINCT FROM, or MySQL's <=>
+//   - a parameter that isn't the last argument of COALESCE, as in
+//     coalesce($1, col) = col
+//
+// Tests of columns, rather than parameters, don't make a parameter nullable.
+func optionalParams(root ast.Node) []int {
+	var numbers []int
+	add := func(n ast.Node) {
+		if ref, ok := unwrapParamRef(n); ok {
+			numbers = append(numbers, ref.Number)
+		}
+	}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.BoolExpr:
+			if n.Boolop != ast.BoolExprTypeOr || n.Args == nil {
+				return
+			}
+			for _, arg := range n.Args.Items {
+				switch test := arg.(type) {
+				case *ast.NullTest:
+					if test.Nulltesttype == ast.NullTestTypeIsNull {
+						add(test.Arg)
+					}
+				case *ast.BoolExpr:
+					if test.Boolop == ast.BoolExprTypeIsNull && test.Args != nil && len(test.Args.Items) == 1 {
+						add(test.Args.Items[0])
+					}
+				}
+			}
+
+		case *ast.A_Expr:
+			if n.Kind == ast.A_Expr_Kind_NOT_DISTINCT {
+				add(n.Lexpr)
+				add(n.Rexpr)
+			}
+
+		case *ast.CoalesceExpr:
+			if n.Args == nil || len(n.Args.Items) == 0 {
+				return
+			}
+			for _, arg := range n.Args.Items[:len(n.Args.Items)-1] {
+				add(arg)
+			}
+		}
+	}), root)
+	return numbers
+}
+
+// unwrapParamRef returns the parameter of an expression that is a parameter,
+// possibly cast to a type.
+func unwrapParamRef(n ast.Node) (*ast.ParamRef, bool) {
+	for {
+		switch v := n.(type) {
+		case *ast.ParamRef:
+			return v, true
+		case *ast.TypeCast:
+			n = v.Arg
+		default:
+			return nil, false
+		}
+	}
+}
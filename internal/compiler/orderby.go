@@ -0,0 +1,91 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// validateOrderBy checks that every column listed in sqlc.orderby is either an
+// output column of the query or a column of one of the tables it reads from.
+// A qualified column must be in the table with that name or alias.
+func (c *Compiler) validateOrderBy(qc *QueryCatalog, rvs []*ast.RangeVar, cols []*Column, orderBy *rewrite.OrderBy) error {
+	if orderBy == nil || qc == nil {
+		return nil
+	}
+	for _, name := range orderBy.Columns {
+		qualifier, column := "", name
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			qualifier, column = name[:i], name[i+1:]
+		}
+		if !c.hasOrderByColumn(qc, rvs, cols, qualifier, column) {
+			rel := qualifier
+			if rel == "" && len(rvs) > 0 && rvs[0].Relname != nil {
+				rel = *rvs[0].Relname
+			}
+			return sqlerr.ColumnNotFound(rel, column)
+		}
+	}
+	return nil
+}
+
+func (c *Compiler) hasOrderByColumn(qc *QueryCatalog, rvs []*ast.RangeVar, cols []*Column, qualifier, column string) bool {
+	if qualifier == "" {
+		for _, col := range cols {
+			if col.Name == column {
+				return true
+			}
+		}
+	}
+	for _, rv := range rvs {
+		if rv.Relname == nil {
+			continue
+		}
+		if qualifier != "" {
+			alias := *rv.Relname
+			if rv.Alias != nil && rv.Alias.Aliasname != nil {
+				alias = *rv.Alias.Aliasname
+			}
+			if qualifier != alias {
+				continue
+			}
+		}
+		fqn, err := ParseTableName(rv)
+		if err != nil {
+			continue
+		}
+		table, err := qc.GetTable(fqn)
+		if err != nil {
+			continue
+		}
+		for _, col := range table.Columns {
+			if col.Name == column {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// orderByParameter returns the parameter holding the column chosen at runtime
+// for sqlc.orderby. It's numbered after the other parameters, but doesn't have
+// a placeholder in the query text.
+func orderByParameter(params []Parameter, orderBy *rewrite.OrderBy) Parameter {
+	number := 0
+	for _, p := range params {
+		if p.Number > number {
+			number = p.Number
+		}
+	}
+	return Parameter{
+		Number: number + 1,
+		Column: &Column{
+			Name:     "order_by",
+			DataType: "text",
+			NotNull:  true,
+			OrderBy:  orderBy.Columns,
+		},
+	}
+}
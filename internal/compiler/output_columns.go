@@ -3,6 +3,7 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
@@ -25,14 +26,20 @@ func (c *Compiler) OutputColumns(stmt ast.Node) ([]*catalog.Column, error) {
 	catCols := make([]*catalog.Column, 0, len(cols))
 	for _, col := range cols {
 		catCols = append(catCols, &catalog.Column{
-			Name:       col.Name,
-			Type:       ast.TypeName{Name: col.DataType},
-			IsNotNull:  col.NotNull,
-			IsUnsigned: col.Unsigned,
-			IsArray:    col.IsArray,
-			ArrayDims:  col.ArrayDims,
-			Comment:    col.Comment,
-			Length:     col.Length,
+			Name:          col.Name,
+			Type:          ast.TypeName{Name: col.DataType},
+			IsNotNull:     col.NotNull,
+			IsUnsigned:    col.Unsigned,
+			IsArray:       col.IsArray,
+			ArrayDims:     col.ArrayDims,
+			Comment:       col.Comment,
+			Length:        col.Length,
+			Precision:     col.Precision,
+			Scale:         col.Scale,
+			HasDefault:    col.HasDefault,
+			DefaultExpr:   col.DefaultExpr,
+			IsGenerated:   col.IsGenerated,
+			GeneratedExpr: col.GeneratedExpr,
 		})
 	}
 	return catCols, nil
@@ -120,6 +127,13 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 
 	var cols []*Column
 
+	var from *ast.List
+	var grouped bool
+	if n, ok := node.(*ast.SelectStmt); ok {
+		from = n.FromClause
+		grouped = n.GroupClause != nil && len(n.GroupClause.Items) > 0
+	}
+
 	for _, target := range targets.Items {
 		res, ok := target.(*ast.ResTarget)
 		if !ok {
@@ -151,11 +165,48 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 				name = *res.Name
 			}
 			switch op := astutils.Join(n.Name, ""); {
+			case n.Kind == ast.A_Expr_Kind_NULLIF:
+				// NULLIF returns NULL when its arguments are equal
+				if name == "" {
+					name = "nullif"
+				}
+				col := &Column{Name: name, DataType: "any"}
+				if typ := exprColumn(res, tables, n.Lexpr); typ != nil {
+					col = &Column{Name: name, DataType: typ.DataType, Type: typ.Type, Unsigned: typ.Unsigned, IsArray: typ.IsArray, ArrayDims: typ.ArrayDims}
+				}
+				cols = append(cols, col)
 			case lang.IsComparisonOperator(op):
 				// TODO: Generate a name for these operations
 				cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
+			case lang.IsContainmentOperator(op):
+				cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
+			case op == "-|-":
+				// Ranges are adjacent
+				cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
+			case op == "&&":
+				// Ranges and arrays overlap, while tsquery values are combined
+				col := &Column{Name: name, DataType: "any"}
+				if typ := exprColumn(res, tables, n.Lexpr); typ != nil && (typ.IsArray || isRangeType(typ.DataType)) {
+					col = &Column{Name: name, DataType: "bool", NotNull: true}
+				}
+				cols = append(cols, col)
 			case lang.IsMathematicalOperator(op):
 				cols = append(cols, &Column{Name: name, DataType: "int", NotNull: true})
+			case lang.IsVectorDistanceOperator(op):
+				cols = append(cols, &Column{Name: name, DataType: "float8", NotNull: false})
+			case lang.IsJSONExtractOperator(op) || lang.IsJSONTextExtractOperator(op):
+				// The extracted value is NULL if the key or path doesn't exist
+				col := &Column{Name: name, DataType: "any"}
+				if typ := exprColumn(res, tables, n.Lexpr); typ != nil && !typ.IsArray {
+					switch strings.TrimPrefix(typ.DataType, "pg_catalog.") {
+					case "json", "jsonb":
+						col.DataType = typ.DataType
+						if lang.IsJSONTextExtractOperator(op) {
+							col.DataType = "text"
+						}
+					}
+				}
+				cols = append(cols, col)
 			default:
 				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
 			}
@@ -187,6 +238,8 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 			if res.Name != nil {
 				name = *res.Name
 			}
+			// CASE is only NULL-free when all of its branches, including ELSE, are
+			notNull := c.exprNotNull(qc, res, tables, from, grouped, n)
 			// TODO: The TypeCase and A_Const code has been copied from below. Instead, we
 			// need a recurse function to get the type of a node.
 			if tc, ok := n.Defresult.(*ast.TypeCast); ok {
@@ -203,22 +256,23 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 				// TODO Validate column names
 				col := toColumn(tc.TypeName)
 				col.Name = name
+				col.NotNull = notNull
 				cols = append(cols, col)
 			} else if aconst, ok := n.Defresult.(*ast.A_Const); ok {
 				switch aconst.Val.(type) {
 				case *ast.String:
-					cols = append(cols, &Column{Name: name, DataType: "text", NotNull: true})
+					cols = append(cols, &Column{Name: name, DataType: "text", NotNull: notNull})
 				case *ast.Integer:
-					cols = append(cols, &Column{Name: name, DataType: "int", NotNull: true})
+					cols = append(cols, &Column{Name: name, DataType: "int", NotNull: notNull})
 				case *ast.Float:
-					cols = append(cols, &Column{Name: name, DataType: "float", NotNull: true})
+					cols = append(cols, &Column{Name: name, DataType: "float", NotNull: notNull})
 				case *ast.Boolean:
-					cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
+					cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: notNull})
 				default:
 					cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
 				}
 			} else {
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
+				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: notNull})
 			}
 
 		case *ast.CoalesceExpr:
@@ -226,32 +280,45 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 			if res.Name != nil {
 				name = *res.Name
 			}
+			// COALESCE is NULL-free as soon as one of its arguments is
+			notNull := c.exprNotNull(qc, res, tables, from, grouped, n)
 			var firstColumn *Column
-			var shouldNotBeNull bool
 			for _, arg := range n.Args.Items {
-				if _, ok := arg.(*ast.A_Const); ok {
-					shouldNotBeNull = true
-					continue
-				}
 				if ref, ok := arg.(*ast.ColumnRef); ok {
 					columns, err := outputColumnRefs(res, tables, ref)
 					if err != nil {
 						return nil, err
 					}
-					for _, c := range columns {
-						if firstColumn == nil {
-							firstColumn = c
+					if firstColumn == nil && len(columns) > 0 {
+						firstColumn = columns[0]
+					}
+				}
+			}
+			if firstColumn == nil {
+				// Without columns, the type is that of the first function
+				// call, such as the aggregate in coalesce(jsonb_agg(x), '[]')
+				for _, arg := range n.Args.Items {
+					if call, ok := arg.(*ast.FuncCall); ok {
+						if col := funcCallColumn(qc, call, tables); col != nil && col.DataType != "any" {
+							firstColumn = col
+							firstColumn.Name = name
+							break
 						}
-						shouldNotBeNull = shouldNotBeNull || c.NotNull
 					}
 				}
 			}
+			if firstColumn == nil {
+				firstColumn = coalesceConstColumn(n)
+				if firstColumn != nil {
+					firstColumn.Name = name
+				}
+			}
 			if firstColumn != nil {
-				firstColumn.NotNull = shouldNotBeNull
+				firstColumn.NotNull = notNull
 				firstColumn.skipTableRequiredCheck = true
 				cols = append(cols, firstColumn)
 			} else {
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
+				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: notNull})
 			}
 
 		case *ast.ColumnRef:
@@ -261,7 +328,10 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 				if embed, ok := qc.embeds.Find(n); ok {
 					cols = append(cols, &Column{
 						Name:       embed.Table.Name,
+						NotNull:    true,
+						TableAlias: embed.Param(),
 						EmbedTable: embed.Table,
+						EmbedName:  embed.Name,
 					})
 					continue
 				}
@@ -278,18 +348,24 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 							cname = *res.Name
 						}
 						cols = append(cols, &Column{
-							Name:         cname,
-							OriginalName: c.Name,
-							Type:         c.Type,
-							Scope:        scope,
-							Table:        c.Table,
-							TableAlias:   t.Rel.Name,
-							DataType:     c.DataType,
-							NotNull:      c.NotNull,
-							Unsigned:     c.Unsigned,
-							IsArray:      c.IsArray,
-							ArrayDims:    c.ArrayDims,
-							Length:       c.Length,
+							Name:          cname,
+							OriginalName:  c.Name,
+							Type:          c.Type,
+							Scope:         scope,
+							Table:         c.Table,
+							TableAlias:    t.Rel.Name,
+							DataType:      c.DataType,
+							NotNull:       c.NotNull,
+							Unsigned:      c.Unsigned,
+							IsArray:       c.IsArray,
+							ArrayDims:     c.ArrayDims,
+							Length:        c.Length,
+							Precision:     c.Precision,
+							Scale:         c.Scale,
+							HasDefault:    c.HasDefault,
+							DefaultExpr:   c.DefaultExpr,
+							IsGenerated:   c.IsGenerated,
+							GeneratedExpr: c.GeneratedExpr,
 						})
 					}
 				}
@@ -308,14 +384,15 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 			if res.Name != nil {
 				name = *res.Name
 			}
-			fun, err := qc.catalog.ResolveFuncCall(n)
-			if err == nil {
-				cols = append(cols, &Column{
-					Name:       name,
-					DataType:   dataType(fun.ReturnType),
-					NotNull:    !fun.ReturnTypeNullable,
-					IsFuncCall: true,
-				})
+			if col := funcCallColumn(qc, n, tables); col != nil {
+				col.Name = name
+				if isNullableAggregate(n) {
+					col.NotNull = !c.aggregateNullable(qc, res, tables, from, grouped, n)
+				}
+				if returnsNull(n) {
+					col.NotNull = !c.callNullable(qc, res, tables, from, grouped, n)
+				}
+				cols = append(cols, col)
 			} else {
 				cols = append(cols, &Column{
 					Name:       name,
@@ -324,6 +401,20 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 				})
 			}
 
+		case *ast.A_Indirection:
+			col, ok, err := c.compositeFieldColumn(res, tables, n)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				name := ""
+				if res.Name != nil {
+					name = *res.Name
+				}
+				col = &Column{Name: name, DataType: "any", NotNull: false}
+			}
+			cols = append(cols, col)
+
 		case *ast.SubLink:
 			name := "exists"
 			if res.Name != nil {
@@ -391,11 +482,17 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 
 	if n, ok := node.(*ast.SelectStmt); ok {
 		for _, col := range cols {
-			if !col.NotNull || col.Table == nil || col.skipTableRequiredCheck {
+			probe := col
+			if col.EmbedTable != nil {
+				// An embedded table is nullable when it comes from the
+				// nullable side of an outer join.
+				probe = &Column{Table: col.EmbedTable, TableAlias: col.TableAlias}
+			}
+			if !col.NotNull || probe.Table == nil || col.skipTableRequiredCheck {
 				continue
 			}
 			for _, f := range n.FromClause.Items {
-				res := isTableRequired(f, col, tableRequired)
+				res := isTableRequired(f, probe, tableRequired)
 				if res != tableNotFound {
 					col.NotNull = res == tableRequired
 					break
@@ -505,7 +602,16 @@ func (c *Compiler) sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, erro
 		var tv tableVisitor
 		astutils.Walk(&tv, n.FromClause)
 		astutils.Walk(&tv, n.Relations)
-		list = &tv.list
+		// The FROM clause of a MySQL multi-table update holds the updated
+		// tables as well
+		seen := map[ast.Node]bool{}
+		list = &ast.List{}
+		for _, item := range tv.list.Items {
+			if !seen[item] {
+				seen[item] = true
+				list.Items = append(list.Items, item)
+			}
+		}
 	}
 
 	var tables []*Table
@@ -514,79 +620,22 @@ func (c *Compiler) sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, erro
 		switch n := item.(type) {
 
 		case *ast.RangeFunction:
-			var funcCall *ast.FuncCall
-			switch f := n.Functions.Items[0].(type) {
-			case *ast.List:
-				switch fi := f.Items[0].(type) {
-				case *ast.FuncCall:
-					funcCall = fi
-				case *ast.SQLValueFunction:
-					continue // TODO handle this correctly
-				default:
+			// If the function can't be found, don't error out. There are many
+			// queries that depend on functions unknown to sqlc.
+			table := c.rangeFunctionTable(qc, n, tables)
+			if table == nil {
+				if n.Alias == nil || n.Alias.Colnames == nil || len(n.Alias.Colnames.Items) == 0 {
 					continue
 				}
-			case *ast.FuncCall:
-				funcCall = f
-			default:
-				return nil, fmt.Errorf("sourceTables: unsupported function call type %T", n.Functions.Items[0])
-			}
-
-			// If the function or table can't be found, don't error out.  There
-			// are many queries that depend on functions unknown to sqlc.
-			fn, err := qc.GetFunc(funcCall.Func)
-			if err != nil {
-				continue
-			}
-			var table *Table
-			if fn.ReturnType != nil {
-				table, err = qc.GetTable(&ast.TableName{
-					Catalog: fn.ReturnType.Catalog,
-					Schema:  fn.ReturnType.Schema,
-					Name:    fn.ReturnType.Name,
-				})
-			}
-			if table == nil || err != nil {
-				if n.Alias != nil && len(n.Alias.Colnames.Items) > 0 {
-					table = &Table{}
-					for _, colName := range n.Alias.Colnames.Items {
-						table.Columns = append(table.Columns, &Column{
-							Name:     colName.(*ast.String).Str,
-							DataType: "any",
-						})
-					}
-				} else {
-					colName := fn.Rel.Name
-					if n.Alias != nil {
-						colName = *n.Alias.Aliasname
-					}
-					table = &Table{
-						Rel: &ast.TableName{
-							Catalog: fn.Rel.Catalog,
-							Schema:  fn.Rel.Schema,
-							Name:    fn.Rel.Name,
-						},
-					}
-					if len(fn.Outs) > 0 {
-						for _, arg := range fn.Outs {
-							table.Columns = append(table.Columns, &Column{
-								Name:     arg.Name,
-								DataType: arg.Type.Name,
-							})
-						}
-					}
-					if fn.ReturnType != nil {
-						table.Columns = []*Column{
-							{
-								Name:     colName,
-								DataType: fn.ReturnType.Name,
-							},
-						}
-					}
+				table = &Table{}
+				for _, colName := range n.Alias.Colnames.Items {
+					table.Columns = append(table.Columns, &Column{
+						Name:     colName.(*ast.String).Str,
+						DataType: "any",
+					})
 				}
-			}
-			if n.Alias != nil {
-				table.Rel = &ast.TableName{
-					Name: *n.Alias.Aliasname,
+				if n.Alias.Aliasname != nil {
+					table.Rel = &ast.TableName{Name: *n.Alias.Aliasname}
 				}
 			}
 			tables = append(tables, table)
@@ -659,8 +708,7 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 		if alias != "" && t.Rel.Name != alias {
 			continue
 		}
-		for _, c := range t.Columns {
-
+		for _, c := range t.namedColumns() {
 			if c.Name == name {
 				found += 1
 				cname := c.Name
@@ -668,27 +716,35 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 					cname = *res.Name
 				}
 				cols = append(cols, &Column{
-					Name:         cname,
-					Type:         c.Type,
-					Table:        c.Table,
-					TableAlias:   alias,
-					DataType:     c.DataType,
-					NotNull:      c.NotNull,
-					Unsigned:     c.Unsigned,
-					IsArray:      c.IsArray,
-					ArrayDims:    c.ArrayDims,
-					Length:       c.Length,
-					EmbedTable:   c.EmbedTable,
-					OriginalName: c.Name,
+					Name:          cname,
+					Type:          c.Type,
+					Table:         c.Table,
+					TableAlias:    alias,
+					DataType:      c.DataType,
+					NotNull:       c.NotNull,
+					Unsigned:      c.Unsigned,
+					IsArray:       c.IsArray,
+					ArrayDims:     c.ArrayDims,
+					Length:        c.Length,
+					Precision:     c.Precision,
+					Scale:         c.Scale,
+					HasDefault:    c.HasDefault,
+					DefaultExpr:   c.DefaultExpr,
+					IsGenerated:   c.IsGenerated,
+					GeneratedExpr: c.GeneratedExpr,
+					EmbedTable:    c.EmbedTable,
+					EmbedName:     c.EmbedName,
+					OriginalName:  c.Name,
 				})
 			}
 		}
 	}
 	if found == 0 {
 		return nil, &sqlerr.Error{
-			Code:     "42703",
-			Message:  fmt.Sprintf("column %q does not exist", name),
-			Location: res.Location,
+			Code:       "42703",
+			Message:    fmt.Sprintf("column %q does not exist", name),
+			Location:   res.Location,
+			ColumnName: name,
 		}
 	}
 	if found > 1 {
@@ -726,7 +782,7 @@ func findColumnForRef(ref *ast.ColumnRef, tables []*Table, targetList *ast.List)
 		}
 
 		// Find matching column
-		for _, c := range t.Columns {
+		for _, c := range t.namedColumns() {
 			if c.Name == name {
 				found++
 				break
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/debug"
 	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/opts"
 	"github.com/sqlc-dev/sqlc/internal/source"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 	"github.com/sqlc-dev/sqlc/internal/sql/validate"
 )
 
@@ -41,7 +43,7 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		return nil, errors.New("missing semicolon at end of file")
 	}
 
-	name, cmd, err := metadata.ParseQueryNameAndType(rawSQL, metadata.CommentSyntax(c.parser.CommentSyntax()))
+	name, cmd, err := metadata.ParseQueryNameAndType(rawSQL, metadata.CommentSyntax(c.parser.CommentSyntax()), c.pluginCommands()...)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +52,12 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		return nil, nil
 	}
 
+	if plugins, consumed := c.commandPlugins(cmd); len(plugins) > 0 && !consumed {
+		return nil, fmt.Errorf("query %q uses command %s of plugin %s, which doesn't generate code for this package", name, cmd, strings.Join(plugins, ", "))
+	}
+	if cmd == metadata.CmdExecLastId && c.conf.Engine == config.EnginePostgreSQL {
+		return nil, fmt.Errorf("query %q specifies parameter %q, which is not supported by PostgreSQL; use :one with a RETURNING clause instead", name, cmd)
+	}
 	if err := validate.Cmd(raw.Stmt, name, cmd); err != nil {
 		return nil, err
 	}
@@ -58,6 +66,7 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		Name: name,
 		Cmd:  cmd,
 	}
+	md.Line, md.Column = annotationPosition(src, raw.StmtLocation, rawSQL, name)
 
 	// TODO eventually can use this for name and type/cmd parsing too
 	cleanedComments, err := source.CleanedComments(rawSQL, c.parser.CommentSyntax())
@@ -70,6 +79,34 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		return nil, err
 	}
 
+	md.Overrides, err = metadata.ParseQueryOverrides(rawSQL, metadata.CommentSyntax(c.parser.CommentSyntax()))
+	if err != nil {
+		return nil, offsetLine(err, src, raw.StmtLocation)
+	}
+
+	md.ParamStyle, err = metadata.ParseParamStyle(rawSQL, metadata.CommentSyntax(c.parser.CommentSyntax()))
+	if err != nil {
+		return nil, offsetLine(err, src, raw.StmtLocation)
+	}
+
+	md.NotFound, err = metadata.ParseNotFound(rawSQL, metadata.CommentSyntax(c.parser.CommentSyntax()))
+	if err != nil {
+		return nil, offsetLine(err, src, raw.StmtLocation)
+	}
+	if md.NotFound != "" && cmd != metadata.CmdOne && cmd != metadata.CmdBatchOne {
+		return nil, fmt.Errorf("query %q has a not_found annotation, which is only supported by %s and %s queries", name, metadata.CmdOne, metadata.CmdBatchOne)
+	}
+
+	md.Deprecated, err = metadata.ParseDeprecated(rawSQL, metadata.CommentSyntax(c.parser.CommentSyntax()))
+	if err != nil {
+		return nil, offsetLine(err, src, raw.StmtLocation)
+	}
+
+	md.Columns, err = metadata.ParseColumns(rawSQL, metadata.CommentSyntax(c.parser.CommentSyntax()))
+	if err != nil {
+		return nil, offsetLine(err, src, raw.StmtLocation)
+	}
+
 	var anlys *analysis
 	if c.analyzer != nil {
 		inference, _ := c.inferQuery(raw, rawSQL)
@@ -106,6 +143,22 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		}
 	}
 
+	// A batch queues the same statement for every item, so the placeholders
+	// of a slice can't be expanded to the length of each item's slice
+	switch cmd {
+	case metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne:
+		for _, p := range anlys.Parameters {
+			if p.Column != nil && p.Column.IsSqlcSlice {
+				return nil, fmt.Errorf("query %q uses sqlc.slice(%s), which is not supported by %s queries", name, p.Column.Name, cmd)
+			}
+		}
+	}
+
+	anlys.Columns, err = c.callResultColumns(raw.Stmt, md, anlys.Columns)
+	if err != nil {
+		return nil, err
+	}
+
 	expanded := anlys.Query
 
 	// If the query string was edited, make sure the syntax is valid
@@ -120,10 +173,25 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		return nil, err
 	}
 
-	md.Comments = comments
+	for _, comment := range comments {
+		if metadata.IsOverrideComment(comment) || metadata.IsParamStyleComment(comment) || metadata.IsNotFoundComment(comment) || metadata.IsColumnsComment(comment) || metadata.IsDeprecatedComment(comment) {
+			continue
+		}
+		md.Comments = append(md.Comments, comment)
+	}
+
+	if anlys.OrderBy != nil {
+		anlys.Parameters = append(anlys.Parameters, orderByParameter(anlys.Parameters, anlys.OrderBy))
+	}
+
+	c.resolveDomains(anlys.Columns)
+	for _, p := range anlys.Parameters {
+		c.resolveDomains([]*Column{p.Column})
+	}
 
 	return &Query{
 		RawStmt:         raw,
+		Embeds:          anlys.Embeds,
 		Metadata:        md,
 		Params:          anlys.Parameters,
 		Columns:         anlys.Columns,
@@ -169,3 +237,26 @@ func uniqueParamRefs(in []paramRef, dollar bool) []paramRef {
 	}
 	return o
 }
+
+// annotationPosition returns the 1-based line and column in src of the name
+// annotation of the query rawSQL, which starts at offset loc in src.
+func annotationPosition(src string, loc int, rawSQL, name string) (int, int) {
+	offset := loc
+	if i := strings.Index(rawSQL, "name: "+name); i >= 0 {
+		offset += i
+	}
+	start := strings.LastIndex(src[:offset], "\n") + 1
+	line := strings.Count(src[:start], "\n") + 1
+	indent := len(src[start:offset]) - len(strings.TrimLeft(src[start:offset], " \t"))
+	return line, indent + 1
+}
+
+// offsetLine moves the line of an *sqlerr.Error reported within the query
+// starting at offset loc in src to the matching line of src.
+func offsetLine(err error, src string, loc int) error {
+	var e *sqlerr.Error
+	if errors.As(err, &e) {
+		e.Line += strings.Count(src[:loc], "\n")
+	}
+	return err
+}
@@ -0,0 +1,195 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// polymorphicArrayTypes are the pseudo-types of PostgreSQL that match any
+// array, and polymorphicElementTypes those that match any type.
+var polymorphicArrayTypes = map[string]bool{
+	"anyarray":           true,
+	"anycompatiblearray": true,
+}
+
+var polymorphicElementTypes = map[string]bool{
+	"anyelement":            true,
+	"anyenum":               true,
+	"anynonarray":           true,
+	"anycompatible":         true,
+	"anycompatiblenonarray": true,
+}
+
+// polymorphicResult returns the column a call of a function returning a
+// polymorphic type, such as array_agg, outputs: the type of its polymorphic
+// arguments, with the dimensions of arrays carried over. It returns nil if
+// the result isn't polymorphic or its type can't be worked out from the
+// arguments.
+func polymorphicResult(fun *catalog.Function, call *ast.FuncCall, tables []*Table) *Column {
+	if fun.ReturnType == nil || call.Args == nil {
+		return nil
+	}
+	ret := fun.ReturnType.Name
+	if !polymorphicArrayTypes[ret] && !polymorphicElementTypes[ret] {
+		return nil
+	}
+
+	for i, arg := range call.Args.Items {
+		if i >= len(fun.Args) || fun.Args[i].Type == nil {
+			break
+		}
+		declared := fun.Args[i].Type.Name
+		if !polymorphicArrayTypes[declared] && !polymorphicElementTypes[declared] {
+			continue
+		}
+		col := argumentColumn(arg, tables)
+		if col == nil {
+			continue
+		}
+
+		// An array argument binds the polymorphic array type to its type and
+		// the element type to the type of its elements, which are never
+		// arrays themselves. Any other argument binds the element type.
+		// Overloads such as those of array_agg differ only in whether they
+		// take an array, so the argument decides which one is called.
+		var elemDims, arrayDims int
+		arrayArg := polymorphicArrayTypes[declared] && col.ArrayDims > 0
+		if arrayArg {
+			arrayDims = col.ArrayDims
+		} else {
+			elemDims = col.ArrayDims
+			arrayDims = col.ArrayDims + 1
+		}
+		dims := elemDims
+		if polymorphicArrayTypes[ret] {
+			dims = arrayDims
+			// Aggregating arrays adds a dimension, rather than collecting
+			// their elements
+			if fun.Name == "array_agg" && arrayArg {
+				dims++
+			}
+		}
+		return &Column{
+			DataType:  col.DataType,
+			Type:      col.Type,
+			NotNull:   !fun.ReturnTypeNullable,
+			IsArray:   dims > 0,
+			ArrayDims: dims,
+		}
+	}
+	return nil
+}
+
+// argumentColumn returns the type of a function argument that is a column
+// reference or a type cast, or nil for any other expression.
+func argumentColumn(arg ast.Node, tables []*Table) *Column {
+	switch n := arg.(type) {
+	case *ast.ColumnRef:
+		if hasStarRef(n) {
+			return nil
+		}
+		cols, err := outputColumnRefs(&ast.ResTarget{}, tables, n)
+		if err != nil || len(cols) != 1 {
+			return nil
+		}
+		return cols[0]
+	case *ast.TypeCast:
+		if n.TypeName == nil {
+			return nil
+		}
+		return toColumn(n.TypeName)
+	}
+	return nil
+}
+
+// funcArgTypes returns the types of the positional arguments of a call, used
+// to pick between the overloads of a function. Types that can't be worked out
+// without resolving nested calls or operators are empty, as are those of
+// string literals, which PostgreSQL types by the overload they're passed to.
+func funcArgTypes(call *ast.FuncCall, tables []*Table) []string {
+	if call.Args == nil {
+		return nil
+	}
+	var types []string
+	for _, arg := range call.Args.Items {
+		if _, ok := arg.(*ast.NamedArgExpr); ok {
+			break
+		}
+		var typ string
+		if n, ok := arg.(*ast.A_Const); ok {
+			switch n.Val.(type) {
+			case *ast.Integer:
+				typ = "integer"
+			case *ast.Float:
+				typ = "numeric"
+			case *ast.Boolean:
+				typ = "boolean"
+			}
+		} else if col := argumentColumn(arg, tables); col != nil {
+			typ = col.DataType + strings.Repeat("[]", col.ArrayDims)
+		}
+		types = append(types, typ)
+	}
+	return types
+}
+
+// orderedSetResult returns the column an ordered-set aggregate, such as
+// percentile_disc or mode, outputs when its result has the type of the
+// aggregated argument, which is given by WITHIN GROUP (ORDER BY ...) rather
+// than by the arguments of the call. It returns nil for any other call, or if
+// the type of the aggregated argument can't be worked out.
+func orderedSetResult(fun *catalog.Function, call *ast.FuncCall, tables []*Table) *Column {
+	if !call.AggWithinGroup || fun.ReturnType == nil || call.AggOrder == nil || len(call.AggOrder.Items) == 0 {
+		return nil
+	}
+	sortBy, ok := call.AggOrder.Items[0].(*ast.SortBy)
+	if !ok {
+		return nil
+	}
+	col := argumentColumn(sortBy.Node, tables)
+	if col == nil || col.IsArray {
+		return nil
+	}
+	ret := fun.ReturnType.Name
+	switch {
+	case polymorphicElementTypes[ret]:
+	case polymorphicArrayTypes[ret]:
+		return &Column{DataType: col.DataType, Type: col.Type, IsArray: true, ArrayDims: 1}
+	// The continuous percentile of intervals is an interval, rather than a
+	// double precision
+	case fun.Name == "percentile_cont" && strings.TrimPrefix(col.DataType, "pg_catalog.") == "interval":
+		if strings.HasSuffix(ret, "[]") {
+			return &Column{DataType: col.DataType, Type: col.Type, IsArray: true, ArrayDims: 1}
+		}
+	default:
+		return nil
+	}
+	return &Column{DataType: col.DataType, Type: col.Type}
+}
+
+// funcCallColumn returns a column with the result type of a function call, or
+// nil if the function can't be resolved.
+func funcCallColumn(qc *QueryCatalog, call *ast.FuncCall, tables []*Table) *Column {
+	fun, err := qc.catalog.ResolveFuncCallTypes(call, funcArgTypes(call, tables))
+	if err != nil {
+		return nil
+	}
+	if col := orderedSetResult(fun, call, tables); col != nil {
+		col.NotNull = !fun.ReturnTypeNullable
+		col.IsFuncCall = true
+		return col
+	}
+	if col := polymorphicResult(fun, call, tables); col != nil {
+		col.IsFuncCall = true
+		return col
+	}
+	return &Column{
+		DataType:   dataType(fun.ReturnType),
+		NotNull:    !fun.ReturnTypeNullable,
+		IsArray:    arrayDims(fun.ReturnType) > 0,
+		ArrayDims:  arrayDims(fun.ReturnType),
+		IsFuncCall: true,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
 )
 
 type Function struct {
@@ -15,6 +16,18 @@ type Function struct {
 type Table struct {
 	Rel     *ast.TableName
 	Columns []*Column
+	// HiddenColumns can be referenced by name, but aren't selected by *
+	HiddenColumns []*Column
+}
+
+// namedColumns returns the columns of the table that can be referenced by name.
+func (t *Table) namedColumns() []*Column {
+	if len(t.HiddenColumns) == 0 {
+		return t.Columns
+	}
+	cols := make([]*Column, 0, len(t.Columns)+len(t.HiddenColumns))
+	cols = append(cols, t.Columns...)
+	return append(cols, t.HiddenColumns...)
 }
 
 type Column struct {
@@ -27,8 +40,15 @@ type Column struct {
 	ArrayDims    int
 	Comment      string
 	Length       *int
+	Precision    *int
+	Scale        *int
 	IsNamedParam bool
 	IsFuncCall   bool
+	HasDefault   bool
+	DefaultExpr  string
+	// IsGenerated is set for generated columns, computed from GeneratedExpr
+	IsGenerated   bool
+	GeneratedExpr string
 
 	// XXX: Figure out what PostgreSQL calls `foo.id`
 	Scope      string
@@ -36,8 +56,19 @@ type Column struct {
 	TableAlias string
 	Type       *ast.TypeName
 	EmbedTable *ast.TableName
+	// EmbedName is set if an embedded table is named by sqlc.embed
+	EmbedName string
+	// Set if the column is typed as a domain, in which case Type holds the
+	// type the domain is based on
+	Domain *ast.TypeName
 
 	IsSqlcSlice bool // is this sqlc.slice()
+	// AnalyzedFromDatabase is set if the type of the column was analyzed by
+	// a database rather than inferred
+	AnalyzedFromDatabase bool
+	// OrderBy is set for the parameter of sqlc.orderby(), to the columns it
+	// may sort by
+	OrderBy []string
 
 	skipTableRequiredCheck bool
 }
@@ -53,6 +84,18 @@ type Query struct {
 
 	// Needed for vet
 	RawStmt *ast.RawStmt
+	// Embeds are the sqlc.embed calls of the query, which RawStmt has as
+	// table.* column refs
+	Embeds rewrite.EmbedSet
+
+	// Path is the file the query is in, and Line and Column where it
+	// starts, for reporting problems with it. EndLine and EndColumn are
+	// just past its end.
+	Path      string
+	Line      int
+	Column    int
+	EndLine   int
+	EndColumn int
 }
 
 type Parameter struct {
@@ -6,6 +6,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 )
 
 type QueryCatalog struct {
@@ -36,9 +37,15 @@ func (comp *Compiler) buildQueryCatalog(c *catalog.Catalog, node ast.Node, embed
 				if err != nil {
 					return nil, err
 				}
+				// The MySQL and SQLite engines store the column list of
+				// the CTE in Ctecolnames
+				aliases := cte.Aliascolnames
+				if aliases == nil || len(aliases.Items) == 0 {
+					aliases = cte.Ctecolnames
+				}
 				var names []string
-				if cte.Aliascolnames != nil {
-					for _, item := range cte.Aliascolnames.Items {
+				if aliases != nil {
+					for _, item := range aliases.Items {
 						if val, ok := item.(*ast.String); ok {
 							names = append(names, val.Str)
 						} else {
@@ -57,23 +64,69 @@ func (comp *Compiler) buildQueryCatalog(c *catalog.Catalog, node ast.Node, embed
 					Rel:     rel,
 					Columns: cols,
 				}
+				if with.Recursive || cte.Cterecursive {
+					if err := comp.resolveRecursiveTerm(qc, cte, cols); err != nil {
+						return nil, err
+					}
+				}
 			}
 		}
 	}
 	return qc, nil
 }
 
+// resolveRecursiveTerm validates the recursive term of a recursive CTE against
+// the columns derived from its non-recursive term, which are already in the
+// query catalog. A column is only NOT NULL if it is in both terms, which may
+// take a few rounds to settle as the recursive term refers to the CTE itself.
+func (comp *Compiler) resolveRecursiveTerm(qc *QueryCatalog, cte *ast.CommonTableExpr, cols []*Column) error {
+	stmt, ok := cte.Ctequery.(*ast.SelectStmt)
+	if !ok || stmt.Op != ast.Union || stmt.Rarg == nil {
+		return nil
+	}
+	for range cols {
+		rcols, err := comp.outputColumns(qc, stmt.Rarg)
+		if err != nil {
+			return err
+		}
+		if len(rcols) != len(cols) {
+			return &sqlerr.Error{
+				Code:     "42601",
+				Message:  "each UNION query must have the same number of columns",
+				Location: cte.Location,
+			}
+		}
+		changed := false
+		for i, col := range cols {
+			if col.NotNull && !rcols[i].NotNull {
+				col.NotNull = false
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return nil
+}
+
 func ConvertColumn(rel *ast.TableName, c *catalog.Column) *Column {
 	return &Column{
-		Table:     rel,
-		Name:      c.Name,
-		DataType:  dataType(&c.Type),
-		NotNull:   c.IsNotNull,
-		Unsigned:  c.IsUnsigned,
-		IsArray:   c.IsArray,
-		ArrayDims: c.ArrayDims,
-		Type:      &c.Type,
-		Length:    c.Length,
+		Table:         rel,
+		Name:          c.Name,
+		DataType:      dataType(&c.Type),
+		NotNull:       c.IsNotNull,
+		Unsigned:      c.IsUnsigned,
+		IsArray:       c.IsArray,
+		ArrayDims:     c.ArrayDims,
+		Type:          &c.Type,
+		Length:        c.Length,
+		Precision:     c.Precision,
+		Scale:         c.Scale,
+		HasDefault:    c.HasDefault,
+		DefaultExpr:   c.DefaultExpr,
+		IsGenerated:   c.IsGenerated,
+		GeneratedExpr: c.GeneratedExpr,
 	}
 }
 
@@ -86,11 +139,18 @@ func (qc QueryCatalog) GetTable(rel *ast.TableName) (*Table, error) {
 	if err != nil {
 		return nil, err
 	}
-	var cols []*Column
+	if rel.Schema == "" && src.Rel.Schema != "" && src.Rel.Schema != qc.catalog.DefaultSchema {
+		// The table was found in another schema of the search path
+		rel = &ast.TableName{Catalog: rel.Catalog, Schema: src.Rel.Schema, Name: rel.Name}
+	}
+	var cols, hidden []*Column
 	for _, c := range src.Columns {
 		cols = append(cols, ConvertColumn(rel, c))
 	}
-	return &Table{Rel: rel, Columns: cols}, nil
+	for _, c := range src.HiddenColumns {
+		hidden = append(hidden, ConvertColumn(rel, c))
+	}
+	return &Table{Rel: rel, Columns: cols, HiddenColumns: hidden}, nil
 }
 
 func (qc QueryCatalog) GetFunc(rel *ast.FuncName) (*Function, error) {
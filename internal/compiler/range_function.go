@@ -0,0 +1,311 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// rangeFunctionTable returns the table of the rows a function in a FROM
+// clause returns, such as unnest($1::text[]) AS t(name), or nil if its
+// columns can't be worked out. The tables before it in the FROM clause are
+// those its arguments may refer to.
+//
+// The columns are those of the function's OUT or TABLE parameters, the
+// column definition list of a function returning record, the columns of the
+// table a function returns rows of, or else the single value it returns. An
+// unnest of several arrays returns a column for each. The alias list renames
+// the columns in order, and WITH ORDINALITY adds a bigint column numbering
+// the rows.
+func (c *Compiler) rangeFunctionTable(qc *QueryCatalog, n *ast.RangeFunction, tables []*Table) *Table {
+	if n.Functions == nil || len(n.Functions.Items) == 0 {
+		return nil
+	}
+
+	var calls []*ast.FuncCall
+	var coldefs []*ast.List
+	for _, item := range n.Functions.Items {
+		var defs *ast.List
+		if list, ok := item.(*ast.List); ok && len(list.Items) > 0 {
+			item = list.Items[0]
+			if len(list.Items) > 1 {
+				defs, _ = list.Items[1].(*ast.List)
+			}
+		}
+		call, ok := item.(*ast.FuncCall)
+		if !ok {
+			return nil
+		}
+		calls = append(calls, call)
+		coldefs = append(coldefs, defs)
+	}
+	if len(calls) == 1 && coldefs[0] == nil {
+		coldefs[0] = n.Coldeflist
+	}
+
+	var aliasName string
+	var aliases []string
+	if n.Alias != nil {
+		if n.Alias.Aliasname != nil {
+			aliasName = *n.Alias.Aliasname
+		}
+		if n.Alias.Colnames != nil {
+			for _, item := range n.Alias.Colnames.Items {
+				if s, ok := item.(*ast.String); ok {
+					aliases = append(aliases, s.Str)
+				}
+			}
+		}
+	}
+
+	var cols []*Column
+	for i, call := range calls {
+		// An unnest of several arrays, which is only allowed in FROM, is
+		// short for unnesting each of them side by side
+		if isUnnest(call) && call.Args != nil && len(call.Args.Items) > 1 {
+			for _, arg := range call.Args.Items {
+				single := &ast.FuncCall{Func: call.Func, Args: &ast.List{Items: []ast.Node{arg}}}
+				fcols := c.functionColumns(qc, single, nil, aliasName, len(calls) == 1, tables)
+				if fcols == nil {
+					return nil
+				}
+				cols = append(cols, fcols...)
+			}
+			continue
+		}
+		fcols := c.functionColumns(qc, call, coldefs[i], aliasName, len(calls) == 1, tables)
+		if fcols == nil {
+			return nil
+		}
+		cols = append(cols, fcols...)
+	}
+	if n.Ordinality {
+		cols = append(cols, &Column{
+			Name:     "ordinality",
+			DataType: "bigint",
+			NotNull:  true,
+		})
+	}
+	for i, alias := range aliases {
+		if i < len(cols) {
+			cols[i].Name = alias
+		}
+	}
+
+	rel := &ast.TableName{Name: calls[0].Func.Name}
+	if len(calls) == 1 {
+		rel.Schema = calls[0].Func.Schema
+	}
+	if aliasName != "" {
+		rel = &ast.TableName{Name: aliasName}
+	}
+	for _, col := range cols {
+		col.Table = rel
+	}
+	return &Table{Rel: rel, Columns: cols}
+}
+
+// functionColumns returns the columns of the rows a function call returns,
+// or nil if the function isn't known. A function returning a single value
+// names its column after the alias of the function, if it's the only one in
+// the FROM item, or else after the function.
+func (c *Compiler) functionColumns(qc *QueryCatalog, call *ast.FuncCall, coldefs *ast.List, alias string, only bool, tables []*Table) []*Column {
+	fun := c.resolveFuncOverload(call, tables)
+	if fun == nil {
+		return nil
+	}
+
+	var cols []*Column
+	for _, arg := range fun.Args {
+		if arg.Mode != ast.FuncParamOut && arg.Mode != ast.FuncParamTable && arg.Mode != ast.FuncParamInOut {
+			continue
+		}
+		col := typeColumn(arg.Type)
+		col.Name = arg.Name
+		cols = append(cols, col)
+	}
+	if len(cols) > 0 {
+		return cols
+	}
+
+	if coldefs != nil && len(coldefs.Items) > 0 {
+		for _, item := range coldefs.Items {
+			def, ok := item.(*ast.ColumnDef)
+			if !ok || def.TypeName == nil {
+				return nil
+			}
+			col := typeColumn(def.TypeName)
+			col.Name = def.Colname
+			col.NotNull = def.IsNotNull
+			cols = append(cols, col)
+		}
+		return cols
+	}
+
+	if fun.ReturnType == nil {
+		return nil
+	}
+	if qc != nil {
+		table, err := qc.GetTable(&ast.TableName{
+			Catalog: fun.ReturnType.Catalog,
+			Schema:  fun.ReturnType.Schema,
+			Name:    fun.ReturnType.Name,
+		})
+		if err == nil && table != nil {
+			for _, col := range table.Columns {
+				copied := *col
+				cols = append(cols, &copied)
+			}
+			return cols
+		}
+	}
+
+	col := polymorphicResult(fun, call, tables)
+	if col == nil {
+		col = typeColumn(fun.ReturnType)
+	}
+	// A set-returning function may return NULL for any row, such as for the
+	// NULL elements of an array
+	col.NotNull = false
+	col.Name = call.Func.Name
+	if only && alias != "" {
+		col.Name = alias
+	}
+	return []*Column{col}
+}
+
+// resolveFuncOverload returns the function a call resolves to, or nil if
+// it's unknown. Of the overloads taking as many arguments, it prefers the one
+// whose parameters have the types of the arguments whose types are known,
+// such as casts, numeric constants and columns, so that generate_series($1,
+// $2::int) calls the integer overload.
+func (c *Compiler) resolveFuncOverload(call *ast.FuncCall, tables []*Table) *catalog.Function {
+	fun, err := c.catalog.ResolveFuncCall(call)
+	if err != nil || fun == nil {
+		return nil
+	}
+	if call.Args == nil || len(call.Args.Items) == 0 {
+		return fun
+	}
+	var known []string
+	for _, arg := range call.Args.Items {
+		if _, ok := arg.(*ast.NamedArgExpr); ok {
+			return fun
+		}
+		known = append(known, argumentType(arg, tables))
+	}
+
+	funs, err := c.catalog.ListFuncsByName(call.Func)
+	if err != nil {
+		return fun
+	}
+	best, bestScore := fun, overloadScore(fun, known)
+	for i := range funs {
+		f := &funs[i]
+		if len(f.InArgs()) != len(known) {
+			continue
+		}
+		if score := overloadScore(f, known); score > bestScore {
+			best, bestScore = f, score
+		}
+	}
+	return best
+}
+
+// overloadScore counts the arguments of known types that a function takes as
+// they are, or -1 if one of them would have to be of another type.
+func overloadScore(fun *catalog.Function, known []string) int {
+	args := fun.InArgs()
+	var score int
+	for i, typ := range known {
+		if typ == "" || i >= len(args) || args[i].Type == nil {
+			continue
+		}
+		want := canonicalTypeName(dataType(args[i].Type))
+		if polymorphicArrayTypes[want] || polymorphicElementTypes[want] || want == "any" {
+			continue
+		}
+		if want != typ {
+			return -1
+		}
+		score++
+	}
+	return score
+}
+
+// argumentType returns the canonical name of the type of a function argument,
+// or an empty string if it isn't known, as for parameters without a cast.
+func argumentType(arg ast.Node, tables []*Table) string {
+	if n, ok := arg.(*ast.A_Const); ok {
+		switch n.Val.(type) {
+		case *ast.Integer:
+			return "integer"
+		case *ast.Float:
+			return "numeric"
+		}
+		return ""
+	}
+	col := argumentColumn(arg, tables)
+	if col == nil || col.IsArray {
+		return ""
+	}
+	return canonicalTypeName(col.DataType)
+}
+
+// canonicalTypeName returns the name PostgreSQL's catalog uses for a type,
+// such as integer for int4.
+func canonicalTypeName(name string) string {
+	name = strings.ToLower(strings.TrimPrefix(name, "pg_catalog."))
+	switch name {
+	case "int2", "smallserial", "serial2":
+		return "smallint"
+	case "int", "int4", "serial", "serial4":
+		return "integer"
+	case "int8", "bigserial", "serial8":
+		return "bigint"
+	case "float4":
+		return "real"
+	case "float8":
+		return "double precision"
+	case "decimal":
+		return "numeric"
+	case "bool":
+		return "boolean"
+	case "varchar":
+		return "character varying"
+	case "bpchar", "char":
+		return "character"
+	case "timestamp":
+		return "timestamp without time zone"
+	case "timestamptz":
+		return "timestamp with time zone"
+	case "time":
+		return "time without time zone"
+	case "timetz":
+		return "time with time zone"
+	}
+	return name
+}
+
+// typeColumn returns a column of the type t, which may come from a schema
+// file or from the built-in catalog, whose types only have a name.
+func typeColumn(t *ast.TypeName) *Column {
+	if t.Names != nil && len(t.Names.Items) > 0 {
+		col := toColumn(t)
+		col.NotNull = false
+		return col
+	}
+	dims := arrayDims(t)
+	return &Column{
+		DataType:  dataType(t),
+		Type:      t,
+		IsArray:   dims > 0,
+		ArrayDims: dims,
+	}
+}
+
+func isUnnest(call *ast.FuncCall) bool {
+	return call.Func != nil && strings.ToLower(call.Func.Name) == "unnest" &&
+		(call.Func.Schema == "" || call.Func.Schema == "pg_catalog")
+}
@@ -0,0 +1,92 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+)
+
+// rangeElementTypes maps the built-in range and multirange types of
+// PostgreSQL to the types of their elements.
+var rangeElementTypes = map[string]string{
+	"int4range":      "pg_catalog.int4",
+	"int4multirange": "pg_catalog.int4",
+	"int8range":      "pg_catalog.int8",
+	"int8multirange": "pg_catalog.int8",
+	"numrange":       "pg_catalog.numeric",
+	"nummultirange":  "pg_catalog.numeric",
+	"tsrange":        "pg_catalog.timestamp",
+	"tsmultirange":   "pg_catalog.timestamp",
+	"tstzrange":      "pg_catalog.timestamptz",
+	"tstzmultirange": "pg_catalog.timestamptz",
+	"daterange":      "date",
+	"datemultirange": "date",
+}
+
+func isRangeType(typ string) bool {
+	_, ok := rangeElementTypes[strings.TrimPrefix(typ, "pg_catalog.")]
+	return ok
+}
+
+// rangeElementParam returns the element type of a range if the parameter ref
+// is compared with a value of that range type by expr as an element, as in
+// during @> $1 or $1 <@ during. Containment is also defined between ranges,
+// but testing whether a range contains a value is by far the common case.
+// Parameters compared with a range by other operators, such as &&, are ranges
+// themselves.
+func rangeElementParam(expr *ast.A_Expr, ref *ast.ParamRef, rangeType string) (string, bool) {
+	element, ok := rangeElementTypes[strings.TrimPrefix(rangeType, "pg_catalog.")]
+	if !ok {
+		return "", false
+	}
+	right := containsParam(expr.Rexpr, ref)
+	switch astutils.Join(expr.Name, ".") {
+	case "@>":
+		if right {
+			return element, true
+		}
+	case "<@":
+		if !right {
+			return element, true
+		}
+	}
+	return "", false
+}
+
+// rangeOperandType returns the range type of the operand of expr that doesn't
+// contain ref, if it's a call to a range constructor, such as
+// tstzrange($1, $2), or a cast to a range type.
+func rangeOperandType(expr *ast.A_Expr, ref *ast.ParamRef) (string, bool) {
+	operand := expr.Lexpr
+	if containsParam(expr.Lexpr, ref) {
+		operand = expr.Rexpr
+	}
+	switch n := operand.(type) {
+	case *ast.FuncCall:
+		if n.Func != nil && isRangeType(n.Func.Name) {
+			return n.Func.Name, true
+		}
+	case *ast.TypeCast:
+		if n.TypeName != nil && (n.TypeName.ArrayBounds == nil || len(n.TypeName.ArrayBounds.Items) == 0) {
+			if typ := rangeCastType(n.TypeName); isRangeType(typ) {
+				return typ, true
+			}
+		}
+	}
+	return "", false
+}
+
+func rangeCastType(tn *ast.TypeName) string {
+	if tn.Names != nil && len(tn.Names.Items) > 0 {
+		names := stringSlice(tn.Names)
+		return names[len(names)-1]
+	}
+	return tn.Name
+}
+
+func containsParam(root ast.Node, ref *ast.ParamRef) bool {
+	return len(astutils.Search(root, func(node ast.Node) bool {
+		return node == ref
+	}).Items) > 0
+}
@@ -0,0 +1,56 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// renameHint adds a hint to err, an error compiling the query raw, if it's
+// about a relation or column that doesn't exist because a schema file renamed
+// it, so that queries written against the old name are easy to fix.
+func (c *Compiler) renameHint(raw *ast.RawStmt, err error) {
+	var e *sqlerr.Error
+	if !errors.As(err, &e) || e.Hint != "" {
+		return
+	}
+	switch e.Code {
+
+	case "42P01":
+		if e.Relation == "" {
+			return
+		}
+		rel := &ast.TableName{Name: e.Relation}
+		for _, rv := range rangeVars(raw) {
+			if rv.Relname != nil && *rv.Relname == e.Relation {
+				if fqn, err := ParseTableName(rv); err == nil {
+					rel = fqn
+				}
+				break
+			}
+		}
+		if r, ok := c.catalog.RenamedTable(rel); ok {
+			e.Hint = fmt.Sprintf("it was renamed to %q in %s", r.NewName, r.Filename)
+		}
+
+	case "42703":
+		if e.ColumnName == "" {
+			return
+		}
+		for _, rv := range rangeVars(raw) {
+			if rv.Relname == nil || (e.Relation != "" && *rv.Relname != e.Relation) {
+				continue
+			}
+			fqn, err := ParseTableName(rv)
+			if err != nil {
+				continue
+			}
+			if r, ok := c.catalog.RenamedColumn(fqn, e.ColumnName); ok {
+				e.Hint = fmt.Sprintf("it was renamed to %q in %s", r.NewName, r.Filename)
+				return
+			}
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/lang"
 	"github.com/sqlc-dev/sqlc/internal/sql/named"
 	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
@@ -21,7 +22,7 @@ func dataType(n *ast.TypeName) string {
 	}
 }
 
-func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar, args []paramRef, params *named.ParamSet, embeds rewrite.EmbedSet) ([]Parameter, error) {
+func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar, subselects []*Table, excluded *ast.TableName, args []paramRef, params *named.ParamSet, embeds rewrite.EmbedSet) ([]Parameter, error) {
 	c := comp.catalog
 
 	aliasMap := map[string]*ast.TableName{}
@@ -47,18 +48,27 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			cc := c
 			typeMap[schema][table.Rel.Name][c.Name] = cc
 		}
+		for _, c := range table.HiddenColumns {
+			typeMap[schema][table.Rel.Name][c.Name] = c
+		}
 		return nil
 	}
 
+	// rvTables maps range variables to the tables they were indexed as
+	rvTables := map[*ast.RangeVar]*ast.TableName{}
 	for _, rv := range rvs {
 		if rv.Relname == nil {
 			continue
 		}
+		if _, found := rvTables[rv]; found {
+			continue
+		}
 		fqn, err := ParseTableName(rv)
 		if err != nil {
 			return nil, err
 		}
-		if _, found := aliasMap[fqn.Name]; found {
+		if original, found := aliasMap[fqn.Name]; found {
+			rvTables[rv] = original
 			continue
 		}
 		table, err := c.GetTable(fqn)
@@ -67,20 +77,69 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				continue
 			}
 			// If the table name doesn't exist, first check if it's a CTE
-			if _, qcerr := qc.GetTable(fqn); qcerr != nil {
+			cte, qcerr := qc.GetTable(fqn)
+			if qcerr != nil {
 				return nil, err
 			}
-			continue
+			table = cteTable(cte)
 		}
 		err = indexTable(table)
 		if err != nil {
 			return nil, err
 		}
+		rvTables[rv] = table.Rel
 		if rv.Alias != nil {
 			aliasMap[*rv.Alias.Aliasname] = fqn
 		}
 	}
 
+	// Subselects in a FROM clause are only searched for columns qualified
+	// with their alias, and for columns no table has, so that they don't
+	// make references to the tables they select from ambiguous
+	var derived []*ast.TableName
+	for _, sub := range subselects {
+		name := sub.Rel.Name
+		if _, found := aliasMap[name]; found {
+			continue
+		}
+		if _, found := typeMap[c.DefaultSchema][name]; found {
+			continue
+		}
+		if _, exists := typeMap[c.DefaultSchema]; !exists {
+			typeMap[c.DefaultSchema] = map[string]map[string]*catalog.Column{}
+		}
+		typeMap[c.DefaultSchema][name] = map[string]*catalog.Column{}
+		for _, col := range cteTable(sub).Columns {
+			typeMap[c.DefaultSchema][name][col.Name] = col
+		}
+		aliasMap[name] = sub.Rel
+		derived = append(derived, sub.Rel)
+	}
+
+	hasColumn := func(table *ast.TableName, key string) bool {
+		schema := table.Schema
+		if schema == "" {
+			schema = c.DefaultSchema
+		}
+		_, ok := typeMap[schema][table.Name][key]
+		return ok
+	}
+	countColumns := func(tables []*ast.TableName, key string) int {
+		var n int
+		for _, table := range tables {
+			if hasColumn(table, key) {
+				n++
+			}
+		}
+		return n
+	}
+
+	if excluded != nil {
+		if _, found := aliasMap["excluded"]; !found {
+			aliasMap["excluded"] = excluded
+		}
+	}
+
 	// resolve a table for an embed
 	for _, embed := range embeds {
 		table, err := c.GetTable(embed.Table)
@@ -144,22 +203,41 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 		case *ast.A_Expr:
 			// TODO: While this works for a wide range of simple expressions,
 			// more complicated expressions will cause this logic to fail.
+			operand := n.Lexpr
 			list := astutils.Search(n.Lexpr, func(node ast.Node) bool {
 				_, ok := node.(*ast.ColumnRef)
 				return ok
 			})
 			if len(list.Items) == 0 {
+				operand = n.Rexpr
 				list = astutils.Search(n.Rexpr, func(node ast.Node) bool {
 					_, ok := node.(*ast.ColumnRef)
 					return ok
 				})
 			}
+			// The parameter is compared to a field of a composite column,
+			// as in (addr).city = $1
+			var fields []string
+			if ind, ok := operand.(*ast.A_Indirection); ok && len(list.Items) > 0 {
+				if ref, f, ok := compositeFields(ind); ok && ref == list.Items[0] {
+					fields = f
+				}
+			}
 
 			if len(list.Items) == 0 {
 				// TODO: Move this to database-specific engine package
 				dataType := "any"
-				if astutils.Join(n.Name, ".") == "||" {
+				switch op := astutils.Join(n.Name, "."); {
+				case op == "||":
 					dataType = "text"
+				case lang.IsVectorDistanceOperator(op):
+					dataType = "vector"
+				}
+				if rangeType, ok := rangeOperandType(n, ref.ref); ok {
+					dataType = rangeType
+					if element, ok := rangeElementParam(n, ref.ref, rangeType); ok {
+						dataType = element
+					}
 				}
 
 				defaultP := named.NewParam("")
@@ -196,6 +274,18 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				}
 
 				search := tables
+				if alias == "" && countColumns(search, key) == 0 {
+					search = derived
+				}
+				if alias == "" && ref.scope != nil && countColumns(search, key) > 1 {
+					// Every table of the statement is searched, so a column
+					// can be ambiguous even though only one of the tables is
+					// in the scope of the parameter, as in the terms of a
+					// recursive CTE
+					if scoped := scopeTables(ref.scope, rvTables); countColumns(scoped, key) > 0 {
+						search = scoped
+					}
+				}
 				if alias != "" {
 					if original, ok := aliasMap[alias]; ok {
 						search = []*ast.TableName{original}
@@ -225,26 +315,54 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 					}
 					if c, ok := typeMap[schema][table.Name][key]; ok {
 						found += 1
+						if fields != nil {
+							field, err := comp.compositeField(&c.Type, c.IsArray, fields, node.Location)
+							if err != nil {
+								return nil, err
+							}
+							c, key = field, field.Name
+						}
 						if ref.name != "" {
 							key = ref.name
 						}
 
 						defaultP := named.NewInferredParam(key, c.IsNotNull)
 						p, isNamed := params.FetchMerge(ref.ref.Number, defaultP)
+						if element, ok := rangeElementParam(n, ref.ref, dataType(&c.Type)); ok && !c.IsArray {
+							// The parameter is an element of the range column,
+							// so overrides of the column don't apply to it
+							a = append(a, Parameter{
+								Number: ref.ref.Number,
+								Column: &Column{
+									Name:         p.Name(),
+									DataType:     element,
+									NotNull:      p.NotNull(),
+									IsNamedParam: isNamed,
+									IsSqlcSlice:  p.IsSqlcSlice(),
+								},
+							})
+							continue
+						}
 						a = append(a, Parameter{
 							Number: ref.ref.Number,
 							Column: &Column{
-								Name:         p.Name(),
-								OriginalName: c.Name,
-								DataType:     dataType(&c.Type),
-								NotNull:      p.NotNull(),
-								Unsigned:     c.IsUnsigned,
-								IsArray:      c.IsArray,
-								ArrayDims:    c.ArrayDims,
-								Length:       c.Length,
-								Table:        table,
-								IsNamedParam: isNamed,
-								IsSqlcSlice:  p.IsSqlcSlice(),
+								Name:          p.Name(),
+								OriginalName:  c.Name,
+								DataType:      dataType(&c.Type),
+								NotNull:       p.NotNull(),
+								Unsigned:      c.IsUnsigned,
+								IsArray:       c.IsArray,
+								ArrayDims:     c.ArrayDims,
+								Length:        c.Length,
+								Precision:     c.Precision,
+								Scale:         c.Scale,
+								HasDefault:    c.HasDefault,
+								DefaultExpr:   c.DefaultExpr,
+								IsGenerated:   c.IsGenerated,
+								GeneratedExpr: c.GeneratedExpr,
+								Table:         table,
+								IsNamedParam:  isNamed,
+								IsSqlcSlice:   p.IsSqlcSlice(),
 							},
 						})
 					}
@@ -252,9 +370,10 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 
 				if found == 0 {
 					return nil, &sqlerr.Error{
-						Code:     "42703",
-						Message:  fmt.Sprintf("column %q does not exist", key),
-						Location: node.Location,
+						Code:       "42703",
+						Message:    fmt.Sprintf("column %q does not exist", key),
+						Location:   node.Location,
+						ColumnName: key,
 					}
 				}
 				if found > 1 {
@@ -463,7 +582,12 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				schema = defaultTable.Schema
 				rel = defaultTable.Name
 			}
-			if ref.rv != nil {
+			if table, ok := rvTables[ref.rv]; ok {
+				// The table may have been found in another schema of the
+				// search path
+				schema = table.Schema
+				rel = table.Name
+			} else if ref.rv != nil {
 				fqn, err := ParseTableName(ref.rv)
 				if err != nil {
 					return nil, err
@@ -486,24 +610,31 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				a = append(a, Parameter{
 					Number: ref.ref.Number,
 					Column: &Column{
-						Name:         p.Name(),
-						OriginalName: c.Name,
-						DataType:     dataType(&c.Type),
-						NotNull:      p.NotNull(),
-						Unsigned:     c.IsUnsigned,
-						IsArray:      c.IsArray,
-						ArrayDims:    c.ArrayDims,
-						Table:        &ast.TableName{Schema: schema, Name: rel},
-						Length:       c.Length,
-						IsNamedParam: isNamed,
-						IsSqlcSlice:  p.IsSqlcSlice(),
+						Name:          p.Name(),
+						OriginalName:  c.Name,
+						DataType:      dataType(&c.Type),
+						NotNull:       p.NotNull(),
+						Unsigned:      c.IsUnsigned,
+						IsArray:       c.IsArray,
+						ArrayDims:     c.ArrayDims,
+						Table:         &ast.TableName{Schema: schema, Name: rel},
+						Length:        c.Length,
+						Precision:     c.Precision,
+						Scale:         c.Scale,
+						HasDefault:    c.HasDefault,
+						DefaultExpr:   c.DefaultExpr,
+						IsGenerated:   c.IsGenerated,
+						GeneratedExpr: c.GeneratedExpr,
+						IsNamedParam:  isNamed,
+						IsSqlcSlice:   p.IsSqlcSlice(),
 					},
 				})
 			} else {
 				return nil, &sqlerr.Error{
-					Code:     "42703",
-					Message:  fmt.Sprintf("column %q does not exist", key),
-					Location: n.Location,
+					Code:       "42703",
+					Message:    fmt.Sprintf("column %q does not exist", key),
+					Location:   n.Location,
+					ColumnName: key,
 				}
 			}
 
@@ -513,10 +644,11 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			}
 			col := toColumn(n.TypeName)
 			defaultP := named.NewInferredParam(col.Name, col.NotNull)
-			p, _ := params.FetchMerge(ref.ref.Number, defaultP)
+			p, isNamed := params.FetchMerge(ref.ref.Number, defaultP)
 
 			col.Name = p.Name()
 			col.NotNull = p.NotNull()
+			col.IsNamedParam = isNamed
 			a = append(a, Parameter{
 				Number: ref.ref.Number,
 				Column: col,
@@ -570,6 +702,15 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			var found int
 			if n.Sel == nil {
 				search := tables
+				if alias == "" && ref.scope != nil && countColumns(search, key) > 1 {
+					// Every table of the statement is searched, so a column
+					// can be ambiguous even though only one of the tables is
+					// in the scope of the parameter, as in the terms of a
+					// recursive CTE
+					if scoped := scopeTables(ref.scope, rvTables); countColumns(scoped, key) > 0 {
+						search = scoped
+					}
+				}
 				if alias != "" {
 					if original, ok := aliasMap[alias]; ok {
 						search = []*ast.TableName{original}
@@ -615,9 +756,10 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 
 			if found == 0 {
 				return nil, &sqlerr.Error{
-					Code:     "42703",
-					Message:  fmt.Sprintf("396: column %q does not exist", key),
-					Location: location,
+					Code:       "42703",
+					Message:    fmt.Sprintf("396: column %q does not exist", key),
+					Location:   location,
+					ColumnName: key,
 				}
 			}
 			if found > 1 {
@@ -635,3 +777,81 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 	}
 	return a, nil
 }
+
+// cteTable returns a CTE as a catalog table, so that parameters can be
+// resolved against its columns.
+func cteTable(cte *Table) catalog.Table {
+	table := catalog.Table{Rel: cte.Rel}
+	for _, col := range cte.Columns {
+		typ := ast.TypeName{Name: col.DataType}
+		if col.Type != nil {
+			typ = *col.Type
+		}
+		table.Columns = append(table.Columns, &catalog.Column{
+			Name:       col.Name,
+			Type:       typ,
+			IsNotNull:  col.NotNull,
+			IsUnsigned: col.Unsigned,
+			IsArray:    col.IsArray,
+			ArrayDims:  col.ArrayDims,
+			Length:     col.Length,
+		})
+	}
+	return table
+}
+
+// subselectTables returns a table for each aliased subselect or function in a
+// FROM clause of the statement, holding the columns it returns. Those whose
+// columns can't be determined are left out.
+func (comp *Compiler) subselectTables(qc *QueryCatalog, root ast.Node) []*Table {
+	var tables []*Table
+	subselects := astutils.Search(root, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.RangeSubselect:
+			return n.Alias != nil && n.Alias.Aliasname != nil
+		case *ast.RangeFunction:
+			return n.Alias != nil && n.Alias.Aliasname != nil
+		}
+		return false
+	})
+	for _, item := range subselects.Items {
+		switch n := item.(type) {
+		case *ast.RangeSubselect:
+			cols, err := comp.outputColumns(qc, n.Subquery)
+			if err != nil {
+				continue
+			}
+			rel := &ast.TableName{Name: *n.Alias.Aliasname}
+			tables = append(tables, &Table{Rel: rel, Columns: cols})
+		case *ast.RangeFunction:
+			if table := comp.rangeFunctionTable(qc, n, nil); table != nil {
+				tables = append(tables, table)
+			}
+		}
+	}
+	return tables
+}
+
+// scopeTables returns the tables in the FROM clause of scope, leaving out
+// those of subqueries.
+func scopeTables(scope *ast.SelectStmt, rvTables map[*ast.RangeVar]*ast.TableName) []*ast.TableName {
+	var tables []*ast.TableName
+	astutils.Walk(scopeVisitor(func(rv *ast.RangeVar) {
+		if table, ok := rvTables[rv]; ok {
+			tables = append(tables, table)
+		}
+	}), scope.FromClause)
+	return tables
+}
+
+type scopeVisitor func(*ast.RangeVar)
+
+func (v scopeVisitor) Visit(node ast.Node) astutils.Visitor {
+	switch n := node.(type) {
+	case *ast.RangeVar:
+		v(n)
+	case *ast.RangeSubselect, *ast.SubLink:
+		return nil
+	}
+	return v
+}
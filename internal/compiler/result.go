@@ -7,4 +7,19 @@ import (
 type Result struct {
 	Catalog *catalog.Catalog
 	Queries []*Query
+	// SchemaFiles and QueryFiles are the files the schema and queries were
+	// read from, in the order they were read.
+	SchemaFiles []string
+	QueryFiles  []string
+	// Warnings are written out even if the package compiled.
+	Warnings []Warning
+	// DatabaseAnalysis is set if the queries were analyzed by a database.
+	DatabaseAnalysis bool
+}
+
+// Warning is a problem that doesn't stop a package from compiling. The code
+// identifies the kind of problem, so that it can be suppressed.
+type Warning struct {
+	Code    string
+	Message string
 }
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -65,17 +67,28 @@ type Config struct {
 	Plugins   []Plugin             `json:"plugins" yaml:"plugins"`
 	Rules     []Rule               `json:"rules" yaml:"rules"`
 	Options   map[string]yaml.Node `json:"options" yaml:"options"`
+	// Strict turns warnings into errors, like the --strict flag.
+	Strict bool `json:"strict,omitempty" yaml:"strict"`
+	// SuppressWarnings lists the codes of warnings that aren't reported,
+	// even in strict mode.
+	SuppressWarnings []string `json:"suppress_warnings,omitempty" yaml:"suppress_warnings"`
 }
 
 type Server struct {
 	Name   string `json:"name,omitempty" yaml:"name"`
 	Engine Engine `json:"engine,omitempty" yaml:"engine"`
 	URI    string `json:"uri" yaml:"uri"`
+
+	// path is where the server is in the configuration, for errors
+	path string
 }
 
 type Database struct {
 	URI     string `json:"uri" yaml:"uri"`
 	Managed bool   `json:"managed" yaml:"managed"`
+
+	// path is where the database is in the configuration, for errors
+	path string
 }
 
 type Cloud struct {
@@ -95,12 +108,63 @@ type Plugin struct {
 		URL    string `json:"url" yaml:"url"`
 		SHA256 string `json:"sha256" yaml:"sha256"`
 	} `json:"wasm" yaml:"wasm"`
+	// Timeout limits how long the plugin may run, as a duration such as
+	// "30s". It defaults to DefaultPluginTimeout, and "0" disables it.
+	Timeout string `json:"timeout,omitempty" yaml:"timeout"`
+	// LogLevel is passed to the plugin in the SQLC_LOG_LEVEL environment
+	// variable. It's one of debug, info, warn or error.
+	LogLevel string `json:"log_level,omitempty" yaml:"log_level"`
+	// SchemaSource is set if the plugin needs the text of the statements
+	// that created the tables and types in the catalog.
+	SchemaSource bool `json:"schema_source,omitempty" yaml:"schema_source"`
+	// Requires lists what the plugin needs from sqlc, which is checked
+	// before the plugin is run.
+	Requires *PluginRequires `json:"requires,omitempty" yaml:"requires"`
+	// Commands are the query commands the plugin accepts besides the
+	// built-in ones, such as ":stream". Queries of packages the plugin
+	// generates code for may use them.
+	Commands []string `json:"commands,omitempty" yaml:"commands"`
+
+	// path is where the plugin is in the configuration, for errors
+	path string
+}
+
+// PluginRequires lists the version of sqlc and the features of the plugin
+// protocol a plugin needs.
+type PluginRequires struct {
+	// SQLC is a version constraint such as ">=1.27", or several separated
+	// by commas that must all hold, such as ">=1.27, <2".
+	SQLC string `json:"sqlc,omitempty" yaml:"sqlc"`
+	// Features are the names of optional parts of the request, such as
+	// index_metadata.
+	Features []string `json:"features,omitempty" yaml:"features"`
+}
+
+// DefaultPluginTimeout is how long a plugin may run if no timeout is set.
+const DefaultPluginTimeout = 2 * time.Minute
+
+// ParseTimeout returns how long the plugin may run, or zero if there is no
+// limit.
+func (p Plugin) ParseTimeout() (time.Duration, error) {
+	if p.Timeout == "" {
+		return DefaultPluginTimeout, nil
+	}
+	d, err := time.ParseDuration(p.Timeout)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("negative duration %s", p.Timeout)
+	}
+	return d, nil
 }
 
 type Rule struct {
 	Name string `json:"name" yaml:"name"`
 	Rule string `json:"rule" yaml:"rule"`
 	Msg  string `json:"message" yaml:"message"`
+	// AllowedSchemas configures the built-in sqlc/no-cross-schema rule
+	AllowedSchemas []string `json:"allowed_schemas,omitempty" yaml:"allowed_schemas"`
 }
 
 type Overrides struct {
@@ -108,17 +172,26 @@ type Overrides struct {
 }
 
 type SQL struct {
-	Name                 string    `json:"name" yaml:"name"`
-	Engine               Engine    `json:"engine,omitempty" yaml:"engine"`
-	Schema               Paths     `json:"schema" yaml:"schema"`
-	Queries              Paths     `json:"queries" yaml:"queries"`
-	Database             *Database `json:"database" yaml:"database"`
-	StrictFunctionChecks bool      `json:"strict_function_checks" yaml:"strict_function_checks"`
-	StrictOrderBy        *bool     `json:"strict_order_by" yaml:"strict_order_by"`
-	Gen                  SQLGen    `json:"gen" yaml:"gen"`
-	Codegen              []Codegen `json:"codegen" yaml:"codegen"`
-	Rules                []string  `json:"rules" yaml:"rules"`
-	Analyzer             Analyzer  `json:"analyzer" yaml:"analyzer"`
+	Name                 string        `json:"name" yaml:"name"`
+	Engine               Engine        `json:"engine,omitempty" yaml:"engine"`
+	Schema               Paths         `json:"schema" yaml:"schema"`
+	Queries              Paths         `json:"queries" yaml:"queries"`
+	Database             *Database     `json:"database" yaml:"database"`
+	StrictFunctionChecks bool          `json:"strict_function_checks" yaml:"strict_function_checks"`
+	StrictOrderBy        *bool         `json:"strict_order_by" yaml:"strict_order_by"`
+	InferNullableParams  *bool         `json:"infer_nullable_params" yaml:"infer_nullable_params"`
+	SearchPath           []string      `json:"search_path" yaml:"search_path"`
+	Gen                  SQLGen        `json:"gen" yaml:"gen"`
+	Codegen              []Codegen     `json:"codegen" yaml:"codegen"`
+	Rules                []string      `json:"rules" yaml:"rules"`
+	Analyzer             Analyzer      `json:"analyzer" yaml:"analyzer"`
+	GenerateCRUD         *GenerateCRUD `json:"generate_crud" yaml:"generate_crud"`
+}
+
+// GenerateCRUD lists the tables to synthesize the standard create, read,
+// update and delete queries of.
+type GenerateCRUD struct {
+	Tables []string `json:"tables" yaml:"tables"`
 }
 
 type Analyzer struct {
@@ -133,8 +206,9 @@ type Codegen struct {
 }
 
 type SQLGen struct {
-	Go   *golang.Options `json:"go,omitempty" yaml:"go"`
-	JSON *SQLJSON        `json:"json,omitempty" yaml:"json"`
+	Go         *golang.Options `json:"go,omitempty" yaml:"go"`
+	JSON       *SQLJSON        `json:"json,omitempty" yaml:"json"`
+	TypeScript *SQLTypeScript  `json:"typescript,omitempty" yaml:"typescript"`
 }
 
 type SQLJSON struct {
@@ -143,11 +217,22 @@ type SQLJSON struct {
 	Filename string `json:"filename,omitempty" yaml:"filename"`
 }
 
+// SQLTypeScript configures the TypeScript interfaces generated to mirror the
+// Go structs of a package.
+type SQLTypeScript struct {
+	Out      string `json:"out" yaml:"out"`
+	Filename string `json:"filename,omitempty" yaml:"filename"`
+	// Bigint is the type of 64-bit integers, either number or string
+	Bigint string `json:"bigint,omitempty" yaml:"bigint"`
+}
+
 var ErrMissingEngine = errors.New("unknown engine")
 var ErrMissingVersion = errors.New("no version number")
 var ErrNoOutPath = errors.New("no output path")
 var ErrNoPackagePath = errors.New("missing package path")
 var ErrNoPackages = errors.New("no packages")
+var ErrDuplicateGoOut = errors.New("the same queries are already generated into this directory")
+var ErrInvalidBigint = errors.New("bigint must be number or string")
 var ErrNoQuerierType = errors.New("no querier emit type enabled")
 var ErrUnknownEngine = errors.New("invalid engine")
 var ErrUnknownVersion = errors.New("invalid version number")
@@ -159,8 +244,11 @@ var ErrPluginNotFound = errors.New("no plugin found")
 var ErrPluginNoType = errors.New("plugin: field `process` or `wasm` required")
 var ErrPluginBothTypes = errors.New("plugin: `process` and `wasm` cannot both be defined")
 var ErrPluginProcessNoCmd = errors.New("plugin: missing process command")
+var ErrPluginLogLevel = errors.New("plugin: log_level must be debug, info, warn or error")
+var ErrPluginCommand = errors.New("plugin: commands must be a colon followed by a name, such as :stream")
 
 var ErrInvalidDatabase = errors.New("database must be managed or have a non-empty URI")
+var ErrInvalidSearchPath = errors.New("search_path must not contain empty schema names")
 var ErrManagedDatabaseNoProject = errors.New(`managed databases require a cloud project
 
 If you don't have a project, you can create one from the sqlc Cloud
@@ -206,6 +294,7 @@ func ParseConfig(rd io.Reader) (Config, error) {
 	if err != nil {
 		return config, err
 	}
+	config.setPaths(version.Number)
 	return config, nil
 }
 
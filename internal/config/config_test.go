@@ -23,6 +23,60 @@ const unknownFields = `{
   "foo": "bar"
 }`
 
+const invalidPluginTimeout = `{
+  "version": "2",
+  "plugins": [
+    {
+      "name": "slow",
+      "timeout": "soon",
+      "process": {"cmd": "sqlc-gen-slow"}
+    }
+  ],
+  "sql": [
+    {
+      "engine": "postgresql",
+      "schema": "schema.sql",
+      "queries": "query.sql"
+    }
+  ]
+}`
+
+const invalidPluginRequires = `{
+  "version": "2",
+  "plugins": [
+    {
+      "name": "new",
+      "requires": {"sqlc": ">=one"},
+      "process": {"cmd": "sqlc-gen-new"}
+    }
+  ],
+  "sql": [
+    {
+      "engine": "postgresql",
+      "schema": "schema.sql",
+      "queries": "query.sql"
+    }
+  ]
+}`
+
+const duplicateGoOutConfig = `{
+  "version": "2",
+  "sql": [
+    {
+      "engine": "postgresql",
+      "schema": "schema.sql",
+      "queries": "query.sql",
+      "gen": {"go": {"package": "db", "out": "db", "sql_package": "pgx/v5"}}
+    },
+    {
+      "engine": "postgresql",
+      "schema": "schema.sql",
+      "queries": "query.sql",
+      "gen": {"go": {"package": "db", "out": "./db"}}
+    }
+  ]
+}`
+
 func TestBadConfigs(t *testing.T) {
 	for _, test := range []struct {
 		name string
@@ -50,6 +104,21 @@ func TestBadConfigs(t *testing.T) {
   line 3: field foo not found in type config.V1GenerateSettings`,
 			unknownFields,
 		},
+		{
+			"invalid plugin timeout",
+			`plugin slow: invalid timeout: time: invalid duration "soon"`,
+			invalidPluginTimeout,
+		},
+		{
+			"invalid plugin requires",
+			`plugin new: invalid sqlc requirement: invalid version "one"`,
+			invalidPluginRequires,
+		},
+		{
+			"duplicate go out",
+			"sql[1].gen.go.out: the same queries are already generated into this directory by sql[0]",
+			duplicateGoOutConfig,
+		},
 	} {
 		tt := test
 		t.Run(tt.name, func(t *testing.T) {
@@ -89,3 +158,28 @@ func TestInvalidConfig(t *testing.T) {
 		t.Errorf("expected err; got nil")
 	}
 }
+
+func TestPluginRequires(t *testing.T) {
+	for _, tt := range []struct {
+		requires PluginRequires
+		err      string
+	}{
+		{PluginRequires{SQLC: ">=1.27"}, ""},
+		{PluginRequires{SQLC: ">=1.27.0, <2"}, ""},
+		{PluginRequires{SQLC: "1.27"}, ""},
+		{PluginRequires{SQLC: ">1.27"}, "requires sqlc >1.27, but this is sqlc v1.27.0"},
+		{PluginRequires{SQLC: ">=1.28"}, "requires sqlc >=1.28, but this is sqlc v1.27.0"},
+		{PluginRequires{Features: []string{"index_metadata"}}, ""},
+		{PluginRequires{Features: []string{"index_metadata", "foo"}}, "requires the foo feature, which sqlc v1.27.0 doesn't provide"},
+		{PluginRequires{Features: []string{"foo", "bar"}}, "requires the foo, bar features, which sqlc v1.27.0 doesn't provide"},
+	} {
+		err := tt.requires.Check("v1.27.0", []string{"index_metadata", "schema_source"})
+		var got string
+		if err != nil {
+			got = err.Error()
+		}
+		if diff := cmp.Diff(tt.err, got); diff != "" {
+			t.Errorf("%+v: differed (-want +got):\n%s", tt.requires, diff)
+		}
+	}
+}
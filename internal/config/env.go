@@ -4,14 +4,103 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/shfmt"
 )
 
 func (c *Config) addEnvVars() error {
 	authToken := os.Getenv("SQLC_AUTH_TOKEN")
-	if authToken != "" && !strings.HasPrefix(authToken, "sqlc_") {
+	// A token read from a file or another variable is checked once it's used
+	if authToken != "" && !strings.Contains(authToken, "${") && !strings.HasPrefix(authToken, "sqlc_") {
 		return fmt.Errorf("$SQLC_AUTH_TOKEN doesn't start with \"sqlc_\"")
 	}
 	c.Cloud.AuthToken = authToken
 
 	return nil
 }
+
+// setPaths records where the values with references in them are in a
+// configuration of the given version, so errors resolving them can say.
+func (c *Config) setPaths(version string) {
+	prefix := "sql"
+	if version == "1" {
+		prefix = "packages"
+	}
+	for i := range c.SQL {
+		if c.SQL[i].Database != nil {
+			c.SQL[i].Database.path = fmt.Sprintf("%s[%d].database", prefix, i)
+		}
+	}
+	for i := range c.Servers {
+		c.Servers[i].path = fmt.Sprintf("servers[%d]", i)
+	}
+	for i := range c.Plugins {
+		c.Plugins[i].path = fmt.Sprintf("plugins[%d]", i)
+	}
+}
+
+// Token returns the auth token, reading it from the file or environment
+// variable $SQLC_AUTH_TOKEN refers to, if it refers to one.
+func (c Cloud) Token() (string, error) {
+	if !strings.Contains(c.AuthToken, "${") {
+		return c.AuthToken, nil
+	}
+	token, err := shfmt.NewReplacer(nil).Replace(c.AuthToken)
+	if err != nil {
+		return "", fmt.Errorf("$SQLC_AUTH_TOKEN: %w", err)
+	}
+	if !strings.HasPrefix(token, "sqlc_") {
+		return "", fmt.Errorf("$SQLC_AUTH_TOKEN doesn't start with \"sqlc_\"")
+	}
+	return token, nil
+}
+
+// ResolveURI returns the URI of the database with the references to
+// environment variables and files in it replaced.
+func (d Database) ResolveURI(r *shfmt.Replacer) (string, error) {
+	return resolveURI(r, d.path, d.URI)
+}
+
+// ResolveURI returns the URI of the server with the references to
+// environment variables and files in it replaced.
+func (s Server) ResolveURI(r *shfmt.Replacer) (string, error) {
+	return resolveURI(r, s.path, s.URI)
+}
+
+func resolveURI(r *shfmt.Replacer, path, uri string) (string, error) {
+	resolved, err := r.ReplaceURI(uri)
+	if err != nil {
+		if path == "" {
+			return "", fmt.Errorf("uri: %w", err)
+		}
+		return "", fmt.Errorf("%s.uri: %w", path, err)
+	}
+	return resolved, nil
+}
+
+// Environ returns the environment of the plugin as KEY=value pairs. Each
+// entry of env is either the name of a variable to pass on from sqlc's
+// environment, or KEY=value, where the value may refer to environment
+// variables and files. A log_level is passed on as SQLC_LOG_LEVEL.
+func (p Plugin) Environ(r *shfmt.Replacer) ([]string, error) {
+	var env []string
+	for i, e := range p.Env {
+		key, value, ok := strings.Cut(e, "=")
+		if !ok {
+			env = append(env, key+"="+os.Getenv(key))
+			continue
+		}
+		resolved, err := r.Replace(value)
+		if err != nil {
+			if p.path == "" {
+				return nil, fmt.Errorf("env[%d]: %w", i, err)
+			}
+			return nil, fmt.Errorf("%s.env[%d]: %w", p.path, i, err)
+		}
+		env = append(env, key+"="+resolved)
+	}
+	if p.LogLevel != "" {
+		env = append(env, "SQLC_LOG_LEVEL="+p.LogLevel)
+	}
+	return env, nil
+}
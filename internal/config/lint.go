@@ -0,0 +1,410 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	golang "github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/constants"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// A Problem is a mistake in a configuration file. Path is where in the file
+// the mistake is, such as sql[0].gen.go, or empty if it can't be pinned down.
+type Problem struct {
+	Path    string
+	Message string
+}
+
+func (p Problem) String() string {
+	if p.Path == "" {
+		return p.Message
+	}
+	return p.Path + ": " + p.Message
+}
+
+// Lint checks a configuration file of either version, in YAML or JSON, and
+// returns all the problems found in it rather than only the first. Besides
+// the checks of ParseConfig, it reports unknown keys with suggestions for
+// what was meant, invalid options of the Go code generator and overrides, and
+// schema and query paths, relative to dir, that don't match any files. An
+// error is only returned if data can't be parsed at all.
+func Lint(dir string, data []byte) ([]Problem, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	l := &linter{dir: dir}
+	if len(doc.Content) == 0 {
+		l.report("version", ErrMissingVersion)
+		return l.problems, nil
+	}
+	root := doc.Content[0]
+
+	var version versionSetting
+	if err := root.Decode(&version); err != nil {
+		return nil, err
+	}
+	switch version.Number {
+	case "":
+		l.report("version", ErrMissingVersion)
+	case "1":
+		l.unknownKeys("", root, reflect.TypeOf(V1GenerateSettings{}))
+		var settings V1GenerateSettings
+		l.decode(root, &settings)
+		l.lintV1(&settings)
+	case "2":
+		l.unknownKeys("", root, reflect.TypeOf(Config{}))
+		var conf Config
+		l.decode(root, &conf)
+		l.lintV2(&conf)
+	default:
+		l.reportf("version", "%s %q", ErrUnknownVersion, version.Number)
+	}
+	return l.problems, nil
+}
+
+type linter struct {
+	dir      string
+	problems []Problem
+}
+
+func (l *linter) report(path string, err error) {
+	l.problems = append(l.problems, Problem{Path: path, Message: err.Error()})
+}
+
+func (l *linter) reportf(path, format string, args ...any) {
+	l.problems = append(l.problems, Problem{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// decode decodes node into v, reporting each value of the wrong type.
+func (l *linter) decode(node *yaml.Node, v any) {
+	err := node.Decode(v)
+	var typeErr *yaml.TypeError
+	switch {
+	case err == nil:
+	case errors.As(err, &typeErr):
+		for _, msg := range typeErr.Errors {
+			l.reportf("", "%s", msg)
+		}
+	default:
+		l.report("", err)
+	}
+}
+
+// unknownKeys reports the keys of the mappings in node that don't match a
+// field of typ, the type the node is decoded into.
+func (l *linter) unknownKeys(path string, node *yaml.Node, typ reflect.Type) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	switch {
+	case typ == reflect.TypeOf(yaml.Node{}):
+		// Options passed through to plugins, which only they know
+	case typ.Kind() == reflect.Struct && node.Kind == yaml.MappingNode:
+		fields := yamlFields(typ)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			field, ok := fields[key]
+			if !ok {
+				known := make([]string, 0, len(fields))
+				for name := range fields {
+					known = append(known, name)
+				}
+				if s := suggest(key, known); s != "" {
+					l.reportf(joinPath(path, key), "unknown key %q, did you mean %q?", key, s)
+				} else {
+					l.reportf(joinPath(path, key), "unknown key %q", key)
+				}
+				continue
+			}
+			l.unknownKeys(joinPath(path, key), node.Content[i+1], field)
+		}
+	case typ.Kind() == reflect.Slice && node.Kind == yaml.SequenceNode:
+		for i, item := range node.Content {
+			l.unknownKeys(fmt.Sprintf("%s[%d]", path, i), item, typ.Elem())
+		}
+	case typ.Kind() == reflect.Map && node.Kind == yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			l.unknownKeys(joinPath(path, node.Content[i].Value), node.Content[i+1], typ.Elem())
+		}
+	}
+}
+
+// yamlFields returns the types of the fields of a struct by the keys they
+// are decoded from.
+func yamlFields(typ reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() || f.Tag.Get("json") == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = strings.ToLower(f.Name)
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// suggest returns the candidate closest to name, if it's close enough to
+// have been meant, or an empty string.
+func suggest(name string, candidates []string) string {
+	sort.Strings(candidates)
+	normalize := func(s string) string {
+		return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(s))
+	}
+	best, bestDist := "", -1
+	for _, c := range candidates {
+		d := editDistance(normalize(name), normalize(c))
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	limit := 2
+	if len(name) <= 4 {
+		limit = 1
+	}
+	if bestDist < 0 || bestDist > limit {
+		return ""
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func (l *linter) lintV1(settings *V1GenerateSettings) {
+	if len(settings.Packages) == 0 {
+		l.report("packages", ErrNoPackages)
+	}
+	if err := settings.ValidateGlobalOverrides(); err != nil {
+		l.report("overrides", err)
+	}
+	for i, o := range settings.Overrides {
+		l.override(fmt.Sprintf("overrides[%d]", i), o)
+	}
+	rules := l.rules(settings.Rules)
+	for j := range settings.Packages {
+		pkg := &settings.Packages[j]
+		if pkg.Name == "" {
+			pkg.Name = filepath.Base(pkg.Path)
+		}
+		if pkg.Engine == "" {
+			pkg.Engine = EnginePostgreSQL
+		}
+	}
+	conf := settings.Translate()
+	for j, pkg := range settings.Packages {
+		path := fmt.Sprintf("packages[%d]", j)
+		l.sql(path, conf.SQL[j], rules)
+		if pkg.Path == "" {
+			l.report(path+".path", ErrNoPackagePath)
+		} else {
+			l.goOptions(path, *conf.SQL[j].Gen.Go)
+		}
+	}
+}
+
+func (l *linter) lintV2(conf *Config) {
+	if len(conf.SQL) == 0 {
+		l.report("sql", ErrNoPackages)
+	}
+	if err := conf.validateGlobalOverrides(); err != nil {
+		l.report("overrides.go", err)
+	}
+	if conf.Overrides.Go != nil {
+		for i, o := range conf.Overrides.Go.Overrides {
+			l.override(fmt.Sprintf("overrides.go.overrides[%d]", i), o)
+		}
+	}
+	plugins := map[string]struct{}{}
+	for i, p := range conf.Plugins {
+		if err := validatePlugin(p, plugins); err != nil {
+			l.report(fmt.Sprintf("plugins[%d]", i), err)
+		}
+		if p.Name != "" {
+			plugins[p.Name] = struct{}{}
+		}
+	}
+	rules := l.rules(conf.Rules)
+	for j, pkg := range conf.SQL {
+		path := fmt.Sprintf("sql[%d]", j)
+		l.sql(path, pkg, rules)
+		if pkg.Gen.Go != nil {
+			if pkg.Gen.Go.Out == "" {
+				l.report(path+".gen.go.out", ErrNoPackagePath)
+			} else if i := duplicateGoOut(conf.SQL, j); i >= 0 {
+				l.reportf(path+".gen.go.out", "%s by sql[%d]", ErrDuplicateGoOut, i)
+			} else {
+				l.goOptions(path+".gen.go", *pkg.Gen.Go)
+			}
+		}
+		if pkg.Gen.JSON != nil && pkg.Gen.JSON.Out == "" {
+			l.report(path+".gen.json.out", ErrNoOutPath)
+		}
+		if pkg.Gen.TypeScript != nil {
+			if err := validateTypeScript(*pkg.Gen.TypeScript); errors.Is(err, ErrNoOutPath) {
+				l.report(path+".gen.typescript.out", err)
+			} else if err != nil {
+				l.report(path+".gen.typescript.bigint", err)
+			}
+		}
+		for k, cg := range pkg.Codegen {
+			cgPath := fmt.Sprintf("%s.codegen[%d]", path, k)
+			err := validateCodegen(cg, plugins)
+			if !errors.Is(err, ErrPluginNotFound) {
+				if err != nil {
+					l.report(cgPath, err)
+				}
+				continue
+			}
+			names := make([]string, 0, len(plugins))
+			for name := range plugins {
+				names = append(names, name)
+			}
+			if s := suggest(cg.Plugin, names); s != "" {
+				l.reportf(cgPath+".plugin", "unknown plugin %q, did you mean %q?", cg.Plugin, s)
+			} else {
+				l.reportf(cgPath+".plugin", "unknown plugin %q", cg.Plugin)
+			}
+		}
+	}
+}
+
+// rules returns the names of the built-in and the declared vet rules.
+func (l *linter) rules(declared []Rule) map[string]bool {
+	rules := map[string]bool{}
+	for _, name := range constants.QueryRules {
+		rules[name] = true
+	}
+	for i, r := range declared {
+		if r.Name == "" {
+			l.reportf(fmt.Sprintf("rules[%d]", i), "missing rule name")
+			continue
+		}
+		rules[r.Name] = true
+	}
+	return rules
+}
+
+// sql checks the settings shared by the packages of both versions.
+func (l *linter) sql(path string, pkg SQL, rules map[string]bool) {
+	switch pkg.Engine {
+	case "":
+		l.report(path+".engine", ErrMissingEngine)
+	case EngineMySQL, EnginePostgreSQL, EngineSQLite:
+	default:
+		engines := []string{string(EngineMySQL), string(EnginePostgreSQL), string(EngineSQLite)}
+		if s := suggest(string(pkg.Engine), engines); s != "" {
+			l.reportf(path+".engine", "%s %q, did you mean %q?", ErrUnknownEngine, pkg.Engine, s)
+		} else {
+			l.reportf(path+".engine", "%s %q", ErrUnknownEngine, pkg.Engine)
+		}
+	}
+	l.paths(path+".schema", pkg.Schema)
+	l.paths(path+".queries", pkg.Queries)
+	if pkg.Database != nil && pkg.Database.URI == "" && !pkg.Database.Managed {
+		l.report(path+".database", ErrInvalidDatabase)
+	}
+	for i, schema := range pkg.SearchPath {
+		if schema == "" {
+			l.report(fmt.Sprintf("%s.search_path[%d]", path, i), ErrInvalidSearchPath)
+		}
+	}
+	for i, rule := range pkg.Rules {
+		if !rules[rule] {
+			l.reportf(fmt.Sprintf("%s.rules[%d]", path, i), "unknown rule %q", rule)
+		}
+	}
+}
+
+// paths reports schema or query paths that don't match any SQL files.
+func (l *linter) paths(path string, paths Paths) {
+	if len(paths) == 0 {
+		l.reportf(path, "no paths")
+		return
+	}
+	files, err := sqlpath.Glob(sqlpath.Join(l.dir, paths))
+	switch {
+	case err != nil:
+		l.report(path, err)
+	case len(files) == 0:
+		l.reportf(path, "no .sql files found")
+	}
+}
+
+// goOptions checks the options of the Go code generator the way the
+// generator does, with each of their overrides checked separately.
+func (l *linter) goOptions(path string, options golang.Options) {
+	overrides := options.Overrides
+	options.Overrides = nil
+	if err := parseGoOptions(&options); err != nil {
+		l.reportf(path, "%s", strings.TrimPrefix(err.Error(), "invalid options: "))
+	}
+	for i, o := range overrides {
+		l.override(fmt.Sprintf("%s.overrides[%d]", path, i), o)
+	}
+}
+
+func (l *linter) override(path string, o golang.Override) {
+	if err := parseGoOptions(&golang.Options{Package: "lint", Overrides: []golang.Override{o}}); err != nil {
+		l.report(path, err)
+	}
+}
+
+func parseGoOptions(options *golang.Options) error {
+	blob, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+	parsed, err := golang.Parse(&plugin.GenerateRequest{
+		Catalog:       &plugin.Catalog{DefaultSchema: "public"},
+		PluginOptions: blob,
+	})
+	if err != nil {
+		return err
+	}
+	return golang.ValidateOpts(parsed)
+}
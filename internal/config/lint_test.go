@@ -0,0 +1,121 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const lintV2 = `
+version: "2"
+plugins:
+  - name: greeter
+    process:
+      cmd: sqlc-gen-greeter
+sql:
+  - engine: postgresq
+    schema: schema.sql
+    queries: missing
+    rules: [no-delete]
+    gen:
+      go:
+        package: db
+        out: db
+        emit_json_tag: true
+        emit_tagged_interfaces: true
+        overrides:
+          - go_type: string
+          - go_type: string
+            column: authors.name
+    codegen:
+      - plugin: greter
+        out: gen
+`
+
+const lintV1 = `{
+  "version": "1",
+  "packages": [
+    {
+      "path": "db",
+      "engine": "mysql",
+      "schema": "schema.sql",
+      "queries": "schema.sql",
+      "emitInterface": true,
+      "overrides": [{"go_type": "string", "colunm": "authors.name"}]
+    }
+  ]
+}`
+
+const lintClean = `
+version: "2"
+sql:
+  - engine: sqlite
+    schema: schema.sql
+    queries: schema.sql
+    gen:
+      go:
+        package: db
+        out: db
+`
+
+func TestLint(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "schema.sql"), []byte("CREATE TABLE authors (name text);"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		config   string
+		problems []string
+	}{
+		{
+			"v2",
+			lintV2,
+			[]string{
+				`sql[0].gen.go.emit_json_tag: unknown key "emit_json_tag", did you mean "emit_json_tags"?`,
+				`sql[0].engine: invalid engine "postgresq", did you mean "postgresql"?`,
+				`sql[0].queries: path error: stat ` + filepath.Join(dir, "missing") + `: no such file or directory`,
+				`sql[0].rules[0]: unknown rule "no-delete"`,
+				`sql[0].gen.go: emit_tagged_interfaces requires emit_interface`,
+				"sql[0].gen.go.overrides[0]: Override must specify one of either `column` or `db_type`",
+				`sql[0].codegen[0].plugin: unknown plugin "greter", did you mean "greeter"?`,
+			},
+		},
+		{
+			"v1",
+			lintV1,
+			[]string{
+				`packages[0].emitInterface: unknown key "emitInterface", did you mean "emit_interface"?`,
+				`packages[0].overrides[0].colunm: unknown key "colunm", did you mean "column"?`,
+				"packages[0].overrides[0]: Override must specify one of either `column` or `db_type`",
+			},
+		},
+		{
+			"clean",
+			lintClean,
+			nil,
+		},
+		{
+			"unknown version",
+			`version: "3"`,
+			[]string{`version: invalid version number "3"`},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := Lint(dir, []byte(tt.config))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, p := range problems {
+				got = append(got, p.String())
+			}
+			if diff := cmp.Diff(tt.problems, got); diff != "" {
+				t.Errorf("problems differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Check returns an error if sqlc, at the given version and filling in the
+// given features of the request, doesn't meet the requirements.
+func (r *PluginRequires) Check(version string, features []string) error {
+	if r.SQLC != "" {
+		constraints, err := parseVersionConstraints(r.SQLC)
+		if err != nil {
+			return fmt.Errorf("invalid sqlc requirement: %w", err)
+		}
+		v, err := parseVersion(version)
+		if err != nil {
+			return err
+		}
+		for _, c := range constraints {
+			if !c.matches(v) {
+				return fmt.Errorf("requires sqlc %s, but this is sqlc %s", r.SQLC, version)
+			}
+		}
+	}
+
+	provided := map[string]bool{}
+	for _, f := range features {
+		provided[f] = true
+	}
+	var missing []string
+	for _, f := range r.Features {
+		if !provided[f] {
+			missing = append(missing, f)
+		}
+	}
+	switch len(missing) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("requires the %s feature, which sqlc %s doesn't provide", missing[0], version)
+	default:
+		return fmt.Errorf("requires the %s features, which sqlc %s doesn't provide", strings.Join(missing, ", "), version)
+	}
+}
+
+type versionConstraint struct {
+	op      string
+	version []int
+}
+
+func (c versionConstraint) matches(v []int) bool {
+	cmp := compareVersions(v, c.version)
+	switch c.op {
+	case ">=":
+		return cmp >= 0
+	case ">":
+		return cmp > 0
+	case "<=":
+		return cmp <= 0
+	case "<":
+		return cmp < 0
+	default: // "=" and "=="
+		return cmp == 0
+	}
+}
+
+// parseVersionConstraints parses comparisons such as ">=1.27" separated by
+// commas. A version without an operator has to match exactly.
+func parseVersionConstraints(s string) ([]versionConstraint, error) {
+	var constraints []versionConstraint
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		op := "="
+		for _, o := range []string{">=", "<=", "==", ">", "<", "="} {
+			if strings.HasPrefix(part, o) {
+				op = o
+				part = strings.TrimSpace(strings.TrimPrefix(part, o))
+				break
+			}
+		}
+		v, err := parseVersion(part)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, versionConstraint{op: op, version: v})
+	}
+	return constraints, nil
+}
+
+// parseVersion parses a version such as 1.27 or v1.27.0, ignoring any
+// pre-release or build suffix.
+func parseVersion(s string) ([]int, error) {
+	trimmed := strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(trimmed, "-+"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	parts := strings.Split(trimmed, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid version %q", s)
+	}
+	v := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %q", s)
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+// compareVersions compares versions part by part, treating missing parts as
+// zero, so that 1.27 and 1.27.0 are equal.
+func compareVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
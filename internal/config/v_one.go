@@ -17,48 +17,83 @@ type V1GenerateSettings struct {
 	Overrides []golang.Override   `json:"overrides,omitempty" yaml:"overrides,omitempty"`
 	Rename    map[string]string   `json:"rename,omitempty" yaml:"rename,omitempty"`
 	Rules     []Rule              `json:"rules" yaml:"rules"`
+	// Strict and SuppressWarnings are those of Config
+	Strict           bool     `json:"strict,omitempty" yaml:"strict"`
+	SuppressWarnings []string `json:"suppress_warnings,omitempty" yaml:"suppress_warnings"`
 }
 
 type v1PackageSettings struct {
-	Name                      string            `json:"name" yaml:"name"`
-	Engine                    Engine            `json:"engine,omitempty" yaml:"engine"`
-	Database                  *Database         `json:"database,omitempty" yaml:"database"`
-	Analyzer                  Analyzer          `json:"analyzer" yaml:"analyzer"`
-	Path                      string            `json:"path" yaml:"path"`
-	Schema                    Paths             `json:"schema" yaml:"schema"`
-	Queries                   Paths             `json:"queries" yaml:"queries"`
-	EmitInterface             bool              `json:"emit_interface" yaml:"emit_interface"`
-	EmitJSONTags              bool              `json:"emit_json_tags" yaml:"emit_json_tags"`
-	JsonTagsIDUppercase       bool              `json:"json_tags_id_uppercase" yaml:"json_tags_id_uppercase"`
-	EmitDBTags                bool              `json:"emit_db_tags" yaml:"emit_db_tags"`
-	EmitPreparedQueries       bool              `json:"emit_prepared_queries" yaml:"emit_prepared_queries"`
-	EmitExactTableNames       bool              `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
-	EmitEmptySlices           bool              `json:"emit_empty_slices,omitempty" yaml:"emit_empty_slices"`
-	EmitExportedQueries       bool              `json:"emit_exported_queries,omitempty" yaml:"emit_exported_queries"`
-	EmitResultStructPointers  bool              `json:"emit_result_struct_pointers" yaml:"emit_result_struct_pointers"`
-	EmitParamsStructPointers  bool              `json:"emit_params_struct_pointers" yaml:"emit_params_struct_pointers"`
-	EmitMethodsWithDBArgument bool              `json:"emit_methods_with_db_argument" yaml:"emit_methods_with_db_argument"`
-	EmitPointersForNullTypes  bool              `json:"emit_pointers_for_null_types" yaml:"emit_pointers_for_null_types"`
-	EmitEnumValidMethod       bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
-	EmitAllEnumValues         bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
-	EmitSqlAsComment          bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
-	JSONTagsCaseStyle         string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
-	SQLPackage                string            `json:"sql_package" yaml:"sql_package"`
-	SQLDriver                 string            `json:"sql_driver" yaml:"sql_driver"`
-	Overrides                 []golang.Override `json:"overrides" yaml:"overrides"`
-	OutputBatchFileName       string            `json:"output_batch_file_name,omitempty" yaml:"output_batch_file_name"`
-	OutputDBFileName          string            `json:"output_db_file_name,omitempty" yaml:"output_db_file_name"`
-	OutputModelsFileName      string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
-	OutputQuerierFileName     string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
-	OutputCopyFromFileName    string            `json:"output_copyfrom_file_name,omitempty" yaml:"output_copyfrom_file_name"`
-	OutputFilesSuffix         string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
-	StrictFunctionChecks      bool              `json:"strict_function_checks" yaml:"strict_function_checks"`
-	StrictOrderBy             *bool             `json:"strict_order_by" yaml:"strict_order_by"`
-	QueryParameterLimit       *int32            `json:"query_parameter_limit,omitempty" yaml:"query_parameter_limit"`
-	OmitSqlcVersion           bool              `json:"omit_sqlc_version,omitempty" yaml:"omit_sqlc_version"`
-	OmitUnusedStructs         bool              `json:"omit_unused_structs,omitempty" yaml:"omit_unused_structs"`
-	Rules                     []string          `json:"rules" yaml:"rules"`
-	BuildTags                 string            `json:"build_tags,omitempty" yaml:"build_tags"`
+	Name                       string            `json:"name" yaml:"name"`
+	Engine                     Engine            `json:"engine,omitempty" yaml:"engine"`
+	Database                   *Database         `json:"database,omitempty" yaml:"database"`
+	Analyzer                   Analyzer          `json:"analyzer" yaml:"analyzer"`
+	Path                       string            `json:"path" yaml:"path"`
+	Schema                     Paths             `json:"schema" yaml:"schema"`
+	Queries                    Paths             `json:"queries" yaml:"queries"`
+	EmitInterface              bool              `json:"emit_interface" yaml:"emit_interface"`
+	EmitJSONTags               bool              `json:"emit_json_tags" yaml:"emit_json_tags"`
+	JsonTagsIDUppercase        bool              `json:"json_tags_id_uppercase" yaml:"json_tags_id_uppercase"`
+	EmitDBTags                 bool              `json:"emit_db_tags" yaml:"emit_db_tags"`
+	EmitCommentTags            bool              `json:"emit_comment_tags" yaml:"emit_comment_tags"`
+	EmitPreparedQueries        bool              `json:"emit_prepared_queries" yaml:"emit_prepared_queries"`
+	EmitExactTableNames        bool              `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
+	EmitEmptySlices            bool              `json:"emit_empty_slices,omitempty" yaml:"emit_empty_slices"`
+	EmitExportedQueries        bool              `json:"emit_exported_queries,omitempty" yaml:"emit_exported_queries"`
+	EmitExportedQueryConstants bool              `json:"emit_exported_query_constants,omitempty" yaml:"emit_exported_query_constants"`
+	EmitQueriesByName          bool              `json:"emit_queries_by_name,omitempty" yaml:"emit_queries_by_name"`
+	EmitNotFoundErrors         bool              `json:"emit_not_found_errors,omitempty" yaml:"emit_not_found_errors"`
+	EmitManifest               bool              `json:"emit_manifest,omitempty" yaml:"emit_manifest"`
+	EmitFake                   bool              `json:"emit_fake,omitempty" yaml:"emit_fake"`
+	EmitResultStructPointers   bool              `json:"emit_result_struct_pointers" yaml:"emit_result_struct_pointers"`
+	EmitParamsStructPointers   bool              `json:"emit_params_struct_pointers" yaml:"emit_params_struct_pointers"`
+	EmitMethodsWithDBArgument  bool              `json:"emit_methods_with_db_argument" yaml:"emit_methods_with_db_argument"`
+	EmitMethodsWithoutContext  bool              `json:"emit_methods_without_context,omitempty" yaml:"emit_methods_without_context"`
+	EmitPointersForNullTypes   bool              `json:"emit_pointers_for_null_types" yaml:"emit_pointers_for_null_types"`
+	EmitEnumValidMethod        bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
+	EmitAllEnumValues          bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
+	EmitCheckConstraintEnums   bool              `json:"emit_check_constraint_enums,omitempty" yaml:"emit_check_constraint_enums"`
+	EmitSqlAsComment           bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
+	EmitSourceLineComments     bool              `json:"emit_source_line_comments,omitempty" yaml:"emit_source_line_comments"`
+	EmitIteratorQueries        bool              `json:"emit_iterator_queries,omitempty" yaml:"emit_iterator_queries"`
+	EmitPaginationHelpers      bool              `json:"emit_pagination_helpers,omitempty" yaml:"emit_pagination_helpers"`
+	EmitScanTargets            bool              `json:"emit_scan_targets,omitempty" yaml:"emit_scan_targets"`
+	EmitOtelTracing            bool              `json:"emit_otel_tracing,omitempty" yaml:"emit_otel_tracing"`
+	EmitOtelDbStatement        bool              `json:"emit_otel_db_statement,omitempty" yaml:"emit_otel_db_statement"`
+	EmitQueryHooks             bool              `json:"emit_query_hooks,omitempty" yaml:"emit_query_hooks"`
+	EmitJSONSchema             string            `json:"emit_json_schema,omitempty" yaml:"emit_json_schema"`
+	JSONSchemaDefault          map[string]any    `json:"json_schema_default,omitempty" yaml:"json_schema_default"`
+	EmitDbComments             *bool             `json:"emit_db_comments,omitempty" yaml:"emit_db_comments"`
+	DocCommentNamePrefix       bool              `json:"doc_comment_name_prefix,omitempty" yaml:"doc_comment_name_prefix"`
+	DocCommentWrap             int               `json:"doc_comment_wrap,omitempty" yaml:"doc_comment_wrap"`
+	EmbedPointerForNullable    bool              `json:"embed_pointer_for_nullable,omitempty" yaml:"embed_pointer_for_nullable"`
+	EmitTaggedInterfaces       bool              `json:"emit_tagged_interfaces,omitempty" yaml:"emit_tagged_interfaces"`
+	EmitExactUnsignedTypes     bool              `json:"emit_exact_unsigned_types,omitempty" yaml:"emit_exact_unsigned_types"`
+	UseNamedArgs               bool              `json:"use_named_args,omitempty" yaml:"use_named_args"`
+	JSONTagsCaseStyle          string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
+	SQLPackage                 string            `json:"sql_package" yaml:"sql_package"`
+	SQLDriver                  string            `json:"sql_driver" yaml:"sql_driver"`
+	JSONType                   string            `json:"json_type,omitempty" yaml:"json_type"`
+	Overrides                  []golang.Override `json:"overrides" yaml:"overrides"`
+	StrictOverrides            bool              `json:"strict_overrides,omitempty" yaml:"strict_overrides"`
+	FollowRenames              bool              `json:"follow_renames,omitempty" yaml:"follow_renames"`
+	OutputBatchFileName        string            `json:"output_batch_file_name,omitempty" yaml:"output_batch_file_name"`
+	OutputDBFileName           string            `json:"output_db_file_name,omitempty" yaml:"output_db_file_name"`
+	OutputModelsFileName       string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
+	OutputModelsFileMode       string            `json:"output_models_file_mode,omitempty" yaml:"output_models_file_mode"`
+	OutputQuerierFileName      string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
+	OutputCopyFromFileName     string            `json:"output_copyfrom_file_name,omitempty" yaml:"output_copyfrom_file_name"`
+	CopyFromChunkSize          int               `json:"copyfrom_chunk_size,omitempty" yaml:"copyfrom_chunk_size"`
+	OutputFilesSuffix          string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
+	OutputStructNamePrefix     string            `json:"output_struct_name_prefix,omitempty" yaml:"output_struct_name_prefix"`
+	OutputStructNameSuffix     string            `json:"output_struct_name_suffix,omitempty" yaml:"output_struct_name_suffix"`
+	StrictFunctionChecks       bool              `json:"strict_function_checks" yaml:"strict_function_checks"`
+	StrictOrderBy              *bool             `json:"strict_order_by" yaml:"strict_order_by"`
+	InferNullableParams        *bool             `json:"infer_nullable_params" yaml:"infer_nullable_params"`
+	QueryParameterLimit        *int32            `json:"query_parameter_limit,omitempty" yaml:"query_parameter_limit"`
+	OmitSqlcVersion            bool              `json:"omit_sqlc_version,omitempty" yaml:"omit_sqlc_version"`
+	OmitUnusedStructs          bool              `json:"omit_unused_structs,omitempty" yaml:"omit_unused_structs"`
+	Rules                      []string          `json:"rules" yaml:"rules"`
+	BuildTags                  string            `json:"build_tags,omitempty" yaml:"build_tags"`
 }
 
 func v1ParseConfig(rd io.Reader) (Config, error) {
@@ -117,9 +152,11 @@ func (c *V1GenerateSettings) ValidateGlobalOverrides() error {
 
 func (c *V1GenerateSettings) Translate() Config {
 	conf := Config{
-		Version: c.Version,
-		Cloud:   c.Cloud,
-		Rules:   c.Rules,
+		Version:          c.Version,
+		Cloud:            c.Cloud,
+		Rules:            c.Rules,
+		Strict:           c.Strict,
+		SuppressWarnings: c.SuppressWarnings,
 	}
 
 	for _, pkg := range c.Packages {
@@ -137,41 +174,73 @@ func (c *V1GenerateSettings) Translate() Config {
 			Analyzer: pkg.Analyzer,
 			Gen: SQLGen{
 				Go: &golang.Options{
-					EmitInterface:             pkg.EmitInterface,
-					EmitJsonTags:              pkg.EmitJSONTags,
-					JsonTagsIdUppercase:       pkg.JsonTagsIDUppercase,
-					EmitDbTags:                pkg.EmitDBTags,
-					EmitPreparedQueries:       pkg.EmitPreparedQueries,
-					EmitExactTableNames:       pkg.EmitExactTableNames,
-					EmitEmptySlices:           pkg.EmitEmptySlices,
-					EmitExportedQueries:       pkg.EmitExportedQueries,
-					EmitResultStructPointers:  pkg.EmitResultStructPointers,
-					EmitParamsStructPointers:  pkg.EmitParamsStructPointers,
-					EmitMethodsWithDbArgument: pkg.EmitMethodsWithDBArgument,
-					EmitPointersForNullTypes:  pkg.EmitPointersForNullTypes,
-					EmitEnumValidMethod:       pkg.EmitEnumValidMethod,
-					EmitAllEnumValues:         pkg.EmitAllEnumValues,
-					EmitSqlAsComment:          pkg.EmitSqlAsComment,
-					Package:                   pkg.Name,
-					Out:                       pkg.Path,
-					SqlPackage:                pkg.SQLPackage,
-					SqlDriver:                 pkg.SQLDriver,
-					Overrides:                 pkg.Overrides,
-					JsonTagsCaseStyle:         pkg.JSONTagsCaseStyle,
-					OutputBatchFileName:       pkg.OutputBatchFileName,
-					OutputDbFileName:          pkg.OutputDBFileName,
-					OutputModelsFileName:      pkg.OutputModelsFileName,
-					OutputQuerierFileName:     pkg.OutputQuerierFileName,
-					OutputCopyfromFileName:    pkg.OutputCopyFromFileName,
-					OutputFilesSuffix:         pkg.OutputFilesSuffix,
-					QueryParameterLimit:       pkg.QueryParameterLimit,
-					OmitSqlcVersion:           pkg.OmitSqlcVersion,
-					OmitUnusedStructs:         pkg.OmitUnusedStructs,
-					BuildTags:                 pkg.BuildTags,
+					EmitInterface:              pkg.EmitInterface,
+					EmitJsonTags:               pkg.EmitJSONTags,
+					JsonTagsIdUppercase:        pkg.JsonTagsIDUppercase,
+					EmitDbTags:                 pkg.EmitDBTags,
+					EmitCommentTags:            pkg.EmitCommentTags,
+					EmitPreparedQueries:        pkg.EmitPreparedQueries,
+					EmitExactTableNames:        pkg.EmitExactTableNames,
+					EmitEmptySlices:            pkg.EmitEmptySlices,
+					EmitExportedQueries:        pkg.EmitExportedQueries,
+					EmitExportedQueryConstants: pkg.EmitExportedQueryConstants,
+					EmitQueriesByName:          pkg.EmitQueriesByName,
+					EmitNotFoundErrors:         pkg.EmitNotFoundErrors,
+					EmitManifest:               pkg.EmitManifest,
+					EmitFake:                   pkg.EmitFake,
+					EmitResultStructPointers:   pkg.EmitResultStructPointers,
+					EmitParamsStructPointers:   pkg.EmitParamsStructPointers,
+					EmitMethodsWithDbArgument:  pkg.EmitMethodsWithDBArgument,
+					EmitMethodsWithoutContext:  pkg.EmitMethodsWithoutContext,
+					EmitPointersForNullTypes:   pkg.EmitPointersForNullTypes,
+					EmitEnumValidMethod:        pkg.EmitEnumValidMethod,
+					EmitAllEnumValues:          pkg.EmitAllEnumValues,
+					EmitCheckConstraintEnums:   pkg.EmitCheckConstraintEnums,
+					EmitSqlAsComment:           pkg.EmitSqlAsComment,
+					EmitSourceLineComments:     pkg.EmitSourceLineComments,
+					EmitIteratorQueries:        pkg.EmitIteratorQueries,
+					EmitPaginationHelpers:      pkg.EmitPaginationHelpers,
+					EmitScanTargets:            pkg.EmitScanTargets,
+					EmitOtelTracing:            pkg.EmitOtelTracing,
+					EmitOtelDbStatement:        pkg.EmitOtelDbStatement,
+					EmitQueryHooks:             pkg.EmitQueryHooks,
+					EmitJsonSchema:             pkg.EmitJSONSchema,
+					JsonSchemaDefault:          pkg.JSONSchemaDefault,
+					EmitDbComments:             pkg.EmitDbComments,
+					DocCommentNamePrefix:       pkg.DocCommentNamePrefix,
+					DocCommentWrap:             pkg.DocCommentWrap,
+					EmbedPointerForNullable:    pkg.EmbedPointerForNullable,
+					EmitTaggedInterfaces:       pkg.EmitTaggedInterfaces,
+					EmitExactUnsignedTypes:     pkg.EmitExactUnsignedTypes,
+					UseNamedArgs:               pkg.UseNamedArgs,
+					Package:                    pkg.Name,
+					Out:                        pkg.Path,
+					SqlPackage:                 pkg.SQLPackage,
+					SqlDriver:                  pkg.SQLDriver,
+					JsonType:                   pkg.JSONType,
+					Overrides:                  pkg.Overrides,
+					StrictOverrides:            pkg.StrictOverrides,
+					FollowRenames:              pkg.FollowRenames,
+					JsonTagsCaseStyle:          pkg.JSONTagsCaseStyle,
+					OutputBatchFileName:        pkg.OutputBatchFileName,
+					OutputDbFileName:           pkg.OutputDBFileName,
+					OutputModelsFileName:       pkg.OutputModelsFileName,
+					OutputModelsFileMode:       pkg.OutputModelsFileMode,
+					OutputQuerierFileName:      pkg.OutputQuerierFileName,
+					OutputCopyfromFileName:     pkg.OutputCopyFromFileName,
+					CopyfromChunkSize:          pkg.CopyFromChunkSize,
+					OutputFilesSuffix:          pkg.OutputFilesSuffix,
+					OutputStructNamePrefix:     pkg.OutputStructNamePrefix,
+					OutputStructNameSuffix:     pkg.OutputStructNameSuffix,
+					QueryParameterLimit:        pkg.QueryParameterLimit,
+					OmitSqlcVersion:            pkg.OmitSqlcVersion,
+					OmitUnusedStructs:          pkg.OmitUnusedStructs,
+					BuildTags:                  pkg.BuildTags,
 				},
 			},
 			StrictFunctionChecks: pkg.StrictFunctionChecks,
 			StrictOrderBy:        pkg.StrictOrderBy,
+			InferNullableParams:  pkg.InferNullableParams,
 		})
 	}
 
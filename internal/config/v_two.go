@@ -3,6 +3,9 @@ package config
 import (
 	"fmt"
 	"io"
+	"path/filepath"
+	"regexp"
+	"slices"
 
 	yaml "gopkg.in/yaml.v3"
 )
@@ -26,69 +29,158 @@ func v2ParseConfig(rd io.Reader) (Config, error) {
 	if err := conf.validateGlobalOverrides(); err != nil {
 		return conf, err
 	}
-	// TODO: Store built-in plugins somewhere else
-	builtins := map[string]struct{}{
-		"go":   {},
-		"json": {},
-	}
 	plugins := map[string]struct{}{}
 	for i := range conf.Plugins {
-		if conf.Plugins[i].Name == "" {
-			return conf, ErrPluginNoName
-		}
-		if _, ok := builtins[conf.Plugins[i].Name]; ok {
-			return conf, ErrPluginBuiltin
-		}
-		if _, ok := plugins[conf.Plugins[i].Name]; ok {
-			return conf, ErrPluginExists
-		}
-		if conf.Plugins[i].Process == nil && conf.Plugins[i].WASM == nil {
-			return conf, ErrPluginNoType
-		}
-		if conf.Plugins[i].Process != nil && conf.Plugins[i].WASM != nil {
-			return conf, ErrPluginBothTypes
-		}
-		if conf.Plugins[i].Process != nil {
-			if conf.Plugins[i].Process.Cmd == "" {
-				return conf, ErrPluginProcessNoCmd
-			}
+		if err := validatePlugin(conf.Plugins[i], plugins); err != nil {
+			return conf, err
 		}
 		plugins[conf.Plugins[i].Name] = struct{}{}
 	}
 	for j := range conf.SQL {
-		if conf.SQL[j].Engine == "" {
-			return conf, ErrMissingEngine
-		}
-		if conf.SQL[j].Gen.Go != nil {
-			if conf.SQL[j].Gen.Go.Out == "" {
-				return conf, ErrNoPackagePath
-			}
-		}
-		if conf.SQL[j].Gen.JSON != nil {
-			if conf.SQL[j].Gen.JSON.Out == "" {
-				return conf, ErrNoOutPath
-			}
-		}
-		for _, cg := range conf.SQL[j].Codegen {
-			if cg.Plugin == "" {
-				return conf, ErrPluginNoName
-			}
-			if cg.Out == "" {
-				return conf, ErrNoOutPath
-			}
-			// TODO: Allow the use of built-in codegen from here
-			if _, ok := plugins[cg.Plugin]; !ok {
-				return conf, ErrPluginNotFound
-			}
+		if err := validateSQL(conf.SQL[j], plugins); err != nil {
+			return conf, err
 		}
 		if conf.SQL[j].StrictOrderBy == nil {
 			defaultValidate := true
 			conf.SQL[j].StrictOrderBy = &defaultValidate
 		}
+		if i := duplicateGoOut(conf.SQL, j); i >= 0 {
+			return conf, fmt.Errorf("sql[%d].gen.go.out: %w by sql[%d]", j, ErrDuplicateGoOut, i)
+		}
 	}
 	return conf, nil
 }
 
+// TODO: Store built-in plugins somewhere else
+var builtinPlugins = map[string]struct{}{
+	"go":   {},
+	"json": {},
+}
+
+var pluginCommand = regexp.MustCompile(`^:[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validatePlugin checks a plugin, given the names of the plugins declared
+// before it.
+func validatePlugin(plugin Plugin, plugins map[string]struct{}) error {
+	if plugin.Name == "" {
+		return ErrPluginNoName
+	}
+	if _, ok := builtinPlugins[plugin.Name]; ok {
+		return ErrPluginBuiltin
+	}
+	if _, ok := plugins[plugin.Name]; ok {
+		return ErrPluginExists
+	}
+	if plugin.Process == nil && plugin.WASM == nil {
+		return ErrPluginNoType
+	}
+	if plugin.Process != nil && plugin.WASM != nil {
+		return ErrPluginBothTypes
+	}
+	if plugin.Process != nil {
+		if plugin.Process.Cmd == "" {
+			return ErrPluginProcessNoCmd
+		}
+	}
+	switch plugin.LogLevel {
+	case "", "debug", "info", "warn", "error":
+	default:
+		return ErrPluginLogLevel
+	}
+	for _, cmd := range plugin.Commands {
+		if !pluginCommand.MatchString(cmd) {
+			return ErrPluginCommand
+		}
+	}
+	if _, err := plugin.ParseTimeout(); err != nil {
+		return fmt.Errorf("plugin %s: invalid timeout: %w", plugin.Name, err)
+	}
+	if req := plugin.Requires; req != nil && req.SQLC != "" {
+		if _, err := parseVersionConstraints(req.SQLC); err != nil {
+			return fmt.Errorf("plugin %s: invalid sqlc requirement: %w", plugin.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateTypeScript(ts SQLTypeScript) error {
+	if ts.Out == "" {
+		return ErrNoOutPath
+	}
+	switch ts.Bigint {
+	case "", "number", "string":
+		return nil
+	}
+	return ErrInvalidBigint
+}
+
+// validateSQL checks a package, given the names of the declared plugins.
+func validateSQL(sql SQL, plugins map[string]struct{}) error {
+	if sql.Engine == "" {
+		return ErrMissingEngine
+	}
+	if sql.Gen.Go != nil {
+		if sql.Gen.Go.Out == "" {
+			return ErrNoPackagePath
+		}
+	}
+	if sql.Gen.JSON != nil {
+		if sql.Gen.JSON.Out == "" {
+			return ErrNoOutPath
+		}
+	}
+	if sql.Gen.TypeScript != nil {
+		if err := validateTypeScript(*sql.Gen.TypeScript); err != nil {
+			return err
+		}
+	}
+	for _, cg := range sql.Codegen {
+		if err := validateCodegen(cg, plugins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// duplicateGoOut returns the index of the first package before pkgs[j] that
+// generates Go code from the same schema and queries into the same directory,
+// or -1 if there is none. A package may be repeated to generate its queries
+// with different options, but each copy needs a directory of its own.
+func duplicateGoOut(pkgs []SQL, j int) int {
+	pkg := pkgs[j]
+	if pkg.Gen.Go == nil {
+		return -1
+	}
+	for i, other := range pkgs[:j] {
+		if other.Gen.Go == nil || other.Engine != pkg.Engine {
+			continue
+		}
+		if !slices.Equal(other.Schema, pkg.Schema) || !slices.Equal(other.Queries, pkg.Queries) {
+			continue
+		}
+		if filepath.Clean(other.Gen.Go.Out) == filepath.Clean(pkg.Gen.Go.Out) {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateCodegen checks a codegen entry, given the names of the declared
+// plugins.
+func validateCodegen(cg Codegen, plugins map[string]struct{}) error {
+	if cg.Plugin == "" {
+		return ErrPluginNoName
+	}
+	if cg.Out == "" {
+		return ErrNoOutPath
+	}
+	// TODO: Allow the use of built-in codegen from here
+	if _, ok := plugins[cg.Plugin]; !ok {
+		return ErrPluginNotFound
+	}
+	return nil
+}
+
 func (c *Config) validateGlobalOverrides() error {
 	engines := map[Engine]struct{}{}
 	for _, pkg := range c.SQL {
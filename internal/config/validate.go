@@ -7,6 +7,11 @@ func Validate(c *Config) error {
 				return ErrInvalidDatabase
 			}
 		}
+		for _, schema := range sql.SearchPath {
+			if schema == "" {
+				return ErrInvalidSearchPath
+			}
+		}
 	}
 	return nil
 }
@@ -4,9 +4,24 @@ package constants
 const (
 	QueryFlagParam          = "@param"
 	QueryFlagSqlcVetDisable = "@sqlc-vet-disable"
+	QueryFlagColumns        = "@columns"
+	QueryFlagDeprecated     = "@deprecated"
 )
 
 // Rules
 const (
-	QueryRuleDbPrepare = "sqlc/db-prepare"
+	QueryRuleDbPrepare            = "sqlc/db-prepare"
+	QueryRuleNoSelectStar         = "sqlc/no-select-star"
+	QueryRuleManyRequiresLimit    = "sqlc/many-requires-limit"
+	QueryRuleNoDeleteWithoutWhere = "sqlc/no-delete-without-where"
+	QueryRuleNoCrossSchema        = "sqlc/no-cross-schema"
 )
+
+// QueryRules are the names of the built-in vet rules.
+var QueryRules = []string{
+	QueryRuleDbPrepare,
+	QueryRuleNoSelectStar,
+	QueryRuleManyRequiresLimit,
+	QueryRuleNoDeleteWithoutWhere,
+	QueryRuleNoCrossSchema,
+}
@@ -2,6 +2,7 @@ package dbmanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"io"
@@ -37,6 +38,7 @@ type ManagedClient struct {
 	cache    *poolcache.Cache
 	replacer *shfmt.Replacer
 	servers  []config.Server
+	mysql    mysqlServers
 }
 
 func dbid(migrations []string) string {
@@ -65,19 +67,26 @@ func (m *ManagedClient) CreateDatabase(ctx context.Context, req *CreateDatabaseR
 		return nil, fmt.Errorf("unsupported engine: %s", engine)
 	}
 
-	var base string
-	for _, server := range m.servers {
-		if server.Engine == engine {
-			base = server.URI
+	var base *config.Server
+	for i := range m.servers {
+		if m.servers[i].Engine == engine {
+			base = &m.servers[i]
 			break
 		}
 	}
 
-	if strings.TrimSpace(base) == "" {
-		return nil, fmt.Errorf("no PostgreSQL database server found")
+	if base == nil || strings.TrimSpace(base.URI) == "" {
+		return nil, fmt.Errorf("no %s database server found", engine)
+	}
+
+	serverUri, err := base.ResolveURI(m.replacer)
+	if err != nil {
+		return nil, err
+	}
+	if engine == config.EngineMySQL {
+		return m.mysql.createDatabase(ctx, serverUri, name, req.Migrations)
 	}
 
-	serverUri := m.replacer.Replace(base)
 	pool, err := m.cache.Open(ctx, serverUri)
 	if err != nil {
 		return nil, err
@@ -85,7 +94,12 @@ func (m *ManagedClient) CreateDatabase(ctx context.Context, req *CreateDatabaseR
 
 	uri, err := url.Parse(serverUri)
 	if err != nil {
-		return nil, err
+		// Don't echo the URI, which may contain a password
+		var uerr *url.Error
+		if errors.As(err, &uerr) {
+			err = uerr.Err
+		}
+		return nil, fmt.Errorf("invalid %s server uri: %w", engine, err)
 	}
 	uri.Path = "/" + name
 
@@ -137,7 +151,10 @@ func (m *ManagedClient) CreateDatabase(ctx context.Context, req *CreateDatabaseR
 	return &CreateDatabaseResponse{Uri: key}, err
 }
 
+// Close drops the MySQL databases created by the client. PostgreSQL databases
+// are kept, and reused by later runs with the same migrations.
 func (m *ManagedClient) Close(ctx context.Context) {
+	m.mysql.close(context.WithoutCancel(ctx))
 	m.cache.Close()
 }
 
@@ -15,6 +15,7 @@ type Testcase struct {
 	Path       string
 	ConfigName string
 	Stderr     []byte
+	Stdout     []byte
 	Exec       *Exec
 }
 
@@ -29,6 +30,10 @@ type Exec struct {
 	OS       []string          `json:"os"`
 	Env      map[string]string `json:"env"`
 	Meta     ExecMeta          `json:"meta"`
+	// Format is the --format flag of the diff, generate and vet commands,
+	// and IncludePatch the --include-patch flag of diff
+	Format       string `json:"format"`
+	IncludePatch bool   `json:"include_patch"`
 }
 
 func parseStderr(t *testing.T, dir, testctx string) []byte {
@@ -50,6 +55,19 @@ func parseStderr(t *testing.T, dir, testctx string) []byte {
 	return nil
 }
 
+func parseStdout(t *testing.T, dir string) []byte {
+	t.Helper()
+	path := filepath.Join(dir, "stdout.txt")
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return blob
+}
+
 func parseExec(t *testing.T, dir string) *Exec {
 	t.Helper()
 	path := filepath.Join(dir, "exec.json")
@@ -83,6 +101,7 @@ func FindTests(t *testing.T, root, testctx string) []*Testcase {
 				Name:       strings.TrimPrefix(dir, root+string(filepath.Separator)),
 				ConfigName: info.Name(),
 				Stderr:     parseStderr(t, dir, testctx),
+				Stdout:     parseStdout(t, dir),
 				Exec:       parseExec(t, dir),
 			})
 			return filepath.SkipDir
@@ -3,6 +3,9 @@ package main
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
 	osexec "os/exec"
 	"path/filepath"
@@ -172,7 +175,7 @@ func TestReplay(t *testing.T) {
 		for _, replay := range FindTests(t, "testdata", name) {
 			tc := replay
 			t.Run(filepath.Join(name, tc.Name), func(t *testing.T) {
-				var stderr bytes.Buffer
+				var stderr, stdout bytes.Buffer
 				var output map[string]string
 				var err error
 
@@ -213,19 +216,30 @@ func TestReplay(t *testing.T) {
 
 				switch args.Command {
 				case "diff":
+					opts.Stdout = &stdout
+					opts.DiffFormat = args.Format
+					opts.DiffPatch = args.IncludePatch
 					err = cmd.Diff(ctx, path, "", &opts)
 				case "generate":
+					opts.Stdout = &stdout
+					opts.Format = args.Format
 					output, err = cmd.Generate(ctx, path, "", &opts)
 					if err == nil {
 						cmpDirectory(t, path, output)
 					}
 				case "vet":
+					opts.Stdout = &stdout
+					opts.Format = args.Format
 					err = cmd.Vet(ctx, path, "", &opts)
 				default:
 					t.Fatalf("unknown command")
 				}
 
-				if len(expected) == 0 && err != nil {
+				// A diff or problems written to stdout have nothing to say on
+				// stderr
+				diffFound := tc.Stdout != nil && errors.Is(err, cmd.ErrDiffFound)
+				problemsFound := tc.Stdout != nil && args.Format == cmd.FormatJSON
+				if len(expected) == 0 && err != nil && !diffFound && !problemsFound {
 					t.Fatalf("sqlc %s failed: %s", args.Command, stderr.String())
 				}
 
@@ -237,6 +251,11 @@ func TestReplay(t *testing.T) {
 				if diff != "" {
 					t.Fatalf("stderr differed (-want +got):\n%s", diff)
 				}
+				if tc.Stdout != nil {
+					if diff := cmp.Diff(string(tc.Stdout), stdout.String()); diff != "" {
+						t.Fatalf("stdout differed (-want +got):\n%s", diff)
+					}
+				}
 			})
 		}
 	}
@@ -251,7 +270,7 @@ func cmpDirectory(t *testing.T, dir string, actual map[string]string) {
 		if file.IsDir() {
 			return nil
 		}
-		if !strings.HasSuffix(path, ".go") && !strings.HasSuffix(path, ".kt") && !strings.HasSuffix(path, ".py") && !strings.HasSuffix(path, ".json") && !strings.HasSuffix(path, ".txt") {
+		if !strings.HasSuffix(path, ".go") && !strings.HasSuffix(path, ".kt") && !strings.HasSuffix(path, ".py") && !strings.HasSuffix(path, ".ts") && !strings.HasSuffix(path, ".json") && !strings.HasSuffix(path, ".txt") && !strings.HasSuffix(path, ".manifest") {
 			return nil
 		}
 		// TODO: Figure out a better way to ignore certain files
@@ -337,3 +356,165 @@ func BenchmarkReplay(b *testing.B) {
 		})
 	}
 }
+
+func TestJobs(t *testing.T) {
+	ctx := context.Background()
+	path, err := filepath.Abs(filepath.Join("testdata", "parallel_packages", "postgresql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	generate := func(jobs int, mutate func(*config.Config)) (map[string]string, string) {
+		var stderr bytes.Buffer
+		output, _ := cmd.Generate(ctx, path, "", &cmd.Options{
+			Env:          cmd.Env{},
+			Stderr:       &stderr,
+			Jobs:         jobs,
+			MutateConfig: mutate,
+		})
+		return output, stderr.String()
+	}
+	// Every package fails, so their errors must be reported in config order
+	// regardless of which finishes first
+	broken := func(c *config.Config) {
+		for i := range c.SQL {
+			c.SQL[i].Queries = []string{"schema.sql"}
+		}
+	}
+
+	want, _ := generate(1, nil)
+	_, wantStderr := generate(1, broken)
+	if wantStderr == "" {
+		t.Fatal("expected errors from the broken configuration")
+	}
+	for _, jobs := range []int{2, runtime.GOMAXPROCS(0) * 2} {
+		for i := 0; i < 5; i++ {
+			got, _ := generate(jobs, nil)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("jobs=%d: output differed (-want +got):\n%s", jobs, diff)
+			}
+			_, gotStderr := generate(jobs, broken)
+			if diff := cmp.Diff(wantStderr, gotStderr); diff != "" {
+				t.Errorf("jobs=%d: stderr differed (-want +got):\n%s", jobs, diff)
+			}
+		}
+	}
+}
+
+func TestGenerateCache(t *testing.T) {
+	ctx := context.Background()
+	path := t.TempDir()
+	if err := copyDir(path, filepath.Join("testdata", "parallel_packages", "postgresql")); err != nil {
+		t.Fatal(err)
+	}
+	cacheDir := t.TempDir()
+	generate := func() (map[string]string, *cmd.GenerateCache) {
+		var stderr bytes.Buffer
+		cache := cmd.NewGenerateCache(cacheDir)
+		output, err := cmd.Generate(ctx, path, "", &cmd.Options{
+			Env:    cmd.Env{},
+			Stderr: &stderr,
+			Cache:  cache,
+		})
+		if err != nil {
+			t.Fatal(stderr.String())
+		}
+		for filename, source := range output {
+			if err := os.WriteFile(filename, []byte(source), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := cache.Save(); err != nil {
+			t.Fatal(err)
+		}
+		return output, cache
+	}
+
+	all, _ := generate()
+	if len(all) == 0 {
+		t.Fatal("expected generated files")
+	}
+	for _, tc := range []struct {
+		name    string
+		change  func() error
+		summary string
+	}{
+		{
+			name:    "unchanged",
+			change:  func() error { return nil },
+			summary: "6 packages up to date, 0 regenerated",
+		},
+		{
+			name: "query changed",
+			change: func() error {
+				f, err := os.OpenFile(filepath.Join(path, "books.sql"), os.O_APPEND|os.O_WRONLY, 0644)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				_, err = f.WriteString("\n")
+				return err
+			},
+			summary: "2 packages up to date, 4 regenerated",
+		},
+		{
+			name: "output removed",
+			change: func() error {
+				return os.Remove(filepath.Join(path, "go", "authors1", "models.go"))
+			},
+			summary: "5 packages up to date, 1 regenerated",
+		},
+	} {
+		if err := tc.change(); err != nil {
+			t.Fatal(err)
+		}
+		_, cache := generate()
+		if diff := cmp.Diff(tc.summary, cache.Summary()); diff != "" {
+			t.Errorf("%s: summary mismatch:\n%s", tc.name, diff)
+		}
+	}
+}
+
+// copyDir copies the files in src to dst.
+func copyDir(dst, src string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		blob, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, blob, 0644)
+	})
+}
+
+func BenchmarkJobs(b *testing.B) {
+	ctx := context.Background()
+	path, err := filepath.Abs(filepath.Join("testdata", "parallel_packages", "postgresql"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, jobs := range []int{1, max(2, runtime.GOMAXPROCS(0))} {
+		b.Run(fmt.Sprintf("jobs=%d", jobs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var stderr bytes.Buffer
+				opts := &cmd.Options{
+					Env:    cmd.Env{},
+					Stderr: &stderr,
+					Jobs:   jobs,
+				}
+				if _, err := cmd.Generate(ctx, path, "", opts); err != nil {
+					b.Fatal(stderr.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+-- name: CategorySummary :one
+SELECT group_concat(name) AS names, count(*) AS products, count(discount) AS discounted
+FROM products
+WHERE category = ?;
+
+-- name: CategorySummaries :many
+SELECT category, group_concat(name) AS names, group_concat(discount) AS discounts, count(*) AS products
+FROM products
+GROUP BY category;
+
+-- name: CategoryPrices :one
+SELECT max(price) AS max_price, min(discount) AS min_discount, sum(price) AS total_price, sum(weight) AS total_weight
+FROM products
+WHERE category = ?;
+
+-- name: CategoryPricesGrouped :many
+SELECT category, max(price) AS max_price, max(discount) AS max_discount, sum(price) AS total_price
+FROM products
+GROUP BY category;
@@ -0,0 +1,8 @@
+CREATE TABLE products (
+  id       BIGINT PRIMARY KEY AUTO_INCREMENT,
+  category TEXT NOT NULL,
+  name     TEXT NOT NULL,
+  price    INT NOT NULL,
+  discount INT,
+  weight   DOUBLE NOT NULL
+);
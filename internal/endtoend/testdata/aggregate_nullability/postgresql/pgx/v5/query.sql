@@ -0,0 +1,17 @@
+-- name: CategorySummary :one
+SELECT max(price) AS max_price, min(price) AS min_price, sum(price) AS total, avg(price) AS average,
+       string_agg(name, ',') AS names, count(*) AS products, count(discount) AS discounted
+FROM products
+WHERE category = $1;
+
+-- name: CategorySummaries :many
+SELECT category, max(price) AS max_price, min(discount) AS min_discount, sum(price) AS total,
+       string_agg(name, ',') AS names, count(*) AS products,
+       max(price) FILTER (WHERE discount IS NOT NULL) AS max_discounted_price
+FROM products
+GROUP BY category;
+
+-- name: CategoryMaxPrice :one
+SELECT coalesce(max(price), 0) AS max_price
+FROM products
+WHERE category = $1;
@@ -0,0 +1,7 @@
+CREATE TABLE products (
+  id       BIGSERIAL PRIMARY KEY,
+  category TEXT NOT NULL,
+  name     TEXT NOT NULL,
+  price    INTEGER NOT NULL,
+  discount INTEGER
+);
@@ -0,0 +1,13 @@
+-- name: InsertValues :batchone
+INSERT INTO myschema.foo (a, b, tags)
+VALUES ($1, $2, $3)
+RETURNING a;
+
+-- name: GetValues :batchmany
+-- Lists the rows with the given b
+SELECT *
+FROM myschema.foo
+WHERE b = $1;
+
+-- name: UpdateValues :batchexec
+UPDATE myschema.foo SET a = $1, b = $2;
@@ -0,0 +1,12 @@
+-- name: InsertValues :batchone
+INSERT INTO foo (a, b)
+VALUES (?, ?)
+RETURNING *;
+
+-- name: GetValues :batchmany
+SELECT a
+FROM foo
+WHERE b = ?;
+
+-- name: DeleteValues :batchexec
+DELETE FROM foo WHERE b = ?;
@@ -0,0 +1 @@
+CREATE TABLE foo (a text, b integer NOT NULL);
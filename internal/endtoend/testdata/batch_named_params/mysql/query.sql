@@ -0,0 +1,4 @@
+-- name: UpdateAuthors :batchexec
+UPDATE authors
+SET bio = coalesce(sqlc.narg(bio), bio), name = sqlc.arg(name)
+WHERE id = sqlc.arg(id) OR name = sqlc.arg(name);
@@ -0,0 +1,13 @@
+-- name: UpdateAuthors :batchexec
+UPDATE authors
+SET bio = coalesce(sqlc.narg(bio), bio),
+    age = sqlc.narg(age)
+WHERE id = sqlc.arg(id) AND name = sqlc.arg(name);
+
+-- name: ListAuthors :batchmany
+SELECT * FROM authors
+WHERE name = $2 AND (bio = $1 OR bio IS NULL) AND age > $3;
+
+-- name: GetAuthor :batchone
+SELECT * FROM authors
+WHERE name = @name AND bio = sqlc.narg(bio) AND id = @id;
@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL,
+  bio  TEXT,
+  age  INT
+);
@@ -0,0 +1,3 @@
+-- name: ListAuthors :batchmany
+SELECT * FROM authors
+WHERE name = sqlc.arg(name) AND id IN (sqlc.slice(ids));
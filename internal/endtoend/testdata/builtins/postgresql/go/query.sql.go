@@ -13,9 +13,9 @@ const abs = `-- name: Abs :one
 SELECT abs(-17.4)
 `
 
-func (q *Queries) Abs(ctx context.Context) (int64, error) {
+func (q *Queries) Abs(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, abs)
-	var abs int64
+	var abs string
 	err := row.Scan(&abs)
 	return abs, err
 }
@@ -35,9 +35,9 @@ const ceil = `-- name: Ceil :one
 SELECT ceil(-42.8)
 `
 
-func (q *Queries) Ceil(ctx context.Context) (float64, error) {
+func (q *Queries) Ceil(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, ceil)
-	var ceil float64
+	var ceil string
 	err := row.Scan(&ceil)
 	return ceil, err
 }
@@ -46,9 +46,9 @@ const ceiling = `-- name: Ceiling :one
 SELECT ceiling(-95.3)
 `
 
-func (q *Queries) Ceiling(ctx context.Context) (float64, error) {
+func (q *Queries) Ceiling(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, ceiling)
-	var ceiling float64
+	var ceiling string
 	err := row.Scan(&ceiling)
 	return ceiling, err
 }
@@ -79,9 +79,9 @@ const exp = `-- name: Exp :one
 SELECT exp(1.0)
 `
 
-func (q *Queries) Exp(ctx context.Context) (float64, error) {
+func (q *Queries) Exp(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, exp)
-	var exp float64
+	var exp string
 	err := row.Scan(&exp)
 	return exp, err
 }
@@ -90,9 +90,9 @@ const floor = `-- name: Floor :one
 SELECT floor(-42.8)
 `
 
-func (q *Queries) Floor(ctx context.Context) (float64, error) {
+func (q *Queries) Floor(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, floor)
-	var floor float64
+	var floor string
 	err := row.Scan(&floor)
 	return floor, err
 }
@@ -101,9 +101,9 @@ const ln = `-- name: Ln :one
 SELECT ln(2.0)
 `
 
-func (q *Queries) Ln(ctx context.Context) (float64, error) {
+func (q *Queries) Ln(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, ln)
-	var ln float64
+	var ln string
 	err := row.Scan(&ln)
 	return ln, err
 }
@@ -112,9 +112,9 @@ const log = `-- name: Log :one
 SELECT log(100.0)
 `
 
-func (q *Queries) Log(ctx context.Context) (float64, error) {
+func (q *Queries) Log(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, log)
-	var log float64
+	var log string
 	err := row.Scan(&log)
 	return log, err
 }
@@ -134,9 +134,9 @@ const mod = `-- name: Mod :one
 SELECT mod(9,4)
 `
 
-func (q *Queries) Mod(ctx context.Context) (int64, error) {
+func (q *Queries) Mod(ctx context.Context) (int32, error) {
 	row := q.db.QueryRowContext(ctx, mod)
-	var mod int64
+	var mod int32
 	err := row.Scan(&mod)
 	return mod, err
 }
@@ -156,9 +156,9 @@ const power = `-- name: Power :one
 SELECT power(9.0, 3.0)
 `
 
-func (q *Queries) Power(ctx context.Context) (float64, error) {
+func (q *Queries) Power(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, power)
-	var power float64
+	var power string
 	err := row.Scan(&power)
 	return power, err
 }
@@ -178,9 +178,9 @@ const round = `-- name: Round :one
 SELECT round(42.4)
 `
 
-func (q *Queries) Round(ctx context.Context) (float64, error) {
+func (q *Queries) Round(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, round)
-	var round float64
+	var round string
 	err := row.Scan(&round)
 	return round, err
 }
@@ -211,9 +211,9 @@ const sign = `-- name: Sign :one
 SELECT sign(-8.4)
 `
 
-func (q *Queries) Sign(ctx context.Context) (float64, error) {
+func (q *Queries) Sign(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, sign)
-	var sign float64
+	var sign string
 	err := row.Scan(&sign)
 	return sign, err
 }
@@ -222,9 +222,9 @@ const sqrt = `-- name: Sqrt :one
 SELECT sqrt(2.0)
 `
 
-func (q *Queries) Sqrt(ctx context.Context) (float64, error) {
+func (q *Queries) Sqrt(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, sqrt)
-	var sqrt float64
+	var sqrt string
 	err := row.Scan(&sqrt)
 	return sqrt, err
 }
@@ -233,9 +233,9 @@ const trunc = `-- name: Trunc :one
 SELECT trunc(42.8)
 `
 
-func (q *Queries) Trunc(ctx context.Context) (float64, error) {
+func (q *Queries) Trunc(ctx context.Context) (string, error) {
 	row := q.db.QueryRowContext(ctx, trunc)
-	var trunc float64
+	var trunc string
 	err := row.Scan(&trunc)
 	return trunc, err
 }
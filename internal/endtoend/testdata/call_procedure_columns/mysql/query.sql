@@ -0,0 +1,10 @@
+-- name: GetUserOrders :many
+-- @columns id BIGINT NOT NULL, total DECIMAL(10,2) NOT NULL, note VARCHAR(255)
+CALL get_user_orders(?, @order_count);
+
+-- name: GetOrder :one
+-- @columns id BIGINT NOT NULL, total DECIMAL(10,2) NOT NULL
+CALL get_order(sqlc.arg(id));
+
+-- name: CountUserOrders :exec
+CALL get_user_orders(?, @order_count);
@@ -0,0 +1,21 @@
+CREATE TABLE orders (
+  id      BIGINT        NOT NULL PRIMARY KEY,
+  user_id BIGINT        NOT NULL,
+  total   DECIMAL(10,2) NOT NULL,
+  note    VARCHAR(255)
+);
+
+DELIMITER ;;
+CREATE PROCEDURE get_user_orders(IN uid BIGINT, OUT order_count INT)
+BEGIN
+  SELECT id, total, note FROM orders WHERE user_id = uid;
+  SET order_count = (SELECT COUNT(*) FROM orders WHERE user_id = uid);
+END ;;
+DELIMITER ;
+
+DELIMITER //
+CREATE PROCEDURE get_order(IN order_id BIGINT)
+BEGIN
+  SELECT id, total FROM orders WHERE id = order_id;
+END //
+DELIMITER ;
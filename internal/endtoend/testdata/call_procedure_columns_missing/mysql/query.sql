@@ -0,0 +1,6 @@
+-- name: GetUserOrders :many
+CALL get_user_orders(?);
+
+-- name: ListOrders :many
+-- @columns id BIGINT NOT NULL
+SELECT id FROM orders;
@@ -0,0 +1,9 @@
+CREATE TABLE orders (
+  id      BIGINT NOT NULL PRIMARY KEY,
+  user_id BIGINT NOT NULL
+);
+
+CREATE PROCEDURE get_user_orders(IN uid BIGINT)
+BEGIN
+  SELECT id FROM orders WHERE user_id = uid;
+END;
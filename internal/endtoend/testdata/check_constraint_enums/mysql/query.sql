@@ -0,0 +1,7 @@
+-- name: ListUsersByStatus :many
+SELECT * FROM users
+WHERE status = ?;
+
+-- name: GetUserRole :one
+SELECT role FROM users
+WHERE id = ?;
@@ -0,0 +1,8 @@
+CREATE TABLE users (
+  id     BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  status VARCHAR(20) NOT NULL CHECK (status IN ('active', 'disabled')),
+  role   VARCHAR(20) CHECK (CHAR_LENGTH(role) > 0),
+  locale VARCHAR(20) NOT NULL CHECK (locale NOT IN ('xx', 'yy')),
+  plan   VARCHAR(20) NOT NULL CHECK (plan IN ('free', 'pro')),
+  CONSTRAINT users_role_chk CHECK (role IN ('admin', 'member'))
+);
@@ -0,0 +1,11 @@
+-- name: ListUsersByStatus :many
+SELECT * FROM users
+WHERE status = $1;
+
+-- name: GetUserRole :one
+SELECT role FROM users
+WHERE id = $1;
+
+-- name: CreateInvite :one
+INSERT INTO invites (state, kind, channel, priority) VALUES ($1, $2, $3, $4)
+RETURNING *;
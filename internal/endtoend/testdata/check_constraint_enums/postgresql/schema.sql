@@ -0,0 +1,23 @@
+CREATE TABLE users (
+  id     BIGSERIAL PRIMARY KEY,
+  status text NOT NULL CHECK (status IN ('active', 'disabled')),
+  role   text CHECK (length(role) > 0),
+  theme  text NOT NULL,
+  locale text NOT NULL CHECK (locale NOT IN ('xx')),
+  plan   text NOT NULL CHECK (plan IN ('free', 'pro')),
+  CONSTRAINT users_role_check_values CHECK (role IN ('admin', 'member')),
+  CHECK (theme = ANY (ARRAY['light'::text, 'dark'::text]))
+);
+
+CREATE TABLE invites (
+  id       BIGSERIAL PRIMARY KEY,
+  state    text NOT NULL,
+  kind     text NOT NULL,
+  channel  text NOT NULL CHECK (channel IN ('web', 'api')),
+  priority integer NOT NULL CHECK (priority IN ('1', '2'))
+);
+
+ALTER TABLE invites ADD CONSTRAINT invites_state_check CHECK (state IN ('pending', 'accepted'));
+ALTER TABLE invites ADD CONSTRAINT invites_kind_check CHECK (kind IN ('email', 'link'));
+ALTER TABLE invites DROP CONSTRAINT invites_kind_check;
+ALTER TABLE invites DROP CONSTRAINT invites_channel_check;
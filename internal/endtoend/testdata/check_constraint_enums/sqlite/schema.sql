@@ -0,0 +1,8 @@
+CREATE TABLE users (
+  id     INTEGER PRIMARY KEY,
+  status TEXT NOT NULL CHECK (status IN ('active', 'disabled')),
+  role   TEXT CHECK (length(role) > 0),
+  locale TEXT NOT NULL CHECK (locale NOT IN ('xx', 'yy')),
+  plan   TEXT NOT NULL CHECK (plan IN ('free', 'pro')),
+  CHECK (role IN ('admin', 'member'))
+);
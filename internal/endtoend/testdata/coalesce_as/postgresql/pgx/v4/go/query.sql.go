@@ -8,6 +8,8 @@ package querytest
 import (
 	"context"
 	"database/sql"
+
+	"github.com/jackc/pgtype"
 )
 
 const sumBaz = `-- name: SumBaz :many
@@ -18,7 +20,7 @@ GROUP BY 1
 
 type SumBazRow struct {
 	Bar      sql.NullString
-	Quantity interface{}
+	Quantity pgtype.Numeric
 }
 
 func (q *Queries) SumBaz(ctx context.Context) ([]SumBazRow, error) {
@@ -18,7 +18,7 @@ GROUP BY 1
 
 type SumBazRow struct {
 	Bar      sql.NullString
-	Quantity interface{}
+	Quantity float64
 }
 
 func (q *Queries) SumBaz(ctx context.Context) ([]SumBazRow, error) {
@@ -0,0 +1,27 @@
+-- name: ListNames :many
+SELECT
+    COALESCE(nickname, email, 'anonymous') AS display_name,
+    COALESCE(nickname, name) AS nickname_or_name,
+    COALESCE(nickname, email) AS nickname_or_email,
+    COALESCE(nickname, NULL) AS nickname_or_null,
+    COALESCE(nickname, CAST(? AS CHAR)) AS nickname_or_cast_param,
+    COALESCE(nickname, CAST('anon' AS CHAR)) AS nickname_or_cast
+FROM users;
+
+-- name: ListCases :many
+SELECT
+    CASE WHEN nickname IS NULL THEN name ELSE nickname END AS maybe_nickname,
+    CASE WHEN nickname IS NULL THEN name ELSE 'has nickname' END AS name_or_literal,
+    CASE WHEN nickname IS NULL THEN NULL ELSE 'has nickname' END AS null_branch,
+    CASE WHEN id > ? THEN 'big' WHEN id > 10 THEN 'medium' ELSE 'small' END AS size,
+    CASE WHEN id > 10 THEN COALESCE(nickname, name) ELSE 'small' END AS nested,
+    NULLIF(name, '') AS name_or_null
+FROM users;
+
+-- name: ListJoined :many
+SELECT
+    COALESCE(profiles.bio, 'none') AS bio_or_none,
+    COALESCE(profiles.bio, users.name) AS bio_or_name,
+    COALESCE(profiles.bio, users.nickname) AS bio_or_nickname
+FROM users
+LEFT JOIN profiles ON profiles.user_id = users.id;
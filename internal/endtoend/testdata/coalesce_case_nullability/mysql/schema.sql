@@ -0,0 +1,11 @@
+CREATE TABLE users (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name TEXT NOT NULL,
+    nickname TEXT,
+    email TEXT
+);
+
+CREATE TABLE profiles (
+    user_id BIGINT NOT NULL,
+    bio TEXT NOT NULL
+);
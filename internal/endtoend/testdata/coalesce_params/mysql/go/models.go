@@ -19,15 +19,22 @@ const (
 )
 
 func (e *CalendarMaincalendar) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = CalendarMaincalendar(s)
+		s = string(v)
 	case string:
-		*e = CalendarMaincalendar(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for CalendarMaincalendar: %T", src)
 	}
-	return nil
+	switch CalendarMaincalendar(s) {
+	case CalendarMaincalendarTrue,
+		CalendarMaincalendarFalse:
+		*e = CalendarMaincalendar(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for CalendarMaincalendar: %q", s)
 }
 
 type NullCalendarMaincalendar struct {
@@ -35,14 +42,22 @@ type NullCalendarMaincalendar struct {
 	Valid                bool // Valid is true if CalendarMaincalendar is not NULL
 }
 
+// NewNullCalendarMaincalendar returns a valid NullCalendarMaincalendar holding e.
+func NewNullCalendarMaincalendar(e CalendarMaincalendar) NullCalendarMaincalendar {
+	return NullCalendarMaincalendar{CalendarMaincalendar: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullCalendarMaincalendar) Scan(value interface{}) error {
 	if value == nil {
 		ns.CalendarMaincalendar, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.CalendarMaincalendar.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.CalendarMaincalendar.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
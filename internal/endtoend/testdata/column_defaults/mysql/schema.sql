@@ -0,0 +1,13 @@
+CREATE TABLE events (
+  id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  kind VARCHAR(20) NOT NULL DEFAULT 'info',
+  note TEXT,
+  attempts INT NOT NULL DEFAULT -1 COMMENT 'retries',
+  label VARCHAR(20) DEFAULT 'it''s',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  updated_at DATETIME DEFAULT now()
+);
+
+ALTER TABLE events ALTER COLUMN note SET DEFAULT 'none';
+ALTER TABLE events ALTER COLUMN label DROP DEFAULT;
+ALTER TABLE events ADD COLUMN flags INT NOT NULL DEFAULT 0;
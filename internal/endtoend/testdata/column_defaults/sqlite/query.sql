@@ -0,0 +1,5 @@
+-- name: CreateEvent :exec
+INSERT INTO events (kind, note) VALUES (?, ?);
+
+-- name: ListEvents :many
+SELECT * FROM events;
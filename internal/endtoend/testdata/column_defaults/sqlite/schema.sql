@@ -0,0 +1,11 @@
+CREATE TABLE events (
+  id INTEGER PRIMARY KEY,
+  kind TEXT NOT NULL DEFAULT 'info',
+  note TEXT,
+  attempts INTEGER NOT NULL DEFAULT -1,
+  label TEXT DEFAULT 'it''s',
+  created_at TEXT NOT NULL DEFAULT (datetime('now')),
+  updated_at TEXT DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE events ADD COLUMN flags INTEGER NOT NULL DEFAULT 0;
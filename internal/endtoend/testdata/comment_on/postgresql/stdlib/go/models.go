@@ -19,15 +19,23 @@ const (
 )
 
 func (e *FooMood) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = FooMood(s)
+		s = string(v)
 	case string:
-		*e = FooMood(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for FooMood: %T", src)
 	}
-	return nil
+	switch FooMood(s) {
+	case FooMoodSad,
+		FooMoodOk,
+		FooMoodHappy:
+		*e = FooMood(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for FooMood: %q", s)
 }
 
 type NullFooMood struct {
@@ -35,14 +43,22 @@ type NullFooMood struct {
 	Valid   bool // Valid is true if FooMood is not NULL
 }
 
+// NewNullFooMood returns a valid NullFooMood holding e.
+func NewNullFooMood(e FooMood) NullFooMood {
+	return NullFooMood{FooMood: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullFooMood) Scan(value interface{}) error {
 	if value == nil {
 		ns.FooMood, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.FooMood.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.FooMood.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -0,0 +1,17 @@
+-- name: A :one
+SELECT nope FROM authors;
+
+-- name: B :one
+DELETE FROM authors WHERE id = $1;
+
+-- name: C :many
+SELECT * FROM missing;
+
+-- name: D :one
+SELECT id FROM authors WHERE name = $1 AND foo = $2;
+
+-- name: A :one
+SELECT id FROM authors;
+
+-- name: E :exec
+INSERT INTO authors (id) VALUES ($1, $2);
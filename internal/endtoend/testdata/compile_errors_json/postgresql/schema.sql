@@ -0,0 +1 @@
+CREATE TABLE authors (id bigserial primary key, name text not null);
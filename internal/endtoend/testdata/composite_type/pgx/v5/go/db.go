@@ -30,3 +30,25 @@ func (q *Queries) WithTx(tx pgx.Tx) *Queries {
 		db: tx,
 	}
 }
+
+// compositeTypes are the types RegisterCompositeTypes registers, in order.
+var compositeTypes = []string{
+	"foo.point_type",
+	"foo._point_type",
+	"point_type",
+	"_point_type",
+}
+
+// RegisterCompositeTypes registers the composite types of the schema with
+// conn, so that their values can be scanned into and encoded from their
+// structs. It's typically called by the AfterConnect hook of a pgxpool.Pool.
+func RegisterCompositeTypes(ctx context.Context, conn *pgx.Conn) error {
+	for _, name := range compositeTypes {
+		typ, err := conn.LoadType(ctx, name)
+		if err != nil {
+			return err
+		}
+		conn.TypeMap().RegisterType(typ)
+	}
+	return nil
+}
@@ -5,10 +5,20 @@
 package querytest
 
 import (
-	"database/sql"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type FooPath struct {
-	PointOne sql.NullString
-	PointTwo sql.NullString
+	PointOne *PointType
+	PointTwo *FooPointType
+}
+
+type FooPointType struct {
+	X pgtype.Int4
+	Y pgtype.Int4
+}
+
+type PointType struct {
+	X pgtype.Int4
+	Y pgtype.Int4
 }
@@ -0,0 +1,5 @@
+-- name: GetZip :one
+SELECT (addr).zip FROM people WHERE id = $1;
+
+-- name: ByFirstName :many
+SELECT id FROM people WHERE (name).first = $1;
@@ -0,0 +1,10 @@
+CREATE TYPE address AS (
+    street text,
+    city   text
+);
+
+CREATE TABLE people (
+    id   bigserial PRIMARY KEY,
+    name text NOT NULL,
+    addr address NOT NULL
+);
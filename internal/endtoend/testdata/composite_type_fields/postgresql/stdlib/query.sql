@@ -0,0 +1,11 @@
+-- name: GetCity :one
+SELECT (addr).city, (p.addr).street, ((loc).home).city AS home_city, (loc).zip FROM people p WHERE id = $1;
+
+-- name: ListPeople :many
+SELECT * FROM people;
+
+-- name: CreatePerson :exec
+INSERT INTO people (addr, loc, past) VALUES ($1, $2, $3);
+
+-- name: ByCity :many
+SELECT id FROM people WHERE (addr).city = $1;
@@ -0,0 +1,19 @@
+CREATE TYPE address_kind AS ENUM ('home', 'work');
+
+CREATE TYPE address AS (
+    street text,
+    city   text,
+    kind   address_kind
+);
+
+CREATE TYPE location AS (
+    home address,
+    zip  integer
+);
+
+CREATE TABLE people (
+    id   bigserial PRIMARY KEY,
+    addr address NOT NULL,
+    loc  location,
+    past address[]
+);
@@ -0,0 +1,5 @@
+-- name: ListAuthorNames :many
+SELECT (a).name FROM authors a;
+
+-- name: ListAuthorCities :many
+SELECT (authors).home.city FROM authors;
@@ -0,0 +1,10 @@
+CREATE TYPE address AS (
+  street text,
+  city   text
+);
+
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text NOT NULL,
+  home address
+);
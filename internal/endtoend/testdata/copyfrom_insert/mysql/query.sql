@@ -0,0 +1,5 @@
+-- name: CreateAuthors :copyfrom
+INSERT INTO authors (name, bio, created_at) VALUES (?, ?, ?);
+
+-- name: CreateAuthorNames :copyfrom
+INSERT INTO authors (name) VALUES (?);
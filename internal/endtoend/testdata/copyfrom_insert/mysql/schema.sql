@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+  id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name TEXT NOT NULL,
+  bio TEXT,
+  created_at DATETIME NOT NULL
+);
@@ -0,0 +1,10 @@
+-- name: GetEventDetails :one
+SELECT slugify(name) AS slug,
+  event_score(id, 1.5) AS score,
+  event_tags(id) AS tags
+FROM events
+WHERE id = $1;
+
+-- name: ListEventsBySlug :many
+SELECT * FROM events
+WHERE slugify(name) = slugify(sqlc.arg(slug));
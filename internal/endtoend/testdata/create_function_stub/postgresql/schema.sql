@@ -0,0 +1,10 @@
+CREATE TABLE events (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT      NOT NULL
+);
+
+-- Stubs declaring the signatures of functions defined elsewhere, which are
+-- only used to type queries
+CREATE FUNCTION slugify(input text) RETURNS text LANGUAGE sql AS '';
+CREATE FUNCTION event_score(event_id bigint, weight double precision) RETURNS numeric LANGUAGE sql AS $$ $$;
+CREATE FUNCTION event_tags(event_id bigint) RETURNS text[] LANGUAGE sql;
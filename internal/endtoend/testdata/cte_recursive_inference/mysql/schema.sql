@@ -0,0 +1,5 @@
+CREATE TABLE categories (
+  id        bigint PRIMARY KEY AUTO_INCREMENT,
+  parent_id bigint,
+  name      text NOT NULL
+);
@@ -0,0 +1,38 @@
+-- name: CategoryTree :many
+WITH RECURSIVE tree AS (
+  SELECT id, parent_id, name, 1 AS depth FROM categories WHERE id = $1
+  UNION ALL
+  SELECT c.id, c.parent_id, c.name, tree.depth + 1
+  FROM categories c JOIN tree ON c.parent_id = tree.id
+  WHERE tree.depth < $2
+)
+SELECT * FROM tree;
+
+-- name: CategoryLevels :many
+WITH RECURSIVE tree (category_id, level) AS (
+  SELECT id, 0 FROM categories WHERE parent_id IS NULL
+  UNION
+  SELECT c.id, t.level + 1 FROM categories c, tree t WHERE c.parent_id = t.category_id
+)
+SELECT t.category_id, t.level, c.name
+FROM tree t
+JOIN categories c ON c.id = t.category_id
+WHERE t.level <= $1;
+
+-- name: ListProductsInCategory :many
+WITH RECURSIVE subcategories AS (
+  SELECT id FROM categories WHERE id = $1
+  UNION
+  SELECT c.id FROM categories c JOIN subcategories s ON c.parent_id = s.id
+)
+SELECT p.id, p.title, s.id AS category_id
+FROM products p
+JOIN subcategories s ON s.id = p.category_id;
+
+-- name: ListAncestors :many
+WITH RECURSIVE ancestors AS (
+  SELECT id, parent_id, name FROM categories WHERE id = $1
+  UNION ALL
+  SELECT p.id, p.parent_id, NULL FROM categories p JOIN ancestors a ON a.parent_id = p.id
+)
+SELECT * FROM ancestors;
@@ -0,0 +1,11 @@
+CREATE TABLE categories (
+  id        bigserial PRIMARY KEY,
+  parent_id bigint REFERENCES categories(id),
+  name      text NOT NULL
+);
+
+CREATE TABLE products (
+  id          bigserial PRIMARY KEY,
+  category_id bigint NOT NULL REFERENCES categories(id),
+  title       text NOT NULL
+);
@@ -0,0 +1,17 @@
+-- name: CategoryTree :many
+WITH RECURSIVE tree AS (
+  SELECT id, parent_id, name, 1 AS depth FROM categories WHERE id = ?
+  UNION ALL
+  SELECT c.id, c.parent_id, c.name, tree.depth + 1
+  FROM categories c JOIN tree ON c.parent_id = tree.id
+  WHERE tree.depth < ?
+)
+SELECT * FROM tree;
+
+-- name: ListAncestors :many
+WITH RECURSIVE ancestors (category_id, parent_id, name) AS (
+  SELECT id, parent_id, name FROM categories WHERE id = ?
+  UNION ALL
+  SELECT p.id, p.parent_id, NULL FROM categories p JOIN ancestors a ON a.parent_id = p.id
+)
+SELECT * FROM ancestors;
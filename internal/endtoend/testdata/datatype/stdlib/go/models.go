@@ -94,19 +94,19 @@ type DtNumericNotNull struct {
 }
 
 type DtRange struct {
-	A interface{}
-	B interface{}
-	C interface{}
-	D interface{}
-	E interface{}
-	F interface{}
+	A sql.NullString
+	B sql.NullString
+	C sql.NullString
+	D sql.NullString
+	E sql.NullString
+	F sql.NullString
 }
 
 type DtRangeNotNull struct {
-	A interface{}
-	B interface{}
-	C interface{}
-	D interface{}
-	E interface{}
-	F interface{}
+	A string
+	B string
+	C string
+	D string
+	E string
+	F string
 }
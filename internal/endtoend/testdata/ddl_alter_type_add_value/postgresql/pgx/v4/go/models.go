@@ -18,15 +18,23 @@ const (
 )
 
 func (e *Status) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = Status(s)
+		s = string(v)
 	case string:
-		*e = Status(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for Status: %T", src)
 	}
-	return nil
+	switch Status(s) {
+	case StatusOpen,
+		StatusClosed,
+		StatusUnknown:
+		*e = Status(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for Status: %q", s)
 }
 
 type NullStatus struct {
@@ -34,14 +42,22 @@ type NullStatus struct {
 	Valid  bool // Valid is true if Status is not NULL
 }
 
+// NewNullStatus returns a valid NullStatus holding e.
+func NewNullStatus(e Status) NullStatus {
+	return NullStatus{Status: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullStatus) Scan(value interface{}) error {
 	if value == nil {
 		ns.Status, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.Status.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.Status.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -17,15 +17,22 @@ const (
 )
 
 func (e *NewEvent) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = NewEvent(s)
+		s = string(v)
 	case string:
-		*e = NewEvent(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for NewEvent: %T", src)
 	}
-	return nil
+	switch NewEvent(s) {
+	case NewEventSTART,
+		NewEventSTOP:
+		*e = NewEvent(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for NewEvent: %q", s)
 }
 
 type NullNewEvent struct {
@@ -33,14 +40,22 @@ type NullNewEvent struct {
 	Valid    bool // Valid is true if NewEvent is not NULL
 }
 
+// NewNullNewEvent returns a valid NullNewEvent holding e.
+func NewNullNewEvent(e NewEvent) NullNewEvent {
+	return NullNewEvent{NewEvent: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullNewEvent) Scan(value interface{}) error {
 	if value == nil {
 		ns.NewEvent, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.NewEvent.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.NewEvent.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -20,15 +20,25 @@ const (
 )
 
 func (e *Level) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = Level(s)
+		s = string(v)
 	case string:
-		*e = Level(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for Level: %T", src)
 	}
-	return nil
+	switch Level(s) {
+	case LevelDEBUG,
+		LevelINFO,
+		LevelWARN,
+		LevelERROR,
+		LevelFATAL:
+		*e = Level(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for Level: %q", s)
 }
 
 type NullLevel struct {
@@ -36,14 +46,22 @@ type NullLevel struct {
 	Valid bool // Valid is true if Level is not NULL
 }
 
+// NewNullLevel returns a valid NullLevel holding e.
+func NewNullLevel(e Level) NullLevel {
+	return NullLevel{Level: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullLevel) Scan(value interface{}) error {
 	if value == nil {
 		ns.Level, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.Level.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.Level.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -62,15 +80,22 @@ const (
 )
 
 func (e *NewEvent) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = NewEvent(s)
+		s = string(v)
 	case string:
-		*e = NewEvent(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for NewEvent: %T", src)
 	}
-	return nil
+	switch NewEvent(s) {
+	case NewEventSTART,
+		NewEventSTOP:
+		*e = NewEvent(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for NewEvent: %q", s)
 }
 
 type NullNewEvent struct {
@@ -78,14 +103,22 @@ type NullNewEvent struct {
 	Valid    bool // Valid is true if NewEvent is not NULL
 }
 
+// NewNullNewEvent returns a valid NullNewEvent holding e.
+func NewNullNewEvent(e NewEvent) NullNewEvent {
+	return NullNewEvent{NewEvent: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullNewEvent) Scan(value interface{}) error {
 	if value == nil {
 		ns.NewEvent, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.NewEvent.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.NewEvent.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
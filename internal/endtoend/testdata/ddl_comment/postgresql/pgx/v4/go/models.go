@@ -18,15 +18,21 @@ const (
 )
 
 func (e *FooBat) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = FooBat(s)
+		s = string(v)
 	case string:
-		*e = FooBat(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for FooBat: %T", src)
 	}
-	return nil
+	switch FooBat(s) {
+	case FooBatBat:
+		*e = FooBat(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for FooBat: %q", s)
 }
 
 type NullFooBat struct {
@@ -34,14 +40,22 @@ type NullFooBat struct {
 	Valid  bool // Valid is true if FooBat is not NULL
 }
 
+// NewNullFooBat returns a valid NullFooBat holding e.
+func NewNullFooBat(e FooBat) NullFooBat {
+	return NullFooBat{FooBat: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullFooBat) Scan(value interface{}) error {
 	if value == nil {
 		ns.FooBat, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.FooBat.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.FooBat.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -27,15 +27,32 @@ const (
 )
 
 func (e *FooDigit) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = FooDigit(s)
+		s = string(v)
 	case string:
-		*e = FooDigit(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for FooDigit: %T", src)
 	}
-	return nil
+	switch FooDigit(s) {
+	case FooDigit0,
+		FooDigit1,
+		FooDigit2,
+		FooDigit3,
+		FooDigit4,
+		FooDigit5,
+		FooDigit6,
+		FooDigit7,
+		FooDigit8,
+		FooDigit9,
+		FooDigitValue10,
+		FooDigitValue11:
+		*e = FooDigit(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for FooDigit: %q", s)
 }
 
 type NullFooDigit struct {
@@ -43,14 +60,22 @@ type NullFooDigit struct {
 	Valid    bool // Valid is true if FooDigit is not NULL
 }
 
+// NewNullFooDigit returns a valid NullFooDigit holding e.
+func NewNullFooDigit(e FooDigit) NullFooDigit {
+	return NullFooDigit{FooDigit: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullFooDigit) Scan(value interface{}) error {
 	if value == nil {
 		ns.FooDigit, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.FooDigit.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.FooDigit.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -74,15 +99,27 @@ const (
 )
 
 func (e *FooFoobar) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = FooFoobar(s)
+		s = string(v)
 	case string:
-		*e = FooFoobar(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for FooFoobar: %T", src)
 	}
-	return nil
+	switch FooFoobar(s) {
+	case FooFoobarFooA,
+		FooFoobarFooB,
+		FooFoobarFooC,
+		FooFoobarFooD,
+		FooFoobarFooe,
+		FooFoobarFoof,
+		FooFoobarFoog:
+		*e = FooFoobar(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for FooFoobar: %q", s)
 }
 
 type NullFooFoobar struct {
@@ -90,14 +127,22 @@ type NullFooFoobar struct {
 	Valid     bool // Valid is true if FooFoobar is not NULL
 }
 
+// NewNullFooFoobar returns a valid NullFooFoobar holding e.
+func NewNullFooFoobar(e FooFoobar) NullFooFoobar {
+	return NullFooFoobar{FooFoobar: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullFooFoobar) Scan(value interface{}) error {
 	if value == nil {
 		ns.FooFoobar, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.FooFoobar.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.FooFoobar.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -27,15 +27,32 @@ const (
 )
 
 func (e *Digit) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = Digit(s)
+		s = string(v)
 	case string:
-		*e = Digit(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for Digit: %T", src)
 	}
-	return nil
+	switch Digit(s) {
+	case Digit0,
+		Digit1,
+		Digit2,
+		Digit3,
+		Digit4,
+		Digit5,
+		Digit6,
+		Digit7,
+		Digit8,
+		Digit9,
+		DigitValue10,
+		DigitValue11:
+		*e = Digit(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for Digit: %q", s)
 }
 
 type NullDigit struct {
@@ -43,14 +60,22 @@ type NullDigit struct {
 	Valid bool // Valid is true if Digit is not NULL
 }
 
+// NewNullDigit returns a valid NullDigit holding e.
+func NewNullDigit(e Digit) NullDigit {
+	return NullDigit{Digit: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullDigit) Scan(value interface{}) error {
 	if value == nil {
 		ns.Digit, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.Digit.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.Digit.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -74,15 +99,27 @@ const (
 )
 
 func (e *Foobar) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = Foobar(s)
+		s = string(v)
 	case string:
-		*e = Foobar(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for Foobar: %T", src)
 	}
-	return nil
+	switch Foobar(s) {
+	case FoobarFooA,
+		FoobarFooB,
+		FoobarFooC,
+		FoobarFooD,
+		FoobarFooe,
+		FoobarFoof,
+		FoobarFoog:
+		*e = Foobar(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for Foobar: %q", s)
 }
 
 type NullFoobar struct {
@@ -90,14 +127,22 @@ type NullFoobar struct {
 	Valid  bool // Valid is true if Foobar is not NULL
 }
 
+// NewNullFoobar returns a valid NullFoobar holding e.
+func NewNullFoobar(e Foobar) NullFoobar {
+	return NullFoobar{Foobar: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullFoobar) Scan(value interface{}) error {
 	if value == nil {
 		ns.Foobar, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.Foobar.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.Foobar.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
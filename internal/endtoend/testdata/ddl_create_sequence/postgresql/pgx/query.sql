@@ -0,0 +1,11 @@
+-- name: NextID :one
+SELECT nextval($1::regclass);
+
+-- name: NextUserID :one
+SELECT nextval('users_id_seq');
+
+-- name: CurrUserID :one
+SELECT currval('users_id_seq');
+
+-- name: SetUserID :one
+SELECT setval('users_id_seq', $1);
@@ -0,0 +1,6 @@
+CREATE SEQUENCE users_id_seq AS bigint START WITH 100 INCREMENT BY 5;
+CREATE TABLE users (
+  id bigint PRIMARY KEY DEFAULT nextval('users_id_seq'),
+  name text NOT NULL
+);
+ALTER SEQUENCE users_id_seq OWNED BY users.id;
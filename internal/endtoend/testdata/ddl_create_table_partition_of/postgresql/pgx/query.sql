@@ -0,0 +1,8 @@
+-- name: ListMeasurements :many
+SELECT * FROM measurements;
+
+-- name: ListMeasurements2024 :many
+SELECT * FROM measurements_y2024 WHERE city_id = $1;
+
+-- name: ListOldMeasurements :many
+SELECT * FROM measurements_old;
@@ -0,0 +1,20 @@
+CREATE TABLE measurements (
+    city_id int NOT NULL,
+    logdate date NOT NULL,
+    peaktemp int
+) PARTITION BY RANGE (logdate);
+
+CREATE TABLE measurements_y2024 PARTITION OF measurements
+    FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+
+CREATE TABLE measurements_y2025 PARTITION OF measurements (
+    peaktemp DEFAULT 0
+) FOR VALUES FROM ('2025-01-01') TO ('2026-01-01');
+
+CREATE TABLE measurements_old (LIKE measurements);
+ALTER TABLE measurements ATTACH PARTITION measurements_old FOR VALUES FROM ('2000-01-01') TO ('2024-01-01');
+ALTER TABLE measurements DETACH PARTITION measurements_old;
+
+CREATE TABLE measurements_y2023 PARTITION OF measurements
+    FOR VALUES FROM ('2023-01-01') TO ('2024-01-01');
+DROP TABLE measurements_y2023;
@@ -0,0 +1,2 @@
+// Hand-written
+package authors
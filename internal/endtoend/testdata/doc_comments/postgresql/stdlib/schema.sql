@@ -0,0 +1,13 @@
+CREATE TYPE mood AS ENUM ('happy', 'sad');
+COMMENT ON TYPE mood IS 'How a user feels, which is recorded every time they log in and shown on their profile page.';
+
+CREATE TABLE users (
+  id    BIGSERIAL PRIMARY KEY,
+  email text NOT NULL,
+  notes text,
+  mood  mood
+);
+COMMENT ON TABLE users IS 'Users of the application.
+Deleted users are kept for a year.';
+COMMENT ON COLUMN users.email IS 'The address that receives notifications, such as */ password resets and weekly digests of the activity on the account.';
+COMMENT ON COLUMN users.notes IS 'Notes is free text written by support staff.';
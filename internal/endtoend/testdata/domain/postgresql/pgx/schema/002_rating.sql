@@ -0,0 +1,4 @@
+ALTER TABLE customers DROP COLUMN rating;
+DROP DOMAIN rating;
+CREATE DOMAIN rating AS smallint CHECK (VALUE BETWEEN 1 AND 5);
+ALTER TABLE customers ADD COLUMN rating rating;
@@ -0,0 +1,18 @@
+-- name: GetCustomerByEmail :one
+SELECT * FROM customers
+WHERE email = $1;
+
+-- name: ListCustomersWithTag :many
+SELECT id, email FROM customers
+WHERE $1::text = ANY(tags);
+
+-- name: CreateCustomer :one
+INSERT INTO customers (email, backup, tags, rating)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListInvoices :many
+SELECT i.id, i.total, i.discount, c.email
+FROM billing.invoices i
+JOIN customers c ON c.id = i.customer_id
+WHERE i.total > sqlc.arg(min_total)::billing.amount;
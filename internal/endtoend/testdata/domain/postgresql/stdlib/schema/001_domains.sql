@@ -0,0 +1,24 @@
+CREATE SCHEMA billing;
+
+CREATE DOMAIN email AS text CHECK (VALUE ~ '^[^@]+@[^@]+$');
+CREATE DOMAIN tags AS text[];
+CREATE DOMAIN billing.amount AS numeric(12, 2) NOT NULL CHECK (VALUE >= 0);
+CREATE DOMAIN billing.positive_amount AS billing.amount CHECK (VALUE > 0);
+
+-- Replaced by a later schema file
+CREATE DOMAIN rating AS text;
+
+CREATE TABLE customers (
+    id      BIGSERIAL PRIMARY KEY,
+    email   email NOT NULL,
+    backup  email,
+    tags    tags NOT NULL,
+    rating  rating
+);
+
+CREATE TABLE billing.invoices (
+    id          BIGSERIAL PRIMARY KEY,
+    customer_id bigint NOT NULL REFERENCES customers (id),
+    total       billing.amount NOT NULL,
+    discount    billing.positive_amount
+);
@@ -0,0 +1,6 @@
+-- name: GetUser :one
+SELECT * FROM users
+WHERE id = $1;
+
+-- name: ListUserEmails :many
+SELECT id, email, age FROM users;
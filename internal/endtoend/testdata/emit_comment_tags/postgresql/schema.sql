@@ -0,0 +1,12 @@
+CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY,
+    email TEXT NOT NULL,
+    name TEXT NOT NULL,
+    bio TEXT,
+    age INT NOT NULL
+);
+
+COMMENT ON COLUMN users.email IS 'json:emailAddress validate:"required,email"';
+COMMENT ON COLUMN users.name IS 'The display name of the user';
+COMMENT ON COLUMN users.bio IS 'xml:bio';
+COMMENT ON COLUMN users.age IS 'validate:"gte=0" db:years';
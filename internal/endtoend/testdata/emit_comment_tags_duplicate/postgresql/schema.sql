@@ -0,0 +1,6 @@
+CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY,
+    email TEXT NOT NULL
+);
+
+COMMENT ON COLUMN users.email IS 'json:email json:emailAddress';
@@ -0,0 +1,7 @@
+-- Returns the user with the given id, or sql.ErrNoRows if there is no such user in the database.
+-- name: GetUser :one
+SELECT * FROM users WHERE id = $1;
+
+-- ListUsers returns all users.
+-- name: ListUsers :many
+SELECT * FROM users ORDER BY id;
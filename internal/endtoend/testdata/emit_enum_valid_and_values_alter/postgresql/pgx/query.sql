@@ -0,0 +1,3 @@
+-- name: ListTicketsByStatus :many
+SELECT * FROM tickets
+WHERE status = $1;
@@ -0,0 +1,9 @@
+CREATE TYPE status AS ENUM ('open', 'closed');
+ALTER TYPE status ADD VALUE 'pending' BEFORE 'open';
+ALTER TYPE status ADD VALUE 'archived';
+ALTER TYPE status RENAME VALUE 'closed' TO 'done';
+
+CREATE TABLE tickets (
+  id     BIGSERIAL PRIMARY KEY,
+  status status NOT NULL
+);
@@ -0,0 +1,11 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
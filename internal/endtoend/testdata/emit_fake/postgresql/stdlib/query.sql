@@ -0,0 +1,24 @@
+-- name: GetAuthor :one
+-- tags: reads
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors ORDER BY name LIMIT $1 OFFSET $2;
+
+-- name: CreateAuthor :exec
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: DeleteAuthor :execrows
+DELETE FROM authors WHERE id = $1;
+
+-- name: UpdateBio :execresult
+UPDATE authors SET bio = $2 WHERE id = $1;
+
+-- name: BatchDelete :batchexec
+DELETE FROM authors WHERE id = $1;
+
+-- name: BatchGet :batchone
+SELECT * FROM authors WHERE id = $1;
+
+-- name: BatchList :batchmany
+SELECT * FROM authors WHERE name = $1;
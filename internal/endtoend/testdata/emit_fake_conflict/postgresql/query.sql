@@ -0,0 +1,2 @@
+-- name: Calls :many
+SELECT * FROM authors;
@@ -0,0 +1,7 @@
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: ListAuthorNames :many
+SELECT name FROM authors
+WHERE bio IS NOT NULL AND id > ?;
@@ -0,0 +1,28 @@
+-- name: GetAuthor :one
+-- GetAuthor returns an author by their ID.
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: ListBooks :many
+SELECT sqlc.embed(books), authors.name
+FROM books
+JOIN authors ON authors.id = books.author_id
+WHERE books.genre = $1 AND books.tags && sqlc.arg(tags)::text[]
+ORDER BY books.title;
+
+-- name: CreateBook :one
+INSERT INTO books (author_id, title, genre, tags, rating, published)
+VALUES ($1, $2, $3, $4, sqlc.narg(rating), $5)
+RETURNING id;
+
+-- name: DeleteBook :exec
+DELETE FROM books WHERE id = $1;
+
+-- name: LatestPublished :one
+SELECT max(published) FROM books;
+
+-- name: MaxRating :one
+SELECT max(rating) FROM books;
+
+-- name: GetAuthorWebsite :one
+SELECT website FROM authors WHERE id = $1;
@@ -0,0 +1,19 @@
+CREATE TYPE genre AS ENUM ('fiction', 'non-fiction', 'poetry');
+
+CREATE TABLE authors (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL,
+  bio TEXT,
+  website TEXT NOT NULL,
+  born DATE
+);
+
+CREATE TABLE books (
+  id BIGSERIAL PRIMARY KEY,
+  author_id BIGINT NOT NULL REFERENCES authors (id),
+  title TEXT NOT NULL,
+  genre genre NOT NULL,
+  tags TEXT[] NOT NULL,
+  rating genre,
+  published TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,4 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT      NOT NULL
+);
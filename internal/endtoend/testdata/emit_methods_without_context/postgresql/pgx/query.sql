@@ -0,0 +1,20 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors ORDER BY name;
+
+-- name: CreateAuthor :exec
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: DeleteAuthor :execrows
+DELETE FROM authors WHERE id = $1;
+
+-- name: UpdateBio :execresult
+UPDATE authors SET bio = $2 WHERE id = $1;
+
+-- name: CopyAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: BatchDelete :batchexec
+DELETE FROM authors WHERE id = $1;
@@ -0,0 +1,14 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors WHERE id IN (sqlc.slice(ids)) ORDER BY name;
+
+-- name: CreateAuthor :exec
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: DeleteAuthor :execrows
+DELETE FROM authors WHERE id = $1;
+
+-- name: UpdateBio :execresult
+UPDATE authors SET bio = $2 WHERE id = $1;
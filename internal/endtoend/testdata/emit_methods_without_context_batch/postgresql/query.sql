@@ -0,0 +1,2 @@
+-- name: BatchDelete :batchexec
+DELETE FROM authors WHERE id = $1;
@@ -0,0 +1,12 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAuthorByName :one
+-- not_found: ErrAuthorNotFound
+SELECT * FROM authors
+WHERE name = $1 LIMIT 1;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
@@ -0,0 +1,19 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: CreateAuthor :exec
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: UpdateBios :execrows
+UPDATE authors SET bio = $1 WHERE name = $2;
+
+-- name: DeleteAuthor :execresult
+DELETE FROM authors WHERE id = $1;
+
+-- name: RenameAuthors :batchexec
+UPDATE authors SET name = $2 WHERE id = $1;
@@ -0,0 +1,21 @@
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY id
+LIMIT $1 OFFSET $2;
+
+-- name: ListAuthorsByStatus :many
+SELECT * FROM authors
+WHERE status = @status
+ORDER BY id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListAuthorNames :many
+SELECT name FROM authors
+WHERE name LIKE $1 AND status = $2
+ORDER BY name
+LIMIT $3 OFFSET $4;
+
+-- name: ListFirstAuthors :many
+SELECT * FROM authors
+ORDER BY id
+LIMIT 10 OFFSET $1;
@@ -0,0 +1,10 @@
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY id
+LIMIT ? OFFSET ?;
+
+-- name: ListAuthorsByStatus :many
+SELECT * FROM authors
+WHERE status = sqlc.arg('status')
+ORDER BY id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
@@ -0,0 +1,4 @@
+-- name: ListPages :many
+SELECT * FROM pages
+ORDER BY id
+LIMIT $1 OFFSET $2;
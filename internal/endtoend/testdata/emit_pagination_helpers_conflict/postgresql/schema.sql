@@ -0,0 +1,4 @@
+CREATE TABLE pages (
+    id    BIGSERIAL PRIMARY KEY,
+    title text NOT NULL
+);
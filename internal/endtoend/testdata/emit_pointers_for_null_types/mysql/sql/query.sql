@@ -0,0 +1,5 @@
+-- name: GetTypes :one
+SELECT * FROM dt_types WHERE k = ? AND f = ?;
+
+-- name: UpdateTypes :exec
+UPDATE dt_types SET a = ?, i = ? WHERE m = ?;
@@ -0,0 +1,15 @@
+CREATE TABLE dt_types (
+    a varchar(255),
+    b tinyint(1),
+    c tinyint,
+    d smallint unsigned,
+    e int,
+    f bigint unsigned,
+    g double,
+    h decimal(10, 2),
+    i datetime,
+    j blob,
+    k enum('open', 'closed'),
+    l text,
+    m bigint NOT NULL
+);
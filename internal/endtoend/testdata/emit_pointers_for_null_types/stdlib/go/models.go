@@ -5,18 +5,76 @@
 package datatype
 
 import (
-	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"time"
 
 	"github.com/sqlc-dev/pqtype"
 )
 
+type Mood string
+
+const (
+	MoodHappy Mood = "happy"
+	MoodSad   Mood = "sad"
+)
+
+func (e *Mood) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return fmt.Errorf("unsupported scan type for Mood: %T", src)
+	}
+	switch Mood(s) {
+	case MoodHappy,
+		MoodSad:
+		*e = Mood(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for Mood: %q", s)
+}
+
+type NullMood struct {
+	Mood  Mood
+	Valid bool // Valid is true if Mood is not NULL
+}
+
+// NewNullMood returns a valid NullMood holding e.
+func NewNullMood(e Mood) NullMood {
+	return NullMood{Mood: e, Valid: true}
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullMood) Scan(value interface{}) error {
+	if value == nil {
+		ns.Mood, ns.Valid = "", false
+		return nil
+	}
+	if err := ns.Mood.Scan(value); err != nil {
+		return err
+	}
+	ns.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullMood) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.Mood), nil
+}
+
 type DtCharacter struct {
-	A sql.NullString
-	B sql.NullString
-	C sql.NullString
-	D sql.NullString
-	E sql.NullString
+	A *string
+	B *string
+	C *string
+	D *string
+	E *string
 }
 
 type DtCharacterNotNull struct {
@@ -28,14 +86,14 @@ type DtCharacterNotNull struct {
 }
 
 type DtDatetime struct {
-	A sql.NullTime
-	B sql.NullTime
-	C sql.NullTime
-	D sql.NullTime
-	E sql.NullTime
-	F sql.NullTime
-	G sql.NullTime
-	H sql.NullTime
+	A *time.Time
+	B *time.Time
+	C *time.Time
+	D *time.Time
+	E *time.Time
+	F *time.Time
+	G *time.Time
+	H *time.Time
 }
 
 type DtDatetimeNotNull struct {
@@ -49,6 +107,11 @@ type DtDatetimeNotNull struct {
 	H time.Time
 }
 
+type DtEnum struct {
+	A *Mood
+	B Mood
+}
+
 type DtNetType struct {
 	A pqtype.Inet
 	B pqtype.CIDR
@@ -62,19 +125,19 @@ type DtNetTypesNotNull struct {
 }
 
 type DtNumeric struct {
-	A sql.NullInt16
-	B sql.NullInt32
-	C sql.NullInt64
-	D sql.NullString
-	E sql.NullString
-	F sql.NullFloat64
-	G sql.NullFloat64
-	H sql.NullInt16
-	I sql.NullInt32
-	J sql.NullInt64
-	K sql.NullInt16
-	L sql.NullInt32
-	M sql.NullInt64
+	A *int16
+	B *int32
+	C *int64
+	D *string
+	E *string
+	F *float32
+	G *float64
+	H *int16
+	I *int32
+	J *int64
+	K *int16
+	L *int32
+	M *int64
 }
 
 type DtNumericNotNull struct {
@@ -94,19 +157,19 @@ type DtNumericNotNull struct {
 }
 
 type DtRange struct {
-	A interface{}
-	B interface{}
-	C interface{}
-	D interface{}
-	E interface{}
-	F interface{}
+	A *string
+	B *string
+	C *string
+	D *string
+	E *string
+	F *string
 }
 
 type DtRangeNotNull struct {
-	A interface{}
-	B interface{}
-	C interface{}
-	D interface{}
-	E interface{}
-	F interface{}
+	A string
+	B string
+	C string
+	D string
+	E string
+	F string
 }
@@ -0,0 +1,9 @@
+CREATE TYPE mood AS ENUM ('happy', 'sad');
+
+CREATE TABLE dt_enum (
+    a mood,
+    b mood NOT NULL
+);
+
+-- name: ListEnums :many
+SELECT * FROM dt_enum WHERE a = $1;
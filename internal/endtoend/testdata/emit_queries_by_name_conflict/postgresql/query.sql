@@ -0,0 +1,2 @@
+-- name: ListQueries :many
+SELECT * FROM queries_by_names;
@@ -0,0 +1,4 @@
+CREATE TABLE queries_by_names (
+    id   BIGSERIAL PRIMARY KEY,
+    name text NOT NULL
+);
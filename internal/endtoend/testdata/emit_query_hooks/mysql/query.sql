@@ -0,0 +1,9 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = ? LIMIT 1;
+
+-- name: CreateAuthor :exec
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: CopyAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES (?, ?);
@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL,
+  bio  TEXT
+);
+
+CREATE TABLE books (
+  id        BIGSERIAL PRIMARY KEY,
+  author_id BIGINT,
+  title     TEXT NOT NULL,
+  tags      TEXT[] NOT NULL
+);
@@ -0,0 +1,11 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: ListBooksWithAuthor :many
+SELECT sqlc.embed(books), sqlc.embed(authors), books.title AS book_title
+FROM books
+LEFT JOIN authors ON authors.id = books.author_id;
+
+-- name: CountBooks :one
+SELECT count(*) FROM books;
@@ -0,0 +1,15 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+/* Comments and blank lines before a query
+   are counted. */
+
+
+-- ListAuthors returns all authors.
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: DeleteAuthor :exec
+-- DeleteAuthor deletes an author.
+DELETE FROM authors WHERE id = $1;
@@ -0,0 +1,15 @@
+-- name: GetPayment :one
+-- tags: payments
+-- GetPayment returns a single payment.
+SELECT * FROM payments WHERE id = $1;
+
+-- name: ListPayments :many
+-- tags: payments, admin
+SELECT * FROM payments;
+
+-- name: DeletePayment :exec
+-- tags: admin
+DELETE FROM payments WHERE id = $1;
+
+-- name: CountPayments :one
+SELECT count(*) FROM payments;
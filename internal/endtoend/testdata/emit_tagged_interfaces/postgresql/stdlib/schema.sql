@@ -0,0 +1,4 @@
+CREATE TABLE payments (
+    id     BIGSERIAL PRIMARY KEY,
+    amount BIGINT NOT NULL
+);
@@ -0,0 +1,3 @@
+-- name: GetPaymentsQuerier :one
+-- tags: payments
+SELECT * FROM payments_queriers WHERE id = $1;
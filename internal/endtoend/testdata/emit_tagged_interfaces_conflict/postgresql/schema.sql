@@ -0,0 +1,3 @@
+CREATE TABLE payments_queriers (
+    id BIGSERIAL PRIMARY KEY
+);
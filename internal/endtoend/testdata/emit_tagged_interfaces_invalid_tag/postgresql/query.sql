@@ -0,0 +1,3 @@
+-- name: GetPayment :one
+-- tags: payments-admin
+SELECT * FROM payments WHERE id = $1;
@@ -21,15 +21,25 @@ const (
 )
 
 func (e *UsersShirtSize) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = UsersShirtSize(s)
+		s = string(v)
 	case string:
-		*e = UsersShirtSize(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for UsersShirtSize: %T", src)
 	}
-	return nil
+	switch UsersShirtSize(s) {
+	case UsersShirtSizeXSmall,
+		UsersShirtSizeSmall,
+		UsersShirtSizeMedium,
+		UsersShirtSizeLarge,
+		UsersShirtSizeXLarge:
+		*e = UsersShirtSize(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for UsersShirtSize: %q", s)
 }
 
 type NullUsersShirtSize struct {
@@ -37,14 +47,22 @@ type NullUsersShirtSize struct {
 	Valid          bool // Valid is true if UsersShirtSize is not NULL
 }
 
+// NewNullUsersShirtSize returns a valid NullUsersShirtSize holding e.
+func NewNullUsersShirtSize(e UsersShirtSize) NullUsersShirtSize {
+	return NullUsersShirtSize{UsersShirtSize: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullUsersShirtSize) Scan(value interface{}) error {
 	if value == nil {
 		ns.UsersShirtSize, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.UsersShirtSize.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.UsersShirtSize.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -66,15 +84,25 @@ const (
 )
 
 func (e *UsersShoeSize) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = UsersShoeSize(s)
+		s = string(v)
 	case string:
-		*e = UsersShoeSize(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for UsersShoeSize: %T", src)
 	}
-	return nil
+	switch UsersShoeSize(s) {
+	case UsersShoeSizeXSmall,
+		UsersShoeSizeSmall,
+		UsersShoeSizeMedium,
+		UsersShoeSizeLarge,
+		UsersShoeSizeXLarge:
+		*e = UsersShoeSize(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for UsersShoeSize: %q", s)
 }
 
 type NullUsersShoeSize struct {
@@ -82,14 +110,22 @@ type NullUsersShoeSize struct {
 	Valid         bool // Valid is true if UsersShoeSize is not NULL
 }
 
+// NewNullUsersShoeSize returns a valid NullUsersShoeSize holding e.
+func NewNullUsersShoeSize(e UsersShoeSize) NullUsersShoeSize {
+	return NullUsersShoeSize{UsersShoeSize: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullUsersShoeSize) Scan(value interface{}) error {
 	if value == nil {
 		ns.UsersShoeSize, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.UsersShoeSize.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.UsersShoeSize.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -21,15 +21,25 @@ const (
 )
 
 func (e *Size) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = Size(s)
+		s = string(v)
 	case string:
-		*e = Size(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for Size: %T", src)
 	}
-	return nil
+	switch Size(s) {
+	case SizeXSmall,
+		SizeSmall,
+		SizeMedium,
+		SizeLarge,
+		SizeXLarge:
+		*e = Size(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for Size: %q", s)
 }
 
 type NullSize struct {
@@ -37,14 +47,22 @@ type NullSize struct {
 	Valid bool // Valid is true if Size is not NULL
 }
 
+// NewNullSize returns a valid NullSize holding e.
+func NewNullSize(e Size) NullSize {
+	return NullSize{Size: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullSize) Scan(value interface{}) error {
 	if value == nil {
 		ns.Size, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.Size.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.Size.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
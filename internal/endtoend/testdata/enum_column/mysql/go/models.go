@@ -17,15 +17,22 @@ const (
 )
 
 func (e *AuthorsAddItem) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = AuthorsAddItem(s)
+		s = string(v)
 	case string:
-		*e = AuthorsAddItem(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for AuthorsAddItem: %T", src)
 	}
-	return nil
+	switch AuthorsAddItem(s) {
+	case AuthorsAddItemOk,
+		AuthorsAddItemAdded:
+		*e = AuthorsAddItem(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for AuthorsAddItem: %q", s)
 }
 
 type NullAuthorsAddItem struct {
@@ -33,14 +40,22 @@ type NullAuthorsAddItem struct {
 	Valid          bool // Valid is true if AuthorsAddItem is not NULL
 }
 
+// NewNullAuthorsAddItem returns a valid NullAuthorsAddItem holding e.
+func NewNullAuthorsAddItem(e AuthorsAddItem) NullAuthorsAddItem {
+	return NullAuthorsAddItem{AuthorsAddItem: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullAuthorsAddItem) Scan(value interface{}) error {
 	if value == nil {
 		ns.AuthorsAddItem, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.AuthorsAddItem.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.AuthorsAddItem.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -58,15 +73,21 @@ const (
 )
 
 func (e *AuthorsAdded) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = AuthorsAdded(s)
+		s = string(v)
 	case string:
-		*e = AuthorsAdded(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for AuthorsAdded: %T", src)
 	}
-	return nil
+	switch AuthorsAdded(s) {
+	case AuthorsAddedOk:
+		*e = AuthorsAdded(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for AuthorsAdded: %q", s)
 }
 
 type NullAuthorsAdded struct {
@@ -74,14 +95,22 @@ type NullAuthorsAdded struct {
 	Valid        bool // Valid is true if AuthorsAdded is not NULL
 }
 
+// NewNullAuthorsAdded returns a valid NullAuthorsAdded holding e.
+func NewNullAuthorsAdded(e AuthorsAdded) NullAuthorsAdded {
+	return NullAuthorsAdded{AuthorsAdded: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullAuthorsAdded) Scan(value interface{}) error {
 	if value == nil {
 		ns.AuthorsAdded, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.AuthorsAdded.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.AuthorsAdded.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -99,15 +128,21 @@ const (
 )
 
 func (e *AuthorsBar) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = AuthorsBar(s)
+		s = string(v)
 	case string:
-		*e = AuthorsBar(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for AuthorsBar: %T", src)
 	}
-	return nil
+	switch AuthorsBar(s) {
+	case AuthorsBarOk:
+		*e = AuthorsBar(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for AuthorsBar: %q", s)
 }
 
 type NullAuthorsBar struct {
@@ -115,14 +150,22 @@ type NullAuthorsBar struct {
 	Valid      bool // Valid is true if AuthorsBar is not NULL
 }
 
+// NewNullAuthorsBar returns a valid NullAuthorsBar holding e.
+func NewNullAuthorsBar(e AuthorsBar) NullAuthorsBar {
+	return NullAuthorsBar{AuthorsBar: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullAuthorsBar) Scan(value interface{}) error {
 	if value == nil {
 		ns.AuthorsBar, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.AuthorsBar.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.AuthorsBar.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -140,15 +183,21 @@ const (
 )
 
 func (e *AuthorsFoo) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = AuthorsFoo(s)
+		s = string(v)
 	case string:
-		*e = AuthorsFoo(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for AuthorsFoo: %T", src)
 	}
-	return nil
+	switch AuthorsFoo(s) {
+	case AuthorsFooOk:
+		*e = AuthorsFoo(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for AuthorsFoo: %q", s)
 }
 
 type NullAuthorsFoo struct {
@@ -156,14 +205,22 @@ type NullAuthorsFoo struct {
 	Valid      bool // Valid is true if AuthorsFoo is not NULL
 }
 
+// NewNullAuthorsFoo returns a valid NullAuthorsFoo holding e.
+func NewNullAuthorsFoo(e AuthorsFoo) NullAuthorsFoo {
+	return NullAuthorsFoo{AuthorsFoo: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullAuthorsFoo) Scan(value interface{}) error {
 	if value == nil {
 		ns.AuthorsFoo, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.AuthorsFoo.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.AuthorsFoo.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -181,15 +238,21 @@ const (
 )
 
 func (e *AuthorsRemoveItem) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = AuthorsRemoveItem(s)
+		s = string(v)
 	case string:
-		*e = AuthorsRemoveItem(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for AuthorsRemoveItem: %T", src)
 	}
-	return nil
+	switch AuthorsRemoveItem(s) {
+	case AuthorsRemoveItemOk:
+		*e = AuthorsRemoveItem(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for AuthorsRemoveItem: %q", s)
 }
 
 type NullAuthorsRemoveItem struct {
@@ -197,14 +260,22 @@ type NullAuthorsRemoveItem struct {
 	Valid             bool // Valid is true if AuthorsRemoveItem is not NULL
 }
 
+// NewNullAuthorsRemoveItem returns a valid NullAuthorsRemoveItem holding e.
+func NewNullAuthorsRemoveItem(e AuthorsRemoveItem) NullAuthorsRemoveItem {
+	return NullAuthorsRemoveItem{AuthorsRemoveItem: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullAuthorsRemoveItem) Scan(value interface{}) error {
 	if value == nil {
 		ns.AuthorsRemoveItem, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.AuthorsRemoveItem.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.AuthorsRemoveItem.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -222,15 +293,21 @@ const (
 )
 
 func (e *BooksFoo) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = BooksFoo(s)
+		s = string(v)
 	case string:
-		*e = BooksFoo(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for BooksFoo: %T", src)
 	}
-	return nil
+	switch BooksFoo(s) {
+	case BooksFooOk:
+		*e = BooksFoo(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for BooksFoo: %q", s)
 }
 
 type NullBooksFoo struct {
@@ -238,14 +315,22 @@ type NullBooksFoo struct {
 	Valid    bool // Valid is true if BooksFoo is not NULL
 }
 
+// NewNullBooksFoo returns a valid NullBooksFoo holding e.
+func NewNullBooksFoo(e BooksFoo) NullBooksFoo {
+	return NullBooksFoo{BooksFoo: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullBooksFoo) Scan(value interface{}) error {
 	if value == nil {
 		ns.BooksFoo, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.BooksFoo.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.BooksFoo.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -23,15 +23,28 @@ const (
 )
 
 func (e *EnumType) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = EnumType(s)
+		s = string(v)
 	case string:
-		*e = EnumType(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for EnumType: %T", src)
 	}
-	return nil
+	switch EnumType(s) {
+	case EnumTypeBeforefirst,
+		EnumTypeFirst,
+		EnumTypeSecond,
+		EnumTypeThird,
+		EnumTypeFourth,
+		EnumTypeFifth,
+		EnumTypeLast,
+		EnumTypeAfterlast:
+		*e = EnumType(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for EnumType: %q", s)
 }
 
 type NullEnumType struct {
@@ -39,14 +52,22 @@ type NullEnumType struct {
 	Valid    bool // Valid is true if EnumType is not NULL
 }
 
+// NewNullEnumType returns a valid NullEnumType holding e.
+func NewNullEnumType(e EnumType) NullEnumType {
+	return NullEnumType{EnumType: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullEnumType) Scan(value interface{}) error {
 	if value == nil {
 		ns.EnumType, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.EnumType.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.EnumType.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
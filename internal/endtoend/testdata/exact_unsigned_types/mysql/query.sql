@@ -0,0 +1,5 @@
+-- name: GetCounter :one
+SELECT * FROM counters WHERE id = ?;
+
+-- name: UpdateCounter :exec
+UPDATE counters SET regular = ?, big = ? WHERE id = ?;
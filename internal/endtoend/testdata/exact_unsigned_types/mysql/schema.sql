@@ -0,0 +1,10 @@
+CREATE TABLE counters (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    tiny       TINYINT UNSIGNED,
+    small      SMALLINT UNSIGNED,
+    medium     MEDIUMINT UNSIGNED,
+    regular    INT UNSIGNED,
+    big        BIGINT UNSIGNED,
+    overridden INT UNSIGNED,
+    signed     INT
+);
@@ -0,0 +1,2 @@
+-- name: UpdateAuthor :execlastid
+UPDATE authors SET name = ? WHERE id = ?;
@@ -0,0 +1,4 @@
+CREATE TABLE authors (
+    id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name text NOT NULL
+);
@@ -0,0 +1,10 @@
+-- name: ListItems :many
+SELECT * FROM items;
+
+-- name: GetItemsByEmail :many
+SELECT name, attrs FROM items
+WHERE email = $1;
+
+-- name: CreateItem :exec
+INSERT INTO items (name, aliases, email, attrs, path, parents)
+VALUES ($1, $2, $3, $4, $5, $6);
@@ -0,0 +1,12 @@
+CREATE EXTENSION IF NOT EXISTS citext;
+CREATE EXTENSION IF NOT EXISTS hstore;
+CREATE EXTENSION IF NOT EXISTS ltree;
+
+CREATE TABLE items (
+  name     citext NOT NULL,
+  aliases  citext[] NOT NULL,
+  email    public.citext,
+  attrs    hstore,
+  path     ltree NOT NULL,
+  parents  ltree[]
+);
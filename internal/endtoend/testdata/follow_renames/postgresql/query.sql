@@ -0,0 +1,5 @@
+-- name: GetUser :one
+SELECT * FROM users WHERE email_address = $1;
+
+-- name: ListProfiles :many
+SELECT * FROM user_profiles;
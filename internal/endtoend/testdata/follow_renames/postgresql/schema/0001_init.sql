@@ -0,0 +1,9 @@
+CREATE TABLE users (
+  id    BIGSERIAL PRIMARY KEY,
+  email text      NOT NULL
+);
+
+CREATE TABLE profiles (
+  user_id bigint NOT NULL REFERENCES users (id),
+  bio     text
+);
@@ -0,0 +1,3 @@
+ALTER TABLE users RENAME COLUMN email TO email_address;
+ALTER TABLE profiles RENAME TO user_profiles;
+ALTER TABLE user_profiles RENAME COLUMN bio TO about;
@@ -7,6 +7,7 @@ package querytest
 
 import (
 	"context"
+	"database/sql"
 )
 
 const percentile = `-- name: Percentile :one
@@ -14,9 +15,9 @@ select percentile_disc(0.5) within group (order by authors.name)
 from authors
 `
 
-func (q *Queries) Percentile(ctx context.Context) (interface{}, error) {
+func (q *Queries) Percentile(ctx context.Context) (sql.NullString, error) {
 	row := q.db.QueryRowContext(ctx, percentile)
-	var percentile_disc interface{}
+	var percentile_disc sql.NullString
 	err := row.Scan(&percentile_disc)
 	return percentile_disc, err
 }
@@ -7,7 +7,6 @@ package querytest
 
 import (
 	"context"
-	"database/sql"
 )
 
 const authorPages = `-- name: AuthorPages :many
@@ -19,7 +18,7 @@ group by author
 type AuthorPagesRow struct {
 	Author     string
 	NumBooks   int64
-	TotalPages sql.NullFloat64
+	TotalPages float64
 }
 
 func (q *Queries) AuthorPages(ctx context.Context) ([]AuthorPagesRow, error) {
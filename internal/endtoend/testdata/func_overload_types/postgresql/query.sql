@@ -0,0 +1,43 @@
+-- name: Aggregates :many
+SELECT date_trunc('day', created_at) AS day,
+  string_agg(name, ', ') AS names,
+  string_agg(name, ', ' ORDER BY name) AS names_ordered,
+  sum(amount) AS total,
+  avg(amount) AS mean,
+  max(created_at) AS latest,
+  min(duration) AS shortest,
+  percentile_cont(0.5) WITHIN GROUP (ORDER BY amount) AS median,
+  percentile_cont(0.5) WITHIN GROUP (ORDER BY duration) AS median_duration,
+  percentile_disc(0.5) WITHIN GROUP (ORDER BY amount) AS median_amount,
+  mode() WITHIN GROUP (ORDER BY name) AS top_name,
+  jsonb_agg(payload) AS payloads,
+  jsonb_object_agg(name, amount) AS amounts
+FROM events
+GROUP BY 1;
+
+-- name: Windows :many
+SELECT id,
+  row_number() OVER w AS position,
+  dense_rank() OVER w AS dense,
+  lag(amount) OVER w AS previous_amount,
+  lead(name, 1, '') OVER w AS next_name,
+  first_value(created_at) OVER w AS first_created_at,
+  ntile(4) OVER w AS quartile,
+  cume_dist() OVER w AS cumulative,
+  sum(amount) OVER w AS running_total
+FROM events
+WINDOW w AS (ORDER BY created_at);
+
+-- name: Jsonb :one
+SELECT jsonb_build_object('name', name) AS object,
+  jsonb_build_array(id, name) AS array,
+  payload -> 'tags' AS tags,
+  payload ->> 'title' AS title,
+  payload #> '{a,b}' AS nested,
+  payload #>> '{a,b}' AS nested_text,
+  jsonb_array_length(payload) AS length,
+  jsonb_typeof(payload) AS type,
+  jsonb_set(payload, '{a}', '1') AS updated,
+  to_jsonb(name) AS name_json
+FROM events
+WHERE id = $1;
@@ -0,0 +1,8 @@
+CREATE TABLE events (
+  id         BIGSERIAL   PRIMARY KEY,
+  name       TEXT        NOT NULL,
+  amount     NUMERIC     NOT NULL,
+  duration   INTERVAL    NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  payload    JSONB       NOT NULL
+);
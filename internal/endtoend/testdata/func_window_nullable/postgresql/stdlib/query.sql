@@ -0,0 +1,17 @@
+-- name: Neighbours :many
+SELECT id,
+  lag(amount) OVER w AS previous_amount,
+  lead(amount) OVER w AS next_amount,
+  lead(amount, 1, 0) OVER w AS next_amount_or_zero,
+  first_value(product) OVER w AS first_product,
+  last_value(product) OVER w AS last_product,
+  nth_value(product, 2) OVER w AS second_product
+FROM sales
+WINDOW w AS (ORDER BY sold_on);
+
+-- name: Medians :many
+SELECT date_trunc('month', sold_on) AS month,
+  percentile_disc(0.5) WITHIN GROUP (ORDER BY amount) AS median_amount,
+  mode() WITHIN GROUP (ORDER BY product) AS top_product
+FROM sales
+GROUP BY 1;
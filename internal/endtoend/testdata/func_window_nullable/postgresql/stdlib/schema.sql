@@ -0,0 +1,6 @@
+CREATE TABLE sales (
+  id      BIGSERIAL PRIMARY KEY,
+  product TEXT      NOT NULL,
+  amount  INTEGER   NOT NULL,
+  sold_on DATE      NOT NULL
+);
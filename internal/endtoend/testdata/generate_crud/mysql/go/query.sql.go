@@ -7,27 +7,26 @@ package querytest
 
 import (
 	"context"
-
-	"github.com/lib/pq"
 )
 
-const textArray = `-- name: TextArray :many
-SELECT tags FROM bar
+const searchAuthors = `-- name: SearchAuthors :many
+SELECT id, name, bio FROM authors
+WHERE name LIKE ?
 `
 
-func (q *Queries) TextArray(ctx context.Context) ([][][]string, error) {
-	rows, err := q.db.QueryContext(ctx, textArray)
+func (q *Queries) SearchAuthors(ctx context.Context, name string) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, searchAuthors, name)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items [][][]string
+	var items []Author
 	for rows.Next() {
-		var tags [][]string
-		if err := rows.Scan(pq.Array(&tags)); err != nil {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
 			return nil, err
 		}
-		items = append(items, tags)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id   BIGINT PRIMARY KEY AUTO_INCREMENT,
+  name text   NOT NULL,
+  bio  text
+);
+
+CREATE TABLE tags (
+  name varchar(64) PRIMARY KEY
+);
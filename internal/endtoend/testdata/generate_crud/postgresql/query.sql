@@ -0,0 +1,3 @@
+-- name: SearchAuthors :many
+SELECT * FROM authors
+WHERE name LIKE $1;
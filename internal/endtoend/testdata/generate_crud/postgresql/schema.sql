@@ -0,0 +1,18 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL,
+  bio  text
+);
+
+CREATE TABLE book_reviews (
+  book_id    bigint      NOT NULL,
+  reviewer   text        NOT NULL,
+  rating     int         NOT NULL,
+  rating_pct int         GENERATED ALWAYS AS (rating * 20) STORED,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (book_id, reviewer)
+);
+
+CREATE TABLE events (
+  payload jsonb NOT NULL
+);
@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id   INTEGER PRIMARY KEY,
+  name text   NOT NULL,
+  bio  text
+);
+
+CREATE TABLE tags (
+  name text PRIMARY KEY
+);
@@ -0,0 +1,18 @@
+-- name: CreateItem :one
+INSERT INTO items (price, quantity) VALUES ($1, $2)
+RETURNING *;
+
+-- name: CreateItemWithDefaults :exec
+INSERT INTO items (price, quantity, total) VALUES ($1, $2, DEFAULT);
+
+-- name: CopyItems :copyfrom
+INSERT INTO items (price, quantity) VALUES ($1, $2);
+
+-- name: GetItem :one
+SELECT * FROM items WHERE id = $1;
+
+-- name: ListExpensiveItems :many
+SELECT id, total FROM items WHERE total > $1;
+
+-- name: UpdateQuantity :exec
+UPDATE items SET quantity = $2, total = DEFAULT WHERE id = $1;
@@ -0,0 +1,7 @@
+CREATE TABLE items (
+  id       BIGSERIAL PRIMARY KEY,
+  price    numeric NOT NULL,
+  quantity integer NOT NULL,
+  total    numeric GENERATED ALWAYS AS ((price * quantity)::numeric(12, 2)) STORED,
+  label    text NOT NULL GENERATED ALWAYS AS ('item ' || id) STORED
+);
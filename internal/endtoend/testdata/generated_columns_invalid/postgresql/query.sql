@@ -0,0 +1,11 @@
+-- name: CreateItem :exec
+INSERT INTO items (price, quantity, total) VALUES ($1, $2, $3);
+
+-- name: CreateItems :exec
+INSERT INTO items (price, quantity, label) VALUES ($1, $2, DEFAULT), ($3, $4, 'x');
+
+-- name: DuplicateItems :exec
+INSERT INTO items (price, quantity, label) SELECT price, quantity, label FROM items;
+
+-- name: UpdateTotal :exec
+UPDATE items SET total = $2 WHERE id = $1;
@@ -12,20 +12,24 @@ require (
 	github.com/jackc/pgx/v4 v4.6.1-0.20200606145419-4e5062306904
 	github.com/jackc/pgx/v5 v5.4.3
 	github.com/lib/pq v1.9.0
+	github.com/pgvector/pgvector-go v0.1.1
 	github.com/sqlc-dev/pqtype v0.2.0
 	github.com/sqlc-dev/sqlc-testdata v1.0.0
 	github.com/volatiletech/null/v8 v8.1.2
+	go.opentelemetry.io/otel v1.14.0
+	go.opentelemetry.io/otel/trace v1.14.0
 	gopkg.in/guregu/null.v4 v4.0.0
 )
 
 require (
 	github.com/friendsofgo/errors v0.9.2 // indirect
+	github.com/go-logr/logr v1.2.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/jackc/chunkreader/v2 v2.0.1 // indirect
 	github.com/jackc/pgio v1.0.0 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgproto3/v2 v2.0.1 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
-	github.com/pgvector/pgvector-go v0.1.1 // indirect
 	github.com/volatiletech/inflect v0.0.1 // indirect
 	github.com/volatiletech/randomize v0.0.1 // indirect
 	github.com/volatiletech/strmangle v0.0.1 // indirect
@@ -7,21 +7,23 @@ package hstore
 
 import (
 	"context"
+
+	"github.com/lib/pq/hstore"
 )
 
 const listBar = `-- name: ListBar :many
 SELECT bar FROM foo
 `
 
-func (q *Queries) ListBar(ctx context.Context) ([]interface{}, error) {
+func (q *Queries) ListBar(ctx context.Context) ([]hstore.Hstore, error) {
 	rows, err := q.db.QueryContext(ctx, listBar)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []interface{}
+	var items []hstore.Hstore
 	for rows.Next() {
-		var bar interface{}
+		var bar hstore.Hstore
 		if err := rows.Scan(&bar); err != nil {
 			return nil, err
 		}
@@ -40,15 +42,15 @@ const listBaz = `-- name: ListBaz :many
 SELECT baz FROM foo
 `
 
-func (q *Queries) ListBaz(ctx context.Context) ([]interface{}, error) {
+func (q *Queries) ListBaz(ctx context.Context) ([]hstore.Hstore, error) {
 	rows, err := q.db.QueryContext(ctx, listBaz)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []interface{}
+	var items []hstore.Hstore
 	for rows.Next() {
-		var baz interface{}
+		var baz hstore.Hstore
 		if err := rows.Scan(&baz); err != nil {
 			return nil, err
 		}
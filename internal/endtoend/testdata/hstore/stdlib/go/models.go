@@ -4,7 +4,11 @@
 
 package hstore
 
+import (
+	"github.com/lib/pq/hstore"
+)
+
 type Foo struct {
-	Bar interface{}
-	Baz interface{}
+	Bar hstore.Hstore
+	Baz hstore.Hstore
 }
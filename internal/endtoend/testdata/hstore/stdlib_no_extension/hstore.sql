@@ -0,0 +1,12 @@
+CREATE TABLE foo (
+        bar hstore NOT NULL,
+        baz hstore
+);
+
+-- name: ListBar :many
+SELECT bar FROM foo;
+
+-- name: ListBaz :many
+SELECT baz FROM foo;
+
+
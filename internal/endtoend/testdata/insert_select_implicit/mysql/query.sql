@@ -0,0 +1,10 @@
+-- name: ArchiveUser :exec
+INSERT INTO archived_users
+SELECT id, name, ? FROM users WHERE id = ?;
+
+-- name: RestoreUser :exec
+INSERT INTO users (id, name)
+SELECT id, name FROM archived_users WHERE id = ?;
+
+-- name: InsertArchivedUser :exec
+INSERT INTO archived_users VALUES (?, ?, ?);
@@ -0,0 +1,11 @@
+CREATE TABLE users (
+  id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name TEXT NOT NULL,
+  deleted_at DATETIME
+);
+
+CREATE TABLE archived_users (
+  id BIGINT PRIMARY KEY,
+  name TEXT NOT NULL,
+  deleted_at DATETIME
+);
@@ -0,0 +1,22 @@
+-- name: ArchiveUser :one
+INSERT INTO archived_users
+SELECT id, name, $1 FROM users WHERE id = $2
+RETURNING *;
+
+-- name: ArchiveUsersNamed :exec
+INSERT INTO archived_users
+SELECT id, $1, now() FROM users WHERE name = $2;
+
+-- name: RestoreUser :exec
+INSERT INTO users (id, name)
+SELECT id, name FROM archived_users WHERE id = $1;
+
+-- name: InsertArchivedUser :exec
+INSERT INTO archived_users VALUES ($1, $2, $3);
+
+-- name: InsertArchivedUserDefaults :exec
+INSERT INTO archived_users VALUES ($1, $2);
+
+-- name: ArchiveUserAt :exec
+INSERT INTO archived_users (id, name, deleted_at)
+SELECT id, name, '2024-01-01' FROM users WHERE id = $1;
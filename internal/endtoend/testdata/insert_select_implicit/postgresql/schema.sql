@@ -0,0 +1,11 @@
+CREATE TABLE users (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+
+CREATE TABLE archived_users (
+  id BIGINT PRIMARY KEY,
+  name TEXT NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
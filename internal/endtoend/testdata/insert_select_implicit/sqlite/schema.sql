@@ -0,0 +1,11 @@
+CREATE TABLE users (
+  id INTEGER PRIMARY KEY,
+  name TEXT NOT NULL,
+  deleted_at DATETIME
+);
+
+CREATE TABLE archived_users (
+  id INTEGER PRIMARY KEY,
+  name TEXT NOT NULL,
+  deleted_at DATETIME
+);
@@ -0,0 +1,3 @@
+-- name: ArchiveUsers :exec
+INSERT INTO archived_users (id, name)
+SELECT id FROM users;
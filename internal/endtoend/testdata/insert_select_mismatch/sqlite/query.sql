@@ -0,0 +1,3 @@
+-- name: ArchiveUsers :exec
+INSERT INTO archived_users
+SELECT id, name FROM users;
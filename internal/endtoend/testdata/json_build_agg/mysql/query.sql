@@ -0,0 +1,8 @@
+-- name: GetAuthorJSON :one
+SELECT JSON_OBJECT('id', id, 'name', name) AS author, JSON_ARRAY(id, meta) AS pair
+FROM authors
+WHERE id = ?;
+
+-- name: ListAuthorsJSON :one
+SELECT JSON_ARRAYAGG(name) AS names, JSON_OBJECTAGG(id, name) AS by_id, COALESCE(JSON_ARRAYAGG(meta), JSON_ARRAY()) AS metas
+FROM authors;
@@ -0,0 +1,17 @@
+-- name: GetAuthorJSON :one
+SELECT jsonb_build_object('id', id, 'name', name) AS author, to_jsonb(name) AS name, json_build_array(id, meta) AS pair
+FROM authors
+WHERE id = $1;
+
+-- name: ListAuthorsJSON :one
+SELECT jsonb_agg(name) AS names, json_object_agg(id, name) AS by_id, coalesce(jsonb_agg(meta), '[]') AS metas
+FROM authors;
+
+-- name: ListAuthorsByName :many
+SELECT name, jsonb_agg(id) AS ids, jsonb_agg(id) FILTER (WHERE meta IS NOT NULL) AS ids_with_meta
+FROM authors
+GROUP BY name;
+
+-- name: ListAuthorsWindow :many
+SELECT id, json_agg(name) OVER (ORDER BY id) AS names_so_far
+FROM authors;
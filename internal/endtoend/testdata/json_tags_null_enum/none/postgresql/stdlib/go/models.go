@@ -7,6 +7,7 @@ package db
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 )
 
@@ -19,15 +20,23 @@ const (
 )
 
 func (e *JobPostLocationType) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = JobPostLocationType(s)
+		s = string(v)
 	case string:
-		*e = JobPostLocationType(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for JobPostLocationType: %T", src)
 	}
-	return nil
+	switch JobPostLocationType(s) {
+	case JobPostLocationTypeRemote,
+		JobPostLocationTypeInOffice,
+		JobPostLocationTypeHybrid:
+		*e = JobPostLocationType(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for JobPostLocationType: %q", s)
 }
 
 type NullJobPostLocationType struct {
@@ -35,14 +44,22 @@ type NullJobPostLocationType struct {
 	Valid               bool                `json:"valid"` // Valid is true if JobPostLocationType is not NULL
 }
 
+// NewNullJobPostLocationType returns a valid NullJobPostLocationType holding e.
+func NewNullJobPostLocationType(e JobPostLocationType) NullJobPostLocationType {
+	return NullJobPostLocationType{JobPostLocationType: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullJobPostLocationType) Scan(value interface{}) error {
 	if value == nil {
 		ns.JobPostLocationType, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.JobPostLocationType.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.JobPostLocationType.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -53,6 +70,31 @@ func (ns NullJobPostLocationType) Value() (driver.Value, error) {
 	return string(ns.JobPostLocationType), nil
 }
 
+// MarshalJSON encodes ns as null if it isn't valid.
+func (ns NullJobPostLocationType) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ns.JobPostLocationType)
+}
+
+// UnmarshalJSON decodes null as a NullJobPostLocationType that isn't valid.
+func (ns *NullJobPostLocationType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		ns.JobPostLocationType, ns.Valid = "", false
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if err := ns.JobPostLocationType.Scan(s); err != nil {
+		return err
+	}
+	ns.Valid = true
+	return nil
+}
+
 type Author struct {
 	ID   int64                   `json:"id"`
 	Type NullJobPostLocationType `json:"type"`
@@ -0,0 +1,6 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListAuthorsJSON :one
+SELECT jsonb_agg(name) AS names, coalesce(jsonb_agg(meta), '[]') AS metas
+FROM authors;
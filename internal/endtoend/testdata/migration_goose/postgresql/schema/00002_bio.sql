@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN bio TEXT;
+CREATE FUNCTION user_name(id BIGINT) RETURNS TEXT AS $$
+BEGIN
+    RETURN (SELECT name FROM users WHERE users.id = user_name.id);
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION user_name;
+ALTER TABLE users DROP COLUMN bio;
+-- +goose StatementEnd
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN bio TEXT;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN bio;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- +migrate StatementBegin
+ALTER TABLE users ADD COLUMN bio TEXT;
+-- +migrate StatementEnd
+
+-- +migrate Down
+ALTER TABLE users DROP COLUMN bio;
@@ -0,0 +1,6 @@
+CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
+---- create above / drop below ----
+DROP TABLE users;
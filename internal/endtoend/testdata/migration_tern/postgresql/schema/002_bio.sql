@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN bio TEXT;
+---- create above / drop below ----
+ALTER TABLE users DROP COLUMN bio;
@@ -7,3 +7,8 @@ package querytest
 type Bar struct {
 	Tags [][]string
 }
+
+type Grid struct {
+	ID    int64
+	Cells [][]int
+}
@@ -9,6 +9,61 @@ import (
 	"context"
 )
 
+const allTags = `-- name: AllTags :one
+SELECT array_agg(tags) AS all_tags FROM bar
+`
+
+func (q *Queries) AllTags(ctx context.Context) ([][][]string, error) {
+	row := q.db.QueryRow(ctx, allTags)
+	var all_tags [][][]string
+	err := row.Scan(&all_tags)
+	return all_tags, err
+}
+
+const cells = `-- name: Cells :many
+SELECT unnest(cells) AS cell FROM grids
+`
+
+func (q *Queries) Cells(ctx context.Context) ([]int, error) {
+	rows, err := q.db.Query(ctx, cells)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int
+	for rows.Next() {
+		var cell int
+		if err := rows.Scan(&cell); err != nil {
+			return nil, err
+		}
+		items = append(items, cell)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCells = `-- name: GetCells :one
+SELECT cells FROM grids WHERE id = $1
+`
+
+func (q *Queries) GetCells(ctx context.Context, id int64) ([][]int, error) {
+	row := q.db.QueryRow(ctx, getCells, id)
+	var cells [][]int
+	err := row.Scan(&cells)
+	return cells, err
+}
+
+const insertCells = `-- name: InsertCells :exec
+INSERT INTO grids (cells) VALUES ($1)
+`
+
+func (q *Queries) InsertCells(ctx context.Context, cells [][]int) error {
+	_, err := q.db.Exec(ctx, insertCells, cells)
+	return err
+}
+
 const textArray = `-- name: TextArray :many
 SELECT tags FROM bar
 `
@@ -1,2 +1,14 @@
 -- name: TextArray :many
 SELECT * FROM bar;
+
+-- name: AllTags :one
+SELECT array_agg(tags) AS all_tags FROM bar;
+
+-- name: GetCells :one
+SELECT cells FROM grids WHERE id = $1;
+
+-- name: InsertCells :exec
+INSERT INTO grids (cells) VALUES ($1);
+
+-- name: Cells :many
+SELECT unnest(cells) AS cell FROM grids;
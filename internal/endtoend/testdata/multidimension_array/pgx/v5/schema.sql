@@ -1,2 +1,6 @@
 CREATE TABLE bar (tags text[][] not null);
 
+CREATE TABLE grids (
+  id bigserial primary key,
+  cells int[][] not null
+);
@@ -0,0 +1,22 @@
+-- name: GetAuthor :one
+-- not_found: ErrAuthorNotFound
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAuthorByName :one
+-- not_found: ErrAuthorNotFound
+SELECT * FROM authors
+WHERE name = $1 LIMIT 1;
+
+-- name: GetAuthorBio :one
+-- not_found: ErrBioNotFound
+SELECT bio FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAuthors :batchone
+-- not_found: ErrAuthorNotFound
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: CountAuthors :one
+SELECT count(*) FROM authors;
@@ -0,0 +1,13 @@
+-- name: GetAuthor :one
+-- not_found: ErrAuthorNotFound
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: GetAuthors :batchone
+-- not_found: ErrAuthorNotFound
+SELECT * FROM authors
+WHERE id = $1;
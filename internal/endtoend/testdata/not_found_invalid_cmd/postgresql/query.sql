@@ -0,0 +1,3 @@
+-- name: ListAuthors :many
+-- not_found: ErrAuthorNotFound
+SELECT * FROM authors;
@@ -0,0 +1,15 @@
+-- name: FilterByName :many
+SELECT * FROM authors
+WHERE (name = sqlc.arg(name) OR sqlc.arg(name) IS NULL);
+
+-- name: FilterByNameNullSafe :many
+SELECT * FROM authors
+WHERE name <=> sqlc.arg(name);
+
+-- name: FilterByNameCoalesce :many
+SELECT * FROM authors
+WHERE coalesce(sqlc.arg(name), name) = name;
+
+-- name: FilterByNameOrNullBio :many
+SELECT * FROM authors
+WHERE name = ? OR bio IS NULL;
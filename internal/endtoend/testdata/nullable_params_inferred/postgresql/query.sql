@@ -0,0 +1,23 @@
+-- name: FilterByName :many
+SELECT * FROM authors
+WHERE (name = $1 OR $1 IS NULL);
+
+-- name: FilterByNamedName :many
+SELECT * FROM authors
+WHERE (name = sqlc.arg(name) OR sqlc.arg(name)::text IS NULL);
+
+-- name: FilterByNameNotDistinct :many
+SELECT * FROM authors
+WHERE name IS NOT DISTINCT FROM @name;
+
+-- name: FilterByNameCoalesce :many
+SELECT * FROM authors
+WHERE coalesce(@name, name) = name;
+
+-- name: FilterByNameOrNullBio :many
+SELECT * FROM authors
+WHERE name = $1 OR bio IS NULL;
+
+-- name: FilterByNameAndNullParam :many
+SELECT * FROM authors
+WHERE name = $1 AND $1 IS NOT NULL;
@@ -0,0 +1,3 @@
+-- name: GetPrice :one
+SELECT amount, rate, whole, plain FROM prices
+WHERE id = ?;
@@ -0,0 +1,7 @@
+CREATE TABLE prices (
+  id     BIGINT PRIMARY KEY,
+  amount DECIMAL(10, 2) NOT NULL,
+  rate   DECIMAL(18, 6),
+  whole  DECIMAL(8) NOT NULL,
+  plain  DECIMAL
+);
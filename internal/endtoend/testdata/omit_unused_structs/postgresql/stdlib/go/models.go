@@ -17,15 +17,22 @@ const (
 )
 
 func (e *QueryParamEnumTableEnum) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = QueryParamEnumTableEnum(s)
+		s = string(v)
 	case string:
-		*e = QueryParamEnumTableEnum(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for QueryParamEnumTableEnum: %T", src)
 	}
-	return nil
+	switch QueryParamEnumTableEnum(s) {
+	case QueryParamEnumTableEnumG,
+		QueryParamEnumTableEnumH:
+		*e = QueryParamEnumTableEnum(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for QueryParamEnumTableEnum: %q", s)
 }
 
 type NullQueryParamEnumTableEnum struct {
@@ -33,14 +40,22 @@ type NullQueryParamEnumTableEnum struct {
 	Valid                   bool // Valid is true if QueryParamEnumTableEnum is not NULL
 }
 
+// NewNullQueryParamEnumTableEnum returns a valid NullQueryParamEnumTableEnum holding e.
+func NewNullQueryParamEnumTableEnum(e QueryParamEnumTableEnum) NullQueryParamEnumTableEnum {
+	return NullQueryParamEnumTableEnum{QueryParamEnumTableEnum: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullQueryParamEnumTableEnum) Scan(value interface{}) error {
 	if value == nil {
 		ns.QueryParamEnumTableEnum, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.QueryParamEnumTableEnum.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.QueryParamEnumTableEnum.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -59,15 +74,22 @@ const (
 )
 
 func (e *QueryParamStructEnumTableEnum) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = QueryParamStructEnumTableEnum(s)
+		s = string(v)
 	case string:
-		*e = QueryParamStructEnumTableEnum(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for QueryParamStructEnumTableEnum: %T", src)
 	}
-	return nil
+	switch QueryParamStructEnumTableEnum(s) {
+	case QueryParamStructEnumTableEnumI,
+		QueryParamStructEnumTableEnumJ:
+		*e = QueryParamStructEnumTableEnum(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for QueryParamStructEnumTableEnum: %q", s)
 }
 
 type NullQueryParamStructEnumTableEnum struct {
@@ -75,14 +97,22 @@ type NullQueryParamStructEnumTableEnum struct {
 	Valid                         bool // Valid is true if QueryParamStructEnumTableEnum is not NULL
 }
 
+// NewNullQueryParamStructEnumTableEnum returns a valid NullQueryParamStructEnumTableEnum holding e.
+func NewNullQueryParamStructEnumTableEnum(e QueryParamStructEnumTableEnum) NullQueryParamStructEnumTableEnum {
+	return NullQueryParamStructEnumTableEnum{QueryParamStructEnumTableEnum: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullQueryParamStructEnumTableEnum) Scan(value interface{}) error {
 	if value == nil {
 		ns.QueryParamStructEnumTableEnum, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.QueryParamStructEnumTableEnum.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.QueryParamStructEnumTableEnum.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -101,15 +131,22 @@ const (
 )
 
 func (e *QueryReturnEnumTableEnum) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = QueryReturnEnumTableEnum(s)
+		s = string(v)
 	case string:
-		*e = QueryReturnEnumTableEnum(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for QueryReturnEnumTableEnum: %T", src)
 	}
-	return nil
+	switch QueryReturnEnumTableEnum(s) {
+	case QueryReturnEnumTableEnumK,
+		QueryReturnEnumTableEnumL:
+		*e = QueryReturnEnumTableEnum(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for QueryReturnEnumTableEnum: %q", s)
 }
 
 type NullQueryReturnEnumTableEnum struct {
@@ -117,14 +154,22 @@ type NullQueryReturnEnumTableEnum struct {
 	Valid                    bool // Valid is true if QueryReturnEnumTableEnum is not NULL
 }
 
+// NewNullQueryReturnEnumTableEnum returns a valid NullQueryReturnEnumTableEnum holding e.
+func NewNullQueryReturnEnumTableEnum(e QueryReturnEnumTableEnum) NullQueryReturnEnumTableEnum {
+	return NullQueryReturnEnumTableEnum{QueryReturnEnumTableEnum: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullQueryReturnEnumTableEnum) Scan(value interface{}) error {
 	if value == nil {
 		ns.QueryReturnEnumTableEnum, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.QueryReturnEnumTableEnum.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.QueryReturnEnumTableEnum.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -143,15 +188,22 @@ const (
 )
 
 func (e *QueryReturnFullTableEnum) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = QueryReturnFullTableEnum(s)
+		s = string(v)
 	case string:
-		*e = QueryReturnFullTableEnum(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for QueryReturnFullTableEnum: %T", src)
 	}
-	return nil
+	switch QueryReturnFullTableEnum(s) {
+	case QueryReturnFullTableEnumE,
+		QueryReturnFullTableEnumF:
+		*e = QueryReturnFullTableEnum(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for QueryReturnFullTableEnum: %q", s)
 }
 
 type NullQueryReturnFullTableEnum struct {
@@ -159,14 +211,22 @@ type NullQueryReturnFullTableEnum struct {
 	Valid                    bool // Valid is true if QueryReturnFullTableEnum is not NULL
 }
 
+// NewNullQueryReturnFullTableEnum returns a valid NullQueryReturnFullTableEnum holding e.
+func NewNullQueryReturnFullTableEnum(e QueryReturnFullTableEnum) NullQueryReturnFullTableEnum {
+	return NullQueryReturnFullTableEnum{QueryReturnFullTableEnum: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullQueryReturnFullTableEnum) Scan(value interface{}) error {
 	if value == nil {
 		ns.QueryReturnFullTableEnum, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.QueryReturnFullTableEnum.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.QueryReturnFullTableEnum.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -185,15 +245,22 @@ const (
 )
 
 func (e *QueryReturnStructEnumTableEnum) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = QueryReturnStructEnumTableEnum(s)
+		s = string(v)
 	case string:
-		*e = QueryReturnStructEnumTableEnum(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for QueryReturnStructEnumTableEnum: %T", src)
 	}
-	return nil
+	switch QueryReturnStructEnumTableEnum(s) {
+	case QueryReturnStructEnumTableEnumK,
+		QueryReturnStructEnumTableEnumL:
+		*e = QueryReturnStructEnumTableEnum(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for QueryReturnStructEnumTableEnum: %q", s)
 }
 
 type NullQueryReturnStructEnumTableEnum struct {
@@ -201,14 +268,22 @@ type NullQueryReturnStructEnumTableEnum struct {
 	Valid                          bool // Valid is true if QueryReturnStructEnumTableEnum is not NULL
 }
 
+// NewNullQueryReturnStructEnumTableEnum returns a valid NullQueryReturnStructEnumTableEnum holding e.
+func NewNullQueryReturnStructEnumTableEnum(e QueryReturnStructEnumTableEnum) NullQueryReturnStructEnumTableEnum {
+	return NullQueryReturnStructEnumTableEnum{QueryReturnStructEnumTableEnum: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullQueryReturnStructEnumTableEnum) Scan(value interface{}) error {
 	if value == nil {
 		ns.QueryReturnStructEnumTableEnum, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.QueryReturnStructEnumTableEnum.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.QueryReturnStructEnumTableEnum.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -227,15 +302,22 @@ const (
 )
 
 func (e *QuerySqlcEmbedEnum) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = QuerySqlcEmbedEnum(s)
+		s = string(v)
 	case string:
-		*e = QuerySqlcEmbedEnum(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for QuerySqlcEmbedEnum: %T", src)
 	}
-	return nil
+	switch QuerySqlcEmbedEnum(s) {
+	case QuerySqlcEmbedEnumM,
+		QuerySqlcEmbedEnumN:
+		*e = QuerySqlcEmbedEnum(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for QuerySqlcEmbedEnum: %q", s)
 }
 
 type NullQuerySqlcEmbedEnum struct {
@@ -243,14 +325,22 @@ type NullQuerySqlcEmbedEnum struct {
 	Valid              bool // Valid is true if QuerySqlcEmbedEnum is not NULL
 }
 
+// NewNullQuerySqlcEmbedEnum returns a valid NullQuerySqlcEmbedEnum holding e.
+func NewNullQuerySqlcEmbedEnum(e QuerySqlcEmbedEnum) NullQuerySqlcEmbedEnum {
+	return NullQuerySqlcEmbedEnum{QuerySqlcEmbedEnum: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullQuerySqlcEmbedEnum) Scan(value interface{}) error {
 	if value == nil {
 		ns.QuerySqlcEmbedEnum, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.QuerySqlcEmbedEnum.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.QuerySqlcEmbedEnum.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -0,0 +1,7 @@
+CREATE TABLE authors (
+  id         BIGSERIAL PRIMARY KEY,
+  name       TEXT NOT NULL UNIQUE,
+  bio        TEXT,
+  version    INT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
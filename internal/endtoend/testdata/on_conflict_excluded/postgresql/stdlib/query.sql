@@ -0,0 +1,16 @@
+-- name: UpsertAuthor :one
+INSERT INTO authors (name, bio, version) VALUES ($1, $2, $3)
+ON CONFLICT (name) DO UPDATE SET
+  bio = EXCLUDED.bio,
+  version = EXCLUDED.version,
+  updated_at = now()
+WHERE EXCLUDED.version > $4 AND authors.bio IS DISTINCT FROM EXCLUDED.bio
+RETURNING *;
+
+-- name: UpsertAuthorBio :exec
+INSERT INTO authors AS a (name, bio) VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET bio = EXCLUDED.bio || $3
+WHERE a.version < EXCLUDED.version + $4;
+
+-- name: InsertAuthorIgnore :exec
+INSERT INTO authors (name) VALUES ($1) ON CONFLICT DO NOTHING;
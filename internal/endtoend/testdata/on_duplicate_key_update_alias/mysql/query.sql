@@ -0,0 +1,17 @@
+-- name: UpsertAuthorValues :exec
+INSERT INTO authors (name, bio, books)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY
+    UPDATE bio = VALUES(bio), books = VALUES(books) + ?;
+
+-- name: UpsertAuthorAlias :exec
+INSERT INTO authors (name, bio, books)
+VALUES (?, ?, ?) AS new
+ON DUPLICATE KEY
+    UPDATE bio = new.bio, books = new.books + ?;
+
+-- name: UpsertAuthorColumnAliases :exec
+INSERT INTO authors (name, bio, books)
+VALUES (?, ?, ?) AS new(n, b, c)
+ON DUPLICATE KEY
+    UPDATE name = 'unknown', bio = b, books = c + ?;
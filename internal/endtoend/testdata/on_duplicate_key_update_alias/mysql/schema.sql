@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+          id    BIGINT PRIMARY KEY AUTO_INCREMENT,
+          name  text      NOT NULL,
+          bio   text,
+          books int       NOT NULL DEFAULT 0
+);
@@ -4,6 +4,6 @@
 
 package querytest
 
-type Bar struct {
-	Tags [][]string
+type LoadTest struct {
+	ID int64
 }
@@ -0,0 +1,10 @@
+-- name: GetUser :one
+SELECT * FROM users WHERE id = $1;
+
+-- name: ListOrders :many
+SELECT sqlc.embed(orders), sqlc.embed(refunds)
+FROM orders
+LEFT JOIN refunds ON refunds.order_id = orders.id;
+
+-- name: ListUserChanges :many
+SELECT * FROM audit.users WHERE user_id = $1;
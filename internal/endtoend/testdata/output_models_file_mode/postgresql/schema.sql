@@ -0,0 +1,30 @@
+CREATE TYPE user_status AS ENUM ('active', 'disabled');
+CREATE TYPE currency AS ENUM ('eur', 'usd');
+
+CREATE TABLE users (
+  id     BIGSERIAL   PRIMARY KEY,
+  name   text        NOT NULL,
+  status user_status NOT NULL
+);
+
+CREATE TABLE orders (
+  id       BIGSERIAL PRIMARY KEY,
+  user_id  bigint    NOT NULL REFERENCES users (id),
+  currency currency
+);
+
+CREATE TABLE refunds (
+  order_id bigint   NOT NULL REFERENCES orders (id),
+  currency currency NOT NULL
+);
+
+CREATE SCHEMA audit;
+
+CREATE TABLE audit.users (
+  user_id    bigint      NOT NULL,
+  changed_at timestamptz NOT NULL
+);
+
+CREATE TABLE load_test (
+  id bigint NOT NULL
+);
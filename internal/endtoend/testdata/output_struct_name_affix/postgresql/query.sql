@@ -0,0 +1,20 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListBooks :many
+SELECT sqlc.embed(authors), books.title, books.status
+FROM books
+JOIN authors ON authors.id = books.author_id
+WHERE books.status = $1;
+
+-- name: CreateBook :one
+INSERT INTO books (author_id, title, status) VALUES ($1, $2, $3) RETURNING *;
+
+-- name: ListAuditLog :many
+SELECT * FROM audit_log;
+
+-- name: ListAuthorsSorted :many
+SELECT * FROM authors ORDER BY sqlc.orderby('name', 'id');
+
+-- name: DeleteBooks :batchexec
+DELETE FROM books WHERE id = $1;
@@ -0,0 +1,18 @@
+CREATE TYPE status AS ENUM ('draft', 'published');
+
+CREATE TABLE authors (
+    id   bigserial PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE books (
+    id        bigserial PRIMARY KEY,
+    author_id bigint NOT NULL REFERENCES authors (id),
+    title     text NOT NULL,
+    status    status
+);
+
+CREATE TABLE audit_log (
+    id      bigserial PRIMARY KEY,
+    message text NOT NULL
+);
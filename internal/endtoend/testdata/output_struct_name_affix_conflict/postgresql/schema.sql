@@ -0,0 +1,7 @@
+CREATE TABLE users (
+    id bigserial PRIMARY KEY
+);
+
+CREATE TABLE accounts (
+    id bigserial PRIMARY KEY
+);
@@ -0,0 +1,8 @@
+-- name: ListDevices :many
+SELECT * FROM devices WHERE id IN (sqlc.slice('ids'));
+
+-- name: ListOwnedDevices :many
+SELECT * FROM devices WHERE name = ? AND owner_id IN (sqlc.slice('owner_ids'));
+
+-- name: CreateDevices :copyfrom
+INSERT INTO devices (id, owner_id, name) VALUES (?, ?, ?);
@@ -0,0 +1,5 @@
+CREATE TABLE devices (
+    id       CHAR(36) PRIMARY KEY,
+    owner_id CHAR(36),
+    name     TEXT NOT NULL
+);
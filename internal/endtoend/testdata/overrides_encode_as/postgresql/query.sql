@@ -0,0 +1,11 @@
+-- name: GetDevice :one
+SELECT * FROM devices WHERE id = $1;
+
+-- name: CreateDevice :exec
+INSERT INTO devices (id, owner_id, fingerprint) VALUES ($1, $2, $3);
+
+-- name: ListDevices :many
+SELECT * FROM devices WHERE id IN (sqlc.slice('ids'));
+
+-- name: ListOwnedDevices :many
+SELECT * FROM devices WHERE fingerprint = $1 AND owner_id IN (sqlc.slice('owner_ids'));
@@ -0,0 +1,5 @@
+CREATE TABLE devices (
+    id          uuid PRIMARY KEY,
+    owner_id    uuid,
+    fingerprint bytea NOT NULL
+);
@@ -0,0 +1,18 @@
+-- name: GetJob :one
+SELECT * FROM jobs WHERE id = $1;
+
+-- name: JobReport :many
+-- sqlc.override: column=elapsed_ms go_type=time.Duration
+-- sqlc.override: column=total_ms go_type=time.Duration
+SELECT name, elapsed_ms, sum(elapsed_ms)::bigint AS total_ms
+FROM jobs
+GROUP BY name, elapsed_ms;
+
+-- name: ListSlowJobs :many
+-- sqlc.override: column=min_elapsed go_type=time.Duration
+SELECT id, name FROM jobs
+WHERE elapsed_ms > sqlc.arg(min_elapsed)::bigint;
+
+-- name: ListJobDurations :many
+-- sqlc.override: column=*_ms go_type=time.Duration
+SELECT name, elapsed_ms FROM jobs;
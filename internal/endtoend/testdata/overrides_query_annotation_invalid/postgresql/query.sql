@@ -0,0 +1,17 @@
+-- name: MissingGoType :many
+-- sqlc.override: column=elapsed_ms
+SELECT * FROM jobs;
+
+-- name: UnknownKey :many
+-- sqlc.override: column=elapsed_ms go_type=time.Duration
+-- sqlc.override: column=name type=string
+SELECT * FROM jobs;
+
+-- name: NotAPair :many
+-- sqlc.override: column elapsed_ms
+SELECT * FROM jobs;
+
+-- name: Duplicate :many
+/* sqlc.override: column=elapsed_ms go_type=time.Duration */
+/* sqlc.override: column=elapsed_ms go_type=int64 */
+SELECT * FROM jobs;
@@ -0,0 +1,5 @@
+CREATE TABLE jobs (
+  id         BIGSERIAL PRIMARY KEY,
+  name       TEXT NOT NULL,
+  elapsed_ms BIGINT NOT NULL
+);
@@ -0,0 +1,7 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: CountAuthors :one
+SELECT count(*) FROM authors
+WHERE created_at > $1::date;
@@ -0,0 +1,8 @@
+CREATE DOMAIN email AS text;
+
+CREATE TABLE authors (
+  id         uuid  PRIMARY KEY,
+  name       text  NOT NULL,
+  email      email NOT NULL,
+  created_at timestamptz NOT NULL
+);
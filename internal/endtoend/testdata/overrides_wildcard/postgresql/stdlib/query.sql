@@ -0,0 +1,5 @@
+-- name: ListEvents :many
+SELECT * FROM events;
+
+-- name: ListAudits :many
+SELECT * FROM audits;
@@ -0,0 +1,13 @@
+CREATE TABLE events (
+    id         BIGSERIAL PRIMARY KEY,
+    name       TEXT NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE TABLE audits (
+    id         BIGSERIAL PRIMARY KEY,
+    action     TEXT NOT NULL,
+    created_at TIMESTAMPTZ
+);
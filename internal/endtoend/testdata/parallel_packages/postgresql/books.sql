@@ -0,0 +1,8 @@
+-- name: GetBook :one
+SELECT * FROM books
+WHERE id = $1 LIMIT 1;
+
+-- name: ListBooksByAuthor :many
+SELECT * FROM books
+WHERE author_id = $1
+ORDER BY title;
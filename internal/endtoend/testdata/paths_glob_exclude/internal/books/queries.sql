@@ -0,0 +1,2 @@
+-- name: ListBooksByAuthor :many
+SELECT * FROM books WHERE author_id = ?;
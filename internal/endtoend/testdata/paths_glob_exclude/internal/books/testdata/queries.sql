@@ -0,0 +1,3 @@
+-- Excluded, as the table doesn't exist
+-- name: ListMagazines :many
+SELECT * FROM magazines;
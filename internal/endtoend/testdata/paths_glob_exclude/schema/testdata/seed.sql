@@ -0,0 +1,2 @@
+-- Not part of the schema, and would fail as the table already exists
+CREATE TABLE authors (id INTEGER PRIMARY KEY);
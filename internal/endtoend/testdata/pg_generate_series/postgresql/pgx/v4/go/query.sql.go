@@ -19,15 +19,15 @@ type GenerateSeriesParams struct {
 	Column2 time.Time `json:"column_2"`
 }
 
-func (q *Queries) GenerateSeries(ctx context.Context, arg GenerateSeriesParams) ([]int64, error) {
+func (q *Queries) GenerateSeries(ctx context.Context, arg GenerateSeriesParams) ([]time.Time, error) {
 	rows, err := q.db.Query(ctx, generateSeries, arg.Column1, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int64
+	var items []time.Time
 	for rows.Next() {
-		var generate_series int64
+		var generate_series time.Time
 		if err := rows.Scan(&generate_series); err != nil {
 			return nil, err
 		}
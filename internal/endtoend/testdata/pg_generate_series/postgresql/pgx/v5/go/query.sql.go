@@ -20,15 +20,15 @@ type GenerateSeriesParams struct {
 	Column2 pgtype.Timestamp `json:"column_2"`
 }
 
-func (q *Queries) GenerateSeries(ctx context.Context, arg GenerateSeriesParams) ([]int64, error) {
+func (q *Queries) GenerateSeries(ctx context.Context, arg GenerateSeriesParams) ([]pgtype.Timestamp, error) {
 	rows, err := q.db.Query(ctx, generateSeries, arg.Column1, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int64
+	var items []pgtype.Timestamp
 	for rows.Next() {
-		var generate_series int64
+		var generate_series pgtype.Timestamp
 		if err := rows.Scan(&generate_series); err != nil {
 			return nil, err
 		}
@@ -8,9 +8,44 @@ package querytest
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pgvector/pgvector-go"
 )
 
+const cosineDistance = `-- name: CosineDistance :one
+SELECT cosine_distance(embedding, $1)
+FROM items
+WHERE id = $2
+`
+
+type CosineDistanceParams struct {
+	CosineDistance pgvector.Vector
+	ID             int64
+}
+
+func (q *Queries) CosineDistance(ctx context.Context, arg CosineDistanceParams) (float64, error) {
+	row := q.db.QueryRow(ctx, cosineDistance, arg.CosineDistance, arg.ID)
+	var cosine_distance float64
+	err := row.Scan(&cosine_distance)
+	return cosine_distance, err
+}
+
+const innerProduct = `-- name: InnerProduct :one
+SELECT $1::vector <#> $2 AS product
+`
+
+type InnerProductParams struct {
+	Column1 pgvector.Vector
+	Column2 pgvector.Vector
+}
+
+func (q *Queries) InnerProduct(ctx context.Context, arg InnerProductParams) (pgtype.Float8, error) {
+	row := q.db.QueryRow(ctx, innerProduct, arg.Column1, arg.Column2)
+	var product pgtype.Float8
+	err := row.Scan(&product)
+	return product, err
+}
+
 const insertVector = `-- name: InsertVector :exec
 INSERT INTO items (embedding) VALUES ($1)
 `
@@ -20,6 +55,38 @@ func (q *Queries) InsertVector(ctx context.Context, embedding pgvector.Vector) e
 	return err
 }
 
+const nearestByCosine = `-- name: NearestByCosine :many
+SELECT id, embedding <=> $1 AS distance
+FROM items
+ORDER BY embedding <=> $1
+LIMIT 5
+`
+
+type NearestByCosineRow struct {
+	ID       int64
+	Distance pgtype.Float8
+}
+
+func (q *Queries) NearestByCosine(ctx context.Context, embedding pgvector.Vector) ([]NearestByCosineRow, error) {
+	rows, err := q.db.Query(ctx, nearestByCosine, embedding)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NearestByCosineRow
+	for rows.Next() {
+		var i NearestByCosineRow
+		if err := rows.Scan(&i.ID, &i.Distance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const nearestNeighbor = `-- name: NearestNeighbor :many
 SELECT id, embedding
 FROM items
@@ -46,3 +113,16 @@ func (q *Queries) NearestNeighbor(ctx context.Context, embedding pgvector.Vector
 	}
 	return items, nil
 }
+
+const vectorDims = `-- name: VectorDims :one
+SELECT vector_dims(embedding)
+FROM items
+WHERE id = $1
+`
+
+func (q *Queries) VectorDims(ctx context.Context, id int64) (int32, error) {
+	row := q.db.QueryRow(ctx, vectorDims, id)
+	var vector_dims int32
+	err := row.Scan(&vector_dims)
+	return vector_dims, err
+}
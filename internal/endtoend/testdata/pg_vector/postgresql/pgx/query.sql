@@ -6,3 +6,22 @@ SELECT *
 FROM items
 ORDER BY embedding <-> $1
 LIMIT 5;
+
+-- name: NearestByCosine :many
+SELECT id, embedding <=> $1 AS distance
+FROM items
+ORDER BY embedding <=> $1
+LIMIT 5;
+
+-- name: InnerProduct :one
+SELECT $1::vector <#> $2 AS product;
+
+-- name: CosineDistance :one
+SELECT cosine_distance(embedding, $1)
+FROM items
+WHERE id = $2;
+
+-- name: VectorDims :one
+SELECT vector_dims(embedding)
+FROM items
+WHERE id = $1;
@@ -0,0 +1,28 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = sqlc.arg(author_id);
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+WHERE name = @name
+  AND (sqlc.narg(bio)::text IS NULL OR bio = sqlc.narg(bio))
+  AND country <> '$1 @country'
+ORDER BY id;
+
+-- name: UpdateAuthor :exec
+UPDATE authors
+SET name = @name, bio = sqlc.narg(bio), country = @country
+WHERE id = @id;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = $1;
+
+-- name: FindAuthors :many
+SELECT * FROM authors
+WHERE name = $1 AND country = $2;
+
+-- name: CreateAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES (@name, @bio);
+
+-- name: DeleteAuthors :batchexec
+DELETE FROM authors WHERE id = @id;
@@ -0,0 +1,7 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: StreamAuthors :stream
+SELECT * FROM authors
+ORDER BY name;
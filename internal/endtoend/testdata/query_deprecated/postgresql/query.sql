@@ -0,0 +1,13 @@
+-- name: GetAuthorByID :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAuthor :one
+-- Returns the author with the given ID.
+-- @deprecated use GetAuthorByID instead
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthorNames :many
+-- @deprecated names are no longer unique, use ListAuthors instead
+SELECT name FROM authors;
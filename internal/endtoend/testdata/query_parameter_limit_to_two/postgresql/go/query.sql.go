@@ -8,6 +8,7 @@ package querytest
 import (
 	"context"
 	"database/sql"
+	"strconv"
 	"strings"
 
 	"github.com/lib/pq"
@@ -92,7 +93,7 @@ func (q *Queries) DeleteAuthor(ctx context.Context, id int64) error {
 
 const deleteAuthors = `-- name: DeleteAuthors :exec
 DELETE FROM authors
-WHERE id IN ($2) AND name = $1
+WHERE id IN ($2/*SLICE:ids*/) AND name = $1
 `
 
 func (q *Queries) DeleteAuthors(ctx context.Context, name string, ids []int64) error {
@@ -100,12 +101,17 @@ func (q *Queries) DeleteAuthors(ctx context.Context, name string, ids []int64) e
 	var queryParams []interface{}
 	queryParams = append(queryParams, name)
 	if len(ids) > 0 {
-		for _, v := range ids {
+		queryParams = append(queryParams, ids[0])
+	} else {
+		queryParams = append(queryParams, nil)
+	}
+	if len(ids) > 1 {
+		var placeholders string
+		for _, v := range ids[1:] {
 			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
 		}
-		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
-	} else {
-		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+		query = strings.ReplaceAll(query, "/*SLICE:ids*/", placeholders)
 	}
 	_, err := q.db.ExecContext(ctx, query, queryParams...)
 	return err
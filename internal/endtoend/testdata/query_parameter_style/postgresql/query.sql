@@ -0,0 +1,23 @@
+-- name: GetAuthor :one
+-- param_style: struct
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAuthorByName :one
+SELECT * FROM authors
+WHERE name = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+-- param_style: struct
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: CreateAuthor :one
+-- Creates an author
+-- param_style: positional
+INSERT INTO authors (name, bio) VALUES ($1, $2)
+RETURNING *;
+
+-- name: UpdateAuthor :exec
+UPDATE authors SET name = $2, bio = $3
+WHERE id = $1;
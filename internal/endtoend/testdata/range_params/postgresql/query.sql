@@ -0,0 +1,23 @@
+-- name: ListEventsAt :many
+SELECT * FROM events WHERE during @> @at;
+
+-- name: ListEventsOnDay :many
+SELECT id FROM events WHERE @day <@ days;
+
+-- name: ListOverlapping :many
+SELECT id FROM events WHERE during && @period;
+
+-- name: ListInWindow :many
+SELECT id FROM events WHERE during && tstzrange(@start, @finish);
+
+-- name: HasSeat :one
+SELECT seats @> @seat::int4 FROM events WHERE id = @id;
+
+-- name: IsSmallSeat :one
+SELECT int4range(1, 10) @> @seat;
+
+-- name: CreateEvent :one
+INSERT INTO events (during, days, slots, seats) VALUES ($1, $2, $3, $4) RETURNING id;
+
+-- name: OverlapsPeriod :one
+SELECT during && @period AS overlaps FROM events WHERE id = @id;
@@ -0,0 +1,7 @@
+CREATE TABLE events (
+    id     bigserial PRIMARY KEY,
+    during tstzrange NOT NULL,
+    days   daterange,
+    slots  int4range[] NOT NULL,
+    seats  int4multirange NOT NULL
+);
@@ -18,15 +18,23 @@ const (
 )
 
 func (e *IPProtocol) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = IPProtocol(s)
+		s = string(v)
 	case string:
-		*e = IPProtocol(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for IPProtocol: %T", src)
 	}
-	return nil
+	switch IPProtocol(s) {
+	case IPProtocolTCP,
+		IpProtocolIp,
+		IpProtocolIcmp:
+		*e = IPProtocol(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for IPProtocol: %q", s)
 }
 
 type NullIPProtocol struct {
@@ -34,14 +42,22 @@ type NullIPProtocol struct {
 	Valid      bool // Valid is true if IPProtocol is not NULL
 }
 
+// NewNullIPProtocol returns a valid NullIPProtocol holding e.
+func NewNullIPProtocol(e IPProtocol) NullIPProtocol {
+	return NullIPProtocol{IPProtocol: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullIPProtocol) Scan(value interface{}) error {
 	if value == nil {
 		ns.IPProtocol, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.IPProtocol.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.IPProtocol.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -0,0 +1,13 @@
+-- name: ListSessions :many
+SELECT * FROM sessions;
+
+-- name: ListSessionAddresses :many
+SELECT ip, user_id FROM sessions
+WHERE user_id = $1;
+
+-- name: ListLogins :many
+SELECT ip FROM logins;
+
+-- name: CreateAuditSession :one
+INSERT INTO audit.sessions (ip) VALUES ($1)
+RETURNING *;
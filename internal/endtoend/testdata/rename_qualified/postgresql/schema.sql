@@ -0,0 +1,17 @@
+CREATE SCHEMA audit;
+
+CREATE TABLE sessions (
+  id      BIGSERIAL PRIMARY KEY,
+  ip      text NOT NULL,
+  user_id bigint NOT NULL
+);
+
+CREATE TABLE logins (
+  id BIGSERIAL PRIMARY KEY,
+  ip text NOT NULL
+);
+
+CREATE TABLE audit.sessions (
+  id BIGSERIAL PRIMARY KEY,
+  ip text NOT NULL
+);
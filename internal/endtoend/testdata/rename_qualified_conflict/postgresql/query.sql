@@ -0,0 +1,2 @@
+-- name: ListSessions :many
+SELECT * FROM sessions;
@@ -0,0 +1,5 @@
+CREATE TABLE sessions (
+  id      BIGSERIAL PRIMARY KEY,
+  ip      text NOT NULL,
+  address text NOT NULL
+);
@@ -0,0 +1,8 @@
+-- name: GetUserByEmail :one
+SELECT id FROM users WHERE email = $1;
+
+-- name: ListEmails :many
+SELECT email FROM users;
+
+-- name: ListProfiles :many
+SELECT * FROM profiles;
@@ -36,6 +36,52 @@ func (q *Queries) DeleteUserAndReturnUser(ctx context.Context, name sql.NullStri
 	return i, err
 }
 
+const deleteUsersAndReturnIDs = `-- name: DeleteUsersAndReturnIDs :many
+DELETE FROM users
+  WHERE name = ?1
+  RETURNING users.id
+`
+
+func (q *Queries) DeleteUsersAndReturnIDs(ctx context.Context, name sql.NullString) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, deleteUsersAndReturnIDs, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertUserAndReturnAliases = `-- name: InsertUserAndReturnAliases :one
+INSERT INTO users (name) VALUES (?1)
+  RETURNING id AS user_id, name AS user_name
+`
+
+type InsertUserAndReturnAliasesRow struct {
+	UserID   int64
+	UserName sql.NullString
+}
+
+func (q *Queries) InsertUserAndReturnAliases(ctx context.Context, name sql.NullString) (InsertUserAndReturnAliasesRow, error) {
+	row := q.db.QueryRowContext(ctx, insertUserAndReturnAliases, name)
+	var i InsertUserAndReturnAliasesRow
+	err := row.Scan(&i.UserID, &i.UserName)
+	return i, err
+}
+
 const insertUserAndReturnID = `-- name: InsertUserAndReturnID :one
 INSERT INTO users (name) VALUES (?1)
   RETURNING id
@@ -95,3 +141,40 @@ func (q *Queries) UpdateUserAndReturnUser(ctx context.Context, arg UpdateUserAnd
 	err := row.Scan(&i.Name, &i.ID)
 	return i, err
 }
+
+const updateUserAndReturnUserAndName = `-- name: UpdateUserAndReturnUserAndName :one
+UPDATE users SET name = ?1
+  WHERE name = ?2
+  RETURNING name, id, name AS new_name
+`
+
+type UpdateUserAndReturnUserAndNameParams struct {
+	Name   sql.NullString
+	Name_2 sql.NullString
+}
+
+type UpdateUserAndReturnUserAndNameRow struct {
+	Name    sql.NullString
+	ID      int64
+	NewName sql.NullString
+}
+
+func (q *Queries) UpdateUserAndReturnUserAndName(ctx context.Context, arg UpdateUserAndReturnUserAndNameParams) (UpdateUserAndReturnUserAndNameRow, error) {
+	row := q.db.QueryRowContext(ctx, updateUserAndReturnUserAndName, arg.Name, arg.Name_2)
+	var i UpdateUserAndReturnUserAndNameRow
+	err := row.Scan(&i.Name, &i.ID, &i.NewName)
+	return i, err
+}
+
+const upsertUserAndReturnUser = `-- name: UpsertUserAndReturnUser :one
+INSERT INTO users (name) VALUES (?1)
+  ON CONFLICT (name) DO UPDATE SET name = excluded.name
+  RETURNING name, id
+`
+
+func (q *Queries) UpsertUserAndReturnUser(ctx context.Context, name sql.NullString) (User, error) {
+	row := q.db.QueryRowContext(ctx, upsertUserAndReturnUser, name)
+	var i User
+	err := row.Scan(&i.Name, &i.ID)
+	return i, err
+}
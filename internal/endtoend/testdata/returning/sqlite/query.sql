@@ -25,3 +25,22 @@ DELETE FROM users
 DELETE FROM users
   WHERE name = ?1
   RETURNING *;
+
+-- name: InsertUserAndReturnAliases :one
+INSERT INTO users (name) VALUES (?1)
+  RETURNING id AS user_id, name AS user_name;
+
+-- name: UpdateUserAndReturnUserAndName :one
+UPDATE users SET name = ?1
+  WHERE name = ?2
+  RETURNING *, name AS new_name;
+
+-- name: DeleteUsersAndReturnIDs :many
+DELETE FROM users
+  WHERE name = ?1
+  RETURNING users.id;
+
+-- name: UpsertUserAndReturnUser :one
+INSERT INTO users (name) VALUES (?1)
+  ON CONFLICT (name) DO UPDATE SET name = excluded.name
+  RETURNING *;
@@ -17,15 +17,22 @@ const (
 )
 
 func (e *FooTypeUserRole) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = FooTypeUserRole(s)
+		s = string(v)
 	case string:
-		*e = FooTypeUserRole(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for FooTypeUserRole: %T", src)
 	}
-	return nil
+	switch FooTypeUserRole(s) {
+	case FooTypeUserRoleAdmin,
+		FooTypeUserRoleUser:
+		*e = FooTypeUserRole(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for FooTypeUserRole: %q", s)
 }
 
 type NullFooTypeUserRole struct {
@@ -33,14 +40,22 @@ type NullFooTypeUserRole struct {
 	Valid           bool // Valid is true if FooTypeUserRole is not NULL
 }
 
+// NewNullFooTypeUserRole returns a valid NullFooTypeUserRole holding e.
+func NewNullFooTypeUserRole(e FooTypeUserRole) NullFooTypeUserRole {
+	return NullFooTypeUserRole{FooTypeUserRole: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullFooTypeUserRole) Scan(value interface{}) error {
 	if value == nil {
 		ns.FooTypeUserRole, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.FooTypeUserRole.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.FooTypeUserRole.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
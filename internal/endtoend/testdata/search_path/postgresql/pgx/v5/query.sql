@@ -0,0 +1,22 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: ListTags :many
+SELECT * FROM tags
+ORDER BY name;
+
+-- name: CreateTag :one
+INSERT INTO tags (name) VALUES ($1)
+RETURNING *;
+
+-- name: UpdateTagDescription :exec
+UPDATE tags SET description = $2
+WHERE id = $1;
+
+-- name: ListBooksByTag :many
+SELECT books.id, books.title, authors.name AS author, tags.name AS tag
+FROM books
+JOIN authors ON authors.id = books.author_id
+JOIN public.tags ON tags.id = books.tag_id
+WHERE tags.name = $1;
@@ -0,0 +1,20 @@
+CREATE SCHEMA app;
+
+CREATE TABLE public.tags (
+    id   BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE TABLE authors (
+    id   BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE TABLE books (
+    id        BIGSERIAL PRIMARY KEY,
+    author_id BIGINT NOT NULL REFERENCES authors (id),
+    tag_id    BIGINT REFERENCES tags (id),
+    title     TEXT
+);
+
+ALTER TABLE tags ADD COLUMN description TEXT;
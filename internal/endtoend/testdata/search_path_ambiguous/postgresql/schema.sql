@@ -0,0 +1,11 @@
+CREATE SCHEMA app;
+
+CREATE TABLE authors (
+    id   BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE TABLE public.authors (
+    id   BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
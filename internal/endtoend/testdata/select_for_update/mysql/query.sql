@@ -0,0 +1,15 @@
+-- name: ClaimJobs :many
+SELECT * FROM jobs
+WHERE queue = sqlc.arg(queue) AND state IN (sqlc.slice(states))
+ORDER BY run_at
+LIMIT ?
+FOR UPDATE SKIP LOCKED;
+
+-- name: ClaimJob :one
+SELECT j.id FROM jobs j WHERE j.queue = sqlc.arg(queue) LIMIT 1 FOR UPDATE OF j NOWAIT;
+
+-- name: ShareJobs :many
+SELECT id FROM jobs WHERE queue = ? FOR SHARE SKIP LOCKED;
+
+-- name: LockInShareMode :many
+SELECT id FROM jobs WHERE queue = ? LOCK IN SHARE MODE;
@@ -0,0 +1,6 @@
+CREATE TABLE jobs (
+    id     BIGINT PRIMARY KEY AUTO_INCREMENT,
+    queue  VARCHAR(255) NOT NULL,
+    state  VARCHAR(32) NOT NULL,
+    run_at DATETIME NOT NULL
+);
@@ -0,0 +1,21 @@
+-- name: ClaimJobs :many
+SELECT * FROM jobs
+WHERE queue = sqlc.arg(queue)
+ORDER BY run_at
+LIMIT sqlc.arg(max)
+FOR UPDATE SKIP LOCKED;
+
+-- name: ClaimJob :one
+SELECT j.id FROM jobs j
+JOIN workers w ON w.job_id = j.id
+WHERE j.queue = sqlc.arg(queue) AND w.id = sqlc.arg(worker_id)
+LIMIT 1
+FOR NO KEY UPDATE OF j NOWAIT;
+
+-- name: ShareJobs :many
+SELECT id FROM jobs WHERE queue = $1 FOR SHARE SKIP LOCKED;
+
+-- name: KeyShareJobs :many
+SELECT j.id, w.id AS worker_id FROM jobs j, workers w
+WHERE w.job_id = j.id
+FOR KEY SHARE OF j FOR UPDATE OF w SKIP LOCKED;
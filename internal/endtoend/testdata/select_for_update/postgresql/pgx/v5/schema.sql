@@ -0,0 +1,10 @@
+CREATE TABLE jobs (
+    id     BIGSERIAL PRIMARY KEY,
+    queue  TEXT NOT NULL,
+    run_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE workers (
+    id     BIGSERIAL PRIMARY KEY,
+    job_id BIGINT REFERENCES jobs (id)
+);
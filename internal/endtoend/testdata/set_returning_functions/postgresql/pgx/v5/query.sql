@@ -0,0 +1,26 @@
+-- name: Names :many
+SELECT * FROM unnest($1::text[]) AS t(name);
+
+-- name: Series :many
+SELECT * FROM generate_series($1::int, $2::int) g(n);
+
+-- name: SeriesNoAlias :many
+SELECT n FROM generate_series(1, $1::int) AS n;
+
+-- name: SeriesUntyped :many
+SELECT * FROM generate_series($1, $2) g(n);
+
+-- name: Ordinal :many
+SELECT t.name, t.idx FROM unnest($1::text[]) WITH ORDINALITY AS t(name, idx);
+
+-- name: Stats :many
+SELECT * FROM author_stats($1);
+
+-- name: StatsJoin :many
+SELECT a.name, s.name_length FROM authors a JOIN author_stats(0) s ON s.author_id = a.id;
+
+-- name: NamesFn :many
+SELECT * FROM author_names();
+
+-- name: Multi :many
+SELECT * FROM unnest($1::text[], $2::int[]) AS t(name, n);
@@ -0,0 +1,11 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL
+);
+
+CREATE FUNCTION author_stats(min_id bigint)
+RETURNS TABLE (author_id bigint, name_length int)
+AS $$ SELECT id, length(name) FROM authors WHERE id >= min_id $$ LANGUAGE sql;
+
+CREATE FUNCTION author_names() RETURNS SETOF text
+AS $$ SELECT name FROM authors $$ LANGUAGE sql;
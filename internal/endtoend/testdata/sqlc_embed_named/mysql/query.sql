@@ -0,0 +1,11 @@
+-- name: GetMessage :one
+SELECT sqlc.embed(messages), sqlc.embed(s, 'sender'), sqlc.embed(r, 'receiver')
+FROM messages
+JOIN users s ON s.id = messages.sender_id
+LEFT JOIN users r ON r.id = messages.receiver_id
+WHERE messages.id = ?;
+
+-- name: ListSentMessages :many
+SELECT sqlc.embed(users,'author'), messages.body
+FROM users
+JOIN messages ON messages.sender_id = users.id;
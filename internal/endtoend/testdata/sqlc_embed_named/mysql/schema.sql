@@ -0,0 +1,11 @@
+CREATE TABLE users (
+  id   int NOT NULL PRIMARY KEY,
+  name text    NOT NULL
+);
+
+CREATE TABLE messages (
+  id          int NOT NULL PRIMARY KEY,
+  sender_id   int NOT NULL,
+  receiver_id int,
+  body        text    NOT NULL
+);
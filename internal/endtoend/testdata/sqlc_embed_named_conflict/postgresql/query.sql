@@ -0,0 +1,4 @@
+-- name: SameName :one
+SELECT sqlc.embed(s, 'user'), sqlc.embed(users)
+FROM users
+JOIN users s ON s.id = users.id;
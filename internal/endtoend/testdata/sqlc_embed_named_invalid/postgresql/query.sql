@@ -0,0 +1,5 @@
+-- name: NotAString :one
+SELECT sqlc.embed(users, name) FROM users;
+
+-- name: NotAnIdentifier :one
+SELECT sqlc.embed(users, 'the user') FROM users;
@@ -0,0 +1,11 @@
+CREATE TABLE users (
+  id   integer NOT NULL PRIMARY KEY,
+  name text    NOT NULL
+);
+
+CREATE TABLE messages (
+  id          integer NOT NULL PRIMARY KEY,
+  sender_id   integer NOT NULL,
+  receiver_id integer,
+  body        text    NOT NULL
+);
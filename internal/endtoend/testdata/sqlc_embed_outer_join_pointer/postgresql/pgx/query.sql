@@ -0,0 +1,26 @@
+-- name: LeftJoin :many
+SELECT sqlc.embed(posts), sqlc.embed(users) FROM posts
+LEFT JOIN users ON users.id = posts.user_id;
+
+-- name: RightJoin :many
+SELECT sqlc.embed(posts), sqlc.embed(users) FROM posts
+RIGHT JOIN users ON users.id = posts.user_id;
+
+-- name: FullJoin :many
+SELECT sqlc.embed(posts), sqlc.embed(users) FROM posts
+FULL JOIN users ON users.id = posts.user_id;
+
+-- name: LeftJoinAlias :one
+SELECT sqlc.embed(p), sqlc.embed(u) FROM posts p
+LEFT JOIN users u ON u.id = p.user_id
+WHERE p.id = $1;
+
+-- name: LeftJoinBatchOne :batchone
+SELECT sqlc.embed(posts), sqlc.embed(users) FROM posts
+LEFT JOIN users ON users.id = posts.user_id
+WHERE posts.id = $1;
+
+-- name: LeftJoinBatchMany :batchmany
+SELECT sqlc.embed(posts), sqlc.embed(users) FROM posts
+LEFT JOIN users ON users.id = posts.user_id
+WHERE posts.user_id = $1;
@@ -0,0 +1,12 @@
+CREATE TABLE users (
+    id integer NOT NULL PRIMARY KEY,
+    name text NOT NULL,
+    age integer,
+    tags text[] NOT NULL
+);
+
+CREATE TABLE posts (
+    id integer NOT NULL PRIMARY KEY,
+    user_id integer,
+    title text NOT NULL
+);
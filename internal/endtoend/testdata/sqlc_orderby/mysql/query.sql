@@ -0,0 +1,14 @@
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY sqlc.orderby('name', 'created_at');
+
+-- name: ListAuthorsByBio :many
+SELECT a.id, a.name FROM authors a
+WHERE a.bio = ?
+ORDER BY sqlc.orderby('a.name', 'a.created_at'), a.id
+LIMIT ?;
+
+-- name: ListAuthorsByIDs :many
+SELECT * FROM authors
+WHERE id IN (sqlc.slice('ids'))
+ORDER BY sqlc.orderby('name');
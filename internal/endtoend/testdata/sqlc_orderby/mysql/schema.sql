@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+  id         BIGINT PRIMARY KEY AUTO_INCREMENT,
+  name       TEXT NOT NULL,
+  bio        TEXT,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,14 @@
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY sqlc.orderby('name', 'created_at');
+
+-- name: ListAuthorsByBio :many
+SELECT a.id, a.name FROM authors a
+WHERE a.bio = $1
+ORDER BY sqlc.orderby('a.name', 'a.created_at') NULLS LAST, a.id
+LIMIT $2;
+
+-- name: ListAuthorsByIDs :many
+SELECT * FROM authors
+WHERE id = ANY(sqlc.slice('ids')::bigint[])
+ORDER BY sqlc.orderby(id);
@@ -0,0 +1,28 @@
+-- name: UnknownColumn :many
+SELECT * FROM authors
+ORDER BY sqlc.orderby('name', 'age');
+
+-- name: WithDirection :many
+SELECT * FROM authors
+ORDER BY sqlc.orderby('name') DESC;
+
+-- name: Twice :many
+SELECT * FROM authors
+ORDER BY sqlc.orderby('name'), sqlc.orderby('bio');
+
+-- name: NotInOrderBy :many
+SELECT * FROM authors
+WHERE name = sqlc.orderby('name');
+
+-- name: NotAColumn :many
+SELECT * FROM authors
+ORDER BY sqlc.orderby('name; DROP TABLE authors');
+
+-- name: NoColumns :many
+SELECT * FROM authors
+ORDER BY sqlc.orderby();
+
+-- name: AfterOrderBy :many
+SELECT * FROM authors
+ORDER BY name
+LIMIT sqlc.orderby('name');
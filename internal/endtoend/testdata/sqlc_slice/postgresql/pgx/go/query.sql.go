@@ -7,11 +7,13 @@ package querytest
 
 import (
 	"context"
+	"strconv"
+	"strings"
 )
 
 const funcParamIdent = `-- name: FuncParamIdent :many
 SELECT name FROM foo WHERE name = $1
-  AND id IN ($2)
+  AND id IN ($2/*SLICE:favourites*/)
 `
 
 type FuncParamIdentParams struct {
@@ -20,7 +22,88 @@ type FuncParamIdentParams struct {
 }
 
 func (q *Queries) FuncParamIdent(ctx context.Context, arg FuncParamIdentParams) ([]string, error) {
-	rows, err := q.db.Query(ctx, funcParamIdent, arg.Slug, arg.Favourites)
+	query := funcParamIdent
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Slug)
+	if len(arg.Favourites) > 0 {
+		queryParams = append(queryParams, arg.Favourites[0])
+	} else {
+		queryParams = append(queryParams, nil)
+	}
+	if len(arg.Favourites) > 1 {
+		var placeholders string
+		for _, v := range arg.Favourites[1:] {
+			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
+		}
+		query = strings.ReplaceAll(query, "/*SLICE:favourites*/", placeholders)
+	}
+	rows, err := q.db.Query(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const funcParamMixed = `-- name: FuncParamMixed :many
+SELECT name FROM foo
+WHERE name = $1
+  AND id IN ($3/*SLICE:ids*/)
+  AND id > $2
+  AND id NOT IN ($4/*SLICE:excluded*/)
+`
+
+type FuncParamMixedParams struct {
+	Name     string
+	ID       int32
+	Ids      []int32
+	Excluded []int32
+}
+
+func (q *Queries) FuncParamMixed(ctx context.Context, arg FuncParamMixedParams) ([]string, error) {
+	query := funcParamMixed
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Name)
+	queryParams = append(queryParams, arg.ID)
+	if len(arg.Ids) > 0 {
+		queryParams = append(queryParams, arg.Ids[0])
+	} else {
+		queryParams = append(queryParams, nil)
+	}
+	if len(arg.Excluded) > 0 {
+		queryParams = append(queryParams, arg.Excluded[0])
+	} else {
+		queryParams = append(queryParams, nil)
+	}
+	if len(arg.Ids) > 1 {
+		var placeholders string
+		for _, v := range arg.Ids[1:] {
+			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
+		}
+		query = strings.ReplaceAll(query, "/*SLICE:ids*/", placeholders)
+	}
+	if len(arg.Excluded) > 1 {
+		var placeholders string
+		for _, v := range arg.Excluded[1:] {
+			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
+		}
+		query = strings.ReplaceAll(query, "/*SLICE:excluded*/", placeholders)
+	}
+	rows, err := q.db.Query(ctx, query, queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +124,7 @@ func (q *Queries) FuncParamIdent(ctx context.Context, arg FuncParamIdentParams)
 
 const funcParamString = `-- name: FuncParamString :many
 SELECT name FROM foo WHERE name = $1
-  AND id IN ($2)
+  AND id IN ($2/*SLICE:favourites*/)
 `
 
 type FuncParamStringParams struct {
@@ -50,7 +133,23 @@ type FuncParamStringParams struct {
 }
 
 func (q *Queries) FuncParamString(ctx context.Context, arg FuncParamStringParams) ([]string, error) {
-	rows, err := q.db.Query(ctx, funcParamString, arg.Slug, arg.Favourites)
+	query := funcParamString
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Slug)
+	if len(arg.Favourites) > 0 {
+		queryParams = append(queryParams, arg.Favourites[0])
+	} else {
+		queryParams = append(queryParams, nil)
+	}
+	if len(arg.Favourites) > 1 {
+		var placeholders string
+		for _, v := range arg.Favourites[1:] {
+			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
+		}
+		query = strings.ReplaceAll(query, "/*SLICE:favourites*/", placeholders)
+	}
+	rows, err := q.db.Query(ctx, query, queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -5,3 +5,10 @@ SELECT name FROM foo WHERE name = sqlc.arg(slug)
 -- name: FuncParamString :many
 SELECT name FROM foo WHERE name = sqlc.arg('slug')
   AND id IN (sqlc.slice('favourites'));
+
+/* name: FuncParamMixed :many */
+SELECT name FROM foo
+WHERE name = $1
+  AND id IN (sqlc.slice(ids))
+  AND id > $2
+  AND id NOT IN (sqlc.slice(excluded));
@@ -7,13 +7,14 @@ package querytest
 
 import (
 	"context"
+	"strconv"
 	"strings"
 )
 
 const funcParamIdent = `-- name: FuncParamIdent :many
 SELECT name FROM foo
 WHERE name = $1
-  AND id IN ($2)
+  AND id IN ($2/*SLICE:favourites*/)
 `
 
 type FuncParamIdentParams struct {
@@ -26,12 +27,85 @@ func (q *Queries) FuncParamIdent(ctx context.Context, arg FuncParamIdentParams)
 	var queryParams []interface{}
 	queryParams = append(queryParams, arg.Slug)
 	if len(arg.Favourites) > 0 {
-		for _, v := range arg.Favourites {
+		queryParams = append(queryParams, arg.Favourites[0])
+	} else {
+		queryParams = append(queryParams, nil)
+	}
+	if len(arg.Favourites) > 1 {
+		var placeholders string
+		for _, v := range arg.Favourites[1:] {
 			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
+		}
+		query = strings.ReplaceAll(query, "/*SLICE:favourites*/", placeholders)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
 		}
-		query = strings.Replace(query, "/*SLICE:favourites*/?", strings.Repeat(",?", len(arg.Favourites))[1:], 1)
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const funcParamMixed = `-- name: FuncParamMixed :many
+SELECT name FROM foo
+WHERE name = $1
+  AND id IN ($3/*SLICE:ids*/)
+  AND id > $2
+  AND id NOT IN ($4/*SLICE:excluded*/)
+`
+
+type FuncParamMixedParams struct {
+	Name     string
+	ID       int32
+	Ids      []int32
+	Excluded []int32
+}
+
+func (q *Queries) FuncParamMixed(ctx context.Context, arg FuncParamMixedParams) ([]string, error) {
+	query := funcParamMixed
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Name)
+	queryParams = append(queryParams, arg.ID)
+	if len(arg.Ids) > 0 {
+		queryParams = append(queryParams, arg.Ids[0])
 	} else {
-		query = strings.Replace(query, "/*SLICE:favourites*/?", "NULL", 1)
+		queryParams = append(queryParams, nil)
+	}
+	if len(arg.Excluded) > 0 {
+		queryParams = append(queryParams, arg.Excluded[0])
+	} else {
+		queryParams = append(queryParams, nil)
+	}
+	if len(arg.Ids) > 1 {
+		var placeholders string
+		for _, v := range arg.Ids[1:] {
+			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
+		}
+		query = strings.ReplaceAll(query, "/*SLICE:ids*/", placeholders)
+	}
+	if len(arg.Excluded) > 1 {
+		var placeholders string
+		for _, v := range arg.Excluded[1:] {
+			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
+		}
+		query = strings.ReplaceAll(query, "/*SLICE:excluded*/", placeholders)
 	}
 	rows, err := q.db.QueryContext(ctx, query, queryParams...)
 	if err != nil {
@@ -58,7 +132,7 @@ func (q *Queries) FuncParamIdent(ctx context.Context, arg FuncParamIdentParams)
 const funcParamString = `-- name: FuncParamString :many
 SELECT name FROM foo
 WHERE name = $1
-  AND id IN ($2)
+  AND id IN ($2/*SLICE:favourites*/)
 `
 
 type FuncParamStringParams struct {
@@ -71,12 +145,17 @@ func (q *Queries) FuncParamString(ctx context.Context, arg FuncParamStringParams
 	var queryParams []interface{}
 	queryParams = append(queryParams, arg.Slug)
 	if len(arg.Favourites) > 0 {
-		for _, v := range arg.Favourites {
+		queryParams = append(queryParams, arg.Favourites[0])
+	} else {
+		queryParams = append(queryParams, nil)
+	}
+	if len(arg.Favourites) > 1 {
+		var placeholders string
+		for _, v := range arg.Favourites[1:] {
 			queryParams = append(queryParams, v)
+			placeholders += ",$" + strconv.Itoa(len(queryParams))
 		}
-		query = strings.Replace(query, "/*SLICE:favourites*/?", strings.Repeat(",?", len(arg.Favourites))[1:], 1)
-	} else {
-		query = strings.Replace(query, "/*SLICE:favourites*/?", "NULL", 1)
+		query = strings.ReplaceAll(query, "/*SLICE:favourites*/", placeholders)
 	}
 	rows, err := q.db.QueryContext(ctx, query, queryParams...)
 	if err != nil {
@@ -9,3 +9,10 @@ WHERE name = sqlc.arg(slug)
 SELECT name FROM foo
 WHERE name = sqlc.arg('slug')
   AND id IN (sqlc.slice('favourites'));
+
+/* name: FuncParamMixed :many */
+SELECT name FROM foo
+WHERE name = $1
+  AND id IN (sqlc.slice(ids))
+  AND id > $2
+  AND id NOT IN (sqlc.slice(excluded));
@@ -0,0 +1,5 @@
+-- name: ListStrictEvents :many
+SELECT * FROM events_strict;
+
+-- name: ListEvents :many
+SELECT * FROM events;
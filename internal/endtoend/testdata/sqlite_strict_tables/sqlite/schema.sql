@@ -0,0 +1,33 @@
+-- The same table, as a STRICT table and with the type names SQLite accepts
+-- in ordinary tables, which are mapped by their affinity
+CREATE TABLE events_strict (
+  id          INTEGER PRIMARY KEY AUTOINCREMENT,
+  name        TEXT NOT NULL,
+  description TEXT,
+  attendees   INT NOT NULL,
+  price       REAL,
+  payload     BLOB,
+  extra       ANY
+) STRICT;
+
+CREATE TABLE events_strict_without_rowid (
+  id          INTEGER PRIMARY KEY,
+  name        TEXT NOT NULL
+) WITHOUT ROWID, STRICT;
+
+CREATE TABLE events (
+  id          INTEGER PRIMARY KEY AUTOINCREMENT,
+  name        VARCHAR(255) NOT NULL,
+  description NATIVE CHARACTER(70),
+  attendees   INT8 NOT NULL,
+  price       DOUBLE PRECISION,
+  payload     BLOB,
+  extra,
+  starts_at   DATETIME NOT NULL,
+  ends_at     DATETIME,
+  public      BOOLEAN NOT NULL,
+  rating      DECIMAL(3, 1),
+  seats       UNSIGNED INTEGER NOT NULL,
+  notes       MEDIUMTEXT,
+  weight      FLOATING POINT
+);
@@ -0,0 +1,2 @@
+-- name: ListEvents :many
+SELECT * FROM events;
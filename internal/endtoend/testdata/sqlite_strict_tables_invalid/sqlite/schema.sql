@@ -0,0 +1,4 @@
+CREATE TABLE events (
+  id        INTEGER PRIMARY KEY,
+  starts_at DATETIME NOT NULL
+) STRICT;
@@ -0,0 +1,8 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL
+);
+
+CREATE TABLE events (
+  payload jsonb NOT NULL
+);
@@ -0,0 +1,15 @@
+CREATE TABLE users (
+    id    BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    email VARCHAR(255) NOT NULL UNIQUE,
+    name  VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE memberships (
+    org_id  BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    slug    VARCHAR(64) NOT NULL,
+    PRIMARY KEY (org_id, user_id),
+    UNIQUE KEY memberships_slug (org_id, slug)
+);
+
+ALTER TABLE users ADD CONSTRAINT users_name UNIQUE (name);
@@ -0,0 +1,3 @@
+-- name: GetMembership :one
+SELECT * FROM memberships
+WHERE org_id = ? AND user_id = ?;
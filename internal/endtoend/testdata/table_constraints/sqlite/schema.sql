@@ -0,0 +1,13 @@
+CREATE TABLE users (
+    id    INTEGER PRIMARY KEY,
+    email TEXT NOT NULL UNIQUE,
+    name  TEXT NOT NULL
+);
+
+CREATE TABLE memberships (
+    org_id  INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    slug    TEXT NOT NULL,
+    PRIMARY KEY (org_id, user_id),
+    CONSTRAINT memberships_slug UNIQUE (org_id, slug)
+);
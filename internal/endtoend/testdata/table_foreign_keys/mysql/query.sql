@@ -0,0 +1,2 @@
+-- name: ListGrants :many
+SELECT * FROM grants;
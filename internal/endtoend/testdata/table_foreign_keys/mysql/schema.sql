@@ -0,0 +1,25 @@
+CREATE TABLE orgs (
+  id INT PRIMARY KEY
+);
+
+CREATE TABLE users (
+  id INT PRIMARY KEY,
+  org_id INT NOT NULL,
+  manager_id INT,
+  FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE,
+  CONSTRAINT users_manager FOREIGN KEY (manager_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE RESTRICT
+);
+
+CREATE TABLE memberships (
+  org_id INT NOT NULL,
+  user_id INT NOT NULL,
+  PRIMARY KEY (org_id, user_id)
+);
+
+CREATE TABLE grants (
+  org_id INT NOT NULL,
+  user_id INT NOT NULL,
+  FOREIGN KEY (org_id, user_id) REFERENCES memberships (org_id, user_id)
+);
+
+ALTER TABLE memberships ADD CONSTRAINT memberships_user FOREIGN KEY (user_id) REFERENCES users (id);
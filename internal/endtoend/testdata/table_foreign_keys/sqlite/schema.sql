@@ -0,0 +1,24 @@
+CREATE TABLE orgs (
+  id INTEGER PRIMARY KEY
+);
+
+CREATE TABLE users (
+  id INTEGER PRIMARY KEY,
+  org_id INTEGER NOT NULL REFERENCES orgs ON DELETE CASCADE,
+  manager_id INTEGER,
+  CONSTRAINT users_manager FOREIGN KEY (manager_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE RESTRICT
+);
+
+CREATE TABLE memberships (
+  org_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  PRIMARY KEY (org_id, user_id)
+);
+
+CREATE TABLE grants (
+  org_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  FOREIGN KEY (org_id, user_id) REFERENCES memberships (org_id, user_id)
+);
+
+ALTER TABLE grants ADD COLUMN granted_by INTEGER REFERENCES users (id) ON UPDATE NO ACTION;
@@ -0,0 +1,12 @@
+CREATE TABLE users (
+  id         INT PRIMARY KEY AUTO_INCREMENT,
+  email      VARCHAR(255) NOT NULL,
+  name       VARCHAR(255) NOT NULL,
+  bio        TEXT
+);
+
+CREATE UNIQUE INDEX users_email ON users ((lower(email)));
+CREATE INDEX users_name ON users (name(10) DESC, id) USING HASH;
+CREATE FULLTEXT INDEX users_bio ON users (bio);
+CREATE INDEX users_id ON users (id);
+DROP INDEX users_id ON users;
@@ -0,0 +1,12 @@
+CREATE TABLE users (
+  id         INTEGER PRIMARY KEY,
+  email      TEXT NOT NULL,
+  name       TEXT NOT NULL,
+  deleted_at DATETIME
+);
+
+CREATE UNIQUE INDEX users_email ON users (lower(email)) WHERE deleted_at IS NULL;
+CREATE INDEX users_name ON users (name COLLATE NOCASE, id DESC);
+CREATE INDEX IF NOT EXISTS users_name ON users (email);
+CREATE INDEX users_id ON users (id);
+DROP INDEX users_id;
@@ -0,0 +1,11 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListBooks :many
+SELECT sqlc.embed(books), authors.name FROM books JOIN authors ON authors.id = books.author_id WHERE books.status = $1 AND books.pages > $2;
+
+-- name: BooksWithAuthors :many
+SELECT books.title, sqlc.embed(authors) FROM books LEFT JOIN authors ON authors.id = books.author_id;
+
+-- name: CountBooks :one
+SELECT count(*) FROM books;
@@ -0,0 +1,21 @@
+CREATE TYPE status AS ENUM ('draft', 'published');
+
+-- Authors of books
+CREATE TABLE authors (
+  id         BIGSERIAL   PRIMARY KEY,
+  name       text        NOT NULL,
+  bio        text,
+  tags       text[]      NOT NULL,
+  grid       int[][],
+  meta       jsonb,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE books (
+  id        BIGSERIAL PRIMARY KEY,
+  author_id bigint    REFERENCES authors (id),
+  title     text      NOT NULL,
+  status    status,
+  price     numeric(10, 2) NOT NULL,
+  pages     int
+);
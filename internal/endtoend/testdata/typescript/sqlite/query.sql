@@ -0,0 +1,2 @@
+-- name: ListAuthors :many
+SELECT id, name, rating FROM authors WHERE active = ? AND name LIKE ?;
@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id         INTEGER PRIMARY KEY,
+  name       TEXT    NOT NULL,
+  bio        TEXT,
+  rating     REAL,
+  active     BOOLEAN NOT NULL,
+  avatar     BLOB,
+  created_at DATETIME NOT NULL
+);
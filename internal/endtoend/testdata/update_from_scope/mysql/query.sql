@@ -0,0 +1,16 @@
+-- name: UpdateOrdersByRegion :exec
+UPDATE orders o
+JOIN customers c ON o.customer_id = c.id AND c.region = ?
+SET o.status = ?
+WHERE o.total > ?;
+
+-- name: UpdateOrdersFromCustomers :exec
+UPDATE orders, customers
+SET orders.status = customers.name, orders.total = ?
+WHERE orders.customer_id = customers.id AND customers.region = ?;
+
+-- name: UpdateOrderTotals :exec
+UPDATE orders o
+JOIN (SELECT customer_id, count(*) AS n FROM orders GROUP BY customer_id) s ON o.customer_id = s.customer_id
+SET o.total = ?
+WHERE s.n > ?;
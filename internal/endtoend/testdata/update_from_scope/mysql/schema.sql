@@ -0,0 +1,12 @@
+CREATE TABLE customers (
+  id BIGINT PRIMARY KEY,
+  name TEXT NOT NULL,
+  region TEXT NOT NULL
+);
+
+CREATE TABLE orders (
+  id BIGINT PRIMARY KEY,
+  customer_id BIGINT NOT NULL,
+  status TEXT NOT NULL,
+  total INT NOT NULL
+);
@@ -0,0 +1,16 @@
+-- name: UpdateOrdersByRegion :exec
+UPDATE orders SET status = $1
+FROM customers
+WHERE orders.customer_id = customers.id AND customers.region = $2;
+
+-- name: UpdateOrdersByCustomerName :many
+UPDATE orders o SET status = c.name
+FROM customers c
+WHERE o.customer_id = c.id AND c.name = sqlc.arg(customer_name)
+RETURNING o.id, c.region;
+
+-- name: UpdateOrderTotals :many
+UPDATE orders SET total = s.total
+FROM (SELECT customer_id, count(*)::int AS total FROM orders GROUP BY customer_id) s
+WHERE orders.customer_id = s.customer_id AND s.total > $1
+RETURNING orders.*, s.total;
@@ -0,0 +1,33 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT id, name, "*" FROM authors LIMIT $1;
+
+-- name: ListAuthorNames :many
+SELECT name FROM authors ORDER BY name;
+
+-- name: ListBooksWithAuthors :many
+SELECT sqlc.embed(authors), books.id
+FROM books
+JOIN authors ON authors.id = books.author_id
+WHERE EXISTS (SELECT * FROM authors a WHERE a.id = books.author_id)
+LIMIT 10;
+
+-- name: ListBooks :many
+SELECT books.* FROM books LIMIT 10;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = $1;
+
+-- name: DeleteBooks :exec
+DELETE FROM books;
+
+-- name: ListEvents :many
+SELECT id FROM audit.events LIMIT 10;
+
+-- name: ListCountries :many
+SELECT code FROM shared.countries LIMIT 10;
+
+-- name: CountAuthors :one
+SELECT count(*) FROM public.authors;
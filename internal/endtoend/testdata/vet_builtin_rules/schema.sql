@@ -0,0 +1,21 @@
+CREATE SCHEMA audit;
+CREATE SCHEMA shared;
+
+CREATE TABLE authors (
+    id    BIGSERIAL PRIMARY KEY,
+    name  text NOT NULL,
+    "*"   text
+);
+
+CREATE TABLE books (
+    id        BIGSERIAL PRIMARY KEY,
+    author_id bigint NOT NULL
+);
+
+CREATE TABLE audit.events (
+    id BIGSERIAL PRIMARY KEY
+);
+
+CREATE TABLE shared.countries (
+    code text PRIMARY KEY
+);
@@ -0,0 +1,11 @@
+-- name: GetAuthorByID :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAuthor :one
+-- @deprecated use GetAuthorByID instead
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthorNames :many
+SELECT name FROM authors;
@@ -20,15 +20,23 @@ const (
 )
 
 func (e *DebugCenum) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = DebugCenum(s)
+		s = string(v)
 	case string:
-		*e = DebugCenum(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for DebugCenum: %T", src)
 	}
-	return nil
+	switch DebugCenum(s) {
+	case DebugCenumOne,
+		DebugCenumTwo,
+		DebugCenumThree:
+		*e = DebugCenum(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for DebugCenum: %q", s)
 }
 
 type NullDebugCenum struct {
@@ -36,14 +44,22 @@ type NullDebugCenum struct {
 	Valid      bool // Valid is true if DebugCenum is not NULL
 }
 
+// NewNullDebugCenum returns a valid NullDebugCenum holding e.
+func NewNullDebugCenum(e DebugCenum) NullDebugCenum {
+	return NullDebugCenum{DebugCenum: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullDebugCenum) Scan(value interface{}) error {
 	if value == nil {
 		ns.DebugCenum, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.DebugCenum.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.DebugCenum.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -63,15 +79,23 @@ const (
 )
 
 func (e *DebugCset) Scan(src interface{}) error {
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
 	case []byte:
-		*e = DebugCset(s)
+		s = string(v)
 	case string:
-		*e = DebugCset(s)
+		s = v
 	default:
 		return fmt.Errorf("unsupported scan type for DebugCset: %T", src)
 	}
-	return nil
+	switch DebugCset(s) {
+	case DebugCsetOne,
+		DebugCsetTwo,
+		DebugCsetThree:
+		*e = DebugCset(s)
+		return nil
+	}
+	return fmt.Errorf("invalid value for DebugCset: %q", s)
 }
 
 type NullDebugCset struct {
@@ -79,14 +103,22 @@ type NullDebugCset struct {
 	Valid     bool // Valid is true if DebugCset is not NULL
 }
 
+// NewNullDebugCset returns a valid NullDebugCset holding e.
+func NewNullDebugCset(e DebugCset) NullDebugCset {
+	return NullDebugCset{DebugCset: e, Valid: true}
+}
+
 // Scan implements the Scanner interface.
 func (ns *NullDebugCset) Scan(value interface{}) error {
 	if value == nil {
 		ns.DebugCset, ns.Valid = "", false
 		return nil
 	}
+	if err := ns.DebugCset.Scan(value); err != nil {
+		return err
+	}
 	ns.Valid = true
-	return ns.DebugCset.Scan(value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
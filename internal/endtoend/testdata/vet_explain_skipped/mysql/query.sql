@@ -0,0 +1,5 @@
+-- name: ListAuthorsByIDs :many
+SELECT * FROM authors WHERE id IN (sqlc.slice(ids));
+
+-- name: CreateAuthors :copyfrom
+INSERT INTO authors (name) VALUES (?);
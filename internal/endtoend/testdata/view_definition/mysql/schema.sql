@@ -0,0 +1,11 @@
+CREATE TABLE authors (
+    id   INTEGER NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    bio  TEXT
+);
+
+-- Authors with a biography.
+CREATE VIEW authors_with_bio (author_name, author_bio) AS
+SELECT name, bio
+FROM authors
+WHERE bio IS NOT NULL;
@@ -0,0 +1,2 @@
+-- name: ListAuthorsWithBio :many
+SELECT * FROM authors_with_bio;
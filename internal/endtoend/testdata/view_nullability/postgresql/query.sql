@@ -0,0 +1,11 @@
+-- name: ListAuthorBooks :many
+SELECT * FROM author_books;
+
+-- name: ListAuthorMaybeBooks :many
+SELECT * FROM author_maybe_books;
+
+-- name: ListBookMaybeAuthors :many
+SELECT * FROM book_maybe_authors;
+
+-- name: ListBookCounts :many
+SELECT * FROM book_counts;
@@ -0,0 +1,38 @@
+CREATE TABLE authors (
+    id   BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    bio  TEXT
+);
+
+CREATE TABLE books (
+    id        BIGSERIAL PRIMARY KEY,
+    author_id BIGINT NOT NULL REFERENCES authors (id),
+    title     TEXT NOT NULL
+);
+
+CREATE VIEW author_books AS
+SELECT a.id, a.name, b.title
+FROM authors a
+JOIN books b ON b.author_id = a.id;
+
+CREATE VIEW author_maybe_books AS
+SELECT a.id, a.name, b.title
+FROM authors a
+LEFT JOIN books b ON b.author_id = a.id;
+
+CREATE VIEW book_maybe_authors (book_title, author_name) AS
+SELECT b.title, a.name
+FROM books b
+FULL JOIN authors a ON b.author_id = a.id;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS book_counts AS
+SELECT author_id, count(*) AS book_count
+FROM books
+GROUP BY author_id;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS book_counts AS
+SELECT author_id, count(*) AS book_count
+FROM books
+GROUP BY author_id;
+
+REFRESH MATERIALIZED VIEW book_counts;
@@ -0,0 +1,5 @@
+-- name: ListAuthorBooks :many
+SELECT * FROM author_books;
+
+-- name: ListAuthorMaybeBooks :many
+SELECT * FROM author_maybe_books;
@@ -0,0 +1,21 @@
+CREATE TABLE authors (
+    id   INTEGER PRIMARY KEY,
+    name TEXT NOT NULL,
+    bio  TEXT
+);
+
+CREATE TABLE books (
+    id        INTEGER PRIMARY KEY,
+    author_id INTEGER NOT NULL REFERENCES authors (id),
+    title     TEXT NOT NULL
+);
+
+CREATE VIEW author_books AS
+SELECT a.id, a.name, b.title
+FROM authors a
+JOIN books b ON b.author_id = a.id;
+
+CREATE VIEW author_maybe_books (author_id, author_name, book_title) AS
+SELECT a.id, a.name, b.title
+FROM authors a
+LEFT JOIN books b ON b.author_id = a.id;
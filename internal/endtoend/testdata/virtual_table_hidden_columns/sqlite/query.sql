@@ -0,0 +1,14 @@
+-- name: Search :many
+SELECT rowid, title, bm25(docs) AS score FROM docs WHERE docs MATCH ?;
+
+-- name: SearchNotes :many
+SELECT rowid, title FROM notes WHERE notes MATCH ?;
+
+-- name: SearchEmpty :many
+SELECT rowid FROM empty WHERE empty MATCH ?;
+
+-- name: SearchTok :many
+SELECT title, highlight(tok, 0, '[', ']') AS hl, snippet(tok, 0, '[', ']', '...', 8) AS snip FROM tok WHERE tok MATCH ? ORDER BY rank;
+
+-- name: ListNotes :many
+SELECT *, offsets(notes) AS offsets, matchinfo(notes) AS info FROM notes WHERE notes MATCH ? AND docid > ?;
@@ -0,0 +1,4 @@
+CREATE VIRTUAL TABLE docs USING fts5(title, body);
+CREATE VIRTUAL TABLE notes USING fts4(title, body);
+CREATE VIRTUAL TABLE empty USING fts5(title, content='', contentless_delete=1);
+CREATE VIRTUAL TABLE tok USING fts5(title, tokenize = 'porter ascii', prefix = '2 3');
@@ -1,10 +1,12 @@
 package dolphin
 
 import (
+	"fmt"
 	"log"
 	"strings"
 
 	pcast "github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/opcode"
 	driver "github.com/pingcap/tidb/pkg/parser/test_driver"
@@ -12,10 +14,15 @@ import (
 
 	"github.com/sqlc-dev/sqlc/internal/debug"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 )
 
 type cc struct {
 	paramCount int
+	// src is the source the statement was parsed from
+	src string
+	// rowAlias is the row alias of the INSERT statement, if any
+	rowAlias *rowAlias
 }
 
 func todo(n pcast.Node) *ast.TODO {
@@ -46,8 +53,14 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     convertColumnDef(def),
+					Def:     convertColumnDef(c.src, def),
 				})
+				for _, c := range columnKeyConstraints(def) {
+					alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+						Subtype:    ast.AT_AddConstraint,
+						Constraint: c,
+					})
+				}
 			}
 
 		case pcast.AlterTableDropColumn:
@@ -70,7 +83,7 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     convertColumnDef(def),
+					Def:     convertColumnDef(c.src, def),
 				})
 			}
 
@@ -84,15 +97,51 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     convertColumnDef(def),
+					Def:     convertColumnDef(c.src, def),
 				})
 			}
 
 		case pcast.AlterTableAlterColumn:
-			// 	spew.Dump("alter column", spec)
+			for _, def := range spec.NewColumns {
+				name := def.Name.String()
+				cmd := &ast.AlterTableCmd{
+					Name:    &name,
+					Subtype: ast.AT_ColumnDefault,
+				}
+				// SET DEFAULT stores the value in an untyped option, while
+				// DROP DEFAULT has no options
+				if len(def.Options) > 0 && def.Options[0].Expr != nil {
+					cmd.Def = &ast.ColumnDef{
+						Colname:     name,
+						HasDefault:  true,
+						DefaultExpr: defaultText(c.src, def.Options[0].Expr),
+					}
+				}
+				alt.Cmds.Items = append(alt.Cmds.Items, cmd)
+			}
 
 		case pcast.AlterTableAddConstraint:
-			// 	spew.Dump("add const", spec)
+			if c := convertKeyConstraint(spec.Constraint); c != nil {
+				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+					Subtype:    ast.AT_AddConstraint,
+					Constraint: c,
+				})
+			}
+
+		case pcast.AlterTableDropPrimaryKey:
+			name := "PRIMARY"
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:    &name,
+				Subtype: ast.AT_DropConstraint,
+			})
+
+		case pcast.AlterTableDropIndex:
+			name := spec.Name
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:      &name,
+				Subtype:   ast.AT_DropConstraint,
+				MissingOk: spec.IfExists,
+			})
 
 		case pcast.AlterTableRenameColumn:
 			// TODO: Returning here may be incorrect if there are multiple specs
@@ -187,8 +236,12 @@ func opToName(o opcode.Op) string {
 
 func (c *cc) convertBinaryOperationExpr(n *pcast.BinaryOperationExpr) ast.Node {
 	if n.Op == opcode.LogicAnd || n.Op == opcode.LogicOr {
+		op := ast.BoolExprTypeAnd
+		if n.Op == opcode.LogicOr {
+			op = ast.BoolExprTypeOr
+		}
 		return &ast.BoolExpr{
-			// TODO: Set op
+			Boolop: op,
 			Args: &ast.List{
 				Items: []ast.Node{
 					c.convert(n.L),
@@ -196,6 +249,18 @@ func (c *cc) convertBinaryOperationExpr(n *pcast.BinaryOperationExpr) ast.Node {
 				},
 			},
 		}
+	} else if n.Op == opcode.NullEQ {
+		// The <=> operator is MySQL's IS NOT DISTINCT FROM
+		return &ast.A_Expr{
+			Kind: ast.A_Expr_Kind_NOT_DISTINCT,
+			Name: &ast.List{
+				Items: []ast.Node{
+					&ast.String{Str: "="},
+				},
+			},
+			Lexpr: c.convert(n.L),
+			Rexpr: c.convert(n.R),
+		}
 	} else {
 		return &ast.A_Expr{
 			// TODO: Set kind
@@ -218,8 +283,48 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	if n.ReferTable != nil {
 		create.ReferTable = parseTableName(n.ReferTable)
 	}
+	// Unnamed CHECK constraints are named the way MySQL names them
+	var checks int
+	checkConstraint := func(name string, expr pcast.ExprNode) *ast.Constraint {
+		checks++
+		if name == "" {
+			name = fmt.Sprintf("%s_chk_%d", create.Name.Name, checks)
+		}
+		return &ast.Constraint{
+			Contype: ast.CONSTR_CHECK,
+			Conname: &name,
+			RawExpr: c.convert(expr),
+		}
+	}
 	for _, def := range n.Cols {
-		create.Cols = append(create.Cols, convertColumnDef(def))
+		create.Cols = append(create.Cols, convertColumnDef(c.src, def))
+		create.Constraints = append(create.Constraints, columnKeyConstraints(def)...)
+		for _, opt := range def.Options {
+			if opt.Tp == pcast.ColumnOptionCheck && opt.Expr != nil {
+				create.Constraints = append(create.Constraints, checkConstraint(opt.ConstraintName, opt.Expr))
+			}
+		}
+	}
+	for _, con := range n.Constraints {
+		if con.Tp == pcast.ConstraintCheck && con.Expr != nil {
+			create.Constraints = append(create.Constraints, checkConstraint(con.Name, con.Expr))
+			continue
+		}
+		if c := convertKeyConstraint(con); c != nil {
+			create.Constraints = append(create.Constraints, c)
+		}
+	}
+	// Unnamed foreign keys are named the way MySQL names them
+	var fks int
+	for _, c := range create.Constraints {
+		if c.Contype != ast.CONSTR_FOREIGN {
+			continue
+		}
+		fks++
+		if *c.Conname == "" {
+			name := fmt.Sprintf("%s_ibfk_%d", create.Name.Name, fks)
+			c.Conname = &name
+		}
 	}
 	for _, opt := range n.Options {
 		switch opt.Tp {
@@ -230,7 +335,7 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	return create
 }
 
-func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
+func convertColumnDef(src string, def *pcast.ColumnDef) *ast.ColumnDef {
 	var vals *ast.List
 	if len(def.Tp.GetElems()) > 0 {
 		vals = &ast.List{}
@@ -257,10 +362,23 @@ func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
 		Comment:    comment,
 		Vals:       vals,
 	}
+	columnDef.HasDefault, columnDef.DefaultExpr = columnDefault(src, def)
 	if def.Tp.GetFlen() >= 0 {
 		length := def.Tp.GetFlen()
 		columnDef.Length = &length
 	}
+	if def.Tp.GetType() == mysql.TypeNewDecimal && def.Tp.GetFlen() >= 0 {
+		columnDef.TypeName.Typmods = &ast.List{
+			Items: []ast.Node{
+				&ast.A_Const{Val: &ast.Integer{Ival: int64(def.Tp.GetFlen())}},
+			},
+		}
+		if def.Tp.GetDecimal() >= 0 {
+			columnDef.TypeName.Typmods.Items = append(columnDef.TypeName.Typmods.Items,
+				&ast.A_Const{Val: &ast.Integer{Ival: int64(def.Tp.GetDecimal())}},
+			)
+		}
+	}
 
 	return &columnDef
 }
@@ -368,6 +486,15 @@ func (c *cc) convertFuncCallExpr(n *pcast.FuncCallExpr) ast.Node {
 		return &ast.CoalesceExpr{
 			Args: args,
 		}
+	} else if schema == "" && name == "nullif" && len(args.Items) == 2 {
+		// Match the PostgreSQL representation of NULLIF
+		return &ast.A_Expr{
+			Kind:     ast.A_Expr_Kind_NULLIF,
+			Name:     &ast.List{Items: []ast.Node{&ast.String{Str: "="}}},
+			Lexpr:    args.Items[0],
+			Rexpr:    args.Items[1],
+			Location: n.OriginTextPosition(),
+		}
 	} else {
 		return &ast.FuncCall{
 			Args: args,
@@ -413,7 +540,11 @@ func (c *cc) convertInsertStmt(n *pcast.InsertStmt) *ast.InsertStmt {
 	if n.OnDuplicate != nil {
 		targetList := &ast.List{}
 		for _, a := range n.OnDuplicate {
-			targetList.Items = append(targetList.Items, c.convertAssignment(a))
+			target := c.convertAssignment(a)
+			if c.rowAlias != nil {
+				target.Val = c.resolveRowAlias(target.Val, insert.Cols)
+			}
+			targetList.Items = append(targetList.Items, target)
 		}
 		insert.OnConflictClause = &ast.OnConflictClause{
 			TargetList: targetList,
@@ -424,6 +555,61 @@ func (c *cc) convertInsertStmt(n *pcast.InsertStmt) *ast.InsertStmt {
 	return insert
 }
 
+// resolveRowAlias replaces references to the row alias of an INSERT statement
+// with the inserted columns. Like VALUES(col), a column of the row alias has
+// the type of the column of the table.
+func (c *cc) resolveRowAlias(node ast.Node, cols *ast.List) ast.Node {
+	alias := c.rowAlias
+	column := func(name string) string {
+		for i, col := range alias.Columns {
+			if col == name && cols != nil && i < len(cols.Items) {
+				if res, ok := cols.Items[i].(*ast.ResTarget); ok && res.Name != nil {
+					return *res.Name
+				}
+			}
+		}
+		return name
+	}
+	return astutils.Apply(node, func(cr *astutils.Cursor) bool {
+		ref, ok := cr.Node().(*ast.ColumnRef)
+		if !ok || ref.Fields == nil {
+			return true
+		}
+		var name string
+		switch items := ref.Fields.Items; len(items) {
+		case 1:
+			if len(alias.Columns) == 0 {
+				return true
+			}
+			s, ok := items[0].(*ast.String)
+			if !ok {
+				return true
+			}
+			name = s.Str
+			if column(name) == name {
+				return true
+			}
+		case 2:
+			table, ok := items[0].(*ast.String)
+			if !ok || table.Str != alias.Name {
+				return true
+			}
+			s, ok := items[1].(*ast.String)
+			if !ok {
+				return true
+			}
+			name = s.Str
+		default:
+			return true
+		}
+		cr.Replace(&ast.ColumnRef{
+			Fields:   &ast.List{Items: []ast.Node{&ast.String{Str: column(name)}}},
+			Location: ref.Location,
+		})
+		return false
+	}, nil)
+}
+
 func (c *cc) convertLists(lists [][]pcast.ExprNode) *ast.List {
 	list := &ast.List{Items: []ast.Node{}}
 	for _, exprs := range lists {
@@ -488,9 +674,52 @@ func (c *cc) convertSelectStmt(n *pcast.SelectStmt) *ast.SelectStmt {
 		stmt.LimitCount = c.convert(n.Limit.Count)
 		stmt.LimitOffset = c.convert(n.Limit.Offset)
 	}
+	if lock := c.convertSelectLockInfo(n.LockInfo); lock != nil {
+		stmt.LockingClause = &ast.List{Items: []ast.Node{lock}}
+	}
 	return stmt
 }
 
+func (c *cc) convertSelectLockInfo(n *pcast.SelectLockInfo) *ast.LockingClause {
+	if n == nil {
+		return nil
+	}
+	lock := &ast.LockingClause{
+		LockedRels: &ast.List{},
+		WaitPolicy: ast.LockWaitPolicy_Block,
+	}
+	switch n.LockType {
+	case pcast.SelectLockForUpdate, pcast.SelectLockForUpdateWaitN:
+		lock.Strength = ast.LockClauseStrength_FORUPDATE
+	case pcast.SelectLockForUpdateNoWait:
+		lock.Strength = ast.LockClauseStrength_FORUPDATE
+		lock.WaitPolicy = ast.LockWaitPolicy_Error
+	case pcast.SelectLockForUpdateSkipLocked:
+		lock.Strength = ast.LockClauseStrength_FORUPDATE
+		lock.WaitPolicy = ast.LockWaitPolicy_Skip
+	case pcast.SelectLockForShare:
+		lock.Strength = ast.LockClauseStrength_FORSHARE
+	case pcast.SelectLockForShareNoWait:
+		lock.Strength = ast.LockClauseStrength_FORSHARE
+		lock.WaitPolicy = ast.LockWaitPolicy_Error
+	case pcast.SelectLockForShareSkipLocked:
+		lock.Strength = ast.LockClauseStrength_FORSHARE
+		lock.WaitPolicy = ast.LockWaitPolicy_Skip
+	default:
+		return nil
+	}
+	for _, table := range n.Tables {
+		rel := identifier(table.Name.String())
+		rv := &ast.RangeVar{Relname: &rel}
+		if table.Schema.String() != "" {
+			schema := identifier(table.Schema.String())
+			rv.Schemaname = &schema
+		}
+		lock.LockedRels.Items = append(lock.LockedRels.Items, rv)
+	}
+	return lock
+}
+
 func (c *cc) convertSubqueryExpr(n *pcast.SubqueryExpr) ast.Node {
 	return c.convert(n.Query)
 }
@@ -546,6 +775,14 @@ func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
 	relations := &ast.List{}
 	convertToRangeVarList(rels, relations)
 
+	// In a multi-table update, the joined tables, their join conditions
+	// and any derived tables are kept as the FROM clause, which is in scope
+	// for the SET and WHERE clauses
+	from := &ast.List{}
+	if _, ok := rels.Items[0].(*ast.JoinExpr); ok {
+		from = rels
+	}
+
 	// TargetList
 	list := &ast.List{}
 	for _, a := range n.List {
@@ -555,7 +792,7 @@ func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
 		Relations:     relations,
 		TargetList:    list,
 		WhereClause:   c.convert(n.Where),
-		FromClause:    &ast.List{},
+		FromClause:    from,
 		ReturningList: &ast.List{},
 		WithClause:    c.convertWithClause(n.With),
 	}
@@ -573,6 +810,10 @@ func (c *cc) convertValueExpr(n *driver.ValueExpr) *ast.A_Const {
 	case mysql.TypeGeometry:
 	case mysql.TypeJSON:
 	case mysql.TypeNull:
+		return &ast.A_Const{
+			Val:      &ast.Null{},
+			Location: n.OriginTextPosition(),
+		}
 	case mysql.TypeSet:
 	case mysql.TypeShort:
 	case mysql.TypeDuration:
@@ -771,7 +1012,43 @@ func (c *cc) convertCreateDatabaseStmt(n *pcast.CreateDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertCreateIndexStmt(n *pcast.CreateIndexStmt) ast.Node {
-	return todo(n)
+	name := identifier(n.IndexName)
+	stmt := &ast.IndexStmt{
+		Idxname:     &name,
+		Relation:    c.convertTableName(n.Table),
+		IndexParams: &ast.List{},
+		Unique:      n.KeyType == pcast.IndexKeyTypeUnique,
+		IfNotExists: n.IfNotExists,
+	}
+	var method string
+	switch n.KeyType {
+	case pcast.IndexKeyTypeSpatial:
+		method = "spatial"
+	case pcast.IndexKeyTypeFullText:
+		method = "fulltext"
+	default:
+		if n.IndexOption != nil && n.IndexOption.Tp != model.IndexTypeInvalid {
+			method = strings.ToLower(n.IndexOption.Tp.String())
+		}
+	}
+	if method != "" {
+		stmt.AccessMethod = &method
+	}
+	for _, part := range n.IndexPartSpecifications {
+		elem := &ast.IndexElem{}
+		if part.Expr != nil {
+			elem.Expr = c.convert(part.Expr)
+			elem.ExprText = indexExprText(c.src, part.Expr)
+		} else if part.Column != nil {
+			col := identifier(part.Column.Name.String())
+			elem.Name = &col
+		}
+		if part.Desc {
+			elem.Ordering = ast.SortByDirDesc
+		}
+		stmt.IndexParams.Items = append(stmt.IndexParams.Items, elem)
+	}
+	return stmt
 }
 
 func (c *cc) convertCreateSequenceStmt(n *pcast.CreateSequenceStmt) ast.Node {
@@ -787,9 +1064,13 @@ func (c *cc) convertCreateUserStmt(n *pcast.CreateUserStmt) ast.Node {
 }
 
 func (c *cc) convertCreateViewStmt(n *pcast.CreateViewStmt) ast.Node {
+	aliases := &ast.List{}
+	for _, col := range n.Cols {
+		aliases.Items = append(aliases.Items, &ast.String{Str: identifier(col.O)})
+	}
 	return &ast.ViewStmt{
 		View:            c.convertTableName(n.ViewName),
-		Aliases:         &ast.List{},
+		Aliases:         aliases,
 		Query:           c.convert(n.Select),
 		Replace:         n.OrReplace,
 		Options:         &ast.List{},
@@ -827,7 +1108,11 @@ func (c *cc) convertDropDatabaseStmt(n *pcast.DropDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertDropIndexStmt(n *pcast.DropIndexStmt) ast.Node {
-	return todo(n)
+	return &ast.DropIndexStmt{
+		Indexes:   []*ast.TableName{{Name: identifier(n.IndexName)}},
+		Table:     parseTableName(n.Table),
+		MissingOk: n.IfExists,
+	}
 }
 
 func (c *cc) convertDropSequenceStmt(n *pcast.DropSequenceStmt) ast.Node {
@@ -1363,8 +1648,10 @@ func (c *cc) convertUseStmt(n *pcast.UseStmt) ast.Node {
 	return todo(n)
 }
 
+// convertValuesExpr converts VALUES(col), which refers to the value inserted
+// into col, to a reference to col so that it has the type of the column.
 func (c *cc) convertValuesExpr(n *pcast.ValuesExpr) ast.Node {
-	return todo(n)
+	return c.convert(n.Column)
 }
 
 func (c *cc) convertVariableAssignment(n *pcast.VariableAssignment) ast.Node {
@@ -1422,13 +1709,22 @@ func (c *cc) convertProcedureInfo(n *pcast.ProcedureInfo) ast.Node {
 	var params ast.List
 	for _, sp := range n.ProcedureParam {
 		paramName := sp.ParamName
+		mode := ast.FuncParamIn
+		switch sp.Paramstatus {
+		case pcast.MODE_OUT:
+			mode = ast.FuncParamOut
+		case pcast.MODE_INOUT:
+			mode = ast.FuncParamInOut
+		}
 		params.Items = append(params.Items, &ast.FuncParam{
 			Name: &paramName,
 			Type: &ast.TypeName{Name: types.TypeToStr(sp.ParamType.GetType(), sp.ParamType.GetCharset())},
+			Mode: mode,
 		})
 	}
 	return &ast.CreateFunctionStmt{
-		Params: &params,
+		IsProcedure: true,
+		Params:      &params,
 		Func: &ast.FuncName{
 			Schema: n.ProcedureName.Schema.L,
 			Name:   n.ProcedureName.Name.L,
@@ -48,27 +48,105 @@ func normalizeErr(err error) error {
 	return errors.New(msg)
 }
 
+// rowAliasPattern matches the row alias of an INSERT statement, as in
+// "VALUES (?, ?) AS new ON DUPLICATE KEY UPDATE bio = new.bio". The TiDB
+// parser doesn't support the syntax, added in MySQL 8.0.19.
+var rowAliasPattern = regexp.MustCompile("(?i)\\)\\s*(AS\\s+(`[^`]+`|\\w+)(?:\\s*\\(([^()]*)\\))?)\\s+ON\\s+DUPLICATE\\s+KEY\\s+UPDATE\\b")
+
+// rowAlias is the row alias of an INSERT statement, and the optional aliases
+// of its columns.
+type rowAlias struct {
+	Location int
+	Name     string
+	Columns  []string
+}
+
+// removeRowAliases blanks out the row aliases in src, so that the statements
+// can be parsed. Offsets and line numbers in the returned source don't change.
+func removeRowAliases(src string) (string, []rowAlias) {
+	var aliases []rowAlias
+	out := []byte(src)
+	for _, m := range rowAliasPattern.FindAllStringSubmatchIndex(src, -1) {
+		alias := rowAlias{
+			Location: m[2],
+			Name:     identifier(strings.Trim(src[m[4]:m[5]], "`")),
+		}
+		if m[6] >= 0 {
+			for _, col := range strings.Split(src[m[6]:m[7]], ",") {
+				alias.Columns = append(alias.Columns, identifier(strings.Trim(strings.TrimSpace(col), "`")))
+			}
+		}
+		aliases = append(aliases, alias)
+		for i := m[2]; i < m[3]; i++ {
+			if out[i] != '\n' {
+				out[i] = ' '
+			}
+		}
+	}
+	return string(out), aliases
+}
+
+// delimiterPattern matches the DELIMITER command of the mysql client, which
+// mysqldump writes around stored procedures so that the semicolons of their
+// bodies don't end the CREATE PROCEDURE statement.
+var delimiterPattern = regexp.MustCompile(`(?i)^\s*DELIMITER\s+(\S+)\s*$`)
+
+// removeDelimiters blanks out the DELIMITER commands in src, and replaces the
+// delimiters they set at the end of lines with semicolons, so that the
+// statements can be parsed. Offsets and line numbers in the returned source
+// don't change.
+func removeDelimiters(src string) string {
+	if !strings.Contains(strings.ToUpper(src), "DELIMITER") {
+		return src
+	}
+	lines := strings.SplitAfter(src, "\n")
+	delimiter := ";"
+	for i, line := range lines {
+		text := strings.TrimRight(line, "\r\n")
+		if m := delimiterPattern.FindStringSubmatch(text); m != nil {
+			delimiter = m[1]
+			lines[i] = strings.Repeat(" ", len(text)) + line[len(text):]
+			continue
+		}
+		if delimiter == ";" {
+			continue
+		}
+		trimmed := strings.TrimRight(text, " \t")
+		if strings.HasSuffix(trimmed, delimiter) {
+			end := len(trimmed) - len(delimiter)
+			lines[i] = trimmed[:end] + ";" + strings.Repeat(" ", len(delimiter)-1) + line[len(trimmed):]
+		}
+	}
+	return strings.Join(lines, "")
+}
+
 func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	blob, err := io.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
-	stmtNodes, _, err := p.pingcap.Parse(string(blob), "", "")
+	src, aliases := removeRowAliases(removeDelimiters(string(blob)))
+	stmtNodes, _, err := p.pingcap.Parse(src, "", "")
 	if err != nil {
 		return nil, normalizeErr(err)
 	}
 	var stmts []ast.Statement
 	for i := range stmtNodes {
-		converter := &cc{}
+		// TODO: Attach the text directly to the ast.Statement node
+		text := stmtNodes[i].Text()
+		loc := strings.Index(src, text)
+
+		converter := &cc{src: string(blob)}
+		for j := range aliases {
+			if aliases[j].Location >= loc && aliases[j].Location < loc+len(text) {
+				converter.rowAlias = &aliases[j]
+			}
+		}
 		out := converter.convert(stmtNodes[i])
 		if _, ok := out.(*ast.TODO); ok {
 			continue
 		}
 
-		// TODO: Attach the text directly to the ast.Statement node
-		text := stmtNodes[i].Text()
-		loc := strings.Index(string(blob), text)
-
 		stmtLen := len(text)
 		if text[stmtLen-1] == ';' {
 			stmtLen -= 1 // Subtract one to remove semicolon
@@ -2138,10 +2138,10 @@ func defaultSchema(name string) *catalog.Schema {
 			Name: "MAX",
 			Args: []*catalog.Argument{
 				{
-					Type: &ast.TypeName{Name: "any"},
+					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType: &ast.TypeName{Name: "anyelement"},
 		},
 		{
 			Name: "MBRCONTAINS",
@@ -2288,10 +2288,10 @@ func defaultSchema(name string) *catalog.Schema {
 			Name: "MIN",
 			Args: []*catalog.Argument{
 				{
-					Type: &ast.TypeName{Name: "any"},
+					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType: &ast.TypeName{Name: "anyelement"},
 		},
 		{
 			Name: "MINUTE",
@@ -5204,6 +5204,24 @@ func defaultSchema(name string) *catalog.Schema {
 			},
 			ReturnType: &ast.TypeName{Name: "time"},
 		},
+		{
+			Name: "SUM",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "float"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "double"},
+		},
+		{
+			Name: "SUM",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "double"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "double"},
+		},
 		{
 			Name: "SUM",
 			Args: []*catalog.Argument{
@@ -5211,7 +5229,7 @@ func defaultSchema(name string) *catalog.Schema {
 					Type: &ast.TypeName{Name: "any"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType: &ast.TypeName{Name: "decimal"},
 		},
 		{
 			Name:       "SYSDATE",
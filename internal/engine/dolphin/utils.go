@@ -1,7 +1,12 @@
 package dolphin
 
 import (
+	"strings"
+	"unicode"
+
 	pcast "github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/format"
+	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
@@ -40,6 +45,231 @@ func isNotNull(n *pcast.ColumnDef) bool {
 	return false
 }
 
+// columnDefault reports whether a column has a default value and returns the
+// SQL text of its default expression, read from the source src.
+// Auto-increment columns have an implicit default without any text.
+func columnDefault(src string, def *pcast.ColumnDef) (bool, string) {
+	for _, opt := range def.Options {
+		switch opt.Tp {
+		case pcast.ColumnOptionAutoIncrement:
+			return true, ""
+		case pcast.ColumnOptionDefaultValue:
+			return true, defaultText(src, opt.Expr)
+		}
+	}
+	return false, ""
+}
+
+// defaultText returns the SQL text of a default value. MySQL only allows
+// literals, function calls and parenthesized expressions as defaults, so the
+// text is a single term, optionally signed.
+func defaultText(src string, expr pcast.ExprNode) string {
+	start := expr.OriginTextPosition()
+	if start < 0 || start >= len(src) {
+		return restoreExpr(expr)
+	}
+	i := start
+	for i < len(src) && (src[i] == '-' || src[i] == '+' || unicode.IsSpace(rune(src[i]))) {
+		i++
+	}
+	for i < len(src) && isIdentByte(src[i]) {
+		i++
+	}
+	switch {
+	case i < len(src) && (src[i] == '\'' || src[i] == '"'):
+		i = skipQuoted(src, i)
+	case i < len(src) && src[i] == '(':
+		i = skipParens(src, i)
+	}
+	return strings.TrimSpace(src[start:i])
+}
+
+// indexExprText returns the SQL text of the expression of a functional key
+// part, which MySQL requires to be parenthesized.
+func indexExprText(src string, expr pcast.ExprNode) string {
+	start := expr.OriginTextPosition()
+	open := start - 1
+	for open >= 0 && open < len(src) && unicode.IsSpace(rune(src[open])) {
+		open--
+	}
+	if start <= 0 || start >= len(src) || open < 0 || src[open] != '(' {
+		return restoreExpr(expr)
+	}
+	end := skipParens(src, open)
+	return strings.TrimSpace(src[start : end-1])
+}
+
+func restoreExpr(expr pcast.ExprNode) string {
+	var sb strings.Builder
+	if err := expr.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return ""
+	}
+	return sb.String()
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '.' || c == '$' || c == '@' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+// skipQuoted returns the index following the quoted string starting at i,
+// including any quotes escaped by doubling or a backslash.
+func skipQuoted(src string, i int) int {
+	quote := src[i]
+	for i++; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			i++
+		case quote:
+			if i+1 < len(src) && src[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(src)
+}
+
+// skipParens returns the index following the parenthesized group starting at
+// i.
+func skipParens(src string, i int) int {
+	depth := 0
+	for ; i < len(src); i++ {
+		switch src[i] {
+		case '\'', '"', '`':
+			i = skipQuoted(src, i) - 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(src)
+}
+
+// convertKeyConstraint converts a PRIMARY KEY, UNIQUE or FOREIGN KEY
+// constraint, returning nil for any other kind of constraint.
+func convertKeyConstraint(n *pcast.Constraint) *ast.Constraint {
+	var contype ast.ConstrType
+	switch n.Tp {
+	case pcast.ConstraintPrimaryKey:
+		contype = ast.CONSTR_PRIMARY
+	case pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex:
+		contype = ast.CONSTR_UNIQUE
+	case pcast.ConstraintForeignKey:
+		if n.Refer == nil {
+			return nil
+		}
+		return newForeignKeyConstraint(n.Name, indexColumns(n.Keys), n.Refer)
+	default:
+		return nil
+	}
+	return newKeyConstraint(contype, n.Name, indexColumns(n.Keys))
+}
+
+// columnKeyConstraints returns the PRIMARY KEY, UNIQUE and FOREIGN KEY
+// constraints declared inline on a column definition.
+func columnKeyConstraints(def *pcast.ColumnDef) []*ast.Constraint {
+	var cons []*ast.Constraint
+	for _, opt := range def.Options {
+		switch opt.Tp {
+		case pcast.ColumnOptionPrimaryKey:
+			cons = append(cons, newKeyConstraint(ast.CONSTR_PRIMARY, "", []string{def.Name.String()}))
+		case pcast.ColumnOptionUniqKey:
+			cons = append(cons, newKeyConstraint(ast.CONSTR_UNIQUE, "", []string{def.Name.String()}))
+		case pcast.ColumnOptionReference:
+			if opt.Refer != nil {
+				cons = append(cons, newForeignKeyConstraint("", []string{def.Name.String()}, opt.Refer))
+			}
+		}
+	}
+	return cons
+}
+
+func indexColumns(keys []*pcast.IndexPartSpecification) []string {
+	var cols []string
+	for _, key := range keys {
+		if key.Column != nil {
+			cols = append(cols, key.Column.Name.String())
+		}
+	}
+	return cols
+}
+
+// newForeignKeyConstraint builds a FOREIGN KEY constraint on cols referencing
+// the table and columns in ref.
+func newForeignKeyConstraint(name string, cols []string, ref *pcast.ReferenceDef) *ast.Constraint {
+	fkAttrs := &ast.List{}
+	for _, col := range cols {
+		fkAttrs.Items = append(fkAttrs.Items, &ast.String{Str: col})
+	}
+	pkAttrs := &ast.List{}
+	for _, col := range indexColumns(ref.IndexPartSpecifications) {
+		pkAttrs.Items = append(pkAttrs.Items, &ast.String{Str: col})
+	}
+	c := &ast.Constraint{
+		Contype: ast.CONSTR_FOREIGN,
+		Conname: &name,
+		FkAttrs: fkAttrs,
+		PkAttrs: pkAttrs,
+	}
+	if ref.Table != nil {
+		table := parseTableName(ref.Table)
+		c.Pktable = &ast.RangeVar{Relname: &table.Name}
+		if table.Schema != "" {
+			c.Pktable.Schemaname = &table.Schema
+		}
+	}
+	if ref.OnDelete != nil {
+		c.FkDelAction = referOption(ref.OnDelete.ReferOpt)
+	}
+	if ref.OnUpdate != nil {
+		c.FkUpdAction = referOption(ref.OnUpdate.ReferOpt)
+	}
+	return c
+}
+
+func referOption(opt model.ReferOptionType) byte {
+	switch opt {
+	case model.ReferOptionRestrict:
+		return ast.FKCONSTR_ACTION_RESTRICT
+	case model.ReferOptionCascade:
+		return ast.FKCONSTR_ACTION_CASCADE
+	case model.ReferOptionSetNull:
+		return ast.FKCONSTR_ACTION_SETNULL
+	case model.ReferOptionSetDefault:
+		return ast.FKCONSTR_ACTION_SETDEFAULT
+	case model.ReferOptionNoAction:
+		return ast.FKCONSTR_ACTION_NOACTION
+	default:
+		return 0
+	}
+}
+
+// newKeyConstraint builds a key constraint, naming it the way MySQL names the
+// underlying index: PRIMARY for the primary key, and the first column for an
+// unnamed unique key.
+func newKeyConstraint(contype ast.ConstrType, name string, cols []string) *ast.Constraint {
+	switch {
+	case contype == ast.CONSTR_PRIMARY:
+		name = "PRIMARY"
+	case name == "" && len(cols) > 0:
+		name = cols[0]
+	}
+	keys := &ast.List{}
+	for _, col := range cols {
+		keys.Items = append(keys.Items, &ast.String{Str: col})
+	}
+	return &ast.Constraint{
+		Contype: contype,
+		Conname: &name,
+		Keys:    keys,
+	}
+}
+
 func convertToRangeVarList(list *ast.List, result *ast.List) {
 	if len(list.Items) == 0 {
 		return
@@ -48,29 +278,18 @@ func convertToRangeVarList(list *ast.List, result *ast.List) {
 
 	// Special case for joins in updates
 	case *ast.JoinExpr:
-		left, ok := rel.Larg.(*ast.RangeVar)
-		if !ok {
-			if list, check := rel.Larg.(*ast.List); check {
-				convertToRangeVarList(list, result)
-			} else {
-				panic("expected range var")
-			}
-		}
-		if left != nil {
-			result.Items = append(result.Items, left)
-		}
-
-		right, ok := rel.Rarg.(*ast.RangeVar)
-		if !ok {
-			if list, check := rel.Rarg.(*ast.List); check {
-				convertToRangeVarList(list, result)
-			} else {
+		for _, arg := range []ast.Node{rel.Larg, rel.Rarg} {
+			switch n := arg.(type) {
+			case *ast.RangeVar:
+				result.Items = append(result.Items, n)
+			case *ast.List:
+				convertToRangeVarList(n, result)
+			case *ast.RangeSubselect:
+				// Derived tables can be joined, but not modified
+			default:
 				panic("expected range var")
 			}
 		}
-		if right != nil {
-			result.Items = append(result.Items, right)
-		}
 
 	case *ast.RangeVar:
 		result.Items = append(result.Items, rel)
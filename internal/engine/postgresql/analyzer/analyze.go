@@ -186,10 +186,16 @@ func (a *Analyzer) Analyze(ctx context.Context, n ast.Node, query string, migrat
 	extractSqlErr := func(e error) error {
 		var pgErr *pgconn.PgError
 		if errors.As(e, &pgErr) {
+			// Position is 1-based and relative to the query, or zero if
+			// the error has no position; report the start of the query then.
+			loc := n.Pos()
+			if pgErr.Position > 0 {
+				loc += int(pgErr.Position) - 1
+			}
 			return &sqlerr.Error{
 				Code:     pgErr.Code,
 				Message:  pgErr.Message,
-				Location: max(n.Pos()+int(pgErr.Position)-1, 0),
+				Location: loc,
 			}
 		}
 		return e
@@ -212,7 +218,11 @@ func (a *Analyzer) Analyze(ctx context.Context, n ast.Node, query string, migrat
 		} else if a.dbg.OnlyManagedDatabases {
 			return nil, fmt.Errorf("database: connections disabled via SQLCDEBUG=databases=managed")
 		} else {
-			uri = a.replacer.Replace(a.db.URI)
+			var err error
+			uri, err = a.db.ResolveURI(a.replacer)
+			if err != nil {
+				return nil, err
+			}
 		}
 		conf, err := pgxpool.ParseConfig(uri)
 		if err != nil {
@@ -14,6 +14,6 @@ func NewCatalog() *catalog.Catalog {
 	c.Schemas = append(c.Schemas, genPGCatalog())
 	c.Schemas = append(c.Schemas, genInformationSchema())
 	c.SearchPath = []string{"pg_catalog"}
-	c.LoadExtension = loadExtension
+	c.LoadExtension = loadExtensionWithVector
 	return c
 }
@@ -6,6 +6,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/sqlc-dev/sqlc/internal/source"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 
 	"github.com/google/go-cmp/cmp"
@@ -140,6 +143,21 @@ func TestUpdateErrors(t *testing.T) {
 			`,
 			sqlerr.ColumnExists("foo", "baz"),
 		},
+		{
+			`
+			CREATE TABLE foo ();
+			CREATE INDEX ON foo (bar);
+			`,
+			sqlerr.ColumnNotFound("foo", "bar"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar text);
+			CREATE INDEX foo_idx ON foo (bar);
+			CREATE INDEX foo_idx ON foo (bar);
+			`,
+			sqlerr.RelationExists("foo_idx"),
+		},
 	} {
 		test := tc
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
@@ -168,3 +186,619 @@ func TestUpdateErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestNumericModifiers(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE prices (
+			amount NUMERIC(10, 2) NOT NULL,
+			rate   DECIMAL(18, 6),
+			whole  NUMERIC(8),
+			plain  NUMERIC,
+			label  VARCHAR(32)
+		);
+		ALTER TABLE prices ALTER COLUMN plain TYPE NUMERIC(12, 4);
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "prices"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deref := func(v *int) int {
+		if v == nil {
+			return -1
+		}
+		return *v
+	}
+	expected := map[string][2]int{
+		"amount": {10, 2},
+		"rate":   {18, 6},
+		"whole":  {8, 0},
+		"plain":  {12, 4},
+		"label":  {-1, -1},
+	}
+	for _, col := range table.Columns {
+		actual := [2]int{deref(col.Precision), deref(col.Scale)}
+		if diff := cmp.Diff(expected[col.Name], actual); diff != "" {
+			t.Errorf("%s: precision and scale mismatch: \n%s", col.Name, diff)
+		}
+	}
+}
+
+func TestCompositeTypes(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TYPE address AS (street text, city text);
+		CREATE TYPE location AS (home address, tags text[], zip integer);
+		ALTER TYPE location RENAME TO place;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		field   string
+		typ     string
+		isArray bool
+	}{
+		{"home", "address", false},
+		{"tags", "text", true},
+		{"zip", "int4", false},
+	} {
+		col, err := c.GetCompositeField(&ast.TypeName{Name: "place"}, tc.field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if col.Type.Name != tc.typ || col.IsArray != tc.isArray {
+			t.Errorf("%s: expected %s (array %t), got %s (array %t)", tc.field, tc.typ, tc.isArray, col.Type.Name, col.IsArray)
+		}
+	}
+	if _, err := c.GetCompositeField(&ast.TypeName{Name: "address"}, "zip"); err == nil {
+		t.Errorf("expected an error for a missing field")
+	}
+}
+
+func TestVectorDimensions(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE EXTENSION IF NOT EXISTS vector;
+		CREATE TABLE items (
+			embedding VECTOR(1536) NOT NULL,
+			summary   VECTOR,
+			label     VARCHAR(32),
+			legacy    VECTOR(768)
+		);
+		ALTER TABLE items ALTER COLUMN summary TYPE VECTOR(384);
+		ALTER TABLE items ALTER COLUMN legacy TYPE TEXT;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "items"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]int{
+		"embedding": 1536,
+		"summary":   384,
+		"label":     -1,
+		"legacy":    -1,
+	}
+	for _, col := range table.Columns {
+		actual := -1
+		if col.Length != nil {
+			actual = *col.Length
+		}
+		if actual != expected[col.Name] {
+			t.Errorf("%s: expected length %d, got %d", col.Name, expected[col.Name], actual)
+		}
+	}
+}
+
+func TestCheckValues(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE users (
+			status TEXT NOT NULL CHECK (status IN ('active', 'disabled')),
+			role   TEXT CHECK (length(role) > 0),
+			locale TEXT CHECK (locale NOT IN ('xx')),
+			theme  TEXT,
+			kind   TEXT,
+			plan   TEXT CHECK (plan IN ('free', 'pro')),
+			level  INT CHECK (level IN ('1', '2')),
+			code   VARCHAR(2) CHECK (code IN ('de', 'en')),
+			CHECK (role IN ('admin', 'member')),
+			CHECK (theme = ANY (ARRAY['light'::text, 'dark'::text]))
+		);
+		ALTER TABLE users ADD CONSTRAINT users_kind_check CHECK (kind IN ('a', 'b'));
+		ALTER TABLE users DROP CONSTRAINT users_kind_check;
+		ALTER TABLE users DROP CONSTRAINT users_plan_check;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "users"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string][]string{
+		"status": {"active", "disabled"},
+		"role":   {"admin", "member"},
+		"theme":  {"light", "dark"},
+		"code":   {"de", "en"},
+	}
+	for _, col := range table.Columns {
+		if diff := cmp.Diff(expected[col.Name], col.CheckValues); diff != "" {
+			t.Errorf("%s: check values differ (-want +got):\n%s", col.Name, diff)
+		}
+	}
+}
+
+func TestKeyConstraints(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE users (
+			id    SERIAL PRIMARY KEY,
+			email TEXT NOT NULL UNIQUE,
+			name  TEXT NOT NULL
+		);
+		CREATE TABLE memberships (
+			org_id  INT NOT NULL,
+			user_id INT NOT NULL,
+			slug    TEXT NOT NULL,
+			PRIMARY KEY (org_id, user_id),
+			CONSTRAINT memberships_slug UNIQUE (org_id, slug)
+		);
+		ALTER TABLE users ADD CONSTRAINT users_name_key UNIQUE (name);
+		ALTER TABLE users DROP CONSTRAINT users_email_key;
+		ALTER TABLE memberships RENAME COLUMN slug TO handle;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		table   string
+		primary *catalog.Constraint
+		unique  []*catalog.Constraint
+	}{
+		{
+			table:   "users",
+			primary: &catalog.Constraint{Name: "users_pkey", Columns: []string{"id"}},
+			unique: []*catalog.Constraint{
+				{Name: "users_name_key", Columns: []string{"name"}},
+			},
+		},
+		{
+			table:   "memberships",
+			primary: &catalog.Constraint{Name: "memberships_pkey", Columns: []string{"org_id", "user_id"}},
+			unique: []*catalog.Constraint{
+				{Name: "memberships_slug", Columns: []string{"org_id", "handle"}},
+			},
+		},
+	} {
+		table, err := c.GetTable(&ast.TableName{Name: tc.table})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tc.primary, table.PrimaryKey); diff != "" {
+			t.Errorf("%s: primary key mismatch: \n%s", tc.table, diff)
+		}
+		if diff := cmp.Diff(tc.unique, table.UniqueConstraints); diff != "" {
+			t.Errorf("%s: unique constraints mismatch: \n%s", tc.table, diff)
+		}
+	}
+}
+
+func TestForeignKeys(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE orgs (
+			id SERIAL PRIMARY KEY
+		);
+		CREATE TABLE users (
+			id        SERIAL PRIMARY KEY,
+			org_id    INT NOT NULL REFERENCES orgs ON DELETE CASCADE,
+			parent_id INT REFERENCES users (id) ON DELETE SET NULL
+		);
+		CREATE TABLE memberships (
+			org_id  INT NOT NULL,
+			user_id INT NOT NULL,
+			PRIMARY KEY (org_id, user_id)
+		);
+		CREATE TABLE grants (
+			org_id  INT NOT NULL,
+			user_id INT NOT NULL,
+			CONSTRAINT grants_membership FOREIGN KEY (org_id, user_id)
+				REFERENCES memberships (org_id, user_id) ON UPDATE RESTRICT
+		);
+		ALTER TABLE memberships ADD FOREIGN KEY (user_id) REFERENCES users (id);
+		ALTER TABLE users RENAME COLUMN parent_id TO manager_id;
+		ALTER TABLE users RENAME COLUMN id TO user_id;
+		ALTER TABLE orgs RENAME TO organizations;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		table string
+		keys  []*catalog.ForeignKey
+	}{
+		{
+			table: "users",
+			keys: []*catalog.ForeignKey{
+				{
+					Name:     "users_org_id_fkey",
+					Columns:  []string{"org_id"},
+					RefTable: &ast.TableName{Name: "organizations"},
+					OnDelete: "CASCADE",
+					OnUpdate: "NO ACTION",
+				},
+				{
+					Name:       "users_parent_id_fkey",
+					Columns:    []string{"manager_id"},
+					RefTable:   &ast.TableName{Name: "users"},
+					RefColumns: []string{"user_id"},
+					OnDelete:   "SET NULL",
+					OnUpdate:   "NO ACTION",
+				},
+			},
+		},
+		{
+			table: "memberships",
+			keys: []*catalog.ForeignKey{
+				{
+					Name:       "memberships_user_id_fkey",
+					Columns:    []string{"user_id"},
+					RefTable:   &ast.TableName{Name: "users"},
+					RefColumns: []string{"user_id"},
+					OnDelete:   "NO ACTION",
+					OnUpdate:   "NO ACTION",
+				},
+			},
+		},
+		{
+			table: "grants",
+			keys: []*catalog.ForeignKey{
+				{
+					Name:       "grants_membership",
+					Columns:    []string{"org_id", "user_id"},
+					RefTable:   &ast.TableName{Name: "memberships"},
+					RefColumns: []string{"org_id", "user_id"},
+					OnDelete:   "NO ACTION",
+					OnUpdate:   "RESTRICT",
+				},
+			},
+		},
+	} {
+		table, err := c.GetTable(&ast.TableName{Name: tc.table})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tc.keys, table.ForeignKeys); diff != "" {
+			t.Errorf("%s: foreign keys mismatch: \n%s", tc.table, diff)
+		}
+	}
+}
+
+func TestColumnDefaults(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE events (
+			id         BIGSERIAL PRIMARY KEY,
+			seq        INT GENERATED ALWAYS AS IDENTITY,
+			kind       TEXT NOT NULL DEFAULT 'info'::text,
+			payload    JSONB DEFAULT '{}' NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			score      INT CONSTRAINT score_default DEFAULT (1 + 2) CHECK (score > 0),
+			note       TEXT DEFAULT NULL,
+			tags       TEXT[] DEFAULT ARRAY['a, b', 'c''d']::text[] -- trailing comment
+		);
+		ALTER TABLE events
+			ALTER COLUMN note SET DEFAULT lower('X'),
+			ALTER COLUMN payload DROP DEFAULT;
+		ALTER TABLE events ADD COLUMN updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "events"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type columnDefault struct {
+		HasDefault  bool
+		DefaultExpr string
+	}
+	want := map[string]columnDefault{
+		"id":         {true, ""},
+		"seq":        {true, ""},
+		"kind":       {true, "'info'::text"},
+		"payload":    {false, ""},
+		"created_at": {true, "now()"},
+		"score":      {true, "(1 + 2)"},
+		"note":       {true, "lower('X')"},
+		"tags":       {true, "ARRAY['a, b', 'c''d']::text[]"},
+		"updated_at": {true, "CURRENT_TIMESTAMP"},
+	}
+	got := map[string]columnDefault{}
+	for _, col := range table.Columns {
+		got[col.Name] = columnDefault{col.HasDefault, col.DefaultExpr}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("column defaults mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGeneratedColumns(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE items (
+			id       BIGSERIAL PRIMARY KEY,
+			price    NUMERIC NOT NULL,
+			quantity INT NOT NULL,
+			total    NUMERIC GENERATED ALWAYS AS (price * quantity) STORED,
+			rounded  NUMERIC GENERATED ALWAYS AS ((price * quantity)::numeric(12, 2)) STORED NOT NULL,
+			seq      INT GENERATED ALWAYS AS IDENTITY
+		);
+		ALTER TABLE items ADD COLUMN label TEXT GENERATED ALWAYS AS ('item ' || id) STORED;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "items"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type generated struct {
+		IsGenerated   bool
+		GeneratedExpr string
+	}
+	want := map[string]generated{
+		"id":       {},
+		"price":    {},
+		"quantity": {},
+		"total":    {true, "price * quantity"},
+		"rounded":  {true, "(price * quantity)::numeric(12, 2)"},
+		"seq":      {},
+		"label":    {true, "'item ' || id"},
+	}
+	got := map[string]generated{}
+	for _, col := range table.Columns {
+		got[col.Name] = generated{col.IsGenerated, col.GeneratedExpr}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("generated columns mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPartitions(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE measurements (
+			city_id  INT NOT NULL,
+			logdate  DATE NOT NULL,
+			peaktemp INT
+		) PARTITION BY RANGE (logdate);
+		CREATE TABLE measurements_y2024 PARTITION OF measurements
+			FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+		CREATE TABLE measurements_y2025 PARTITION OF measurements (
+			peaktemp NOT NULL DEFAULT 0
+		) FOR VALUES FROM ('2025-01-01') TO ('2026-01-01');
+		CREATE TABLE measurements_old (LIKE measurements);
+		ALTER TABLE measurements ATTACH PARTITION measurements_old
+			FOR VALUES FROM ('2000-01-01') TO ('2024-01-01');
+		ALTER TABLE measurements ADD COLUMN unit TEXT;
+		ALTER TABLE measurements DETACH PARTITION measurements_old;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		table       string
+		columns     []string
+		notNull     []string
+		partitionOf *ast.TableName
+	}{
+		{
+			table:   "measurements",
+			columns: []string{"city_id", "logdate", "peaktemp", "unit"},
+			notNull: []string{"city_id", "logdate"},
+		},
+		{
+			table:       "measurements_y2024",
+			columns:     []string{"city_id", "logdate", "peaktemp", "unit"},
+			notNull:     []string{"city_id", "logdate"},
+			partitionOf: &ast.TableName{Name: "measurements"},
+		},
+		{
+			table:       "measurements_y2025",
+			columns:     []string{"city_id", "logdate", "peaktemp", "unit"},
+			notNull:     []string{"city_id", "logdate", "peaktemp"},
+			partitionOf: &ast.TableName{Name: "measurements"},
+		},
+		{
+			table:   "measurements_old",
+			columns: []string{"city_id", "logdate", "peaktemp", "unit"},
+			notNull: []string{"city_id", "logdate"},
+		},
+	} {
+		table, err := c.GetTable(&ast.TableName{Name: tc.table})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var columns, notNull []string
+		for _, col := range table.Columns {
+			columns = append(columns, col.Name)
+			if col.IsNotNull {
+				notNull = append(notNull, col.Name)
+			}
+		}
+		if diff := cmp.Diff(tc.columns, columns); diff != "" {
+			t.Errorf("%s: columns mismatch: \n%s", tc.table, diff)
+		}
+		if diff := cmp.Diff(tc.notNull, notNull); diff != "" {
+			t.Errorf("%s: not null mismatch: \n%s", tc.table, diff)
+		}
+		if diff := cmp.Diff(tc.partitionOf, table.PartitionOf); diff != "" {
+			t.Errorf("%s: partition mismatch: \n%s", tc.table, diff)
+		}
+	}
+}
+
+func TestIndexes(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE users (
+			id         SERIAL PRIMARY KEY,
+			email      TEXT NOT NULL,
+			name       TEXT NOT NULL,
+			tags       TEXT[] NOT NULL,
+			deleted_at TIMESTAMP
+		);
+		CREATE UNIQUE INDEX users_email_key ON users (lower(email)) WHERE deleted_at IS NULL;
+		CREATE INDEX ON users USING gin (tags);
+		CREATE INDEX users_name_idx ON users (name DESC, (id + 1) ASC);
+		CREATE INDEX users_id_idx ON users (id);
+		CREATE INDEX IF NOT EXISTS users_id_idx ON users (name);
+		DROP INDEX users_id_idx;
+		ALTER TABLE users RENAME COLUMN name TO full_name;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "users"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []*catalog.Index{
+		{
+			Name:    "users_email_key",
+			Unique:  true,
+			Columns: []catalog.IndexColumn{{Expr: "lower(email)"}},
+			Where:   "deleted_at IS NULL",
+			Method:  "btree",
+		},
+		{
+			Name:    "users_tags_idx",
+			Columns: []catalog.IndexColumn{{Name: "tags"}},
+			Method:  "gin",
+		},
+		{
+			Name:    "users_name_idx",
+			Columns: []catalog.IndexColumn{{Name: "full_name"}, {Expr: "id + 1"}},
+			Method:  "btree",
+		},
+	}
+	if diff := cmp.Diff(expected, table.Indexes); diff != "" {
+		t.Errorf("indexes mismatch: \n%s", diff)
+	}
+}
+
+func TestSources(t *testing.T) {
+	p := NewParser()
+	schema := `CREATE TABLE users (id SERIAL PRIMARY KEY);
+CREATE TABLE IF NOT EXISTS users (name TEXT);
+CREATE TYPE mood AS ENUM ('sad', 'happy');
+CREATE TYPE point AS (x INT, y INT);
+
+ALTER TABLE users ADD COLUMN name TEXT;
+ALTER TABLE users RENAME TO people;
+ALTER TYPE mood ADD VALUE 'ok';
+`
+	stmts, err := p.Parse(strings.NewReader(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	for _, stmt := range stmts {
+		if err := c.Update(stmt, nil); err != nil {
+			t.Fatal(err)
+		}
+		text, err := source.Pluck(schema, stmt.Raw.StmtLocation, stmt.Raw.StmtLen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		line, _ := source.LineNumber(schema, stmt.Raw.StmtLocation)
+		text = strings.TrimSuffix(strings.TrimSpace(text), ";")
+		c.SetSource(stmt, text, "schema.sql", line)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "people"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &catalog.Source{
+		Text:     "CREATE TABLE users (id SERIAL PRIMARY KEY);\nALTER TABLE users ADD COLUMN name TEXT;\nALTER TABLE users RENAME TO people",
+		Filename: "schema.sql",
+		Line:     1,
+		Altered:  true,
+	}
+	if diff := cmp.Diff(expected, table.Source); diff != "" {
+		t.Errorf("table source mismatch: \n%s", diff)
+	}
+	sources := map[string]*catalog.Source{}
+	for _, typ := range c.Schemas[0].Types {
+		switch typ := typ.(type) {
+		case *catalog.Enum:
+			sources[typ.Name] = typ.Source
+		case *catalog.CompositeType:
+			sources[typ.Name] = typ.Source
+		}
+	}
+	expectedTypes := map[string]*catalog.Source{
+		"mood": {
+			Text:     "CREATE TYPE mood AS ENUM ('sad', 'happy');\nALTER TYPE mood ADD VALUE 'ok'",
+			Filename: "schema.sql",
+			Line:     3,
+			Altered:  true,
+		},
+		"point": {
+			Text:     "CREATE TYPE point AS (x INT, y INT)",
+			Filename: "schema.sql",
+			Line:     4,
+		},
+	}
+	if diff := cmp.Diff(expectedTypes, sources); diff != "" {
+		t.Errorf("type sources mismatch: \n%s", diff)
+	}
+}
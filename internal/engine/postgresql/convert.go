@@ -1282,6 +1282,7 @@ func convertCreateTableAsStmt(n *pg.CreateTableAsStmt) *ast.CreateTableAsStmt {
 		Relkind:      ast.ObjectType(n.Objtype),
 		IsSelectInto: n.IsSelectInto,
 		IfNotExists:  n.IfNotExists,
+		IsMatview:    n.Objtype == pg.ObjectType_OBJECT_MATVIEW,
 	}
 	return res
 }
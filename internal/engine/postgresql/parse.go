@@ -56,6 +56,16 @@ func (r relation) TypeName() *ast.TypeName {
 	}
 }
 
+// ColumnTypeName returns the type name for a column definition, keeping the
+// type modifiers (e.g. the precision and scale of NUMERIC(10, 2)).
+func (r relation) ColumnTypeName(n *nodes.TypeName) *ast.TypeName {
+	tn := r.TypeName()
+	if n != nil && len(n.Typmods) > 0 {
+		tn.Typmods = convertSlice(n.Typmods)
+	}
+	return tn
+}
+
 func (r relation) FuncName() *ast.FuncName {
 	return &ast.FuncName{
 		Catalog: r.Catalog,
@@ -159,7 +169,7 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 
 	var stmts []ast.Statement
 	for _, raw := range tree.Stmts {
-		n, err := translate(raw.Stmt)
+		n, err := translate(string(contents), raw.Stmt)
 		if err == errSkip {
 			continue
 		}
@@ -203,7 +213,9 @@ func (p *Parser) CommentSyntax() source.CommentSyntax {
 	}
 }
 
-func translate(node *nodes.Node) (ast.Node, error) {
+// translate converts a statement parsed from src, the source it was parsed
+// from, into an AST node.
+func translate(src string, node *nodes.Node) (ast.Node, error) {
 	switch inner := node.Node.(type) {
 
 	case *nodes.Node_AlterEnumStmt:
@@ -241,7 +253,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				MissingOk: n.MissingOk,
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, err
@@ -281,11 +293,29 @@ func translate(node *nodes.Node) (ast.Node, error) {
 					item.Subtype = ast.AT_AddColumn
 					item.Def = &ast.ColumnDef{
 						Colname:   d.ColumnDef.Colname,
-						TypeName:  rel.TypeName(),
+						TypeName:  rel.ColumnTypeName(d.ColumnDef.TypeName),
 						IsNotNull: isNotNull(d.ColumnDef),
 						IsArray:   isArray(d.ColumnDef.TypeName),
 						ArrayDims: len(d.ColumnDef.TypeName.ArrayBounds),
 					}
+					item.Def.HasDefault, item.Def.DefaultExpr = columnDefault(src, d.ColumnDef)
+					item.Def.IsGenerated, item.Def.GeneratedExpr = columnGenerated(src, d.ColumnDef)
+					at.Cmds.Items = append(at.Cmds.Items, item)
+
+					// Constraints declared inline on the new column
+					for _, con := range d.ColumnDef.Constraints {
+						constraint, ok := con.Node.(*nodes.Node_Constraint)
+						if !ok {
+							continue
+						}
+						if c := convertKeyConstraint(at.Table.Name, constraint.Constraint, d.ColumnDef.Colname); c != nil {
+							at.Cmds.Items = append(at.Cmds.Items, &ast.AlterTableCmd{
+								Subtype:    ast.AT_AddConstraint,
+								Constraint: c,
+							})
+						}
+					}
+					continue
 
 				case nodes.AlterTableType_AT_AlterColumnType:
 					d, ok := altercmd.Def.Node.(*nodes.Node_ColumnDef)
@@ -307,7 +337,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 					item.Subtype = ast.AT_AlterColumnType
 					item.Def = &ast.ColumnDef{
 						Colname:   col,
-						TypeName:  rel.TypeName(),
+						TypeName:  rel.ColumnTypeName(d.ColumnDef.TypeName),
 						IsNotNull: isNotNull(d.ColumnDef),
 						IsArray:   isArray(d.ColumnDef.TypeName),
 						ArrayDims: len(d.ColumnDef.TypeName.ArrayBounds),
@@ -322,6 +352,44 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				case nodes.AlterTableType_AT_SetNotNull:
 					item.Subtype = ast.AT_SetNotNull
 
+				case nodes.AlterTableType_AT_ColumnDefault:
+					item.Subtype = ast.AT_ColumnDefault
+					// DROP DEFAULT has no expression
+					if altercmd.Def != nil {
+						item.Def = &ast.ColumnDef{
+							Colname:     altercmd.Name,
+							HasDefault:  true,
+							DefaultExpr: defaultText(src, altercmd.Def),
+						}
+					}
+
+				case nodes.AlterTableType_AT_AddConstraint:
+					d, ok := altercmd.Def.Node.(*nodes.Node_Constraint)
+					if !ok {
+						return nil, fmt.Errorf("expected alter table definition to be a Constraint")
+					}
+					c := convertKeyConstraint(at.Table.Name, d.Constraint)
+					if c == nil {
+						continue
+					}
+					item.Subtype = ast.AT_AddConstraint
+					item.Constraint = c
+
+				case nodes.AlterTableType_AT_DropConstraint:
+					item.Subtype = ast.AT_DropConstraint
+
+				case nodes.AlterTableType_AT_AttachPartition, nodes.AlterTableType_AT_DetachPartition:
+					// ALTER INDEX ... ATTACH PARTITION has no effect on tables
+					d, ok := altercmd.Def.Node.(*nodes.Node_PartitionCmd)
+					if !ok || n.Objtype != nodes.ObjectType_OBJECT_TABLE {
+						continue
+					}
+					item.Subtype = ast.AT_AttachPartition
+					if altercmd.Subtype == nodes.AlterTableType_AT_DetachPartition {
+						item.Subtype = ast.AT_DetachPartition
+					}
+					item.Partition = parseRelationFromRangeVar(d.PartitionCmd.Name).TableName()
+
 				default:
 					continue
 				}
@@ -366,7 +434,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				Comment: makeString(n.Comment),
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, err
@@ -392,9 +460,26 @@ func translate(node *nodes.Node) (ast.Node, error) {
 	case *nodes.Node_CompositeTypeStmt:
 		n := inner.CompositeTypeStmt
 		rel := parseRelationFromRangeVar(n.Typevar)
-		return &ast.CompositeTypeStmt{
+		stmt := &ast.CompositeTypeStmt{
 			TypeName: rel.TypeName(),
-		}, nil
+		}
+		for _, elt := range n.Coldeflist {
+			item, ok := elt.Node.(*nodes.Node_ColumnDef)
+			if !ok {
+				continue
+			}
+			rel, err := parseRelationFromNodes(item.ColumnDef.TypeName.Names)
+			if err != nil {
+				return nil, err
+			}
+			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+				Colname:   item.ColumnDef.Colname,
+				TypeName:  rel.ColumnTypeName(item.ColumnDef.TypeName),
+				IsArray:   isArray(item.ColumnDef.TypeName),
+				ArrayDims: len(item.ColumnDef.TypeName.ArrayBounds),
+			})
+		}
+		return stmt, nil
 
 	case *nodes.Node_CreateStmt:
 		n := inner.CreateStmt
@@ -406,7 +491,10 @@ func translate(node *nodes.Node) (ast.Node, error) {
 		for _, node := range n.InhRelations {
 			switch item := node.Node.(type) {
 			case *nodes.Node_RangeVar:
-				if item.RangeVar.Inh {
+				if n.Partbound != nil {
+					rel := parseRelationFromRangeVar(item.RangeVar)
+					create.PartitionOf = rel.TableName()
+				} else if item.RangeVar.Inh {
 					rel := parseRelationFromRangeVar(item.RangeVar)
 					create.Inherits = append(create.Inherits, rel.TableName())
 				}
@@ -431,6 +519,17 @@ func translate(node *nodes.Node) (ast.Node, error) {
 		for _, elt := range n.TableElts {
 			switch item := elt.Node.(type) {
 			case *nodes.Node_ColumnDef:
+				if item.ColumnDef.TypeName == nil {
+					// Options for a column of the partitioned table, such as
+					// a default or a NOT NULL constraint
+					def := &ast.ColumnDef{
+						Colname:   item.ColumnDef.Colname,
+						IsNotNull: isNotNull(item.ColumnDef),
+					}
+					def.HasDefault, def.DefaultExpr = columnDefault(src, item.ColumnDef)
+					create.Cols = append(create.Cols, def)
+					continue
+				}
 				rel, err := parseRelationFromNodes(item.ColumnDef.TypeName.Names)
 				if err != nil {
 					return nil, err
@@ -440,21 +539,46 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				for _, con := range item.ColumnDef.Constraints {
 					if constraint, ok := con.Node.(*nodes.Node_Constraint); ok {
 						primary = constraint.Constraint.Contype == nodes.ConstrType_CONSTR_PRIMARY
+						if c := convertKeyConstraint(create.Name.Name, constraint.Constraint, item.ColumnDef.Colname); c != nil {
+							create.Constraints = append(create.Constraints, c)
+						}
 					}
 				}
 
-				create.Cols = append(create.Cols, &ast.ColumnDef{
+				def := &ast.ColumnDef{
 					Colname:    item.ColumnDef.Colname,
-					TypeName:   rel.TypeName(),
+					TypeName:   rel.ColumnTypeName(item.ColumnDef.TypeName),
 					IsNotNull:  isNotNull(item.ColumnDef) || primaryKey[item.ColumnDef.Colname],
 					IsArray:    isArray(item.ColumnDef.TypeName),
 					ArrayDims:  len(item.ColumnDef.TypeName.ArrayBounds),
 					PrimaryKey: primary,
-				})
+				}
+				def.HasDefault, def.DefaultExpr = columnDefault(src, item.ColumnDef)
+				def.IsGenerated, def.GeneratedExpr = columnGenerated(src, item.ColumnDef)
+				create.Cols = append(create.Cols, def)
+
+			case *nodes.Node_Constraint:
+				if c := convertKeyConstraint(create.Name.Name, item.Constraint); c != nil {
+					create.Constraints = append(create.Constraints, c)
+				}
 			}
 		}
 		return create, nil
 
+	case *nodes.Node_CreateDomainStmt:
+		n := inner.CreateDomainStmt
+		rel, err := parseRelationFromNodes(n.TypeName.Names)
+		if err != nil {
+			return nil, err
+		}
+		base := rel.ColumnTypeName(n.TypeName)
+		base.ArrayBounds = convertSlice(n.TypeName.ArrayBounds)
+		return &ast.CreateDomainStmt{
+			Domainname:  convertSlice(n.Domainname),
+			TypeName:    base,
+			Constraints: convertSlice(n.Constraints),
+		}, nil
+
 	case *nodes.Node_CreateEnumStmt:
 		n := inner.CreateEnumStmt
 		rel, err := parseRelationFromNodes(n.TypeName)
@@ -488,12 +612,14 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				return nil, err
 			}
 			rt = rel.TypeName()
+			rt.ArrayBounds = convertSlice(n.ReturnType.ArrayBounds)
 		}
 		stmt := &ast.CreateFunctionStmt{
-			Func:       fn.FuncName(),
-			ReturnType: rt,
-			Replace:    n.Replace,
-			Params:     &ast.List{},
+			Func:        fn.FuncName(),
+			ReturnType:  rt,
+			Replace:     n.Replace,
+			Params:      &ast.List{},
+			IsProcedure: n.IsProcedure,
 		}
 		for _, item := range n.Parameters {
 			arg := item.Node.(*nodes.Node_FunctionParameter).FunctionParameter
@@ -588,7 +714,20 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_INDEX:
+			drop := &ast.DropIndexStmt{
+				MissingOk: n.MissingOk,
+			}
+			for _, obj := range n.Objects {
+				name, err := parseRelation(obj)
+				if err != nil {
+					return nil, fmt.Errorf("nodes.DropStmt: INDEX: %w", err)
+				}
+				drop.Indexes = append(drop.Indexes, name.TableName())
+			}
+			return drop, nil
+
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			drop := &ast.DropTypeStmt{
 				IfExists: n.MissingOk,
 			}
@@ -604,6 +743,9 @@ func translate(node *nodes.Node) (ast.Node, error) {
 		}
 		return nil, errSkip
 
+	case *nodes.Node_IndexStmt:
+		return convertIndexStmtText(src, inner.IndexStmt), nil
+
 	case *nodes.Node_RenameStmt:
 		n := inner.RenameStmt
 		switch n.RenameType {
@@ -625,7 +767,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				MissingOk: n.MissingOk,
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, fmt.Errorf("nodes.RenameStmt: TYPE: %w", err)
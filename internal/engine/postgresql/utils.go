@@ -1,7 +1,13 @@
 package postgresql
 
 import (
+	"strings"
+	"unicode"
+
 	nodes "github.com/pganalyze/pg_query_go/v5"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 )
 
 func isArray(n *nodes.TypeName) bool {
@@ -29,6 +35,247 @@ func isNotNull(n *nodes.ColumnDef) bool {
 	return false
 }
 
+// columnDefault reports whether a column has a default value and returns the
+// SQL text of its default expression, read from the source src. Serial and
+// identity columns have an implicit default without any text.
+func columnDefault(src string, n *nodes.ColumnDef) (bool, string) {
+	for _, con := range n.Constraints {
+		c, ok := con.Node.(*nodes.Node_Constraint)
+		if !ok {
+			continue
+		}
+		switch c.Constraint.Contype {
+		case nodes.ConstrType_CONSTR_IDENTITY:
+			return true, ""
+		case nodes.ConstrType_CONSTR_DEFAULT:
+			return true, defaultText(src, c.Constraint.RawExpr)
+		}
+	}
+	if n.TypeName != nil && len(n.TypeName.Names) > 0 {
+		switch stringSliceFromNodes(n.TypeName.Names)[len(n.TypeName.Names)-1] {
+		case "serial", "serial2", "serial4", "serial8", "smallserial", "bigserial":
+			return true, ""
+		}
+	}
+	return false, ""
+}
+
+// defaultText returns the SQL text of the default expression expr, which
+// follows the DEFAULT keyword in src.
+func defaultText(src string, expr *nodes.Node) string {
+	loc := leftmostLocation(expr)
+	if loc < 0 || loc > len(src) {
+		return ""
+	}
+	// The expression may be wrapped in parentheses, so start from the DEFAULT
+	// keyword rather than the location of the expression itself
+	start := loc
+	if end := keywordEnd(src[:loc], "DEFAULT"); end >= 0 {
+		start = end
+	}
+	return scanExpr(src, start)
+}
+
+// columnGenerated reports whether the column is a generated column, declared
+// with GENERATED ALWAYS AS (expr) STORED, and returns the SQL text of expr.
+func columnGenerated(src string, n *nodes.ColumnDef) (bool, string) {
+	for _, con := range n.Constraints {
+		c, ok := con.Node.(*nodes.Node_Constraint)
+		if !ok || c.Constraint.Contype != nodes.ConstrType_CONSTR_GENERATED {
+			continue
+		}
+		return true, generatedText(src, c.Constraint.RawExpr)
+	}
+	return false, ""
+}
+
+// generatedText returns the SQL text of the generation expression expr, which
+// is enclosed in parentheses after the AS keyword in src.
+func generatedText(src string, expr *nodes.Node) string {
+	loc := leftmostLocation(expr)
+	if loc < 0 || loc > len(src) {
+		return ""
+	}
+	// The expression may itself start with a parenthesis, so start from the
+	// one following the AS keyword
+	start := loc
+	if end := keywordEnd(src[:loc], "AS"); end >= 0 {
+		start = end
+		for start < loc && unicode.IsSpace(rune(src[start])) {
+			start++
+		}
+		if start < loc && src[start] == '(' {
+			start++
+		}
+	}
+	return scanExpr(src, start)
+}
+
+// keywordEnd returns the index after the last occurrence of keyword in src,
+// or -1 if it doesn't occur as a word of its own.
+func keywordEnd(src, keyword string) int {
+	upper := strings.ToUpper(src)
+	for i := strings.LastIndex(upper, keyword); i >= 0; i = strings.LastIndex(upper[:i], keyword) {
+		end := i + len(keyword)
+		if (i == 0 || !isIdentRune(rune(src[i-1]))) && (end == len(src) || !isIdentRune(rune(src[end]))) {
+			return end
+		}
+	}
+	return -1
+}
+
+// leftmostLocation returns the location of the first token of an expression.
+// The location of operators and casts is that of the operator itself.
+func leftmostLocation(n *nodes.Node) int {
+	switch inner := n.GetNode().(type) {
+	case *nodes.Node_TypeCast:
+		return leftmostLocation(inner.TypeCast.Arg)
+	case *nodes.Node_AExpr:
+		if inner.AExpr.Lexpr != nil {
+			return leftmostLocation(inner.AExpr.Lexpr)
+		}
+	}
+	m := n.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("node"))
+	if fd == nil {
+		return -1
+	}
+	msg := m.Get(fd).Message()
+	loc := msg.Descriptor().Fields().ByName("location")
+	if loc == nil {
+		return -1
+	}
+	return int(msg.Get(loc).Int())
+}
+
+// scanExpr returns the expression starting at start in src, ending before the
+// first comma, closing parenthesis, semicolon, comment or column constraint
+// outside of any brackets or quotes.
+func scanExpr(src string, start int) string {
+	return scanUntil(src, start, isConstraintKeyword)
+}
+
+// scanUntil is like scanExpr, but ends the expression before any word for
+// which stop returns true. stop may be nil.
+func scanUntil(src string, start int, stop func(string) bool) string {
+	for start < len(src) && unicode.IsSpace(rune(src[start])) {
+		start++
+	}
+	depth := 0
+	i := start
+scan:
+	for i < len(src) {
+		switch c := src[i]; {
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(src[i+1:], c)
+			if end < 0 {
+				i = len(src)
+				break scan
+			}
+			i += end + 2
+			continue
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			if depth == 0 {
+				break scan
+			}
+			depth--
+		case (c == ',' || c == ';') && depth == 0:
+			break scan
+		case strings.HasPrefix(src[i:], "--") || strings.HasPrefix(src[i:], "/*"):
+			break scan
+		case depth == 0 && i > start && isIdentRune(rune(c)) && !isIdentRune(rune(src[i-1])):
+			if stop != nil && stop(src[i:]) {
+				break scan
+			}
+		}
+		i++
+	}
+	return strings.TrimSpace(src[start:i])
+}
+
+// isConstraintKeyword reports whether s starts with a keyword that begins a
+// column constraint, ending a DEFAULT expression.
+func isConstraintKeyword(s string) bool {
+	end := strings.IndexFunc(s, func(r rune) bool { return !isIdentRune(r) })
+	if end < 0 {
+		end = len(s)
+	}
+	switch strings.ToUpper(s[:end]) {
+	case "CONSTRAINT", "NOT", "NULL", "CHECK", "UNIQUE", "PRIMARY", "REFERENCES", "GENERATED", "DEFERRABLE", "INITIALLY":
+		return true
+	}
+	return false
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// convertKeyConstraint converts a PRIMARY KEY, UNIQUE or FOREIGN KEY
+// constraint on table, as well as a CHECK constraint, returning nil for any
+// other kind of constraint. Column constraints don't list their keys, so the
+// column they're declared on must be passed in cols. Unnamed constraints are
+// given the name PostgreSQL would generate.
+func convertKeyConstraint(table string, n *nodes.Constraint, cols ...string) *ast.Constraint {
+	var suffix string
+	switch n.Contype {
+	case nodes.ConstrType_CONSTR_PRIMARY:
+		suffix = "pkey"
+	case nodes.ConstrType_CONSTR_UNIQUE:
+		suffix = "key"
+	case nodes.ConstrType_CONSTR_FOREIGN:
+		suffix = "fkey"
+	case nodes.ConstrType_CONSTR_CHECK:
+		return convertCheckConstraint(table, n, cols...)
+	default:
+		return nil
+	}
+	keys := n.Keys
+	if n.Contype == nodes.ConstrType_CONSTR_FOREIGN {
+		keys = n.FkAttrs
+	}
+	for _, key := range keys {
+		if s, ok := key.Node.(*nodes.Node_String_); ok {
+			cols = append(cols, s.String_.Sval)
+		}
+	}
+	name := n.Conname
+	if name == "" {
+		parts := []string{table}
+		if n.Contype != nodes.ConstrType_CONSTR_PRIMARY {
+			parts = append(parts, cols...)
+		}
+		name = strings.Join(append(parts, suffix), "_")
+	}
+	list := &ast.List{}
+	for _, col := range cols {
+		list.Items = append(list.Items, &ast.String{Str: col})
+	}
+	c := &ast.Constraint{
+		Contype:  ast.ConstrType(n.Contype),
+		Conname:  &name,
+		Location: int(n.Location),
+	}
+	if n.Contype != nodes.ConstrType_CONSTR_FOREIGN {
+		c.Keys = list
+		return c
+	}
+	refs := &ast.List{}
+	for _, key := range n.PkAttrs {
+		if s, ok := key.Node.(*nodes.Node_String_); ok {
+			refs.Items = append(refs.Items, &ast.String{Str: s.String_.Sval})
+		}
+	}
+	c.FkAttrs = list
+	c.PkAttrs = refs
+	c.Pktable = convertRangeVar(n.Pktable)
+	c.FkUpdAction = makeByte(n.FkUpdAction)
+	c.FkDelAction = makeByte(n.FkDelAction)
+	return c
+}
+
 func IsNamedParamFunc(node *nodes.Node) bool {
 	fun, ok := node.Node.(*nodes.Node_FuncCall)
 	return ok && joinNodes(fun.FuncCall.Funcname, ".") == "sqlc.arg"
@@ -61,3 +308,199 @@ func makeString(s string) *string {
 	}
 	return &s
 }
+
+// convertIndexStmtText converts a CREATE INDEX statement, reading the text of
+// expressions and of the WHERE clause of a partial index from src. Unnamed
+// indexes are given the name PostgreSQL would generate.
+func convertIndexStmtText(src string, n *nodes.IndexStmt) *ast.IndexStmt {
+	stmt := convertIndexStmt(n)
+	elems, end := indexElemTexts(src, int(n.Relation.GetLocation()))
+	parts := []string{n.Relation.GetRelname()}
+	if stmt.IndexParams != nil {
+		for i, item := range stmt.IndexParams.Items {
+			elem, ok := item.(*ast.IndexElem)
+			if !ok {
+				continue
+			}
+			if elem.Name != nil {
+				parts = append(parts, *elem.Name)
+				continue
+			}
+			if i < len(elems) {
+				elem.ExprText = indexExprText(elems[i])
+			}
+			if fn, ok := elem.Expr.(*ast.FuncCall); ok && fn.Func != nil {
+				parts = append(parts, fn.Func.Name)
+			} else {
+				parts = append(parts, "expr")
+			}
+		}
+	}
+	if stmt.Idxname == nil || *stmt.Idxname == "" {
+		name := strings.Join(append(parts, "idx"), "_")
+		stmt.Idxname = &name
+	}
+	if n.WhereClause != nil && end >= 0 {
+		if where := keywordIndex(src, end, "WHERE"); where >= 0 {
+			stmt.Predicate = scanUntil(src, where+len("WHERE"), nil)
+		}
+	}
+	return stmt
+}
+
+// indexElemTexts returns the text of each element in the parenthesized list
+// of an index, which is the first list after the table name at loc. It also
+// returns the position following the list, or -1 if there's no list.
+func indexElemTexts(src string, loc int) ([]string, int) {
+	i := loc
+	for i >= 0 && i < len(src) && src[i] != '(' {
+		if src[i] == '"' {
+			end := strings.IndexByte(src[i+1:], '"')
+			if end < 0 {
+				return nil, -1
+			}
+			i += end + 1
+		}
+		i++
+	}
+	if i < 0 || i >= len(src) {
+		return nil, -1
+	}
+	var elems []string
+	for i < len(src) && src[i] != ')' {
+		start := skipSpace(src, i+1)
+		elem := scanUntil(src, start, nil)
+		elems = append(elems, elem)
+		i = skipSpace(src, start+len(elem))
+		for i < len(src) && src[i] != ',' && src[i] != ')' {
+			i++
+		}
+	}
+	return elems, i + 1
+}
+
+// skipSpace returns the position of the first character at or after i that
+// isn't whitespace or part of a comment.
+func skipSpace(src string, i int) int {
+	for i < len(src) {
+		switch {
+		case unicode.IsSpace(rune(src[i])):
+			i++
+		case strings.HasPrefix(src[i:], "--"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				return len(src)
+			}
+			i += end
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i:], "*/")
+			if end < 0 {
+				return len(src)
+			}
+			i += end + 2
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// indexExprText returns the expression of an index element, which is either
+// parenthesized or a function call, followed by options such as DESC.
+func indexExprText(elem string) string {
+	open := strings.IndexByte(elem, '(')
+	if open < 0 {
+		return elem
+	}
+	end := closingParen(elem, open)
+	if open == 0 {
+		return strings.TrimSpace(elem[1:end])
+	}
+	return strings.TrimSpace(elem[:end+1])
+}
+
+// closingParen returns the index of the parenthesis closing the one at open,
+// or the length of s if it isn't closed.
+func closingParen(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\'', '"':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return len(s)
+			}
+			i += end + 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(s)
+}
+
+// keywordIndex returns the position of the first occurrence of keyword in src
+// at or after start, outside of quotes and parentheses. It returns -1 if the
+// keyword doesn't occur before the end of the statement.
+func keywordIndex(src string, start int, keyword string) int {
+	depth := 0
+	for i := start; i < len(src); i++ {
+		switch c := src[i]; {
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(src[i+1:], c)
+			if end < 0 {
+				return -1
+			}
+			i += end + 1
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ';' && depth == 0:
+			return -1
+		case depth == 0 && (i == 0 || !isIdentRune(rune(src[i-1]))) && strings.HasPrefix(strings.ToUpper(src[i:]), keyword):
+			end := i + len(keyword)
+			if end == len(src) || !isIdentRune(rune(src[end])) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// convertCheckConstraint converts a CHECK constraint on table. An unnamed
+// constraint is named after the column it's declared on or, for a table
+// constraint, the first column its expression references.
+func convertCheckConstraint(table string, n *nodes.Constraint, cols ...string) *ast.Constraint {
+	expr := convertNode(n.RawExpr)
+	name := n.Conname
+	if name == "" {
+		if len(cols) == 0 {
+			refs := astutils.Search(expr, func(node ast.Node) bool {
+				_, ok := node.(*ast.ColumnRef)
+				return ok
+			})
+			for _, ref := range refs.Items {
+				fields := ref.(*ast.ColumnRef).Fields
+				if fields == nil || len(fields.Items) == 0 {
+					continue
+				}
+				if s, ok := fields.Items[len(fields.Items)-1].(*ast.String); ok {
+					cols = append(cols, s.Str)
+					break
+				}
+			}
+		}
+		name = strings.Join(append(append([]string{table}, cols...), "check"), "_")
+	}
+	return &ast.Constraint{
+		Contype:  ast.CONSTR_CHECK,
+		Conname:  &name,
+		Location: int(n.Location),
+		RawExpr:  expr,
+	}
+}
@@ -0,0 +1,65 @@
+package postgresql
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// pgvector is not a contrib module, so its functions are not generated by
+// sqlc-pg-gen and are listed here instead.
+//
+// https://github.com/pgvector/pgvector#vector-functions
+func vectorFuncs() []*catalog.Function {
+	vector := func() *ast.TypeName { return &ast.TypeName{Name: "vector"} }
+	distance := func(name string) *catalog.Function {
+		return &catalog.Function{
+			Name: name,
+			Args: []*catalog.Argument{
+				{Type: vector()},
+				{Type: vector()},
+			},
+			ReturnType: &ast.TypeName{Name: "double precision"},
+		}
+	}
+	return []*catalog.Function{
+		distance("cosine_distance"),
+		distance("inner_product"),
+		distance("l1_distance"),
+		distance("l2_distance"),
+		{
+			Name:       "l2_normalize",
+			Args:       []*catalog.Argument{{Type: vector()}},
+			ReturnType: vector(),
+		},
+		{
+			Name: "subvector",
+			Args: []*catalog.Argument{
+				{Type: vector()},
+				{Type: &ast.TypeName{Name: "integer"}},
+				{Type: &ast.TypeName{Name: "integer"}},
+			},
+			ReturnType: vector(),
+		},
+		{
+			Name:       "vector_dims",
+			Args:       []*catalog.Argument{{Type: vector()}},
+			ReturnType: &ast.TypeName{Name: "integer"},
+		},
+		{
+			Name:       "vector_norm",
+			Args:       []*catalog.Argument{{Type: vector()}},
+			ReturnType: &ast.TypeName{Name: "double precision"},
+		},
+	}
+}
+
+// loadExtensionWithVector loads the functions of an extension, including
+// the pgvector extension on top of the generated contrib modules.
+func loadExtensionWithVector(name string) *catalog.Schema {
+	if name == "vector" {
+		s := &catalog.Schema{Name: "pg_catalog"}
+		s.Funcs = vectorFuncs()
+		return s
+	}
+	return loadExtension(name)
+}
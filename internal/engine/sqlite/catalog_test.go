@@ -230,6 +230,48 @@ func TestUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			`
+			CREATE VIRTUAL TABLE notes USING fts4(title, body, tokenize=porter);
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "notes"},
+						Columns: []*catalog.Column{
+							{
+								Name:      "title",
+								Type:      ast.TypeName{Name: "text"},
+								IsNotNull: true,
+							},
+							{
+								Name:      "body",
+								Type:      ast.TypeName{Name: "text"},
+								IsNotNull: true,
+							},
+						},
+						HiddenColumns: []*catalog.Column{
+							{
+								Name:      "notes",
+								Type:      ast.TypeName{Name: "text"},
+								IsNotNull: true,
+							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name:      "docid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+						},
+					},
+				},
+			},
+		},
 	} {
 		test := tc
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
@@ -267,3 +309,46 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestStrictTables(t *testing.T) {
+	p := NewParser()
+
+	for _, tc := range []struct {
+		stmt string
+		err  string
+	}{
+		{
+			stmt: `CREATE TABLE foo (bar INTEGER PRIMARY KEY, baz TEXT, qux ANY) STRICT;`,
+		},
+		{
+			stmt: `CREATE TABLE foo (bar INT PRIMARY KEY, baz real, qux blob) WITHOUT ROWID, STRICT;`,
+		},
+		{
+			stmt: `CREATE TABLE foo (bar BOOLEAN, baz) WITHOUT ROWID;`,
+		},
+		{
+			stmt: `CREATE TABLE foo (bar INTEGER, baz BOOLEAN) STRICT;`,
+			err:  `unknown datatype for foo.baz: "BOOLEAN"`,
+		},
+		{
+			stmt: `CREATE TABLE foo (bar VARCHAR(10)) STRICT, WITHOUT ROWID;`,
+			err:  `unknown datatype for foo.bar: "VARCHAR(10)"`,
+		},
+		{
+			stmt: `CREATE TABLE foo (bar INTEGER, baz) STRICT;`,
+			err:  `missing datatype for foo.baz`,
+		},
+	} {
+		test := tc
+		t.Run(test.stmt, func(t *testing.T) {
+			_, err := p.Parse(strings.NewReader(test.stmt))
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+			if got != test.err {
+				t.Errorf("expected error %q, got %q", test.err, got)
+			}
+		})
+	}
+}
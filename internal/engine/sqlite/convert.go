@@ -68,17 +68,25 @@ func (c *cc) convertAlter_table_stmtContext(n *parser.Alter_table_stmtContext) a
 				Cmds:  &ast.List{},
 			}
 			name := def.Column_name().GetText()
+			col := &ast.ColumnDef{
+				Colname: name,
+				TypeName: &ast.TypeName{
+					Name: def.Type_name().GetText(),
+				},
+				IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
+			}
+			col.HasDefault, col.DefaultExpr = columnDefault(def)
 			stmt.Cmds.Items = append(stmt.Cmds.Items, &ast.AlterTableCmd{
 				Name:    &name,
 				Subtype: ast.AT_AddColumn,
-				Def: &ast.ColumnDef{
-					Colname: name,
-					TypeName: &ast.TypeName{
-						Name: def.Type_name().GetText(),
-					},
-					IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
-				},
+				Def:     col,
 			})
+			for _, con := range append(columnKeyConstraints(def), c.columnCheckConstraints(def)...) {
+				stmt.Cmds.Items = append(stmt.Cmds.Items, &ast.AlterTableCmd{
+					Subtype:    ast.AT_AddConstraint,
+					Constraint: con,
+				})
+			}
 			return stmt
 		}
 	}
@@ -106,6 +114,44 @@ func (c *cc) convertAttach_stmtContext(n *parser.Attach_stmtContext) ast.Node {
 	}
 }
 
+func (c *cc) convertCreate_index_stmtContext(n *parser.Create_index_stmtContext) ast.Node {
+	name := identifier(n.Index_name().GetText())
+	table := identifier(n.Table_name().GetText())
+	stmt := &ast.IndexStmt{
+		Idxname:     &name,
+		Relation:    &ast.RangeVar{Relname: &table},
+		IndexParams: &ast.List{},
+		Unique:      n.UNIQUE_() != nil,
+		IfNotExists: n.EXISTS_() != nil,
+	}
+	// The schema qualifies the index, which is created in the same schema as
+	// its table
+	if n.Schema_name() != nil {
+		schema := n.Schema_name().GetText()
+		stmt.Relation.Schemaname = &schema
+	}
+	for _, icol := range n.AllIndexed_column() {
+		col, ok := icol.(*parser.Indexed_columnContext)
+		if !ok {
+			continue
+		}
+		elem := &ast.IndexElem{}
+		if col.Column_name() != nil {
+			name := identifier(col.Column_name().GetText())
+			elem.Name = &name
+		} else if col.Expr() != nil {
+			elem.Expr = c.convert(col.Expr())
+			elem.ExprText = exprText(col.Expr())
+		}
+		stmt.IndexParams.Items = append(stmt.IndexParams.Items, elem)
+	}
+	if n.WHERE_() != nil && n.Expr() != nil {
+		stmt.WhereClause = c.convert(n.Expr())
+		stmt.Predicate = exprText(n.Expr())
+	}
+	return stmt
+}
+
 func (c *cc) convertCreate_table_stmtContext(n *parser.Create_table_stmtContext) ast.Node {
 	stmt := &ast.CreateTableStmt{
 		Name:        parseTableName(n),
@@ -117,21 +163,64 @@ func (c *cc) convertCreate_table_stmtContext(n *parser.Create_table_stmtContext)
 			if def.Type_name() != nil {
 				typeName = def.Type_name().GetText()
 			}
-			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+			col := &ast.ColumnDef{
 				Colname:   identifier(def.Column_name().GetText()),
 				IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
 				TypeName:  &ast.TypeName{Name: typeName},
-			})
+			}
+			col.HasDefault, col.DefaultExpr = columnDefault(def)
+			stmt.Cols = append(stmt.Cols, col)
+			stmt.Constraints = append(stmt.Constraints, columnKeyConstraints(def)...)
+			stmt.Constraints = append(stmt.Constraints, c.columnCheckConstraints(def)...)
+		}
+	}
+	for _, icon := range n.AllTable_constraint() {
+		if con, ok := icon.(*parser.Table_constraintContext); ok {
+			if con.CHECK_() != nil && con.Expr() != nil {
+				stmt.Constraints = append(stmt.Constraints, c.checkConstraint(con.Name(), con.Expr()))
+				continue
+			}
+			if c := convertKeyConstraint(con); c != nil {
+				stmt.Constraints = append(stmt.Constraints, c)
+			}
 		}
 	}
 	return stmt
 }
 
+// columnCheckConstraints returns the CHECK constraints declared inline on a
+// column definition.
+func (c *cc) columnCheckConstraints(def *parser.Column_defContext) []*ast.Constraint {
+	var cons []*ast.Constraint
+	for _, icon := range def.AllColumn_constraint() {
+		con, ok := icon.(*parser.Column_constraintContext)
+		if !ok || con.CHECK_() == nil || con.Expr() == nil {
+			continue
+		}
+		cons = append(cons, c.checkConstraint(con.Name(), con.Expr()))
+	}
+	return cons
+}
+
+func (c *cc) checkConstraint(n parser.INameContext, expr parser.IExprContext) *ast.Constraint {
+	var name string
+	if n != nil {
+		name = identifier(n.GetText())
+	}
+	return &ast.Constraint{
+		Contype:  ast.CONSTR_CHECK,
+		Conname:  &name,
+		Location: expr.GetStart().GetStart(),
+		RawExpr:  c.convert(expr),
+	}
+}
+
 func (c *cc) convertCreate_virtual_table_stmtContext(n *parser.Create_virtual_table_stmtContext) ast.Node {
-	switch moduleName := n.Module_name().GetText(); moduleName {
-	case "fts5":
+	switch moduleName := strings.ToLower(n.Module_name().GetText()); moduleName {
+	case "fts5", "fts4", "fts3":
 		// https://www.sqlite.org/fts5.html
-		return c.convertCreate_virtual_table_fts5(n)
+		// https://www.sqlite.org/fts3.html
+		return c.convertCreate_virtual_table_fts(n, moduleName)
 	default:
 		return todo(
 			fmt.Sprintf("create_virtual_table. unsupported module name: %q", moduleName),
@@ -140,7 +229,7 @@ func (c *cc) convertCreate_virtual_table_stmtContext(n *parser.Create_virtual_ta
 	}
 }
 
-func (c *cc) convertCreate_virtual_table_fts5(n *parser.Create_virtual_table_stmtContext) ast.Node {
+func (c *cc) convertCreate_virtual_table_fts(n *parser.Create_virtual_table_stmtContext, moduleName string) ast.Node {
 	stmt := &ast.CreateTableStmt{
 		Name:        parseTableName(n),
 		IfNotExists: n.EXISTS_() != nil,
@@ -152,6 +241,8 @@ func (c *cc) convertCreate_virtual_table_fts5(n *parser.Create_virtual_table_stm
 		// For example: CREATE VIRTUAL TABLE tbl_ft USING fts5(b, c UNINDEXED)
 		//   * the 'b' column is parsed like Expr_qualified_column_nameContext
 		//   * the 'c' column is parsed like Column_defContext
+		// Options such as content='tbl' are parsed as comparisons, and don't
+		// declare columns.
 		if columnExpr, ok := arg.Expr().(*parser.Expr_qualified_column_nameContext); ok {
 			columnName = columnExpr.Column_name().GetText()
 		} else if columnDef, ok := arg.Column_def().(*parser.Column_defContext); ok {
@@ -168,6 +259,37 @@ func (c *cc) convertCreate_virtual_table_fts5(n *parser.Create_virtual_table_stm
 		}
 	}
 
+	// The hidden column named after the table is the left operand of MATCH
+	// queries against all of its columns
+	hidden := []*ast.ColumnDef{
+		{
+			Colname:   stmt.Name.Name,
+			IsNotNull: true,
+			TypeName:  &ast.TypeName{Name: "text"},
+		},
+		{
+			Colname:   "rowid",
+			IsNotNull: true,
+			TypeName:  &ast.TypeName{Name: "integer"},
+		},
+	}
+	if moduleName == "fts5" {
+		hidden = append(hidden, &ast.ColumnDef{
+			Colname:  "rank",
+			TypeName: &ast.TypeName{Name: "real"},
+		})
+	} else {
+		hidden = append(hidden, &ast.ColumnDef{
+			Colname:   "docid",
+			IsNotNull: true,
+			TypeName:  &ast.TypeName{Name: "integer"},
+		})
+	}
+	for _, col := range hidden {
+		col.IsHidden = true
+		stmt.Cols = append(stmt.Cols, col)
+	}
+
 	return stmt
 }
 
@@ -182,9 +304,14 @@ func (c *cc) convertCreate_view_stmtContext(n *parser.Create_view_stmtContext) a
 		relation.Schemaname = &schemaName
 	}
 
+	aliases := &ast.List{}
+	for _, col := range n.AllColumn_name() {
+		aliases.Items = append(aliases.Items, &ast.String{Str: identifier(col.GetText())})
+	}
+
 	return &ast.ViewStmt{
 		View:            relation,
-		Aliases:         &ast.List{},
+		Aliases:         aliases,
 		Query:           c.convert(n.Select_stmt()),
 		Replace:         false,
 		Options:         &ast.List{},
@@ -265,6 +392,18 @@ func (c *cc) convertDrop_stmtContext(n *parser.Drop_stmtContext) ast.Node {
 			Tables:   []*ast.TableName{&name},
 		}
 	}
+	if n.INDEX_() != nil {
+		name := ast.TableName{
+			Name: identifier(n.Any_name().GetText()),
+		}
+		if n.Schema_name() != nil {
+			name.Schema = n.Schema_name().GetText()
+		}
+		return &ast.DropIndexStmt{
+			Indexes:   []*ast.TableName{&name},
+			MissingOk: n.EXISTS_() != nil,
+		}
+	}
 	return todo("convertDrop_stmtContext", n)
 }
 
@@ -356,7 +495,7 @@ func (c *cc) convertComparison(n *parser.Expr_comparisonContext) ast.Node {
 		return &ast.In{
 			Expr:     lexpr,
 			List:     rexprs,
-			Not:      false,
+			Not:      n.NOT_() != nil,
 			Sel:      nil,
 			Location: n.GetStart().GetStart(),
 		}
@@ -439,30 +578,7 @@ func (c *cc) convertMultiSelect_stmtContext(n *parser.Select_stmtContext) ast.No
 				var orderBy ast.List
 				if windowDef.ORDER_() != nil {
 					for _, e := range windowDef.AllOrdering_term() {
-						oterm := e.(*parser.Ordering_termContext)
-						sortByDir := ast.SortByDirDefault
-						if ad := oterm.Asc_desc(); ad != nil {
-							if ad.ASC_() != nil {
-								sortByDir = ast.SortByDirAsc
-							} else {
-								sortByDir = ast.SortByDirDesc
-							}
-						}
-						sortByNulls := ast.SortByNullsDefault
-						if oterm.NULLS_() != nil {
-							if oterm.FIRST_() != nil {
-								sortByNulls = ast.SortByNullsFirst
-							} else {
-								sortByNulls = ast.SortByNullsLast
-							}
-						}
-
-						orderBy.Items = append(orderBy.Items, &ast.SortBy{
-							Node:        c.convert(oterm.Expr()),
-							SortbyDir:   sortByDir,
-							SortbyNulls: sortByNulls,
-							UseOp:       &ast.List{},
-						})
+						orderBy.Items = append(orderBy.Items, c.convertOrdering_termContext(e.(*parser.Ordering_termContext)))
 					}
 				}
 				window.Items = append(window.Items, &ast.WindowDef{
@@ -511,6 +627,14 @@ func (c *cc) convertMultiSelect_stmtContext(n *parser.Select_stmtContext) ast.No
 		}
 	}
 
+	if ob, ok := n.Order_by_stmt().(*parser.Order_by_stmtContext); ok {
+		sortClause := &ast.List{}
+		for _, o := range ob.AllOrdering_term() {
+			sortClause.Items = append(sortClause.Items, c.convertOrdering_termContext(o.(*parser.Ordering_termContext)))
+		}
+		selectStmt.SortClause = sortClause
+	}
+
 	limitCount, limitOffset := c.convertLimit_stmtContext(n.Limit_stmt())
 	selectStmt.LimitCount = limitCount
 	selectStmt.LimitOffset = limitOffset
@@ -518,6 +642,31 @@ func (c *cc) convertMultiSelect_stmtContext(n *parser.Select_stmtContext) ast.No
 	return selectStmt
 }
 
+func (c *cc) convertOrdering_termContext(n *parser.Ordering_termContext) *ast.SortBy {
+	sortByDir := ast.SortByDirDefault
+	if ad := n.Asc_desc(); ad != nil {
+		if ad.ASC_() != nil {
+			sortByDir = ast.SortByDirAsc
+		} else {
+			sortByDir = ast.SortByDirDesc
+		}
+	}
+	sortByNulls := ast.SortByNullsDefault
+	if n.NULLS_() != nil {
+		if n.FIRST_() != nil {
+			sortByNulls = ast.SortByNullsFirst
+		} else {
+			sortByNulls = ast.SortByNullsLast
+		}
+	}
+	return &ast.SortBy{
+		Node:        c.convert(n.Expr()),
+		SortbyDir:   sortByDir,
+		SortbyNulls: sortByNulls,
+		UseOp:       &ast.List{},
+	}
+}
+
 func (c *cc) convertExprListContext(n *parser.Expr_listContext) ast.Node {
 	list := &ast.List{Items: []ast.Node{}}
 	for _, e := range n.AllExpr() {
@@ -830,24 +979,37 @@ func (c *cc) convertReturning_caluseContext(n parser.IReturning_clauseContext) *
 		return list
 	}
 
-	for _, exp := range r.AllExpr() {
-		list.Items = append(list.Items, &ast.ResTarget{
-			Indirection: &ast.List{},
-			Val:         c.convert(exp),
-		})
-	}
-
-	for _, star := range r.AllSTAR() {
-		list.Items = append(list.Items, &ast.ResTarget{
-			Indirection: &ast.List{},
-			Val: &ast.ColumnRef{
-				Fields: &ast.List{
-					Items: []ast.Node{&ast.A_Star{}},
+	// Stars and expressions are converted in the order they appear in, so
+	// that the columns match the expanded query
+	for _, child := range r.GetChildren() {
+		switch child := child.(type) {
+		case antlr.TerminalNode:
+			if child.GetSymbol().GetTokenType() != parser.SQLiteParserSTAR {
+				continue
+			}
+			list.Items = append(list.Items, &ast.ResTarget{
+				Indirection: &ast.List{},
+				Val: &ast.ColumnRef{
+					Fields: &ast.List{
+						Items: []ast.Node{&ast.A_Star{}},
+					},
+					Location: child.GetSymbol().GetStart(),
 				},
-				Location: star.GetSymbol().GetStart(),
-			},
-			Location: star.GetSymbol().GetStart(),
-		})
+				Location: child.GetSymbol().GetStart(),
+			})
+		case parser.IExprContext:
+			list.Items = append(list.Items, &ast.ResTarget{
+				Indirection: &ast.List{},
+				Val:         c.convert(child),
+				Location:    child.GetStart().GetStart(),
+			})
+		case parser.IColumn_aliasContext:
+			if len(list.Items) == 0 {
+				continue
+			}
+			name := identifier(child.GetText())
+			list.Items[len(list.Items)-1].(*ast.ResTarget).Name = &name
+		}
 	}
 
 	return list
@@ -1126,6 +1288,9 @@ func (c *cc) convert(node node) ast.Node {
 	case *parser.Attach_stmtContext:
 		return c.convertAttach_stmtContext(n)
 
+	case *parser.Create_index_stmtContext:
+		return c.convertCreate_index_stmtContext(n)
+
 	case *parser.Create_table_stmtContext:
 		return c.convertCreate_table_stmtContext(n)
 
@@ -66,6 +66,13 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 		loc := 0
 
 		for _, stmt := range list.AllSql_stmt() {
+			if sql, ok := stmt.(*parser.Sql_stmtContext); ok {
+				if create, ok := sql.Create_table_stmt().(*parser.Create_table_stmtContext); ok {
+					if err := checkStrictTable(create); err != nil {
+						return nil, err
+					}
+				}
+			}
 			converter := &cc{}
 			out := converter.convert(stmt)
 			if _, ok := out.(*ast.TODO); ok {
@@ -1,8 +1,14 @@
 package sqlite
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
 	"github.com/sqlc-dev/sqlc/internal/engine/sqlite/parser"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 )
 
 type tableNamer interface {
@@ -20,6 +26,56 @@ func parseTableName(c tableNamer) *ast.TableName {
 	return &name
 }
 
+// strictTypes are the only column types allowed in a STRICT table.
+// https://www.sqlite.org/stricttables.html
+var strictTypes = map[string]bool{
+	"int":     true,
+	"integer": true,
+	"real":    true,
+	"text":    true,
+	"blob":    true,
+	"any":     true,
+}
+
+func isStrictTable(n *parser.Create_table_stmtContext) bool {
+	for _, iopt := range n.AllTable_option() {
+		if opt, ok := iopt.(*parser.Table_optionContext); ok && opt.STRICT_() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStrictTable returns an error if a column of a STRICT table is declared
+// without a type, or with a type other than INT, INTEGER, REAL, TEXT, BLOB or
+// ANY.
+func checkStrictTable(n *parser.Create_table_stmtContext) error {
+	if !isStrictTable(n) {
+		return nil
+	}
+	table := identifier(n.Table_name().GetText())
+	for _, idef := range n.AllColumn_def() {
+		def, ok := idef.(*parser.Column_defContext)
+		if !ok {
+			continue
+		}
+		column := identifier(def.Column_name().GetText())
+		if def.Type_name() == nil {
+			return &sqlerr.Error{
+				Message:  fmt.Sprintf("missing datatype for %s.%s", table, column),
+				Location: def.GetStart().GetStart(),
+			}
+		}
+		if typ := def.Type_name().GetText(); !strictTypes[strings.ToLower(typ)] {
+			return &sqlerr.Error{
+				Message:  fmt.Sprintf("unknown datatype for %s.%s: %q", table, column, typ),
+				Location: def.Type_name().GetStart().GetStart(),
+			}
+		}
+	}
+	return nil
+}
+
 func hasNotNullConstraint(checks []parser.IColumn_constraintContext) bool {
 	for i := range checks {
 		constraint, ok := checks[i].(*parser.Column_constraintContext)
@@ -35,3 +91,175 @@ func hasNotNullConstraint(checks []parser.IColumn_constraintContext) bool {
 	}
 	return false
 }
+
+// columnDefault reports whether a column has a default value and returns the
+// SQL text of its default expression. INTEGER PRIMARY KEY columns, which alias
+// the rowid, have an implicit default without any text.
+func columnDefault(def *parser.Column_defContext) (bool, string) {
+	for _, icon := range def.AllColumn_constraint() {
+		con, ok := icon.(*parser.Column_constraintContext)
+		if !ok {
+			continue
+		}
+		switch {
+		case con.DEFAULT_() != nil:
+			start := con.DEFAULT_().GetSymbol().GetTokenIndex() + 1
+			stream := con.GetParser().GetTokenStream()
+			text := stream.GetTextFromInterval(antlr.NewInterval(start, con.GetStop().GetTokenIndex()))
+			return true, strings.TrimSpace(text)
+		case con.PRIMARY_() != nil && con.KEY_() != nil:
+			if con.AUTOINCREMENT_() != nil {
+				return true, ""
+			}
+			if def.Type_name() != nil && strings.EqualFold(def.Type_name().GetText(), "integer") {
+				return true, ""
+			}
+		}
+	}
+	return false, ""
+}
+
+// exprText returns the source text of an expression, including whitespace.
+func exprText(ctx parser.IExprContext) string {
+	stream := ctx.GetParser().GetTokenStream()
+	return stream.GetTextFromInterval(antlr.NewInterval(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex()))
+}
+
+// columnKeyConstraints returns the PRIMARY KEY, UNIQUE and FOREIGN KEY
+// constraints declared inline on a column definition.
+func columnKeyConstraints(def *parser.Column_defContext) []*ast.Constraint {
+	var cons []*ast.Constraint
+	for _, icon := range def.AllColumn_constraint() {
+		con, ok := icon.(*parser.Column_constraintContext)
+		if !ok {
+			continue
+		}
+		var name string
+		if con.Name() != nil {
+			name = identifier(con.Name().GetText())
+		}
+		cols := []string{identifier(def.Column_name().GetText())}
+		var contype ast.ConstrType
+		switch {
+		case con.PRIMARY_() != nil && con.KEY_() != nil:
+			contype = ast.CONSTR_PRIMARY
+		case con.UNIQUE_() != nil:
+			contype = ast.CONSTR_UNIQUE
+		case con.Foreign_key_clause() != nil:
+			if fk, ok := con.Foreign_key_clause().(*parser.Foreign_key_clauseContext); ok {
+				cons = append(cons, newForeignKeyConstraint(name, cols, fk))
+			}
+			continue
+		default:
+			continue
+		}
+		cons = append(cons, newKeyConstraint(contype, name, cols))
+	}
+	return cons
+}
+
+// convertKeyConstraint converts a PRIMARY KEY, UNIQUE or FOREIGN KEY table
+// constraint, returning nil for any other kind of constraint.
+func convertKeyConstraint(con *parser.Table_constraintContext) *ast.Constraint {
+	var name string
+	if con.Name() != nil {
+		name = identifier(con.Name().GetText())
+	}
+	var contype ast.ConstrType
+	switch {
+	case con.PRIMARY_() != nil:
+		contype = ast.CONSTR_PRIMARY
+	case con.UNIQUE_() != nil:
+		contype = ast.CONSTR_UNIQUE
+	case con.FOREIGN_() != nil:
+		fk, ok := con.Foreign_key_clause().(*parser.Foreign_key_clauseContext)
+		if !ok {
+			return nil
+		}
+		var cols []string
+		for _, col := range con.AllColumn_name() {
+			cols = append(cols, identifier(col.GetText()))
+		}
+		return newForeignKeyConstraint(name, cols, fk)
+	default:
+		return nil
+	}
+	var cols []string
+	for _, icol := range con.AllIndexed_column() {
+		col, ok := icol.(*parser.Indexed_columnContext)
+		if !ok || col.Column_name() == nil {
+			continue
+		}
+		cols = append(cols, identifier(col.Column_name().GetText()))
+	}
+	return newKeyConstraint(contype, name, cols)
+}
+
+func newKeyConstraint(contype ast.ConstrType, name string, cols []string) *ast.Constraint {
+	keys := &ast.List{}
+	for _, col := range cols {
+		keys.Items = append(keys.Items, &ast.String{Str: col})
+	}
+	return &ast.Constraint{
+		Contype: contype,
+		Conname: &name,
+		Keys:    keys,
+	}
+}
+
+// newForeignKeyConstraint builds a FOREIGN KEY constraint on cols referencing
+// the table and columns named in the clause fk.
+func newForeignKeyConstraint(name string, cols []string, fk *parser.Foreign_key_clauseContext) *ast.Constraint {
+	fkAttrs := &ast.List{}
+	for _, col := range cols {
+		fkAttrs.Items = append(fkAttrs.Items, &ast.String{Str: col})
+	}
+	pkAttrs := &ast.List{}
+	for _, col := range fk.AllColumn_name() {
+		pkAttrs.Items = append(pkAttrs.Items, &ast.String{Str: identifier(col.GetText())})
+	}
+	table := identifier(fk.Foreign_table().GetText())
+	c := &ast.Constraint{
+		Contype: ast.CONSTR_FOREIGN,
+		Conname: &name,
+		FkAttrs: fkAttrs,
+		PkAttrs: pkAttrs,
+		Pktable: &ast.RangeVar{Relname: &table},
+	}
+
+	// The grammar doesn't label the actions, so read them from the tokens
+	// following each ON DELETE or ON UPDATE
+	var words []string
+	for _, child := range fk.GetChildren() {
+		if t, ok := child.(antlr.TerminalNode); ok {
+			words = append(words, strings.ToUpper(t.GetText()))
+		}
+	}
+	for i := 0; i+2 < len(words); i++ {
+		if words[i] != "ON" {
+			continue
+		}
+		var action byte
+		switch words[i+2] {
+		case "SET":
+			if i+3 < len(words) && words[i+3] == "NULL" {
+				action = ast.FKCONSTR_ACTION_SETNULL
+			} else {
+				action = ast.FKCONSTR_ACTION_SETDEFAULT
+			}
+		case "CASCADE":
+			action = ast.FKCONSTR_ACTION_CASCADE
+		case "RESTRICT":
+			action = ast.FKCONSTR_ACTION_RESTRICT
+		case "NO":
+			action = ast.FKCONSTR_ACTION_NOACTION
+		}
+		switch words[i+1] {
+		case "DELETE":
+			c.FkDelAction = action
+		case "UPDATE":
+			c.FkUpdAction = action
+		}
+	}
+	return c
+}
@@ -40,6 +40,7 @@ func (w *wrapper) Invoke(ctx context.Context, method string, args any, reply any
 		return err
 	}
 	resp.Files = res.Files
+	resp.Diagnostics = res.Diagnostics
 	return nil
 }
 
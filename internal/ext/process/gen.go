@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
+	"io"
 	"os/exec"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -14,12 +16,21 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/sqlc-dev/sqlc/internal/ext"
 	"github.com/sqlc-dev/sqlc/internal/info"
 )
 
 type Runner struct {
-	Cmd string
+	// Name is the name of the plugin, used in error messages
+	Name string
+	Cmd  string
+	// Env is the environment of the command, as KEY=value pairs
 	Env []string
+	// Timeout limits how long the command may run. Zero means no limit.
+	Timeout time.Duration
+	// Stderr receives the stderr of the command while it runs, each line
+	// prefixed with the name of the plugin.
+	Stderr io.Writer
 }
 
 func (r *Runner) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
@@ -39,26 +50,47 @@ func (r *Runner) Invoke(ctx context.Context, method string, args any, reply any,
 		return fmt.Errorf("process: %s not found", r.Cmd)
 	}
 
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
 	cmd := exec.CommandContext(ctx, path, method)
+	killProcessGroup(cmd)
+	// Don't wait for children that keep the output open once the command
+	// has been killed
+	cmd.WaitDelay = time.Second
 	cmd.Stdin = bytes.NewReader(stdin)
 	cmd.Env = []string{
 		fmt.Sprintf("SQLC_VERSION=%s", info.Version),
+		fmt.Sprintf("SQLC_REQUEST_ID=%s", ext.RequestID()),
 	}
-	for _, key := range r.Env {
-		if key == "SQLC_AUTH_TOKEN" {
+	for _, e := range r.Env {
+		if key, _, _ := strings.Cut(e, "="); key == "SQLC_AUTH_TOKEN" {
 			continue
 		}
-		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, os.Getenv(key)))
+		cmd.Env = append(cmd.Env, e)
 	}
 
+	stderr := &ext.Stderr{Name: r.Name, Out: r.Stderr}
+	cmd.Stderr = stderr
 	out, err := cmd.Output()
+	stderr.Close()
 	if err != nil {
-		stderr := err.Error()
+		if r.Timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			msg := fmt.Sprintf("process: plugin %s timed out after %s", r.Name, r.Timeout)
+			if s := strings.TrimSpace(stderr.String()); s != "" {
+				msg += ": " + s
+			}
+			return errors.New(msg)
+		}
+		msg := err.Error()
 		var exit *exec.ExitError
 		if errors.As(err, &exit) {
-			stderr = string(exit.Stderr)
+			msg = stderr.String()
 		}
-		return fmt.Errorf("process: error running command %s", stderr)
+		return fmt.Errorf("process: error running command %s", msg)
 	}
 
 	resp, ok := reply.(protoreflect.ProtoMessage)
@@ -0,0 +1,74 @@
+//go:build unix
+
+package process
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+func TestTimeout(t *testing.T) {
+	// The plugin starts a child that keeps stdout open, which must be killed
+	// along with it
+	script := filepath.Join(t.TempDir(), "sqlc-gen-slow")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\necho 'still working' >&2\nsleep 30 &\nwait\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	r := &Runner{
+		Name:    "slow",
+		Cmd:     script,
+		Timeout: 500 * time.Millisecond,
+	}
+	start := time.Now()
+	err := r.Invoke(context.Background(), "/plugin.CodegenService/Generate", &plugin.GenerateRequest{}, &plugin.GenerateResponse{})
+	if err == nil {
+		t.Fatal("expected a timeout")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("plugin wasn't stopped in time: %s", elapsed)
+	}
+	expected := "process: plugin slow timed out after 500ms: still working"
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+}
+
+func TestStderr(t *testing.T) {
+	script := filepath.Join(t.TempDir(), "sqlc-gen-noisy")
+	body := "#!/bin/sh\necho \"request $SQLC_REQUEST_ID\" >&2\necho \"level $SQLC_LOG_LEVEL\" >&2\nprintf 'failed' >&2\nexit 1\n"
+	if err := os.WriteFile(script, []byte(body), 0755); err != nil {
+		t.Fatal(err)
+	}
+	var live bytes.Buffer
+	r := &Runner{
+		Name:   "noisy",
+		Cmd:    script,
+		Env:    []string{"SQLC_LOG_LEVEL=debug"},
+		Stderr: &live,
+	}
+	err := r.Invoke(context.Background(), "/plugin.CodegenService/Generate", &plugin.GenerateRequest{}, &plugin.GenerateResponse{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	lines := strings.Split(strings.TrimSuffix(live.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines of stderr, got %q", live.String())
+	}
+	if id := strings.TrimPrefix(lines[0], "[noisy] request "); id == lines[0] || id == "" {
+		t.Errorf("expected a request ID, got %q", lines[0])
+	}
+	if lines[1] != "[noisy] level debug" || lines[2] != "[noisy] failed" {
+		t.Errorf("unexpected stderr %q", live.String())
+	}
+	if !strings.HasSuffix(err.Error(), "level debug\nfailed") {
+		t.Errorf("expected the error to end with the stderr of the plugin, got %q", err)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package process
+
+import (
+	"os/exec"
+)
+
+// killProcessGroup leaves the command as is. Only the process itself is killed
+// when the context of the command is done.
+func killProcessGroup(cmd *exec.Cmd) {}
@@ -0,0 +1,102 @@
+package ext
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"sync"
+)
+
+// stderrTailSize is how much of the end of a plugin's stderr is kept for the
+// error reported when the plugin fails.
+const stderrTailSize = 64 * 1024
+
+// stderrLineSize is how long a line may get before it's streamed, so that a
+// plugin that never writes a newline isn't buffered without bound.
+const stderrLineSize = 4 * 1024
+
+// Stderr collects what a plugin writes to stderr. If Out is set, each line is
+// streamed to it as it's written, prefixed with the name of the plugin in
+// brackets. Lines longer than stderrLineSize are split. The end of the output
+// is kept for errors. Close streams the last line if it didn't end with a
+// newline.
+type Stderr struct {
+	Name string
+	Out  io.Writer
+
+	mu   sync.Mutex
+	line []byte
+	tail []byte
+}
+
+func (s *Stderr) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tail = append(s.tail, p...)
+	if over := len(s.tail) - stderrTailSize; over > 0 {
+		s.tail = s.tail[over:]
+	}
+	if s.Out == nil {
+		return len(p), nil
+	}
+	s.line = append(s.line, p...)
+	rest := s.line
+	for {
+		i := bytes.IndexByte(rest, '\n')
+		if i < 0 {
+			break
+		}
+		s.writeLine(rest[:i+1])
+		rest = rest[i+1:]
+	}
+	for len(rest) >= stderrLineSize {
+		s.writeLine(append(rest[:stderrLineSize:stderrLineSize], '\n'))
+		rest = rest[stderrLineSize:]
+	}
+	// Move the unfinished line to the start of the buffer, so that it doesn't
+	// grow with the lines already streamed
+	s.line = append(s.line[:0], rest...)
+	return len(p), nil
+}
+
+// Close streams the last line written, if it didn't end with a newline.
+func (s *Stderr) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Out == nil || len(s.line) == 0 {
+		return nil
+	}
+	s.writeLine(append(s.line, '\n'))
+	s.line = nil
+	return nil
+}
+
+// writeLine writes a prefixed line in a single write, so that it isn't
+// interleaved with the output of other plugins sharing Out.
+func (s *Stderr) writeLine(line []byte) {
+	buf := make([]byte, 0, len(s.Name)+3+len(line))
+	buf = append(buf, '[')
+	buf = append(buf, s.Name...)
+	buf = append(buf, "] "...)
+	buf = append(buf, line...)
+	// Errors writing the output of a plugin don't fail the plugin
+	s.Out.Write(buf)
+}
+
+// String returns the end of what the plugin wrote to stderr.
+func (s *Stderr) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return string(s.tail)
+}
+
+// RequestID returns a new ID for an invocation of a plugin, which it's passed
+// in the SQLC_REQUEST_ID environment variable.
+func RequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
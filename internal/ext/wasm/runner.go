@@ -1,7 +1,20 @@
 package wasm
 
+import (
+	"io"
+	"time"
+)
+
 type Runner struct {
+	// Name is the name of the plugin, used in error messages
+	Name   string
 	URL    string
 	SHA256 string
-	Env    []string
+	// Env is the environment of the module, as KEY=value pairs
+	Env []string
+	// Timeout limits how long the module may run. Zero means no limit.
+	Timeout time.Duration
+	// Stderr receives the stderr of the module while it runs, each line
+	// prefixed with the name of the plugin.
+	Stderr io.Writer
 }
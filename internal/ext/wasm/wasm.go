@@ -25,6 +25,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/sqlc-dev/sqlc/internal/cache"
+	"github.com/sqlc-dev/sqlc/internal/ext"
 	"github.com/sqlc-dev/sqlc/internal/info"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
 )
@@ -147,7 +148,8 @@ func (r *Runner) loadAndCompileWASM(ctx context.Context, cache string, expected
 		return nil, fmt.Errorf("wazero.NewCompilationCacheWithDir: %w", err)
 	}
 
-	config := wazero.NewRuntimeConfig().WithCompilationCache(wazeroCache)
+	// Closing the module once the context is done enforces the timeout
+	config := wazero.NewRuntimeConfig().WithCompilationCache(wazeroCache).WithCloseOnContextDone(true)
 	rt := wazero.NewRuntimeWithConfig(ctx, config)
 
 	if _, err := wasi_snapshot_preview1.Instantiate(ctx, rt); err != nil {
@@ -208,23 +210,41 @@ func (r *Runner) Invoke(ctx context.Context, method string, args any, reply any,
 		return fmt.Errorf("loadBytes: %w", err)
 	}
 
-	var stderr, stdout bytes.Buffer
+	var stdout bytes.Buffer
+	stderr := &ext.Stderr{Name: r.Name, Out: r.Stderr}
 
 	conf := wazero.NewModuleConfig().
 		WithName("").
 		WithArgs("plugin.wasm", method).
 		WithStdin(bytes.NewReader(stdinBlob)).
 		WithStdout(&stdout).
-		WithStderr(&stderr).
-		WithEnv("SQLC_VERSION", info.Version)
-	for _, key := range r.Env {
-		conf = conf.WithEnv(key, os.Getenv(key))
+		WithStderr(stderr).
+		WithEnv("SQLC_VERSION", info.Version).
+		WithEnv("SQLC_REQUEST_ID", ext.RequestID())
+	for _, e := range r.Env {
+		key, value, _ := strings.Cut(e, "=")
+		conf = conf.WithEnv(key, value)
 	}
 
-	result, err := runtimeAndCode.rt.InstantiateModule(ctx, runtimeAndCode.code, conf)
+	runCtx := ctx
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
+	result, err := runtimeAndCode.rt.InstantiateModule(runCtx, runtimeAndCode.code, conf)
+	stderr.Close()
 	if result != nil {
 		defer result.Close(ctx)
 	}
+	if err != nil && r.Timeout > 0 && errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+		msg := fmt.Sprintf("wasm: plugin %s timed out after %s", r.Name, r.Timeout)
+		if s := strings.TrimSpace(stderr.String()); s != "" {
+			msg += ": " + s
+		}
+		return errors.New(msg)
+	}
 	if cerr := checkError(err, stderr); cerr != nil {
 		return cerr
 	}
@@ -248,7 +268,7 @@ func (r *Runner) NewStream(ctx context.Context, desc *grpc.StreamDesc, method st
 	return nil, status.Error(codes.Unimplemented, "")
 }
 
-func checkError(err error, stderr bytes.Buffer) error {
+func checkError(err error, stderr *ext.Stderr) error {
 	if err == nil {
 		return err
 	}
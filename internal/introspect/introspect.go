@@ -0,0 +1,113 @@
+// Package introspect reads the schema of a live database and renders it as
+// SQL statements that sqlc can parse.
+package introspect
+
+import (
+	"sort"
+	"strings"
+)
+
+// Kind is the kind of a database object. Objects are rendered in the order of
+// their kinds, so that statements only depend on earlier ones.
+type Kind int
+
+const (
+	KindSchema Kind = iota
+	KindType
+	KindTable
+	KindConstraint
+	KindView
+)
+
+// Object is a database object, along with the statement that creates it.
+type Object struct {
+	Kind   Kind
+	Schema string
+	Name   string
+
+	// SQL is the statement that creates the object, without a trailing
+	// semicolon.
+	SQL string
+
+	// DependsOn holds the views that a view selects from, as "schema.name".
+	DependsOn []string
+}
+
+func (o Object) qualifiedName() string {
+	return o.Schema + "." + o.Name
+}
+
+// Render returns the statements that create objects. Statements are sorted by
+// kind, schema and name, except that views are moved after the views they
+// depend on, so the output is stable across runs against the same database.
+func Render(objects []Object) string {
+	objects = append([]Object{}, objects...)
+	sort.SliceStable(objects, func(i, j int) bool {
+		a, b := objects[i], objects[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.SQL < b.SQL
+	})
+
+	var b strings.Builder
+	for i, o := range orderViews(objects) {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(strings.TrimSpace(o.SQL))
+		b.WriteString(";\n")
+	}
+	return b.String()
+}
+
+// orderViews moves every view after the views it depends on, keeping the
+// sorted order otherwise.
+func orderViews(objects []Object) []Object {
+	views := map[string]bool{}
+	for _, o := range objects {
+		if o.Kind == KindView {
+			views[o.qualifiedName()] = true
+		}
+	}
+
+	out := make([]Object, 0, len(objects))
+	emitted := map[string]bool{}
+	ready := func(o Object) bool {
+		for _, dep := range o.DependsOn {
+			if views[dep] && !emitted[dep] && dep != o.qualifiedName() {
+				return false
+			}
+		}
+		return true
+	}
+	pending := objects
+	for len(pending) > 0 {
+		i := 0
+		for i < len(pending) && !ready(pending[i]) {
+			i++
+		}
+		if i == len(pending) {
+			// A cycle, which the database wouldn't allow in the first place.
+			return append(out, pending...)
+		}
+		o := pending[i]
+		out = append(out, o)
+		if o.Kind == KindView {
+			emitted[o.qualifiedName()] = true
+		}
+		pending = append(pending[:i:i], pending[i+1:]...)
+	}
+	return out
+}
+
+// quoteString returns s as an SQL string literal.
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,201 @@
+package introspect
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// buildCatalog parses the rendered schema the way sqlc generate does.
+func buildCatalog(t *testing.T, engine config.Engine, schema string) *catalog.Catalog {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schema.sql")
+	if err := os.WriteFile(path, []byte(schema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	conf := config.SQL{Engine: engine, Schema: []string{path}}
+	c, err := compiler.NewCompiler(conf, config.Combine(config.Config{}, conf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ParseCatalog(conf.Schema); err != nil {
+		t.Fatalf("%s\n%s", err, schema)
+	}
+	return c.Catalog()
+}
+
+func TestRenderOrder(t *testing.T) {
+	objects := []Object{
+		{Kind: KindView, Schema: "public", Name: "a_view", SQL: "CREATE VIEW a_view AS SELECT * FROM b_view", DependsOn: []string{"public.b_view", "public.users"}},
+		{Kind: KindConstraint, Schema: "public", Name: "users.users_org_id_fkey", SQL: "ALTER TABLE users ADD FOREIGN KEY (org_id) REFERENCES orgs (id)"},
+		{Kind: KindTable, Schema: "public", Name: "users", SQL: "CREATE TABLE users (org_id int)"},
+		{Kind: KindView, Schema: "public", Name: "b_view", SQL: "CREATE VIEW b_view AS SELECT * FROM users", DependsOn: []string{"public.users"}},
+		{Kind: KindTable, Schema: "public", Name: "orgs", SQL: "CREATE TABLE orgs (id int)"},
+		{Kind: KindType, Schema: "public", Name: "status", SQL: "CREATE TYPE status AS ENUM ('open')"},
+		{Kind: KindSchema, Schema: "audit", SQL: "CREATE SCHEMA audit"},
+	}
+	expected := `CREATE SCHEMA audit;
+
+CREATE TYPE status AS ENUM ('open');
+
+CREATE TABLE orgs (id int);
+
+CREATE TABLE users (org_id int);
+
+ALTER TABLE users ADD FOREIGN KEY (org_id) REFERENCES orgs (id);
+
+CREATE VIEW b_view AS SELECT * FROM users;
+
+CREATE VIEW a_view AS SELECT * FROM b_view;
+`
+	if diff := cmp.Diff(expected, Render(objects)); diff != "" {
+		t.Errorf("render mismatch: \n%s", diff)
+	}
+}
+
+func TestPostgreSQLRoundTrip(t *testing.T) {
+	users := &pgTable{
+		Schema: "public",
+		Name:   "users",
+		Columns: []pgColumn{
+			{Name: "id", Type: "bigint", NotNull: true, Identity: "d"},
+			{Name: "org_id", Type: "integer", NotNull: true},
+			{Name: "name", Type: "character varying(255)", NotNull: true},
+			{Name: "status", Type: "audit.status", NotNull: true, Default: "'open'::audit.status"},
+			{Name: "User", Type: "text"},
+			{Name: "tags", Type: "text[]"},
+			{Name: "name_length", Type: "integer", Default: "length((name)::text)", Generated: "s"},
+		},
+		Constraints: []pgConstraint{
+			{Name: "users_pkey", Type: "p", Definition: "PRIMARY KEY (id)"},
+			{Name: "users_name_key", Type: "u", Definition: "UNIQUE (name)"},
+			{Name: "users_name_check", Type: "c", Definition: "CHECK (name::text <> ''::text)"},
+			{Name: "users_org_id_fkey", Type: "f", Definition: "FOREIGN KEY (org_id) REFERENCES audit.orgs(id) ON DELETE CASCADE"},
+		},
+	}
+	orgs := &pgTable{
+		Schema: "audit",
+		Name:   "orgs",
+		Columns: []pgColumn{
+			{Name: "id", Type: "integer", NotNull: true, Default: "nextval('audit.orgs_id_seq'::regclass)"},
+		},
+		Constraints: []pgConstraint{
+			{Name: "orgs_pkey", Type: "p", Definition: "PRIMARY KEY (id)"},
+		},
+	}
+	objects := []Object{
+		{Kind: KindSchema, Schema: "audit", SQL: "CREATE SCHEMA audit"},
+		{Kind: KindType, Schema: "audit", Name: "status", SQL: pgCreateEnum("audit", "status", []string{"open", "won't fix"})},
+	}
+	for _, table := range []*pgTable{users, orgs} {
+		objects = append(objects, Object{Kind: KindTable, Schema: table.Schema, Name: table.Name, SQL: table.createStmt()})
+	}
+	objects = append(objects,
+		Object{Kind: KindConstraint, Schema: "public", Name: "users.users_org_id_fkey", SQL: "ALTER TABLE users ADD " + users.Constraints[3].clause()},
+		Object{Kind: KindView, Schema: "public", Name: "active_users", SQL: pgCreateView("public", "active_users", false, " SELECT id,\n    name\n   FROM users\n  WHERE status = 'open'::audit.status;")},
+		Object{Kind: KindView, Schema: "public", Name: "user_names", SQL: pgCreateView("public", "user_names", true, " SELECT name\n   FROM active_users;"), DependsOn: []string{"public.active_users"}},
+	)
+	out := Render(objects)
+
+	c := buildCatalog(t, config.EnginePostgreSQL, out)
+
+	table, err := c.GetTable(&ast.TableName{Name: "users"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var columns []string
+	for _, col := range table.Columns {
+		columns = append(columns, col.Name)
+	}
+	if diff := cmp.Diff([]string{"id", "org_id", "name", "status", "User", "tags", "name_length"}, columns); diff != "" {
+		t.Errorf("columns mismatch: \n%s", diff)
+	}
+	if table.PrimaryKey == nil || len(table.UniqueConstraints) != 1 {
+		t.Errorf("expected a primary key and one unique constraint")
+	}
+	if diff := cmp.Diff([]*catalog.ForeignKey{
+		{
+			Name:       "users_org_id_fkey",
+			Columns:    []string{"org_id"},
+			RefTable:   &ast.TableName{Schema: "audit", Name: "orgs"},
+			RefColumns: []string{"id"},
+			OnDelete:   "CASCADE",
+			OnUpdate:   "NO ACTION",
+		},
+	}, table.ForeignKeys); diff != "" {
+		t.Errorf("foreign keys mismatch: \n%s", diff)
+	}
+	for _, name := range []string{"active_users", "user_names"} {
+		view, err := c.GetTable(&ast.TableName{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !view.IsView {
+			t.Errorf("%s: expected a view", name)
+		}
+	}
+}
+
+func TestMySQLRoundTrip(t *testing.T) {
+	authors := myCreateTable("app", "authors", "CREATE TABLE `authors` (\n"+
+		"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n"+
+		"  `name` varchar(255) NOT NULL,\n"+
+		"  `status` enum('active','retired') NOT NULL DEFAULT 'active',\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  UNIQUE KEY `authors_name` (`name`)\n"+
+		") ENGINE=InnoDB AUTO_INCREMENT=42 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci", false)
+	if strings.Contains(authors, "AUTO_INCREMENT=") {
+		t.Errorf("expected the AUTO_INCREMENT table option to be removed: %s", authors)
+	}
+	books := myCreateTable("app", "books", "CREATE TABLE `books` (\n"+
+		"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n"+
+		"  `author_id` bigint unsigned NOT NULL,\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  KEY `author_id` (`author_id`),\n"+
+		"  CONSTRAINT `books_ibfk_1` FOREIGN KEY (`author_id`) REFERENCES `authors` (`id`)\n"+
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci", false)
+	view := myCreateView("app", "active_authors", "select `app`.`authors`.`id` AS `id`,`app`.`authors`.`name` AS `name` from `app`.`authors` where (`app`.`authors`.`status` = 'active')", false)
+
+	out := Render([]Object{
+		{Kind: KindView, Schema: "app", Name: "active_authors", SQL: view, DependsOn: []string{"app.authors"}},
+		{Kind: KindTable, Schema: "app", Name: "books", SQL: books},
+		{Kind: KindTable, Schema: "app", Name: "authors", SQL: authors},
+	})
+
+	c := buildCatalog(t, config.EngineMySQL, out)
+	for _, name := range []string{"authors", "books", "active_authors"} {
+		if _, err := c.GetTable(&ast.TableName{Name: name}); err != nil {
+			t.Errorf("%s: %s", name, err)
+		}
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "active_authors"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !table.IsView || len(table.Columns) != 2 {
+		t.Errorf("expected a view with two columns, got %+v", table)
+	}
+}
+
+func TestPgQuoteIdent(t *testing.T) {
+	for in, expected := range map[string]string{
+		"users":     "users",
+		"user":      `"user"`,
+		"User":      `"User"`,
+		"my table":  `"my table"`,
+		`say"hi"`:   `"say""hi"""`,
+		"_private1": "_private1",
+	} {
+		if actual := pgQuoteIdent(in); actual != expected {
+			t.Errorf("pgQuoteIdent(%q) = %s, expected %s", in, actual, expected)
+		}
+	}
+}
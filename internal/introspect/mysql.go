@@ -0,0 +1,176 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+const myTablesQuery = `
+SELECT TABLE_NAME
+FROM information_schema.TABLES
+WHERE TABLE_SCHEMA = ? AND TABLE_TYPE = 'BASE TABLE'
+`
+
+const myViewsQuery = `
+SELECT TABLE_NAME, VIEW_DEFINITION
+FROM information_schema.VIEWS
+WHERE TABLE_SCHEMA = ?
+`
+
+const myViewDependenciesQuery = `
+SELECT VIEW_NAME, TABLE_SCHEMA, TABLE_NAME
+FROM information_schema.VIEW_TABLE_USAGE
+WHERE VIEW_SCHEMA = ?
+`
+
+// MySQL returns the objects in the given schemas of the MySQL database at dsn.
+// If no schemas are given, the database named in dsn is read. Table names are
+// qualified with their schema if more than one schema is read.
+func MySQL(ctx context.Context, dsn string, schemas []string) ([]Object, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	if len(schemas) == 0 {
+		var current sql.NullString
+		if err := db.QueryRowContext(ctx, "SELECT DATABASE()").Scan(&current); err != nil {
+			return nil, err
+		}
+		if !current.Valid {
+			return nil, fmt.Errorf("no database selected; add one to the URI or pass --schema")
+		}
+		schemas = []string{current.String}
+	}
+	qualify := len(schemas) > 1
+
+	var objects []Object
+	for _, schema := range schemas {
+		if qualify {
+			objects = append(objects, Object{
+				Kind:   KindSchema,
+				Schema: schema,
+				SQL:    "CREATE SCHEMA " + myQuoteIdent(schema),
+			})
+		}
+		objs, err := myObjects(ctx, db, schema, qualify)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, objs...)
+	}
+	return objects, nil
+}
+
+func myObjects(ctx context.Context, db *sql.DB, schema string, qualify bool) ([]Object, error) {
+	var tables []string
+	rows, err := db.QueryContext(ctx, myTablesQuery, schema)
+	if err != nil {
+		return nil, fmt.Errorf("read tables: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read tables: %w", err)
+	}
+
+	var objects []Object
+	for _, name := range tables {
+		var table, stmt string
+		query := "SHOW CREATE TABLE " + myQuoteIdent(schema) + "." + myQuoteIdent(name)
+		if err := db.QueryRowContext(ctx, query).Scan(&table, &stmt); err != nil {
+			return nil, fmt.Errorf("show create table %s: %w", name, err)
+		}
+		objects = append(objects, Object{
+			Kind:   KindTable,
+			Schema: schema,
+			Name:   name,
+			SQL:    myCreateTable(schema, name, stmt, qualify),
+		})
+	}
+
+	deps := map[string][]string{}
+	if depRows, err := db.QueryContext(ctx, myViewDependenciesQuery, schema); err == nil {
+		// VIEW_TABLE_USAGE is only available as of MySQL 8.0.13. Without
+		// it, views are ordered by name.
+		defer depRows.Close()
+		for depRows.Next() {
+			var view, refSchema, refName string
+			if err := depRows.Scan(&view, &refSchema, &refName); err != nil {
+				return nil, err
+			}
+			deps[view] = append(deps[view], refSchema+"."+refName)
+		}
+		if err := depRows.Err(); err != nil {
+			return nil, fmt.Errorf("read view dependencies: %w", err)
+		}
+	}
+
+	viewRows, err := db.QueryContext(ctx, myViewsQuery, schema)
+	if err != nil {
+		return nil, fmt.Errorf("read views: %w", err)
+	}
+	defer viewRows.Close()
+	for viewRows.Next() {
+		var name, definition string
+		if err := viewRows.Scan(&name, &definition); err != nil {
+			return nil, err
+		}
+		objects = append(objects, Object{
+			Kind:      KindView,
+			Schema:    schema,
+			Name:      name,
+			SQL:       myCreateView(schema, name, definition, qualify),
+			DependsOn: deps[name],
+		})
+	}
+	if err := viewRows.Err(); err != nil {
+		return nil, fmt.Errorf("read views: %w", err)
+	}
+	return objects, nil
+}
+
+var myAutoIncrement = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
+
+// myCreateTable cleans up the output of SHOW CREATE TABLE. The next
+// AUTO_INCREMENT value is dropped, as it changes as rows are inserted.
+func myCreateTable(schema, name, stmt string, qualify bool) string {
+	stmt = myAutoIncrement.ReplaceAllString(stmt, "")
+	if qualify {
+		stmt = strings.Replace(stmt, "CREATE TABLE "+myQuoteIdent(name), "CREATE TABLE "+myQualify(schema, name, true), 1)
+	}
+	return stmt
+}
+
+// myCreateView returns the CREATE VIEW statement for a view. MySQL qualifies
+// every table in the definition with its schema, which is removed unless
+// tables are qualified.
+func myCreateView(schema, name, definition string, qualify bool) string {
+	if !qualify {
+		definition = strings.ReplaceAll(definition, myQuoteIdent(schema)+".", "")
+	}
+	return fmt.Sprintf("CREATE VIEW %s AS %s", myQualify(schema, name, qualify), definition)
+}
+
+func myQualify(schema, name string, qualify bool) string {
+	if !qualify {
+		return myQuoteIdent(name)
+	}
+	return myQuoteIdent(schema) + "." + myQuoteIdent(name)
+}
+
+func myQuoteIdent(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
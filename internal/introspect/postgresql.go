@@ -0,0 +1,356 @@
+package introspect
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/sqlc-dev/sqlc/internal/engine/postgresql"
+)
+
+const pgDefaultSchema = "public"
+
+const pgEnumsQuery = `
+SELECT n.nspname, t.typname, array_agg(e.enumlabel ORDER BY e.enumsortorder)
+FROM pg_catalog.pg_type t
+JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+JOIN pg_catalog.pg_enum e ON e.enumtypid = t.oid
+WHERE n.nspname = ANY($1)
+GROUP BY n.nspname, t.typname
+`
+
+const pgTablesQuery = `
+SELECT n.nspname, c.relname
+FROM pg_catalog.pg_class c
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p') AND NOT c.relispartition AND n.nspname = ANY($1)
+`
+
+const pgColumnsQuery = `
+SELECT
+  n.nspname,
+  c.relname,
+  a.attname,
+  pg_catalog.format_type(a.atttypid, a.atttypmod),
+  a.attnotnull,
+  COALESCE(pg_catalog.pg_get_expr(d.adbin, d.adrelid), ''),
+  a.attidentity::text,
+  a.attgenerated::text
+FROM pg_catalog.pg_attribute a
+JOIN pg_catalog.pg_class c ON c.oid = a.attrelid
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+LEFT JOIN pg_catalog.pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+WHERE c.relkind IN ('r', 'p') AND NOT c.relispartition AND n.nspname = ANY($1)
+  AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY a.attnum
+`
+
+const pgConstraintsQuery = `
+SELECT n.nspname, c.relname, con.conname, con.contype::text, pg_catalog.pg_get_constraintdef(con.oid, true)
+FROM pg_catalog.pg_constraint con
+JOIN pg_catalog.pg_class c ON c.oid = con.conrelid
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE con.contype IN ('p', 'u', 'c', 'f') AND NOT c.relispartition AND n.nspname = ANY($1)
+ORDER BY con.conname
+`
+
+const pgViewsQuery = `
+SELECT n.nspname, c.relname, c.relkind = 'm', pg_catalog.pg_get_viewdef(c.oid, true)
+FROM pg_catalog.pg_class c
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('v', 'm') AND n.nspname = ANY($1)
+`
+
+const pgViewDependenciesQuery = `
+SELECT DISTINCT vn.nspname, v.relname, rn.nspname, r.relname
+FROM pg_catalog.pg_depend d
+JOIN pg_catalog.pg_rewrite rw ON rw.oid = d.objid
+JOIN pg_catalog.pg_class v ON v.oid = rw.ev_class
+JOIN pg_catalog.pg_namespace vn ON vn.oid = v.relnamespace
+JOIN pg_catalog.pg_class r ON r.oid = d.refobjid
+JOIN pg_catalog.pg_namespace rn ON rn.oid = r.relnamespace
+WHERE d.classid = 'pg_catalog.pg_rewrite'::regclass
+  AND d.refclassid = 'pg_catalog.pg_class'::regclass
+  AND r.relkind IN ('v', 'm') AND v.oid <> r.oid
+  AND vn.nspname = ANY($1)
+`
+
+type pgColumn struct {
+	Name      string
+	Type      string
+	NotNull   bool
+	Default   string
+	Identity  string
+	Generated string
+}
+
+type pgConstraint struct {
+	Name       string
+	Type       string
+	Definition string
+}
+
+type pgTable struct {
+	Schema      string
+	Name        string
+	Columns     []pgColumn
+	Constraints []pgConstraint
+}
+
+// PostgreSQL returns the objects in the given schemas of the PostgreSQL
+// database at uri. If no schemas are given, the public schema is read.
+func PostgreSQL(ctx context.Context, uri string, schemas []string) ([]Object, error) {
+	if len(schemas) == 0 {
+		schemas = []string{pgDefaultSchema}
+	}
+	conn, err := pgx.Connect(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+
+	var objects []Object
+	for _, schema := range schemas {
+		if schema != pgDefaultSchema {
+			objects = append(objects, Object{
+				Kind:   KindSchema,
+				Schema: schema,
+				SQL:    "CREATE SCHEMA " + pgQuoteIdent(schema),
+			})
+		}
+	}
+
+	rows, err := conn.Query(ctx, pgEnumsQuery, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("read enums: %w", err)
+	}
+	for rows.Next() {
+		var schema, name string
+		var values []string
+		if err := rows.Scan(&schema, &name, &values); err != nil {
+			return nil, err
+		}
+		objects = append(objects, Object{
+			Kind:   KindType,
+			Schema: schema,
+			Name:   name,
+			SQL:    pgCreateEnum(schema, name, values),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read enums: %w", err)
+	}
+
+	tables, err := pgTables(ctx, conn, schemas)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		objects = append(objects, Object{
+			Kind:   KindTable,
+			Schema: t.Schema,
+			Name:   t.Name,
+			SQL:    t.createStmt(),
+		})
+		for _, con := range t.Constraints {
+			if con.Type != "f" {
+				continue
+			}
+			// Foreign keys are added once all tables exist, as they may
+			// reference tables that sort after their own.
+			objects = append(objects, Object{
+				Kind:   KindConstraint,
+				Schema: t.Schema,
+				Name:   t.Name + "." + con.Name,
+				SQL:    fmt.Sprintf("ALTER TABLE %s ADD %s", pgQualify(t.Schema, t.Name), con.clause()),
+			})
+		}
+	}
+
+	views, err := pgViews(ctx, conn, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return append(objects, views...), nil
+}
+
+func pgTables(ctx context.Context, conn *pgx.Conn, schemas []string) ([]*pgTable, error) {
+	var tables []*pgTable
+	index := map[string]*pgTable{}
+
+	rows, err := conn.Query(ctx, pgTablesQuery, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("read tables: %w", err)
+	}
+	for rows.Next() {
+		t := &pgTable{}
+		if err := rows.Scan(&t.Schema, &t.Name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+		index[t.Schema+"."+t.Name] = t
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read tables: %w", err)
+	}
+
+	rows, err = conn.Query(ctx, pgColumnsQuery, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("read columns: %w", err)
+	}
+	for rows.Next() {
+		var schema, table string
+		var col pgColumn
+		if err := rows.Scan(&schema, &table, &col.Name, &col.Type, &col.NotNull, &col.Default, &col.Identity, &col.Generated); err != nil {
+			return nil, err
+		}
+		if t, ok := index[schema+"."+table]; ok {
+			t.Columns = append(t.Columns, col)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read columns: %w", err)
+	}
+
+	rows, err = conn.Query(ctx, pgConstraintsQuery, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("read constraints: %w", err)
+	}
+	for rows.Next() {
+		var schema, table string
+		var con pgConstraint
+		if err := rows.Scan(&schema, &table, &con.Name, &con.Type, &con.Definition); err != nil {
+			return nil, err
+		}
+		if t, ok := index[schema+"."+table]; ok {
+			t.Constraints = append(t.Constraints, con)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read constraints: %w", err)
+	}
+	return tables, nil
+}
+
+func pgViews(ctx context.Context, conn *pgx.Conn, schemas []string) ([]Object, error) {
+	deps := map[string][]string{}
+	rows, err := conn.Query(ctx, pgViewDependenciesQuery, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("read view dependencies: %w", err)
+	}
+	for rows.Next() {
+		var schema, name, refSchema, refName string
+		if err := rows.Scan(&schema, &name, &refSchema, &refName); err != nil {
+			return nil, err
+		}
+		deps[schema+"."+name] = append(deps[schema+"."+name], refSchema+"."+refName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read view dependencies: %w", err)
+	}
+
+	var views []Object
+	rows, err = conn.Query(ctx, pgViewsQuery, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("read views: %w", err)
+	}
+	for rows.Next() {
+		var schema, name, definition string
+		var materialized bool
+		if err := rows.Scan(&schema, &name, &materialized, &definition); err != nil {
+			return nil, err
+		}
+		views = append(views, Object{
+			Kind:      KindView,
+			Schema:    schema,
+			Name:      name,
+			SQL:       pgCreateView(schema, name, materialized, definition),
+			DependsOn: deps[schema+"."+name],
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read views: %w", err)
+	}
+	return views, nil
+}
+
+func pgCreateEnum(schema, name string, values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quoteString(v)
+	}
+	return fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", pgQualify(schema, name), strings.Join(quoted, ", "))
+}
+
+func pgCreateView(schema, name string, materialized bool, definition string) string {
+	kind := "VIEW"
+	if materialized {
+		kind = "MATERIALIZED VIEW"
+	}
+	definition = strings.TrimSuffix(strings.TrimSpace(definition), ";")
+	return fmt.Sprintf("CREATE %s %s AS\n%s", kind, pgQualify(schema, name), definition)
+}
+
+// createStmt returns the CREATE TABLE statement for the table. Foreign keys
+// are left out.
+func (t *pgTable) createStmt() string {
+	var lines []string
+	for _, col := range t.Columns {
+		lines = append(lines, "    "+col.definition())
+	}
+	for _, con := range t.Constraints {
+		if con.Type == "f" {
+			continue
+		}
+		lines = append(lines, "    "+con.clause())
+	}
+	if len(lines) == 0 {
+		return fmt.Sprintf("CREATE TABLE %s ()", pgQualify(t.Schema, t.Name))
+	}
+	return fmt.Sprintf("CREATE TABLE %s (\n%s\n)", pgQualify(t.Schema, t.Name), strings.Join(lines, ",\n"))
+}
+
+func (col pgColumn) definition() string {
+	def := pgQuoteIdent(col.Name) + " " + col.Type
+	switch {
+	case col.Generated == "s":
+		def += " GENERATED ALWAYS AS (" + col.Default + ") STORED"
+	case col.Identity == "a":
+		def += " GENERATED ALWAYS AS IDENTITY"
+	case col.Identity == "d":
+		def += " GENERATED BY DEFAULT AS IDENTITY"
+	case col.Default != "":
+		def += " DEFAULT " + col.Default
+	}
+	if col.NotNull {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+func (con pgConstraint) clause() string {
+	return "CONSTRAINT " + pgQuoteIdent(con.Name) + " " + con.Definition
+}
+
+// pgQualify returns the name of an object, qualified with its schema unless
+// that is the default schema.
+func pgQualify(schema, name string) string {
+	if schema == pgDefaultSchema {
+		return pgQuoteIdent(name)
+	}
+	return pgQuoteIdent(schema) + "." + pgQuoteIdent(name)
+}
+
+var pgPlainIdent = regexp.MustCompile(`^[a-z_][a-z0-9_$]*$`)
+
+// pgQuoteIdent quotes an identifier if it would otherwise be folded to lower
+// case or parsed as a keyword.
+func pgQuoteIdent(s string) string {
+	if pgPlainIdent.MatchString(s) && !postgresql.NewParser().IsReservedKeyword(s) {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
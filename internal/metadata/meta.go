@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/sqlc-dev/sqlc/internal/constants"
+	"slices"
 	"strings"
 	"unicode"
 
 	"github.com/sqlc-dev/sqlc/internal/source"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 )
 
 type CommentSyntax source.CommentSyntax
@@ -19,13 +21,61 @@ type Metadata struct {
 	Params   map[string]string
 	Flags    map[string]bool
 
+	// Overrides contains the type overrides declared with sqlc.override
+	// annotations. They apply to this query only.
+	Overrides []Override
+
+	// ParamStyle is set by a param_style annotation to ParamStyleStruct or
+	// ParamStylePositional. It takes precedence over query_parameter_limit.
+	ParamStyle string
+
+	// NotFound is the name of the error declared by a not_found annotation,
+	// which a :one or :batchone query returns along with the error of its
+	// driver when it finds no row.
+	NotFound string
+
+	// Deprecated is the message of a @deprecated annotation, which marks the
+	// query as one that shouldn't be used anymore.
+	Deprecated string
+
+	// Columns holds the column definitions of a @columns annotation, which
+	// declares the result columns of a CALL statement, as in
+	// "id BIGINT NOT NULL, total DECIMAL(10, 2)".
+	Columns string
+
 	// RuleSkiplist contains the names of rules to disable vetting for.
 	// If the map is empty, but the disable vet flag is specified, then all rules are ignored.
 	RuleSkiplist map[string]struct{}
 
 	Filename string
+
+	// Line and Column are the 1-based position of the query's name
+	// annotation in Filename.
+	Line   int
+	Column int
 }
 
+// Override is a type override declared on a single query, e.g.
+//
+//	-- sqlc.override: column=elapsed_ms go_type=time.Duration
+type Override struct {
+	Column string
+	GoType string
+}
+
+const overridePrefix = "sqlc.override:"
+
+const paramStylePrefix = "param_style:"
+
+const notFoundPrefix = "not_found:"
+
+const (
+	// ParamStyleStruct passes the parameters of a query in a Params struct
+	ParamStyleStruct = "struct"
+	// ParamStylePositional passes the parameters of a query as arguments
+	ParamStylePositional = "positional"
+)
+
 const (
 	CmdExec       = ":exec"
 	CmdExecResult = ":execresult"
@@ -39,6 +89,16 @@ const (
 	CmdBatchOne   = ":batchone"
 )
 
+// IsBuiltinCmd reports whether cmd is one of the commands built into sqlc,
+// rather than one contributed by a plugin.
+func IsBuiltinCmd(cmd string) bool {
+	switch cmd {
+	case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne:
+		return true
+	}
+	return false
+}
+
 // A query name must be a valid Go identifier
 //
 // https://golang.org/ref/spec#Identifiers
@@ -58,7 +118,10 @@ func validateQueryName(name string) error {
 	return nil
 }
 
-func ParseQueryNameAndType(t string, commentStyle CommentSyntax) (string, string, error) {
+// ParseQueryNameAndType returns the name and command of a query from its name
+// annotation. Commands are the commands accepted besides the built-in ones,
+// which plugins contribute.
+func ParseQueryNameAndType(t string, commentStyle CommentSyntax, commands ...string) (string, string, error) {
 	for _, line := range strings.Split(t, "\n") {
 		var prefix string
 		if strings.HasPrefix(line, "--") {
@@ -105,9 +168,7 @@ func ParseQueryNameAndType(t string, commentStyle CommentSyntax) (string, string
 		}
 		queryName := part[2]
 		queryType := strings.TrimSpace(part[3])
-		switch queryType {
-		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne:
-		default:
+		if !IsBuiltinCmd(queryType) && !slices.Contains(commands, queryType) {
 			return "", "", fmt.Errorf("invalid query type: %s", queryType)
 		}
 		if err := validateQueryName(queryName); err != nil {
@@ -170,3 +231,201 @@ func ParseCommentFlags(comments []string) (map[string]string, map[string]bool, m
 
 	return params, flags, ruleSkiplist, nil
 }
+
+// IsOverrideComment reports whether a comment line, with its comment syntax
+// removed, is a sqlc.override annotation.
+func IsOverrideComment(comment string) bool {
+	return strings.HasPrefix(strings.TrimSpace(comment), overridePrefix)
+}
+
+// commentText returns the text of a comment line without its comment syntax.
+func commentText(line string, commentStyle CommentSyntax) (string, bool) {
+	switch {
+	case strings.HasPrefix(line, "--") && commentStyle.Dash:
+		return line[2:], true
+	case strings.HasPrefix(line, "/*") && commentStyle.SlashStar:
+		return strings.TrimSuffix(strings.TrimSpace(line[2:]), "*/"), true
+	case strings.HasPrefix(line, "#") && commentStyle.Hash:
+		return line[1:], true
+	}
+	return "", false
+}
+
+// ParseQueryOverrides returns the sqlc.override annotations found in the
+// comments of a query. Errors are returned as an *sqlerr.Error whose Line is
+// the line of the offending annotation within t.
+func ParseQueryOverrides(t string, commentStyle CommentSyntax) ([]Override, error) {
+	var overrides []Override
+	seen := map[string]struct{}{}
+	for i, line := range strings.Split(t, "\n") {
+		rest, ok := commentText(line, commentStyle)
+		if !ok || !IsOverrideComment(rest) {
+			continue
+		}
+		rest = strings.TrimSpace(rest)
+
+		var o Override
+		for _, pair := range strings.Fields(rest[len(overridePrefix):]) {
+			key, val, ok := strings.Cut(pair, "=")
+			if !ok || val == "" {
+				return nil, &sqlerr.Error{
+					Message: fmt.Sprintf("invalid sqlc.override: expected key=value, got %q", pair),
+					Line:    i + 1,
+					Column:  1,
+				}
+			}
+			switch key {
+			case "column":
+				o.Column = val
+			case "go_type":
+				o.GoType = val
+			default:
+				return nil, &sqlerr.Error{
+					Message: fmt.Sprintf("invalid sqlc.override: unknown key %q", key),
+					Line:    i + 1,
+					Column:  1,
+				}
+			}
+		}
+		if o.Column == "" || o.GoType == "" {
+			return nil, &sqlerr.Error{
+				Message: "invalid sqlc.override: both column and go_type are required",
+				Line:    i + 1,
+				Column:  1,
+			}
+		}
+		if _, ok := seen[o.Column]; ok {
+			return nil, &sqlerr.Error{
+				Message: fmt.Sprintf("invalid sqlc.override: column %q is overridden more than once", o.Column),
+				Line:    i + 1,
+				Column:  1,
+			}
+		}
+		seen[o.Column] = struct{}{}
+		overrides = append(overrides, o)
+	}
+	return overrides, nil
+}
+
+// IsParamStyleComment reports whether a comment line, with its comment syntax
+// removed, is a param_style annotation.
+func IsParamStyleComment(comment string) bool {
+	return isAnnotation(comment, paramStylePrefix)
+}
+
+// ParseParamStyle returns the value of the param_style annotation found in the
+// comments of a query, or an empty string if there is none.
+func ParseParamStyle(t string, commentStyle CommentSyntax) (string, error) {
+	return parseAnnotation(t, commentStyle, paramStylePrefix, func(val string) string {
+		if val != ParamStyleStruct && val != ParamStylePositional {
+			return fmt.Sprintf("expected %q or %q, got %q", ParamStyleStruct, ParamStylePositional, val)
+		}
+		return ""
+	})
+}
+
+// IsNotFoundComment reports whether a comment line, with its comment syntax
+// removed, is a not_found annotation.
+func IsNotFoundComment(comment string) bool {
+	return isAnnotation(comment, notFoundPrefix)
+}
+
+// ParseNotFound returns the name of the error declared by the not_found
+// annotation found in the comments of a query, or an empty string if there is
+// none.
+func ParseNotFound(t string, commentStyle CommentSyntax) (string, error) {
+	return parseAnnotation(t, commentStyle, notFoundPrefix, func(val string) string {
+		if !isIdentifier(val) {
+			return fmt.Sprintf("expected an identifier, got %q", val)
+		}
+		return ""
+	})
+}
+
+// IsColumnsComment reports whether a comment line, with its comment syntax
+// removed, is a @columns annotation.
+func IsColumnsComment(comment string) bool {
+	return isAnnotation(comment, constants.QueryFlagColumns)
+}
+
+// ParseColumns returns the column definitions of the @columns annotation
+// found in the comments of a query, or an empty string if there is none.
+func ParseColumns(t string, commentStyle CommentSyntax) (string, error) {
+	return parseAnnotation(t, commentStyle, constants.QueryFlagColumns, func(val string) string {
+		if val == "" {
+			return "expected column definitions"
+		}
+		return ""
+	})
+}
+
+// IsDeprecatedComment reports whether a comment line, with its comment syntax
+// removed, is a @deprecated annotation.
+func IsDeprecatedComment(comment string) bool {
+	return isAnnotation(comment, constants.QueryFlagDeprecated)
+}
+
+// ParseDeprecated returns the message of the @deprecated annotation found in
+// the comments of a query, or an empty string if there is none.
+func ParseDeprecated(t string, commentStyle CommentSyntax) (string, error) {
+	return parseAnnotation(t, commentStyle, constants.QueryFlagDeprecated, func(val string) string {
+		if val == "" {
+			return "expected a message, such as \"use GetAuthorByID instead\""
+		}
+		return ""
+	})
+}
+
+// isAnnotation reports whether a comment line, with its comment syntax
+// removed, starts with the prefix of an annotation. Prefixes that don't end
+// with a colon, such as @deprecated, must be followed by a space.
+func isAnnotation(comment, prefix string) bool {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(comment), prefix)
+	if !ok {
+		return false
+	}
+	return strings.HasSuffix(prefix, ":") || rest == "" || unicode.IsSpace(rune(rest[0]))
+}
+
+// parseAnnotation returns the value of the annotation with the given prefix
+// found in the comments of a query, or an empty string if there is none. The
+// annotation may appear at most once, and check returns a description of
+// what's wrong with its value, if anything. Errors are returned as an
+// *sqlerr.Error whose Line is the line of the offending annotation within t.
+func parseAnnotation(t string, commentStyle CommentSyntax, prefix string, check func(val string) string) (string, error) {
+	name := strings.Trim(prefix, "@:")
+	var value string
+	var found bool
+	for i, line := range strings.Split(t, "\n") {
+		rest, ok := commentText(line, commentStyle)
+		if !ok || !isAnnotation(rest, prefix) {
+			continue
+		}
+		val := strings.TrimSpace(strings.TrimSpace(rest)[len(prefix):])
+		problem := check(val)
+		if found {
+			problem = "the annotation is repeated"
+		}
+		if problem != "" {
+			return "", &sqlerr.Error{
+				Message: fmt.Sprintf("invalid %s: %s", name, problem),
+				Line:    i + 1,
+				Column:  1,
+			}
+		}
+		value, found = val, true
+	}
+	return value, nil
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
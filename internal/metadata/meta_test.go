@@ -1,7 +1,11 @@
 package metadata
 
 import (
+	"errors"
+	"strings"
 	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 )
 
 func TestParseQueryNameAndType(t *testing.T) {
@@ -51,6 +55,16 @@ func TestParseQueryNameAndType(t *testing.T) {
 		}
 	}
 
+	if _, _, err := ParseQueryNameAndType(`-- name: StreamFoo :stream`, CommentSyntax{Dash: true}, ":tail"); err == nil {
+		t.Errorf("expected :stream to be rejected")
+	}
+	_, queryCmd, err := ParseQueryNameAndType(`-- name: StreamFoo :stream`, CommentSyntax{Dash: true}, ":stream")
+	if err != nil {
+		t.Errorf("expected :stream to be accepted: %s", err)
+	}
+	if queryCmd != ":stream" {
+		t.Errorf("incorrect queryCmd parsed: %q", queryCmd)
+	}
 }
 
 func TestParseQueryParams(t *testing.T) {
@@ -180,3 +194,138 @@ func TestParseQueryRuleSkiplist(t *testing.T) {
 		}
 	}
 }
+
+func TestParseQueryOverrides(t *testing.T) {
+	for query, cs := range map[string]CommentSyntax{
+		"-- name: Report :many\n-- sqlc.override: column=elapsed_ms go_type=time.Duration\n-- sqlc.override: go_type=int64 column=total":          {Dash: true},
+		"# name: Report :many\n# sqlc.override: column=elapsed_ms go_type=time.Duration\n# sqlc.override: column=total go_type=int64":             {Hash: true},
+		"/* name: Report :many */\n/* sqlc.override: column=elapsed_ms go_type=time.Duration */\n/* sqlc.override: column=total go_type=int64 */": {SlashStar: true},
+	} {
+		overrides, err := ParseQueryOverrides(query, cs)
+		if err != nil {
+			t.Errorf("expected valid overrides: %q: %s", query, err)
+			continue
+		}
+		expected := []Override{
+			{Column: "elapsed_ms", GoType: "time.Duration"},
+			{Column: "total", GoType: "int64"},
+		}
+		if len(overrides) != len(expected) {
+			t.Errorf("expected %d overrides, got %d: %q", len(expected), len(overrides), query)
+			continue
+		}
+		for i := range expected {
+			if overrides[i] != expected[i] {
+				t.Errorf("unexpected override %v: %q", overrides[i], query)
+			}
+		}
+	}
+
+	for _, query := range []string{
+		"-- name: Report :many\n-- sqlc.override: column=elapsed_ms",
+		"-- name: Report :many\n-- sqlc.override: go_type=time.Duration",
+		"-- name: Report :many\n-- sqlc.override: column=elapsed_ms go_type=",
+		"-- name: Report :many\n-- sqlc.override: column elapsed_ms",
+		"-- name: Report :many\n-- sqlc.override: column=elapsed_ms type=int64",
+		"-- name: Report :many\n-- sqlc.override: column=a go_type=int64\n-- sqlc.override: column=a go_type=int32",
+	} {
+		_, err := ParseQueryOverrides(query, CommentSyntax{Dash: true})
+		var e *sqlerr.Error
+		if !errors.As(err, &e) {
+			t.Errorf("expected invalid override: %q", query)
+			continue
+		}
+		if e.Line != strings.Count(query, "\n")+1 {
+			t.Errorf("expected error on last line, got line %d: %q", e.Line, query)
+		}
+	}
+}
+
+func TestParseAnnotations(t *testing.T) {
+	type parser func(string, CommentSyntax) (string, error)
+	for _, tc := range []struct {
+		name    string
+		parse   parser
+		valid   map[string]string
+		invalid []string
+	}{
+		{
+			name:  "param_style",
+			parse: ParseParamStyle,
+			valid: map[string]string{
+				"-- name: CreateAuthor :one\n-- param_style: struct":       ParamStyleStruct,
+				"-- name: CreateAuthor :one\n--   param_style: positional": ParamStylePositional,
+				"-- name: CreateAuthor :one\n-- Creates an author":         "",
+			},
+			invalid: []string{
+				"-- name: CreateAuthor :one\n-- param_style: named",
+				"-- name: CreateAuthor :one\n-- param_style:",
+				"-- name: CreateAuthor :one\n-- param_style: struct\n-- param_style: positional",
+			},
+		},
+		{
+			name:  "not_found",
+			parse: ParseNotFound,
+			valid: map[string]string{
+				"-- name: GetAuthor :one\n-- not_found: ErrAuthorNotFound": "ErrAuthorNotFound",
+				"-- name: GetAuthor :one\n--   not_found: errNoAuthor":     "errNoAuthor",
+				"-- name: GetAuthor :one\n-- Gets an author":               "",
+			},
+			invalid: []string{
+				"-- name: GetAuthor :one\n-- not_found:",
+				"-- name: GetAuthor :one\n-- not_found: 1Author",
+				"-- name: GetAuthor :one\n-- not_found: Err Author",
+				"-- name: GetAuthor :one\n-- not_found: ErrA\n-- not_found: ErrB",
+			},
+		},
+		{
+			name:  "@columns",
+			parse: ParseColumns,
+			valid: map[string]string{
+				"-- name: GetUserOrders :many\n-- @columns id BIGINT NOT NULL, total DECIMAL(10, 2)": "id BIGINT NOT NULL, total DECIMAL(10, 2)",
+				"-- name: GetUserOrders :many\n#   @columns id INT":                                  "id INT",
+				"-- name: GetUserOrders :many\n-- columns: id INT":                                   "",
+				"-- name: GetUserOrders :many\n-- @columnsx id INT":                                  "",
+			},
+			invalid: []string{
+				"-- name: GetUserOrders :many\n-- @columns",
+				"-- name: GetUserOrders :many\n-- @columns id INT\n-- @columns total INT",
+			},
+		},
+		{
+			name:  "@deprecated",
+			parse: ParseDeprecated,
+			valid: map[string]string{
+				"-- name: GetAuthor :one\n-- @deprecated use GetAuthorByID instead":       "use GetAuthorByID instead",
+				"-- name: GetAuthor :one\n--   @deprecated  no longer used ":              "no longer used",
+				"-- name: GetAuthor :one\n-- deprecated: the cache makes this one faster": "",
+			},
+			invalid: []string{
+				"-- name: GetAuthor :one\n-- @deprecated",
+				"-- name: GetAuthor :one\n-- @deprecated a\n-- @deprecated b",
+			},
+		},
+	} {
+		for query, expected := range tc.valid {
+			val, err := tc.parse(query, CommentSyntax{Dash: true, Hash: true})
+			if err != nil {
+				t.Errorf("expected valid %s: %q: %s", tc.name, query, err)
+				continue
+			}
+			if val != expected {
+				t.Errorf("expected %s %q, got %q: %q", tc.name, expected, val, query)
+			}
+		}
+		for _, query := range tc.invalid {
+			_, err := tc.parse(query, CommentSyntax{Dash: true})
+			var e *sqlerr.Error
+			if !errors.As(err, &e) {
+				t.Errorf("expected invalid %s: %q", tc.name, query)
+				continue
+			}
+			if e.Line != strings.Count(query, "\n")+1 {
+				t.Errorf("expected error on last line, got line %d: %q", e.Line, query)
+			}
+		}
+	}
+}
@@ -5,34 +5,137 @@ import (
 	"strings"
 )
 
-// Remove all lines after a rollback comment.
+// convention is the migration tool a file was written for, detected from the
+// first annotation in the file.
+type convention int
+
+const (
+	conventionNone convention = iota
+	conventionGoose
+	conventionSQLMigrate
+	conventionTern
+	conventionDbmate
+)
+
+type marker int
+
+const (
+	markerNone marker = iota
+	markerUp
+	markerDown
+	markerStatement
+)
+
+// parseMarker returns the annotation on a line, and the tool it belongs to.
 //
-// goose:       -- +goose Down
-// sql-migrate: -- +migrate Down
+// goose:       -- +goose Up, -- +goose Down, -- +goose StatementBegin
+// sql-migrate: -- +migrate Up, -- +migrate Down, -- +migrate StatementBegin
 // tern:        ---- create above / drop below ----
-// dbmate:      -- migrate:down
+// dbmate:      -- migrate:up, -- migrate:down
+func parseMarker(line string) (convention, marker) {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "---- create above / drop below ----") {
+		return conventionTern, markerDown
+	}
+	if !strings.HasPrefix(line, "--") {
+		return conventionNone, markerNone
+	}
+	fields := strings.Fields(strings.TrimPrefix(line, "--"))
+	if len(fields) == 0 {
+		return conventionNone, markerNone
+	}
+	switch fields[0] {
+	case "migrate:up":
+		return conventionDbmate, markerUp
+	case "migrate:down":
+		return conventionDbmate, markerDown
+	case "+goose", "+migrate":
+		conv := conventionGoose
+		if fields[0] == "+migrate" {
+			conv = conventionSQLMigrate
+		}
+		if len(fields) < 2 {
+			return conv, markerNone
+		}
+		switch strings.ToLower(fields[1]) {
+		case "up":
+			return conv, markerUp
+		case "down":
+			return conv, markerDown
+		case "statementbegin", "statementend":
+			return conv, markerStatement
+		}
+		return conv, markerNone
+	}
+	return conventionNone, markerNone
+}
+
+// RemoveRollbackStatements removes the rollback sections of a migration,
+// leaving only the statements that are applied when migrating up.
+//
+// The migration tool is detected from the first annotation in the file, and
+// only annotations of that tool are honoured afterwards. Down sections run
+// until the next Up annotation, or the end of the file. The StatementBegin and
+// StatementEnd annotations of goose and sql-migrate are removed, keeping the
+// statements between them. Removed lines followed by statements are left
+// blank, so that the positions of those statements don't change.
 func RemoveRollbackStatements(contents string) string {
 	s := bufio.NewScanner(strings.NewReader(contents))
+	s.Buffer(nil, len(contents)+1)
 	var lines []string
+	var blank int
+	conv := conventionNone
+	down := false
 	for s.Scan() {
-		if strings.HasPrefix(s.Text(), "-- +goose Down") {
-			break
+		line := s.Text()
+		c, m := parseMarker(line)
+		if c != conventionNone && conv == conventionNone {
+			conv = c
+		}
+		if c != conv {
+			m = markerNone
 		}
-		if strings.HasPrefix(s.Text(), "-- +migrate Down") {
-			break
+		switch {
+		case m == markerDown:
+			down = true
+		case m == markerUp:
+			down = false
 		}
-		if strings.HasPrefix(s.Text(), "---- create above / drop below ----") {
-			break
+		if down || m == markerStatement {
+			blank++
+			continue
 		}
-		if strings.HasPrefix(s.Text(), "-- migrate:down") {
-			break
+		for ; blank > 0; blank-- {
+			lines = append(lines, "")
 		}
-		lines = append(lines, s.Text())
+		lines = append(lines, line)
 	}
 	return strings.Join(lines, "\n")
 }
 
+// IsDown reports whether a file is a golang-migrate rollback, such as
+// 0001_init.down.sql.
 func IsDown(filename string) bool {
-	// Remove golang-migrate rollback files.
-	return strings.HasSuffix(filename, ".down.sql")
+	return strings.HasSuffix(strings.ToLower(filename), ".down.sql")
+}
+
+// SortKey returns a key ordering files by the version their name starts
+// with, so that 2_users.sql comes before 10_posts.sql. Files without a
+// version are ordered by name.
+func SortKey(filename string) string {
+	end := strings.IndexFunc(filename, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if end == 0 {
+		return filename
+	}
+	if end < 0 {
+		end = len(filename)
+	}
+	version := strings.TrimLeft(filename[:end], "0")
+	const width = 32
+	if len(version) > width {
+		return filename
+	}
+	return strings.Repeat("0", width-len(version)) + version + filename[end:]
 }
@@ -1,6 +1,7 @@
 package migrations
 
 import (
+	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -56,6 +57,59 @@ const outputDbmate = `
 -- migrate:up
 CREATE TABLE foo (bar int);`
 
+const inputGooseStatements = `-- +goose Up
+-- +goose StatementBegin
+CREATE FUNCTION one() RETURNS int AS $$ SELECT 1 $$ LANGUAGE sql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION one;
+-- +goose StatementEnd
+
+-- +goose Up
+CREATE TABLE two (id int);
+`
+
+const outputGooseStatements = `-- +goose Up
+
+CREATE FUNCTION one() RETURNS int AS $$ SELECT 1 $$ LANGUAGE sql;
+
+
+
+
+
+
+
+-- +goose Up
+CREATE TABLE two (id int);`
+
+const inputMigrateStatements = `-- +migrate Up
+-- +migrate StatementBegin
+CREATE TABLE people (id int);
+-- +migrate StatementEnd
+-- +migrate Down
+DROP TABLE people;`
+
+const outputMigrateStatements = `-- +migrate Up
+
+CREATE TABLE people (id int);`
+
+// Annotations of other tools are comments, once the tool has been detected
+const inputMixedMarkers = `
+-- migrate:up
+CREATE TABLE foo (bar int);
+-- +goose Down
+CREATE TABLE baz (bar int);
+-- migrate:down
+DROP TABLE foo;`
+
+const outputMixedMarkers = `
+-- migrate:up
+CREATE TABLE foo (bar int);
+-- +goose Down
+CREATE TABLE baz (bar int);`
+
 func TestRemoveRollback(t *testing.T) {
 	if diff := cmp.Diff(outputGoose, RemoveRollbackStatements(inputGoose)); diff != "" {
 		t.Errorf("goose migration mismatch:\n%s", diff)
@@ -69,6 +123,15 @@ func TestRemoveRollback(t *testing.T) {
 	if diff := cmp.Diff(outputDbmate, RemoveRollbackStatements(inputDbmate)); diff != "" {
 		t.Errorf("dbmate migration mismatch:\n%s", diff)
 	}
+	if diff := cmp.Diff(outputGooseStatements, RemoveRollbackStatements(inputGooseStatements)); diff != "" {
+		t.Errorf("goose statements mismatch:\n%s", diff)
+	}
+	if diff := cmp.Diff(outputMigrateStatements, RemoveRollbackStatements(inputMigrateStatements)); diff != "" {
+		t.Errorf("sql-migrate statements mismatch:\n%s", diff)
+	}
+	if diff := cmp.Diff(outputMixedMarkers, RemoveRollbackStatements(inputMixedMarkers)); diff != "" {
+		t.Errorf("mixed markers mismatch:\n%s", diff)
+	}
 }
 
 func TestRemoveGolangMigrateRollback(t *testing.T) {
@@ -79,6 +142,7 @@ func TestRemoveGolangMigrateRollback(t *testing.T) {
 		"migrations/2.sql":      false,
 		"migrations/foo.sql":    false,
 		"migrations/1.down.sql": true,
+		"migrations/1.DOWN.sql": true,
 	}
 
 	for filename, want := range filenames {
@@ -88,3 +152,14 @@ func TestRemoveGolangMigrateRollback(t *testing.T) {
 		}
 	}
 }
+
+func TestSortKey(t *testing.T) {
+	files := []string{"10_rename.sql", "00002_bio.sql", "1_init.up.sql", "schema.sql", "20240101120000_dbmate.sql"}
+	sort.SliceStable(files, func(i, j int) bool {
+		return SortKey(files[i]) < SortKey(files[j])
+	})
+	expected := []string{"1_init.up.sql", "00002_bio.sql", "10_rename.sql", "20240101120000_dbmate.sql", "schema.sql"}
+	if diff := cmp.Diff(expected, files); diff != "" {
+		t.Errorf("order mismatch:\n%s", diff)
+	}
+}
@@ -1,6 +1,7 @@
 package multierr
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/sqlc-dev/sqlc/internal/source"
@@ -11,7 +12,11 @@ type FileError struct {
 	Filename string
 	Line     int
 	Column   int
-	Err      error
+	// EndLine and EndColumn are just past the text the error is about, if
+	// it's known, or zero.
+	EndLine   int
+	EndColumn int
+	Err       error
 }
 
 func (e *FileError) Unwrap() error {
@@ -23,20 +28,33 @@ type Error struct {
 }
 
 func (e *Error) Add(filename, in string, loc int, err error) {
+	e.AddRange(filename, in, loc, 0, err)
+}
+
+// AddRange adds an error about the text of in from loc to end, such as a
+// statement. The range is ignored if err has a position of its own.
+func (e *Error) AddRange(filename, in string, loc, end int, err error) {
 	line := 1
 	column := 1
-	if lerr, ok := err.(*sqlerr.Error); ok {
+	var lerr *sqlerr.Error
+	if errors.As(err, &lerr) {
 		if lerr.Location != 0 {
 			loc = lerr.Location
+			end = 0
 		} else if lerr.Line != 0 && lerr.Column != 0 {
 			line = lerr.Line
 			column = lerr.Column
+			end = 0
 		}
 	}
 	if in != "" && loc != 0 {
 		line, column = source.LineNumber(in, loc)
 	}
-	e.errs = append(e.errs, &FileError{filename, line, column, err})
+	fileErr := &FileError{Filename: filename, Line: line, Column: column, Err: err}
+	if in != "" && end > loc {
+		fileErr.EndLine, fileErr.EndColumn = source.EndPosition(in, end)
+	}
+	e.errs = append(e.errs, fileErr)
 }
 
 func (e *Error) Errs() []*FileError {
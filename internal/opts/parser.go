@@ -1,5 +1,42 @@
 package opts
 
+import "path/filepath"
+
 type Parser struct {
 	Debug Debug
+	// Files, if set, restricts parsing to the query files at these paths.
+	Files []string
+	// Queries, if set, restricts parsing to the queries with these names.
+	Queries []string
+}
+
+// Filtered reports whether only some of the queries are parsed.
+func (p Parser) Filtered() bool {
+	return len(p.Files) > 0 || len(p.Queries) > 0
+}
+
+// MatchFile reports whether the queries in the file at path are parsed.
+func (p Parser) MatchFile(path string) bool {
+	if len(p.Files) == 0 {
+		return true
+	}
+	for _, f := range p.Files {
+		if filepath.Clean(f) == filepath.Clean(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchQuery reports whether the query called name is parsed.
+func (p Parser) MatchQuery(name string) bool {
+	if len(p.Queries) == 0 {
+		return true
+	}
+	for _, q := range p.Queries {
+		if q == name {
+			return true
+		}
+	}
+	return false
 }
@@ -16,7 +16,11 @@ const defaultHostname = "grpc.sqlc.dev"
 
 func NewClientFromConfig(cloudConfig config.Cloud) (pb.QuickClient, error) {
 	projectID := cloudConfig.Project
-	return NewClient(projectID, cloudConfig.AuthToken, WithHost(cloudConfig.Hostname))
+	token, err := cloudConfig.Token()
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(projectID, token, WithHost(cloudConfig.Hostname))
 }
 
 type options struct {
@@ -15,9 +15,13 @@ const defaultHostname = "remote.sqlc.dev"
 
 func NewClient(cloudConfig config.Cloud) (GenClient, error) {
 	authID := cloudConfig.Organization + "/" + cloudConfig.Project
+	token, err := cloudConfig.Token()
+	if err != nil {
+		return nil, err
+	}
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
-		grpc.WithPerRPCCredentials(basic.NewPerRPCCredentials(authID, cloudConfig.AuthToken)),
+		grpc.WithPerRPCCredentials(basic.NewPerRPCCredentials(authID, token)),
 		grpc.WithUnaryInterceptor(rpc.UnaryInterceptor),
 	}
 
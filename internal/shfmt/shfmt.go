@@ -1,25 +1,139 @@
 package shfmt
 
 import (
+	"fmt"
 	"os"
 	"regexp"
 	"strings"
 )
 
-var pat = regexp.MustCompile(`\$\{[A-Z_]+\}`)
+var pat = regexp.MustCompile(`\$\{[^{}]*\}`)
 
+var envName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Replacer replaces references in a string from the configuration with the
+// values they refer to:
+//
+//	${NAME}, ${env:NAME}                    the environment variable NAME
+//	${NAME:-default}, ${env:NAME:-default}  the same, or default if it's unset or empty
+//	${file:/run/secrets/dbpass}             the contents of the file, without a trailing newline
+//
+// A reference to an unset environment variable without a default, or to a
+// file that can't be read, is an error. Errors name the reference, never the
+// value it resolves to.
 type Replacer struct {
 	envmap map[string]string
 }
 
-func (r *Replacer) Replace(f string) string {
-	return pat.ReplaceAllStringFunc(f, func(s string) string {
-		s = strings.TrimPrefix(s, "${")
-		s = strings.TrimSuffix(s, "}")
-		return r.envmap[s]
+// Replace returns f with the references in it replaced.
+func (r *Replacer) Replace(f string) (string, error) {
+	return r.replace(f, nil)
+}
+
+// ReplaceURI returns the URI f with the references in it replaced. Values
+// that are part of the user information, path, query or fragment of the URI
+// are percent-escaped, so that they can contain characters such as @ and /.
+// The scheme, host and port are left as they are, as is f if it's a single
+// reference or not a URI, such as a MySQL DSN.
+func (r *Replacer) ReplaceURI(f string) (string, error) {
+	refs := pat.FindAllStringIndex(f, -1)
+	if len(refs) == 0 || (len(refs) == 1 && refs[0][0] == 0 && refs[0][1] == len(f)) {
+		return r.Replace(f)
+	}
+
+	// Find the structure of the URI with the references masked, as a
+	// reference to a file contains slashes
+	masked := []byte(f)
+	for _, ref := range refs {
+		for i := ref[0]; i < ref[1]; i++ {
+			masked[i] = 'x'
+		}
+	}
+	scheme := strings.Index(string(masked), "://")
+	if scheme < 0 {
+		return r.Replace(f)
+	}
+	authority := scheme + len("://")
+	end := strings.IndexAny(string(masked[authority:]), "/?#")
+	if end < 0 {
+		end = len(f)
+	} else {
+		end += authority
+	}
+	host := authority
+	if at := strings.LastIndex(string(masked[authority:end]), "@"); at >= 0 {
+		host += at
+	}
+
+	return r.replace(f, func(start int) bool {
+		return (start >= authority && start < host) || start >= end
 	})
 }
 
+func (r *Replacer) replace(f string, escaped func(start int) bool) (string, error) {
+	var b strings.Builder
+	last := 0
+	for _, ref := range pat.FindAllStringIndex(f, -1) {
+		v, err := r.resolve(f[ref[0]:ref[1]])
+		if err != nil {
+			return "", err
+		}
+		if escaped != nil && escaped(ref[0]) {
+			v = escape(v)
+		}
+		b.WriteString(f[last:ref[0]])
+		b.WriteString(v)
+		last = ref[1]
+	}
+	b.WriteString(f[last:])
+	return b.String(), nil
+}
+
+func (r *Replacer) resolve(ref string) (string, error) {
+	body := strings.TrimSuffix(strings.TrimPrefix(ref, "${"), "}")
+	if path, ok := strings.CutPrefix(body, "file:"); ok {
+		if path == "" {
+			return "", fmt.Errorf("%s: missing file name", ref)
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", ref, err)
+		}
+		return strings.TrimRight(string(contents), "\r\n"), nil
+	}
+
+	body = strings.TrimPrefix(body, "env:")
+	name, def, hasDefault := strings.Cut(body, ":-")
+	if !envName.MatchString(name) {
+		return "", fmt.Errorf("%s: invalid reference", ref)
+	}
+	v, ok := r.envmap[name]
+	if hasDefault && v == "" {
+		return def, nil
+	}
+	if !ok {
+		return "", fmt.Errorf("%s: environment variable %s is not set", ref, name)
+	}
+	return v, nil
+}
+
+// escape percent-escapes every byte of s other than the unreserved
+// characters of RFC 3986, which is safe in any part of a URI.
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
 func NewReplacer(env []string) *Replacer {
 	r := Replacer{
 		envmap: map[string]string{},
@@ -51,6 +51,24 @@ func LineNumber(source string, head int) (int, int) {
 	return line + 1, col
 }
 
+// EndPosition returns the line and column just past the text of source that
+// ends at end, ignoring trailing spaces, such as the end of a statement.
+func EndPosition(source string, end int) (int, int) {
+	if end > len(source) {
+		end = len(source)
+	}
+	end = len(strings.TrimRightFunc(source[:end], unicode.IsSpace))
+	line, col := 1, 1
+	for _, char := range source[:end] {
+		col++
+		if char == '\n' {
+			line++
+			col = 1
+		}
+	}
+	return line, col
+}
+
 func Pluck(source string, location, length int) (string, error) {
 	head := location
 	tail := location + length
@@ -16,6 +16,14 @@ func (n *A_Expr) Format(buf *TrackedBuffer) {
 	if n == nil {
 		return
 	}
+	if n.Kind == A_Expr_Kind_NULLIF {
+		buf.WriteString("NULLIF(")
+		buf.astFormat(n.Lexpr)
+		buf.WriteString(", ")
+		buf.astFormat(n.Rexpr)
+		buf.WriteString(")")
+		return
+	}
 	buf.astFormat(n.Lexpr)
 	buf.WriteString(" ")
 	switch n.Kind {
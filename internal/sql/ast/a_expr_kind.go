@@ -3,8 +3,12 @@ package ast
 type A_Expr_Kind uint
 
 const (
-	A_Expr_Kind_IN   A_Expr_Kind = 7
-	A_Expr_Kind_LIKE A_Expr_Kind = 8
+	A_Expr_Kind_OP_ANY       A_Expr_Kind = 2
+	A_Expr_Kind_DISTINCT     A_Expr_Kind = 4
+	A_Expr_Kind_NOT_DISTINCT A_Expr_Kind = 5
+	A_Expr_Kind_NULLIF       A_Expr_Kind = 6
+	A_Expr_Kind_IN           A_Expr_Kind = 7
+	A_Expr_Kind_LIKE         A_Expr_Kind = 8
 )
 
 func (n *A_Expr_Kind) Pos() int {
@@ -9,3 +9,16 @@ type A_Indices struct {
 func (n *A_Indices) Pos() int {
 	return 0
 }
+
+func (n *A_Indices) Format(buf *TrackedBuffer) {
+	if n == nil {
+		return
+	}
+	buf.WriteString("[")
+	buf.astFormat(n.Lidx)
+	if n.IsSlice {
+		buf.WriteString(":")
+		buf.astFormat(n.Uidx)
+	}
+	buf.WriteString("]")
+}
@@ -8,3 +8,26 @@ type A_Indirection struct {
 func (n *A_Indirection) Pos() int {
 	return 0
 }
+
+func (n *A_Indirection) Format(buf *TrackedBuffer) {
+	if n == nil {
+		return
+	}
+	buf.WriteString("(")
+	buf.astFormat(n.Arg)
+	buf.WriteString(")")
+	if n.Indirection == nil {
+		return
+	}
+	for _, item := range n.Indirection.Items {
+		switch item := item.(type) {
+		case *String:
+			buf.WriteString(".")
+			buf.WriteString(item.Str)
+		case *A_Star:
+			buf.WriteString(".*")
+		default:
+			buf.astFormat(item)
+		}
+	}
+}
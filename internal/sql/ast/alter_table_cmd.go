@@ -6,6 +6,11 @@ const (
 	AT_DropColumn
 	AT_DropNotNull
 	AT_SetNotNull
+	AT_AddConstraint
+	AT_DropConstraint
+	AT_ColumnDefault
+	AT_AttachPartition
+	AT_DetachPartition
 )
 
 type AlterTableType int
@@ -22,18 +27,30 @@ func (t AlterTableType) String() string {
 		return "DropNotNull"
 	case AT_SetNotNull:
 		return "SetNotNull"
+	case AT_AddConstraint:
+		return "AddConstraint"
+	case AT_DropConstraint:
+		return "DropConstraint"
+	case AT_ColumnDefault:
+		return "ColumnDefault"
+	case AT_AttachPartition:
+		return "AttachPartition"
+	case AT_DetachPartition:
+		return "DetachPartition"
 	default:
 		return "Unknown"
 	}
 }
 
 type AlterTableCmd struct {
-	Subtype   AlterTableType
-	Name      *string
-	Def       *ColumnDef
-	Newowner  *RoleSpec
-	Behavior  DropBehavior
-	MissingOk bool
+	Subtype    AlterTableType
+	Name       *string
+	Def        *ColumnDef
+	Constraint *Constraint
+	Partition  *TableName
+	Newowner   *RoleSpec
+	Behavior   DropBehavior
+	MissingOk  bool
 }
 
 func (n *AlterTableCmd) Pos() int {
@@ -10,6 +10,18 @@ type ColumnDef struct {
 	Vals       *List
 	Length     *int
 	PrimaryKey bool
+	// HasDefault is set if the column has a default value. DefaultExpr is the
+	// SQL text of the default expression; it is empty for implicit defaults,
+	// such as those of serial and auto-increment columns.
+	HasDefault  bool
+	DefaultExpr string
+	// IsGenerated is set for generated columns, whose value is computed from
+	// GeneratedExpr and can't be inserted or updated.
+	IsGenerated   bool
+	GeneratedExpr string
+	// IsHidden is set for the hidden columns of SQLite virtual tables, which
+	// queries can reference but which aren't selected by *.
+	IsHidden bool
 
 	// From pg.ColumnDef
 	Inhcount      int
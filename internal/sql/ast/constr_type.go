@@ -2,6 +2,37 @@ package ast
 
 type ConstrType uint
 
+// The values match the ConstrType enum used by the PostgreSQL parser, so
+// converted constraints can be compared against these constants directly.
+const (
+	_ ConstrType = iota
+	CONSTR_NULL
+	CONSTR_NOTNULL
+	CONSTR_DEFAULT
+	CONSTR_IDENTITY
+	CONSTR_GENERATED
+	CONSTR_CHECK
+	CONSTR_PRIMARY
+	CONSTR_UNIQUE
+	CONSTR_EXCLUSION
+	CONSTR_FOREIGN
+	CONSTR_ATTR_DEFERRABLE
+	CONSTR_ATTR_NOT_DEFERRABLE
+	CONSTR_ATTR_DEFERRED
+	CONSTR_ATTR_IMMEDIATE
+)
+
+// Foreign key actions stored in Constraint.FkUpdAction and
+// Constraint.FkDelAction, using the same codes as the PostgreSQL parser. A zero
+// value means no action was specified.
+const (
+	FKCONSTR_ACTION_NOACTION   byte = 'a'
+	FKCONSTR_ACTION_RESTRICT   byte = 'r'
+	FKCONSTR_ACTION_CASCADE    byte = 'c'
+	FKCONSTR_ACTION_SETNULL    byte = 'n'
+	FKCONSTR_ACTION_SETDEFAULT byte = 'd'
+)
+
 func (n *ConstrType) Pos() int {
 	return 0
 }
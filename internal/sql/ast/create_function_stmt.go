@@ -5,6 +5,8 @@ type CreateFunctionStmt struct {
 	Params     *List
 	ReturnType *TypeName
 	Func       *FuncName
+	// IsProcedure is set by CREATE PROCEDURE
+	IsProcedure bool
 	// TODO: Undertand these two fields
 	Options    *List
 	WithClause *List
@@ -6,6 +6,9 @@ type CreateTableAsStmt struct {
 	Relkind      ObjectType
 	IsSelectInto bool
 	IfNotExists  bool
+	IsMatview    bool
+	// Definition is the text of the statement, set for materialized views.
+	Definition string
 }
 
 func (n *CreateTableAsStmt) Pos() int {
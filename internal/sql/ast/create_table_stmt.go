@@ -7,6 +7,10 @@ type CreateTableStmt struct {
 	ReferTable  *TableName
 	Comment     string
 	Inherits    []*TableName
+	Constraints []*Constraint
+	// PartitionOf is set for a partition, which has the columns of the
+	// partitioned table
+	PartitionOf *TableName
 }
 
 func (n *CreateTableStmt) Pos() int {
@@ -0,0 +1,13 @@
+package ast
+
+// DropIndexStmt drops indexes by name. Table is only set by engines, such as
+// MySQL, where index names are scoped to a table.
+type DropIndexStmt struct {
+	Indexes   []*TableName
+	Table     *TableName
+	MissingOk bool
+}
+
+func (n *DropIndexStmt) Pos() int {
+	return 0
+}
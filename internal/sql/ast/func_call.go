@@ -30,4 +30,13 @@ func (n *FuncCall) Format(buf *TrackedBuffer) {
 		buf.astFormat(n.Args)
 	}
 	buf.WriteString(")")
+	if set(n.AggFilter) {
+		buf.WriteString(" FILTER (WHERE ")
+		buf.astFormat(n.AggFilter)
+		buf.WriteString(")")
+	}
+	if n.Over != nil {
+		buf.WriteString(" OVER ")
+		buf.astFormat(n.Over)
+	}
 }
@@ -8,6 +8,9 @@ type IndexElem struct {
 	Opclass       *List
 	Ordering      SortByDir
 	NullsOrdering SortByNulls
+
+	// ExprText is the SQL text of Expr, set for expression indexes.
+	ExprText string
 }
 
 func (n *IndexElem) Pos() int {
@@ -19,6 +19,9 @@ type IndexStmt struct {
 	Transformed    bool
 	Concurrent     bool
 	IfNotExists    bool
+
+	// Predicate is the SQL text of WhereClause, set for partial indexes.
+	Predicate string
 }
 
 func (n *IndexStmt) Pos() int {
@@ -2,6 +2,13 @@ package ast
 
 type LockClauseStrength uint
 
+const (
+	LockClauseStrength_FORKEYSHARE    LockClauseStrength = 2
+	LockClauseStrength_FORSHARE       LockClauseStrength = 3
+	LockClauseStrength_FORNOKEYUPDATE LockClauseStrength = 4
+	LockClauseStrength_FORUPDATE      LockClauseStrength = 5
+)
+
 func (n *LockClauseStrength) Pos() int {
 	return 0
 }
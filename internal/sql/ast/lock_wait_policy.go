@@ -2,6 +2,12 @@ package ast
 
 type LockWaitPolicy uint
 
+const (
+	LockWaitPolicy_Block LockWaitPolicy = 1
+	LockWaitPolicy_Skip  LockWaitPolicy = 2
+	LockWaitPolicy_Error LockWaitPolicy = 3
+)
+
 func (n *LockWaitPolicy) Pos() int {
 	return 0
 }
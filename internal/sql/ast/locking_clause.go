@@ -16,9 +16,23 @@ func (n *LockingClause) Format(buf *TrackedBuffer) {
 	}
 	buf.WriteString("FOR ")
 	switch n.Strength {
-	case 3:
+	case LockClauseStrength_FORKEYSHARE:
+		buf.WriteString("KEY SHARE")
+	case LockClauseStrength_FORSHARE:
 		buf.WriteString("SHARE")
-	case 5:
+	case LockClauseStrength_FORNOKEYUPDATE:
+		buf.WriteString("NO KEY UPDATE")
+	case LockClauseStrength_FORUPDATE:
 		buf.WriteString("UPDATE")
 	}
+	if items(n.LockedRels) {
+		buf.WriteString(" OF ")
+		buf.join(n.LockedRels, ", ")
+	}
+	switch n.WaitPolicy {
+	case LockWaitPolicy_Skip:
+		buf.WriteString(" SKIP LOCKED")
+	case LockWaitPolicy_Error:
+		buf.WriteString(" NOWAIT")
+	}
 }
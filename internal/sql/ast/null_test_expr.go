@@ -11,3 +11,15 @@ type NullTest struct {
 func (n *NullTest) Pos() int {
 	return n.Location
 }
+
+func (n *NullTest) Format(buf *TrackedBuffer) {
+	if n == nil {
+		return
+	}
+	buf.astFormat(n.Arg)
+	if n.Nulltesttype == NullTestTypeIsNotNull {
+		buf.WriteString(" IS NOT NULL")
+	} else {
+		buf.WriteString(" IS NULL")
+	}
+}
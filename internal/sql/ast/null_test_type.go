@@ -2,6 +2,11 @@ package ast
 
 type NullTestType uint
 
+const (
+	NullTestTypeIsNull    NullTestType = 1
+	NullTestTypeIsNotNull NullTestType = 2
+)
+
 func (n *NullTestType) Pos() int {
 	return 0
 }
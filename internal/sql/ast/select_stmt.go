@@ -106,7 +106,7 @@ func (n *SelectStmt) Format(buf *TrackedBuffer) {
 
 	if items(n.LockingClause) {
 		buf.WriteString(" ")
-		buf.astFormat(n.LockingClause)
+		buf.join(n.LockingClause, " ")
 	}
 
 }
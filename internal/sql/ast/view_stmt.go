@@ -7,6 +7,8 @@ type ViewStmt struct {
 	Replace         bool
 	Options         *List
 	WithCheckOption ViewCheckOption
+	// Definition is the text of the statement.
+	Definition string
 }
 
 func (n *ViewStmt) Pos() int {
@@ -14,3 +14,36 @@ type WindowDef struct {
 func (n *WindowDef) Pos() int {
 	return n.Location
 }
+
+func (n *WindowDef) Format(buf *TrackedBuffer) {
+	if n == nil {
+		return
+	}
+	// OVER w refers to a window of the WINDOW clause by name
+	if n.Name != nil && *n.Name != "" {
+		buf.WriteString(*n.Name)
+		return
+	}
+	buf.WriteString("(")
+	var space bool
+	if n.Refname != nil && *n.Refname != "" {
+		buf.WriteString(*n.Refname)
+		space = true
+	}
+	if items(n.PartitionClause) {
+		if space {
+			buf.WriteString(" ")
+		}
+		buf.WriteString("PARTITION BY ")
+		buf.astFormat(n.PartitionClause)
+		space = true
+	}
+	if items(n.OrderClause) {
+		if space {
+			buf.WriteString(" ")
+		}
+		buf.WriteString("ORDER BY ")
+		buf.astFormat(n.OrderClause)
+	}
+	buf.WriteString(")")
+}
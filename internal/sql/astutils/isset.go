@@ -0,0 +1,13 @@
+package astutils
+
+import "github.com/sqlc-dev/sqlc/internal/sql/ast"
+
+// IsSet reports whether an optional clause is present. Parsers represent
+// missing clauses as nil or as ast.TODO.
+func IsSet(n ast.Node) bool {
+	if n == nil {
+		return false
+	}
+	_, todo := n.(*ast.TODO)
+	return !todo
+}
@@ -176,6 +176,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.DropSchemaStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropTableStmt:
 		// pass
 
@@ -365,6 +368,7 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.AlterTableCmd:
 		a.apply(n, "Newowner", nil, n.Newowner)
 		a.apply(n, "Def", nil, n.Def)
+		a.apply(n, "Constraint", nil, n.Constraint)
 
 	case *ast.AlterTableMoveAllStmt:
 		a.apply(n, "Roles", nil, n.Roles)
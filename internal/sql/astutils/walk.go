@@ -98,6 +98,9 @@ func Walk(f Visitor, node ast.Node) {
 	case *ast.DropSchemaStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropTableStmt:
 		// pass
 
@@ -428,6 +431,9 @@ func Walk(f Visitor, node ast.Node) {
 		if n.Def != nil {
 			Walk(f, n.Def)
 		}
+		if n.Constraint != nil {
+			Walk(f, n.Constraint)
+		}
 
 	case *ast.AlterTableMoveAllStmt:
 		if n.Roles != nil {
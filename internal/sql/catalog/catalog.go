@@ -11,6 +11,9 @@ type Catalog struct {
 	Name          string
 	Schemas       []*Schema
 	SearchPath    []string
+	// SearchSchemas are the schemas objects without a schema are looked up
+	// in, after those of SearchPath. It's set by SetSearchPath.
+	SearchSchemas []string
 	LoadExtension func(string) *Schema
 
 	// TODO: un-export
@@ -49,6 +52,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	var err error
 	switch n := stmt.Raw.Stmt.(type) {
 
+	case *ast.AlterSeqStmt:
+		err = c.alterSequence(n)
+
 	case *ast.AlterTableStmt:
 		err = c.alterTable(n)
 
@@ -82,6 +88,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.CompositeTypeStmt:
 		err = c.createCompositeType(n)
 
+	case *ast.CreateDomainStmt:
+		err = c.createDomain(n)
+
 	case *ast.CreateEnumStmt:
 		err = c.createEnum(n)
 
@@ -94,6 +103,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.CreateSchemaStmt:
 		err = c.createSchema(n)
 
+	case *ast.CreateSeqStmt:
+		err = c.createSequence(n)
+
 	case *ast.CreateTableStmt:
 		err = c.createTable(n)
 
@@ -106,6 +118,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.DropFunctionStmt:
 		err = c.dropFunction(n)
 
+	case *ast.DropIndexStmt:
+		err = c.dropIndex(n)
+
 	case *ast.DropSchemaStmt:
 		err = c.dropSchema(n)
 
@@ -115,6 +130,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.DropTypeStmt:
 		err = c.dropType(n)
 
+	case *ast.IndexStmt:
+		err = c.createIndex(n)
+
 	case *ast.RenameColumnStmt:
 		err = c.renameColumn(n)
 
@@ -0,0 +1,131 @@
+package catalog
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// addCheck records the values allowed by a CHECK constraint of the form
+// col IN ('a', 'b') on its string column, which is how enums are often
+// emulated by engines without them. Other constraints are ignored, as are
+// constraints on a column which already has allowed values. An unnamed
+// constraint gets the name PostgreSQL gives it, <table>_<column>_check.
+func (table *Table) addCheck(name string, expr ast.Node) {
+	colName, vals, ok := checkValues(expr)
+	if !ok {
+		return
+	}
+	if name == "" {
+		name = table.Rel.Name + "_" + colName + "_check"
+	}
+	for _, col := range table.Columns {
+		if col.Name != colName {
+			continue
+		}
+		if col.CheckValues == nil && isStringType(col.Type) {
+			col.CheckValues = vals
+			col.checkConstraint = name
+		}
+		return
+	}
+}
+
+// isStringType reports whether values of the type are strings, using the
+// rules SQLite applies to find the text affinity of a type name.
+func isStringType(tn ast.TypeName) bool {
+	name := strings.ToLower(tn.Name)
+	return strings.Contains(name, "char") || strings.Contains(name, "text") ||
+		strings.Contains(name, "clob") || name == "name"
+}
+
+// dropCheck forgets the values allowed by the CHECK constraint name.
+func (table *Table) dropCheck(name string) {
+	if name == "" {
+		return
+	}
+	for _, col := range table.Columns {
+		if col.checkConstraint == name {
+			col.CheckValues = nil
+			col.checkConstraint = ""
+		}
+	}
+}
+
+// checkValues returns the column and the values allowed by a CHECK
+// expression of the form col IN ('a', 'b'), or its equivalent
+// col = ANY (ARRAY['a', 'b']) as written by pg_dump. ok is false for any other
+// expression, including NOT IN.
+func checkValues(expr ast.Node) (string, []string, bool) {
+	var lexpr ast.Node
+	var items []ast.Node
+	switch n := expr.(type) {
+	case *ast.In:
+		if n.Not || n.Sel != nil {
+			return "", nil, false
+		}
+		lexpr, items = n.Expr, n.List
+	case *ast.A_Expr:
+		if n.Name == nil || len(n.Name.Items) != 1 {
+			return "", nil, false
+		}
+		if op, ok := n.Name.Items[0].(*ast.String); !ok || op.Str != "=" {
+			return "", nil, false
+		}
+		switch n.Kind {
+		case ast.A_Expr_Kind_IN:
+			list, ok := n.Rexpr.(*ast.List)
+			if !ok {
+				return "", nil, false
+			}
+			items = list.Items
+		case ast.A_Expr_Kind_OP_ANY:
+			array, ok := stripCasts(n.Rexpr).(*ast.A_ArrayExpr)
+			if !ok || array.Elements == nil {
+				return "", nil, false
+			}
+			items = array.Elements.Items
+		default:
+			return "", nil, false
+		}
+		lexpr = n.Lexpr
+	default:
+		return "", nil, false
+	}
+
+	ref, ok := stripCasts(lexpr).(*ast.ColumnRef)
+	if !ok || ref.Fields == nil || len(ref.Fields.Items) == 0 {
+		return "", nil, false
+	}
+	colName, ok := ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.String)
+	if !ok {
+		return "", nil, false
+	}
+	if len(items) == 0 {
+		return "", nil, false
+	}
+	vals := make([]string, 0, len(items))
+	for _, item := range items {
+		c, ok := stripCasts(item).(*ast.A_Const)
+		if !ok {
+			return "", nil, false
+		}
+		s, ok := c.Val.(*ast.String)
+		if !ok {
+			return "", nil, false
+		}
+		vals = append(vals, s.Str)
+	}
+	return colName.Str, vals, true
+}
+
+// stripCasts returns the expression cast by any type casts wrapping n.
+func stripCasts(n ast.Node) ast.Node {
+	for {
+		cast, ok := n.(*ast.TypeCast)
+		if !ok {
+			return n
+		}
+		n = cast.Arg
+	}
+}
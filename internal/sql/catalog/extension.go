@@ -12,6 +12,7 @@ func (c *Catalog) createExtension(stmt *ast.CreateExtensionStmt) error {
 	if _, exists := c.Extensions[*stmt.Extname]; exists {
 		return nil
 	}
+	c.Extensions[*stmt.Extname] = struct{}{}
 	if c.LoadExtension == nil {
 		return nil
 	}
@@ -2,6 +2,7 @@ package catalog
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
@@ -17,6 +18,9 @@ type Function struct {
 	Comment            string
 	Desc               string
 	ReturnTypeNullable bool
+	// Procedure is set for stored procedures, which are called with a value
+	// for each of their arguments, including OUT arguments.
+	Procedure bool
 }
 
 type Argument struct {
@@ -39,6 +43,141 @@ func (f *Function) InArgs() []*Argument {
 	return args
 }
 
+// typeAliases maps the names PostgreSQL gives types internally, which is how
+// columns are typed, to the SQL names function parameters are declared with.
+var typeAliases = map[string]string{
+	"bigserial":   "bigint",
+	"bool":        "boolean",
+	"bpchar":      "character",
+	"char":        "character",
+	"decimal":     "numeric",
+	"float4":      "real",
+	"float8":      "double precision",
+	"int":         "integer",
+	"int2":        "smallint",
+	"int4":        "integer",
+	"int8":        "bigint",
+	"serial":      "integer",
+	"serial2":     "smallint",
+	"serial4":     "integer",
+	"serial8":     "bigint",
+	"smallserial": "smallint",
+	"time":        "time without time zone",
+	"timestamp":   "timestamp without time zone",
+	"timestamptz": "timestamp with time zone",
+	"timetz":      "time with time zone",
+	"varchar":     "character varying",
+}
+
+// canonicalTypeName returns the SQL name of a type, without the pg_catalog
+// schema, so that differently spelled names of the same type compare equal.
+func canonicalTypeName(name string) string {
+	name = strings.ToLower(strings.TrimPrefix(name, "pg_catalog."))
+	elem := strings.TrimRight(name, "[]")
+	if alias, ok := typeAliases[elem]; ok {
+		return alias + name[len(elem):]
+	}
+	return name
+}
+
+// implicitCasts maps types to the types PostgreSQL implicitly casts them to
+// when picking an overload, and preferredTypes are the types it prefers among
+// those of a category, such as timestamptz among date and time types.
+var implicitCasts = map[string][]string{
+	"smallint":                    {"integer", "bigint", "numeric", "real", "double precision"},
+	"integer":                     {"bigint", "numeric", "real", "double precision"},
+	"bigint":                      {"numeric", "real", "double precision"},
+	"numeric":                     {"real", "double precision"},
+	"real":                        {"double precision"},
+	"character":                   {"text", "character varying"},
+	"character varying":           {"text"},
+	"date":                        {"timestamp without time zone", "timestamp with time zone"},
+	"timestamp without time zone": {"timestamp with time zone"},
+	"time without time zone":      {"interval", "time with time zone"},
+}
+
+var preferredTypes = map[string]bool{
+	"double precision":         true,
+	"interval":                 true,
+	"text":                     true,
+	"timestamp with time zone": true,
+}
+
+// implicitCast reports whether PostgreSQL implicitly casts from to to.
+func implicitCast(from, to string) bool {
+	for _, typ := range implicitCasts[from] {
+		if typ == to {
+			return true
+		}
+	}
+	return false
+}
+
+// matchArgTypes scores how well the parameters of an overload match the types
+// of the arguments of a call: an argument of the type of its parameter scores
+// highest, then one passed to a polymorphic parameter or implicitly cast to a
+// preferred type, then one implicitly cast to another type. Arguments of
+// unknown type or of a type that can't be cast implicitly don't score at all,
+// unless only one of the argument and the parameter is an array.
+func matchArgTypes(params []*Argument, types []string) int {
+	var score int
+	for i, typ := range types {
+		if typ == "" || len(params) == 0 {
+			continue
+		}
+		param := params[len(params)-1]
+		if i < len(params) {
+			param = params[i]
+		}
+		if param.Type == nil {
+			continue
+		}
+		declared := canonicalTypeName(param.Type.Name)
+		typ = canonicalTypeName(typ)
+		switch {
+		case declared == typ:
+			score += 4
+		case declared == "any", declared == "anyelement", declared == "anycompatible":
+			score += 2
+		case declared == "anynonarray", declared == "anycompatiblenonarray":
+			if !strings.HasSuffix(typ, "[]") {
+				score += 2
+			}
+		case declared == "anyarray", declared == "anycompatiblearray":
+			if strings.HasSuffix(typ, "[]") {
+				score += 2
+			} else {
+				score -= 2
+			}
+		case declared == "anyenum":
+			// Argument types don't say which are enums, so any type that
+			// isn't an array may be one
+			if !strings.HasSuffix(typ, "[]") {
+				score++
+			}
+		case implicitCast(typ, declared):
+			if preferredTypes[declared] {
+				score += 2
+			} else {
+				score++
+			}
+		case strings.HasSuffix(declared, "[]") != strings.HasSuffix(typ, "[]"):
+			// PostgreSQL doesn't cast between arrays and other types
+			score -= 2
+		}
+	}
+	return score
+}
+
+// CallArgs returns the arguments a call to the function passes values for:
+// every argument of a procedure, and the input arguments of a function.
+func (f *Function) CallArgs() []*Argument {
+	if f.Procedure {
+		return f.Args
+	}
+	return f.InArgs()
+}
+
 func (f *Function) OutArgs() []*Argument {
 	var args []*Argument
 	for _, a := range f.Args {
@@ -63,6 +202,7 @@ func (c *Catalog) createFunction(stmt *ast.CreateFunctionStmt) error {
 		Name:       stmt.Func.Name,
 		Args:       make([]*Argument, len(stmt.Params.Items)),
 		ReturnType: stmt.ReturnType,
+		Procedure:  stmt.IsProcedure,
 	}
 	types := make([]*ast.TypeName, len(stmt.Params.Items))
 	for i, item := range stmt.Params.Items {
@@ -0,0 +1,110 @@
+package catalog
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// Index describes an index created by a CREATE INDEX statement.
+type Index struct {
+	Name    string
+	Unique  bool
+	Columns []IndexColumn
+	// Where is the predicate of a partial index
+	Where string
+	// Method is the access method, such as btree or gin. It is empty if the
+	// statement doesn't name one and the engine has a single kind of index.
+	Method string
+}
+
+// IndexColumn is an element of an index: either a column, or the text of an
+// expression.
+type IndexColumn struct {
+	Name string
+	Expr string
+}
+
+func (c *Catalog) createIndex(stmt *ast.IndexStmt) error {
+	if stmt.Relation == nil {
+		return nil
+	}
+	_, tbl, err := c.getTable(rangeVarToTableName(stmt.Relation))
+	if err != nil {
+		return err
+	}
+	idx := &Index{
+		Unique: stmt.Unique,
+		Where:  stmt.Predicate,
+	}
+	if stmt.Idxname != nil {
+		idx.Name = *stmt.Idxname
+	}
+	if stmt.AccessMethod != nil {
+		idx.Method = *stmt.AccessMethod
+	}
+	if stmt.IndexParams != nil {
+		for _, item := range stmt.IndexParams.Items {
+			elem, ok := item.(*ast.IndexElem)
+			if !ok {
+				continue
+			}
+			if elem.Name == nil {
+				idx.Columns = append(idx.Columns, IndexColumn{Expr: elem.ExprText})
+				continue
+			}
+			if !tbl.hasColumn(*elem.Name) {
+				return sqlerr.ColumnNotFound(tbl.Rel.Name, *elem.Name)
+			}
+			idx.Columns = append(idx.Columns, IndexColumn{Name: *elem.Name})
+		}
+	}
+	for _, existing := range tbl.Indexes {
+		if idx.Name != "" && existing.Name == idx.Name {
+			if stmt.IfNotExists {
+				return nil
+			}
+			return sqlerr.RelationExists(idx.Name)
+		}
+	}
+	tbl.Indexes = append(tbl.Indexes, idx)
+	return nil
+}
+
+func (c *Catalog) dropIndex(stmt *ast.DropIndexStmt) error {
+	for _, name := range stmt.Indexes {
+		schemaName := name.Schema
+		if stmt.Table != nil {
+			schemaName = stmt.Table.Schema
+		}
+		if schemaName == "" {
+			schemaName = c.DefaultSchema
+		}
+		schema, err := c.getSchema(schemaName)
+		if err != nil {
+			return checkMissing(err, stmt.MissingOk)
+		}
+		// Indexes that back constraints or are declared inside CREATE TABLE
+		// aren't tracked, so an unknown name isn't an error
+		for _, tbl := range schema.Tables {
+			if stmt.Table != nil && tbl.Rel.Name != stmt.Table.Name {
+				continue
+			}
+			for i, idx := range tbl.Indexes {
+				if idx.Name == name.Name {
+					tbl.Indexes = append(tbl.Indexes[:i], tbl.Indexes[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (table *Table) hasColumn(name string) bool {
+	for _, col := range table.Columns {
+		if col.Name == name {
+			return true
+		}
+	}
+	return false
+}
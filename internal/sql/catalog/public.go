@@ -10,7 +10,7 @@ import (
 
 func (c *Catalog) schemasToSearch(ns string) []string {
 	if ns == "" {
-		ns = c.DefaultSchema
+		return append(c.SearchPath, c.pathSchemas()...)
 	}
 	return append(c.SearchPath, ns)
 }
@@ -33,6 +33,15 @@ func (c *Catalog) ListFuncsByName(rel *ast.FuncName) ([]Function, error) {
 }
 
 func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
+	return c.ResolveFuncCallTypes(call, nil)
+}
+
+// ResolveFuncCallTypes resolves call like ResolveFuncCall. When several
+// overloads of the function accept its arguments, it picks the one whose
+// parameters best match types, the types of its positional arguments, rather
+// than the first one. An empty type is unknown, as is the type of a string
+// literal until PostgreSQL resolves it.
+func (c *Catalog) ResolveFuncCallTypes(call *ast.FuncCall, types []string) (*Function, error) {
 	// Do not validate unknown functions
 	funs, err := c.ListFuncsByName(call.Func)
 	if err != nil || len(funs) == 0 {
@@ -63,8 +72,10 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 		}
 	}
 
+	var best *Function
+	var bestScore int
 	for _, fun := range funs {
-		args := fun.InArgs()
+		args := fun.CallArgs()
 		var defaults int
 		var variadic bool
 		known := map[string]struct{}{}
@@ -107,7 +118,13 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 			continue
 		}
 
-		return &fun, nil
+		score := matchArgTypes(args, types)
+		if best == nil || score > bestScore {
+			best, bestScore = &fun, score
+		}
+	}
+	if best != nil {
+		return best, nil
 	}
 
 	var sig []string
@@ -123,6 +140,52 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 	}
 }
 
+// ResolveDomain returns the type a domain named by rel is based on, following
+// domains over other domains. It reports false if rel doesn't name a domain.
+func (c *Catalog) ResolveDomain(rel *ast.TypeName) (*Domain, bool) {
+	var out *Domain
+	for depth := 0; depth < 32; depth++ {
+		typ, _, err := c.getType(rel)
+		if err != nil {
+			break
+		}
+		d, ok := typ.(*Domain)
+		if !ok {
+			break
+		}
+		if out == nil {
+			out = &Domain{Name: d.Name, Comment: d.Comment}
+		}
+		out.BaseType = d.BaseType
+		out.ArrayDims += d.ArrayDims
+		out.IsArray = out.ArrayDims > 0
+		rel = &d.BaseType
+	}
+	return out, out != nil
+}
+
+// GetCompositeField returns the field name of the composite type named by rel,
+// as selected by (value).name.
+func (c *Catalog) GetCompositeField(rel *ast.TypeName, name string) (*Column, error) {
+	typ, _, _ := c.getType(rel)
+	ct, ok := typ.(*CompositeType)
+	if !ok {
+		return nil, &sqlerr.Error{
+			Code:    "42809",
+			Message: fmt.Sprintf("column notation .%s applied to type %s, which is not a composite type", name, rel.Name),
+		}
+	}
+	for _, col := range ct.Columns {
+		if col.Name == name {
+			return col, nil
+		}
+	}
+	return nil, &sqlerr.Error{
+		Code:    "42703",
+		Message: fmt.Sprintf("column %q not found in data type %s", name, ct.Name),
+	}
+}
+
 func (c *Catalog) GetTable(rel *ast.TableName) (Table, error) {
 	_, table, err := c.getTable(rel)
 	if table == nil {
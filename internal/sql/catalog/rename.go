@@ -0,0 +1,120 @@
+package catalog
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// Rename records that a table, or one of its columns, was renamed, and the
+// schema file of the statement that renamed it.
+type Rename struct {
+	// Column is set if a column was renamed rather than the table
+	Column   bool
+	OldName  string
+	NewName  string
+	Filename string
+}
+
+// RecordRename records the rename made by stmt, if it renamed a table or a
+// column, in the history of the table. It is called after Update has applied
+// stmt.
+func (c *Catalog) RecordRename(stmt ast.Statement, filename string) {
+	if stmt.Raw == nil {
+		return
+	}
+	switch n := stmt.Raw.Stmt.(type) {
+
+	case *ast.RenameColumnStmt:
+		if n.Col == nil || n.NewName == nil {
+			return
+		}
+		_, tbl, err := c.getTable(n.Table)
+		if err != nil {
+			return
+		}
+		tbl.Renames = append(tbl.Renames, Rename{
+			Column:   true,
+			OldName:  n.Col.Name,
+			NewName:  *n.NewName,
+			Filename: filename,
+		})
+
+	case *ast.RenameTableStmt:
+		if n.NewName == nil {
+			return
+		}
+		_, tbl, err := c.getTable(&ast.TableName{Catalog: n.Table.Catalog, Schema: n.Table.Schema, Name: *n.NewName})
+		if err != nil {
+			return
+		}
+		tbl.Renames = append(tbl.Renames, Rename{
+			OldName:  n.Table.Name,
+			NewName:  *n.NewName,
+			Filename: filename,
+		})
+	}
+}
+
+// RenamedColumn returns how the column name of the table rel was renamed, if
+// the table has no such column but had one. Renames of renamed columns are
+// followed, so NewName is the current name of the column, and Filename is
+// where it was last renamed.
+func (c *Catalog) RenamedColumn(rel *ast.TableName, name string) (Rename, bool) {
+	_, tbl, err := c.getTable(rel)
+	if err != nil {
+		return Rename{}, false
+	}
+	for _, col := range tbl.Columns {
+		if col.Name == name {
+			return Rename{}, false
+		}
+	}
+	r, ok := tbl.followRenames(true, name)
+	if !ok {
+		return Rename{}, false
+	}
+	// The column may have been dropped since
+	for _, col := range tbl.Columns {
+		if col.Name == r.NewName {
+			return r, true
+		}
+	}
+	return Rename{}, false
+}
+
+// RenamedTable returns how the table rel was renamed, if there is no such
+// table but there was one. NewName is the current name of the table.
+func (c *Catalog) RenamedTable(rel *ast.TableName) (Rename, bool) {
+	if _, _, err := c.getTable(rel); err == nil {
+		return Rename{}, false
+	}
+	schemaName := rel.Schema
+	if schemaName == "" {
+		schemaName = c.DefaultSchema
+	}
+	schema, err := c.getSchema(schemaName)
+	if err != nil {
+		return Rename{}, false
+	}
+	for _, tbl := range schema.Tables {
+		if r, ok := tbl.followRenames(false, rel.Name); ok && r.NewName == tbl.Rel.Name {
+			return r, true
+		}
+	}
+	return Rename{}, false
+}
+
+// followRenames follows the renames of the table, or of its columns, from
+// name to the current name.
+func (t *Table) followRenames(column bool, name string) (Rename, bool) {
+	found := Rename{Column: column, OldName: name, NewName: name}
+	for _, r := range t.Renames {
+		if r.Column == column && r.OldName == found.NewName {
+			found.NewName = r.NewName
+			found.Filename = r.Filename
+		}
+	}
+	if found.NewName == name {
+		return Rename{}, false
+	}
+	return found, true
+}
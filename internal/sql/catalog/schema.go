@@ -14,6 +14,8 @@ type Schema struct {
 	Tables []*Table
 	Types  []Type
 	Funcs  []*Function
+	// Sequences are the sequences created with CREATE SEQUENCE.
+	Sequences []*Sequence
 
 	Comment string
 }
@@ -81,6 +83,10 @@ func (s *Schema) getType(rel *ast.TypeName) (Type, int, error) {
 			if typ.Name == rel.Name {
 				return s.Types[i], i, nil
 			}
+		case *Domain:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
 		}
 	}
 	return nil, -1, sqlerr.TypeNotFound(rel.Name)
@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// SetSearchPath sets the schemas that tables and functions referenced without
+// a schema are looked up in, in order, like PostgreSQL's search_path. The
+// first schema becomes the default schema, which objects created without a
+// schema are created in, and is created if it doesn't exist. Schemas of the
+// path that don't exist are skipped.
+func (c *Catalog) SetSearchPath(path []string) {
+	if len(path) == 0 {
+		return
+	}
+	c.SearchSchemas = path
+	c.DefaultSchema = path[0]
+	if _, err := c.getSchema(c.DefaultSchema); err != nil {
+		c.Schemas = append(c.Schemas, &Schema{Name: c.DefaultSchema})
+	}
+}
+
+// tableSchema returns the schema holding the table named by rel. A table
+// without a schema is looked up in each schema of the search path, and must
+// only be found in one of them. If it isn't found, the default schema is
+// returned.
+func (c *Catalog) tableSchema(rel *ast.TableName) (*Schema, error) {
+	if rel.Schema != "" {
+		return c.getSchema(rel.Schema)
+	}
+	if len(c.SearchSchemas) == 0 {
+		return c.getSchema(c.DefaultSchema)
+	}
+	var found []*Schema
+	for _, name := range c.SearchSchemas {
+		s, err := c.getSchema(name)
+		if err != nil {
+			continue
+		}
+		if _, _, err := s.getTable(rel); err == nil {
+			found = append(found, s)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return c.getSchema(c.DefaultSchema)
+	case 1:
+		return found[0], nil
+	}
+	var candidates []string
+	for _, s := range found {
+		candidates = append(candidates, s.Name+"."+rel.Name)
+	}
+	return nil, &sqlerr.Error{
+		Code:     "42P09",
+		Message:  fmt.Sprintf("relation %q is ambiguous", rel.Name),
+		Hint:     fmt.Sprintf("it matches %s in search_path", strings.Join(candidates, ", ")),
+		Relation: rel.Name,
+	}
+}
+
+// pathSchemas returns the schemas of the search path that exist, or the
+// default schema if there's no search path.
+func (c *Catalog) pathSchemas() []string {
+	if len(c.SearchSchemas) == 0 {
+		return []string{c.DefaultSchema}
+	}
+	var out []string
+	for _, name := range c.SearchSchemas {
+		if _, err := c.getSchema(name); err == nil {
+			out = append(out, name)
+		}
+	}
+	return out
+}
@@ -0,0 +1,140 @@
+package catalog
+
+import (
+	"strconv"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// Sequence describes a sequence created with CREATE SEQUENCE. Start and
+// Increment are nil unless the statements that created or altered the
+// sequence specified them.
+type Sequence struct {
+	Name      string
+	Type      ast.TypeName
+	Start     *int64
+	Increment *int64
+}
+
+func (s *Schema) getSequence(name string) (*Sequence, int, error) {
+	for i := range s.Sequences {
+		if s.Sequences[i].Name == name {
+			return s.Sequences[i], i, nil
+		}
+	}
+	return nil, -1, sqlerr.RelationNotFound(name)
+}
+
+func (c *Catalog) createSequence(stmt *ast.CreateSeqStmt) error {
+	rel := rangeVarTableName(stmt.Sequence)
+	if rel == nil {
+		return nil
+	}
+	ns := rel.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	// Sequences are relations, so their names must be distinct from the
+	// names of the tables in the same schema.
+	_, _, tableErr := schema.getTable(rel)
+	_, _, seqErr := schema.getSequence(rel.Name)
+	if tableErr == nil || seqErr == nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return sqlerr.RelationExists(rel.Name)
+	}
+	seq := &Sequence{
+		Name: rel.Name,
+		Type: ast.TypeName{Schema: "pg_catalog", Name: "int8"},
+	}
+	setSequenceOptions(seq, stmt.Options)
+	schema.Sequences = append(schema.Sequences, seq)
+	return nil
+}
+
+// alterSequence applies the options of an ALTER SEQUENCE statement. Sequences
+// the catalog doesn't know are ignored, as serial and identity columns create
+// theirs implicitly.
+func (c *Catalog) alterSequence(stmt *ast.AlterSeqStmt) error {
+	rel := rangeVarTableName(stmt.Sequence)
+	if rel == nil {
+		return nil
+	}
+	ns := rel.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	seq, _, err := schema.getSequence(rel.Name)
+	if err != nil {
+		return nil
+	}
+	setSequenceOptions(seq, stmt.Options)
+	return nil
+}
+
+// setSequenceOptions sets the data type, start and increment of a sequence
+// from the options of a CREATE or ALTER SEQUENCE statement. Other options,
+// such as OWNED BY, don't change what the catalog records.
+func setSequenceOptions(seq *Sequence, options *ast.List) {
+	if options == nil {
+		return
+	}
+	for _, item := range options.Items {
+		opt, ok := item.(*ast.DefElem)
+		if !ok || opt.Defname == nil {
+			continue
+		}
+		switch *opt.Defname {
+		case "as":
+			if tn, ok := opt.Arg.(*ast.TypeName); ok {
+				seq.Type = sequenceType(tn)
+			}
+		case "start":
+			if v, ok := sequenceValue(opt.Arg); ok {
+				seq.Start = &v
+			}
+		case "increment":
+			if v, ok := sequenceValue(opt.Arg); ok {
+				seq.Increment = &v
+			}
+		}
+	}
+}
+
+func sequenceType(tn *ast.TypeName) ast.TypeName {
+	var names []string
+	if tn.Names != nil {
+		names = stringSlice(tn.Names)
+	}
+	if len(names) == 0 {
+		return ast.TypeName{Schema: tn.Schema, Name: tn.Name}
+	}
+	typ := ast.TypeName{Name: names[len(names)-1]}
+	if len(names) > 1 {
+		typ.Schema = names[len(names)-2]
+	}
+	return typ
+}
+
+// sequenceValue returns the value of a numeric sequence option. The parser
+// represents values that don't fit in 32 bits as floats.
+func sequenceValue(n ast.Node) (int64, bool) {
+	switch n := n.(type) {
+	case *ast.Integer:
+		return n.Ival, true
+	case *ast.Float:
+		v, err := strconv.ParseInt(n.Str, 10, 64)
+		return v, err == nil
+	}
+	return 0, false
+}
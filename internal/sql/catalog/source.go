@@ -0,0 +1,142 @@
+package catalog
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// Source is the text of the statement that created a table or type, without
+// a trailing semicolon, and where it is found.
+type Source struct {
+	Text     string
+	Filename string
+	Line     int
+	// Altered is set if later statements, such as ALTER TABLE, changed the
+	// table or type. Their text is appended to Text, separated by
+	// semicolons.
+	Altered bool
+}
+
+// SetSource records text as the source of the table or type that stmt
+// created or changed. It is called after Update has applied stmt, and
+// ignores statements that don't define tables or types.
+func (c *Catalog) SetSource(stmt ast.Statement, text, filename string, line int) {
+	if stmt.Raw == nil {
+		return
+	}
+	src := &Source{Text: text, Filename: filename, Line: line}
+	switch n := stmt.Raw.Stmt.(type) {
+
+	case *ast.CreateTableStmt:
+		c.createTableSource(n.Name, src)
+
+	case *ast.CreateTableAsStmt:
+		c.createTableSource(rangeVarTableName(n.Into.Rel), src)
+
+	case *ast.ViewStmt:
+		c.createTableSource(rangeVarTableName(n.View), src)
+
+	case *ast.CreateEnumStmt:
+		c.createTypeSource(n.TypeName, src)
+
+	case *ast.CompositeTypeStmt:
+		c.createTypeSource(n.TypeName, src)
+
+	case *ast.AlterTableStmt:
+		c.alterTableSource(n.Table, text)
+
+	case *ast.RenameColumnStmt:
+		c.alterTableSource(n.Table, text)
+
+	case *ast.RenameTableStmt:
+		if n.NewName != nil {
+			c.alterTableSource(&ast.TableName{Schema: n.Table.Schema, Name: *n.NewName}, text)
+		}
+
+	case *ast.AlterTableSetSchemaStmt:
+		if n.NewSchema != nil {
+			c.alterTableSource(&ast.TableName{Schema: *n.NewSchema, Name: n.Table.Name}, text)
+		}
+
+	case *ast.AlterTypeAddValueStmt:
+		c.alterTypeSource(n.Type, text)
+
+	case *ast.AlterTypeRenameValueStmt:
+		c.alterTypeSource(n.Type, text)
+
+	case *ast.AlterTypeSetSchemaStmt:
+		if n.NewSchema != nil {
+			c.alterTypeSource(&ast.TypeName{Schema: *n.NewSchema, Name: n.Type.Name}, text)
+		}
+
+	case *ast.RenameTypeStmt:
+		if n.NewName != nil {
+			c.alterTypeSource(&ast.TypeName{Schema: n.Type.Schema, Name: *n.NewName}, text)
+		}
+
+	}
+}
+
+func rangeVarTableName(rv *ast.RangeVar) *ast.TableName {
+	if rv == nil || rv.Relname == nil {
+		return nil
+	}
+	name := &ast.TableName{Name: *rv.Relname}
+	if rv.Schemaname != nil {
+		name.Schema = *rv.Schemaname
+	}
+	return name
+}
+
+func (c *Catalog) createTableSource(rel *ast.TableName, src *Source) {
+	if rel == nil {
+		return
+	}
+	_, tbl, err := c.getTable(rel)
+	// CREATE TABLE IF NOT EXISTS leaves an existing table as it is
+	if err != nil || tbl.Source != nil {
+		return
+	}
+	tbl.Source = src
+}
+
+func (c *Catalog) alterTableSource(rel *ast.TableName, text string) {
+	if rel == nil {
+		return
+	}
+	_, tbl, err := c.getTable(rel)
+	if err != nil || tbl.Source == nil {
+		return
+	}
+	tbl.Source.Text += ";\n" + text
+	tbl.Source.Altered = true
+}
+
+func (c *Catalog) typeSource(rel *ast.TypeName) **Source {
+	if rel == nil {
+		return nil
+	}
+	typ, _, err := c.getType(rel)
+	if err != nil {
+		return nil
+	}
+	switch typ := typ.(type) {
+	case *Enum:
+		return &typ.Source
+	case *CompositeType:
+		return &typ.Source
+	}
+	return nil
+}
+
+func (c *Catalog) createTypeSource(rel *ast.TypeName, src *Source) {
+	if s := c.typeSource(rel); s != nil && *s == nil {
+		*s = src
+	}
+}
+
+func (c *Catalog) alterTypeSource(rel *ast.TypeName, text string) {
+	if s := c.typeSource(rel); s != nil && *s != nil {
+		(*s).Text += ";\n" + text
+		(*s).Altered = true
+	}
+}
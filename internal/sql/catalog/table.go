@@ -13,9 +13,188 @@ import (
 // A database table is a collection of related data held in a table format within a database.
 // It consists of columns and rows.
 type Table struct {
-	Rel     *ast.TableName
-	Columns []*Column
-	Comment string
+	Rel               *ast.TableName
+	Columns           []*Column
+	Comment           string
+	PrimaryKey        *Constraint
+	UniqueConstraints []*Constraint
+	ForeignKeys       []*ForeignKey
+	// IsView is set for views and materialized views, which are defined by
+	// the ViewDefinition statement.
+	IsView         bool
+	ViewDefinition string
+	// PartitionOf is set for a partition of a partitioned table. It points
+	// to the Rel of that table.
+	PartitionOf *ast.TableName
+	Indexes     []*Index
+	// Source is only recorded if a plugin asks for it.
+	Source *Source
+	// Renames are the renames of the table and its columns, in the order
+	// the schema files made them.
+	Renames []Rename
+	// HiddenColumns are columns, such as the rowid of a SQLite virtual table,
+	// that queries can reference by name but that aren't part of its rows.
+	HiddenColumns []*Column
+}
+
+// Constraint describes a PRIMARY KEY or UNIQUE constraint on a table.
+type Constraint struct {
+	Name    string
+	Columns []string
+}
+
+// ForeignKey describes a FOREIGN KEY constraint on a table. RefColumns is empty
+// if the constraint references the primary key of RefTable implicitly.
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	RefTable   *ast.TableName
+	RefColumns []string
+	OnDelete   string
+	OnUpdate   string
+}
+
+func stringList(list *ast.List) []string {
+	var out []string
+	if list == nil {
+		return out
+	}
+	for _, item := range list.Items {
+		if s, ok := item.(*ast.String); ok {
+			out = append(out, s.Str)
+		}
+	}
+	return out
+}
+
+func rangeVarToTableName(rv *ast.RangeVar) *ast.TableName {
+	tn := &ast.TableName{}
+	if rv.Catalogname != nil {
+		tn.Catalog = *rv.Catalogname
+	}
+	if rv.Schemaname != nil {
+		tn.Schema = *rv.Schemaname
+	}
+	if rv.Relname != nil {
+		tn.Name = *rv.Relname
+	}
+	return tn
+}
+
+func fkAction(action byte) string {
+	switch action {
+	case ast.FKCONSTR_ACTION_RESTRICT:
+		return "RESTRICT"
+	case ast.FKCONSTR_ACTION_CASCADE:
+		return "CASCADE"
+	case ast.FKCONSTR_ACTION_SETNULL:
+		return "SET NULL"
+	case ast.FKCONSTR_ACTION_SETDEFAULT:
+		return "SET DEFAULT"
+	default:
+		return "NO ACTION"
+	}
+}
+
+func (table *Table) addConstraint(con *ast.Constraint) {
+	tc := &Constraint{}
+	if con.Conname != nil {
+		tc.Name = *con.Conname
+	}
+	tc.Columns = stringList(con.Keys)
+	switch con.Contype {
+	case ast.CONSTR_FOREIGN:
+		fk := &ForeignKey{
+			Name:       tc.Name,
+			Columns:    stringList(con.FkAttrs),
+			RefColumns: stringList(con.PkAttrs),
+			OnDelete:   fkAction(con.FkDelAction),
+			OnUpdate:   fkAction(con.FkUpdAction),
+		}
+		if con.Pktable != nil {
+			fk.RefTable = rangeVarToTableName(con.Pktable)
+		}
+		table.ForeignKeys = append(table.ForeignKeys, fk)
+	case ast.CONSTR_PRIMARY:
+		table.PrimaryKey = tc
+	case ast.CONSTR_UNIQUE:
+		table.UniqueConstraints = append(table.UniqueConstraints, tc)
+	case ast.CONSTR_CHECK:
+		table.addCheck(tc.Name, con.RawExpr)
+	}
+}
+
+func (table *Table) dropConstraint(cmd *ast.AlterTableCmd) {
+	// Constraints other than PRIMARY KEY, UNIQUE, FOREIGN KEY and CHECK
+	// aren't tracked, so an unknown name isn't an error
+	name := *cmd.Name
+	table.dropCheck(name)
+	if table.PrimaryKey != nil && table.PrimaryKey.Name == name {
+		table.PrimaryKey = nil
+	}
+	for i, con := range table.UniqueConstraints {
+		if con.Name == name {
+			table.UniqueConstraints = append(table.UniqueConstraints[:i], table.UniqueConstraints[i+1:]...)
+			break
+		}
+	}
+	for i, fk := range table.ForeignKeys {
+		if fk.Name == name {
+			table.ForeignKeys = append(table.ForeignKeys[:i], table.ForeignKeys[i+1:]...)
+			break
+		}
+	}
+}
+
+func (table *Table) renameConstraintColumn(oldName, newName string) {
+	cons := table.UniqueConstraints
+	if table.PrimaryKey != nil {
+		cons = append([]*Constraint{table.PrimaryKey}, cons...)
+	}
+	for _, con := range cons {
+		for i := range con.Columns {
+			if con.Columns[i] == oldName {
+				con.Columns[i] = newName
+			}
+		}
+	}
+	for _, fk := range table.ForeignKeys {
+		for i := range fk.Columns {
+			if fk.Columns[i] == oldName {
+				fk.Columns[i] = newName
+			}
+		}
+	}
+	for _, idx := range table.Indexes {
+		for i := range idx.Columns {
+			if idx.Columns[i].Name == oldName {
+				idx.Columns[i].Name = newName
+			}
+		}
+	}
+}
+
+// referencingKeys returns the foreign keys, on any table, that reference the
+// table tbl in schema sch.
+func (c *Catalog) referencingKeys(sch *Schema, tbl *Table) []*ForeignKey {
+	var out []*ForeignKey
+	for _, s := range c.Schemas {
+		for _, t := range s.Tables {
+			for _, fk := range t.ForeignKeys {
+				if fk.RefTable == nil || fk.RefTable.Name != tbl.Rel.Name {
+					continue
+				}
+				schema := fk.RefTable.Schema
+				if schema == "" {
+					schema = c.DefaultSchema
+				}
+				if schema == sch.Name {
+					out = append(out, fk)
+				}
+			}
+		}
+	}
+	return out
 }
 
 func checkMissing(err error, missingOK bool) error {
@@ -67,6 +246,13 @@ func (table *Table) alterColumnType(cmd *ast.AlterTableCmd) error {
 		table.Columns[index].Type = *cmd.Def.TypeName
 		table.Columns[index].IsArray = cmd.Def.IsArray
 		table.Columns[index].ArrayDims = cmd.Def.ArrayDims
+		// The modifiers of the old type don't apply to the new one
+		table.Columns[index].IsUnsigned = cmd.Def.IsUnsigned
+		table.Columns[index].Length = cmd.Def.Length
+		table.Columns[index].Precision, table.Columns[index].Scale = numericModifiers(cmd.Def.TypeName)
+		if length := vectorDimensions(cmd.Def.TypeName); length != nil {
+			table.Columns[index].Length = length
+		}
 	}
 	return nil
 }
@@ -116,6 +302,24 @@ func (table *Table) setNotNull(cmd *ast.AlterTableCmd) error {
 	return nil
 }
 
+// setDefault sets or, if cmd.Def is nil, drops the default value of a column.
+func (table *Table) setDefault(cmd *ast.AlterTableCmd) error {
+	index, err := table.isExistColumn(cmd)
+	if err != nil {
+		return err
+	}
+	if index < 0 {
+		return nil
+	}
+	col := table.Columns[index]
+	if cmd.Def == nil {
+		col.HasDefault, col.DefaultExpr = false, ""
+	} else {
+		col.HasDefault, col.DefaultExpr = cmd.Def.HasDefault, cmd.Def.DefaultExpr
+	}
+	return nil
+}
+
 // Column describes a set of data values of a particular type in a relational database table
 //
 // TODO: Should this just be ast Nodes?
@@ -128,8 +332,80 @@ type Column struct {
 	ArrayDims  int
 	Comment    string
 	Length     *int
+	Precision  *int
+	Scale      *int
+	// HasDefault is set if the column has a default value, and DefaultExpr
+	// holds its SQL text. Implicit defaults, such as those of serial and
+	// auto-increment columns, have no text.
+	HasDefault  bool
+	DefaultExpr string
+	// IsGenerated is set for generated columns, and GeneratedExpr holds the
+	// SQL text of the expression computing them.
+	IsGenerated   bool
+	GeneratedExpr string
+	// CheckValues holds the values allowed by a CHECK (col IN (...))
+	// constraint on the column, named checkConstraint.
+	CheckValues []string
+
+	linkedType      bool
+	checkConstraint string
+}
 
-	linkedType bool
+// numericModifiers returns the precision and scale declared by the type
+// modifiers of a NUMERIC or DECIMAL type, e.g. NUMERIC(10, 2). Both values are
+// nil if the type has no modifiers.
+func numericModifiers(tn *ast.TypeName) (*int, *int) {
+	if tn == nil || tn.Typmods == nil {
+		return nil, nil
+	}
+	switch tn.Name {
+	case "numeric", "decimal":
+	default:
+		return nil, nil
+	}
+	var mods []int
+	for _, item := range tn.Typmods.Items {
+		con, ok := item.(*ast.A_Const)
+		if !ok {
+			return nil, nil
+		}
+		val, ok := con.Val.(*ast.Integer)
+		if !ok {
+			return nil, nil
+		}
+		mods = append(mods, int(val.Ival))
+	}
+	if len(mods) == 0 {
+		return nil, nil
+	}
+	// NUMERIC(p) is equivalent to NUMERIC(p, 0)
+	precision, scale := mods[0], 0
+	if len(mods) > 1 {
+		scale = mods[1]
+	}
+	return &precision, &scale
+}
+
+// vectorDimensions returns the number of dimensions declared by the type
+// modifier of a pgvector VECTOR type, e.g. VECTOR(1536). It is nil if the type
+// has no modifier.
+func vectorDimensions(tn *ast.TypeName) *int {
+	if tn == nil || tn.Typmods == nil || tn.Name != "vector" {
+		return nil
+	}
+	if len(tn.Typmods.Items) != 1 {
+		return nil
+	}
+	con, ok := tn.Typmods.Items[0].(*ast.A_Const)
+	if !ok {
+		return nil
+	}
+	val, ok := con.Val.(*ast.Integer)
+	if !ok {
+		return nil
+	}
+	dims := int(val.Ival)
+	return &dims
 }
 
 // An interface is used to resolve a circular import between the catalog and compiler packages.
@@ -140,19 +416,9 @@ type columnGenerator interface {
 }
 
 func (c *Catalog) getTable(tableName *ast.TableName) (*Schema, *Table, error) {
-	schemaName := tableName.Schema
-	if schemaName == "" {
-		schemaName = c.DefaultSchema
-	}
-	var schema *Schema
-	for i := range c.Schemas {
-		if c.Schemas[i].Name == schemaName {
-			schema = c.Schemas[i]
-			break
-		}
-	}
-	if schema == nil {
-		return nil, nil, sqlerr.SchemaNotFound(schemaName)
+	schema, err := c.tableSchema(tableName)
+	if err != nil {
+		return nil, nil, err
 	}
 	table, _, err := schema.getTable(tableName)
 	if err != nil {
@@ -177,6 +443,14 @@ func isStmtImplemented(stmt *ast.AlterTableStmt) bool {
 				implemented = true
 			case ast.AT_SetNotNull:
 				implemented = true
+			case ast.AT_AddConstraint:
+				implemented = true
+			case ast.AT_DropConstraint:
+				implemented = true
+			case ast.AT_ColumnDefault:
+				implemented = true
+			case ast.AT_AttachPartition, ast.AT_DetachPartition:
+				implemented = true
 			}
 		}
 	}
@@ -191,28 +465,60 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 	if err != nil {
 		return checkMissing(err, stmt.MissingOk)
 	}
+	// Changes to the columns of a partitioned table apply to its partitions
+	tables := append([]*Table{table}, c.partitions(table)...)
 	for _, item := range stmt.Cmds.Items {
 		switch cmd := item.(type) {
 		case *ast.AlterTableCmd:
 			switch cmd.Subtype {
 			case ast.AT_AddColumn:
-				if err := c.addColumn(table, cmd); err != nil {
-					return err
+				for _, t := range tables {
+					if err := c.addColumn(t, cmd); err != nil {
+						return err
+					}
 				}
 			case ast.AT_AlterColumnType:
-				if err := table.alterColumnType(cmd); err != nil {
-					return err
+				for _, t := range tables {
+					if err := t.alterColumnType(cmd); err != nil {
+						return err
+					}
 				}
 			case ast.AT_DropColumn:
-				if err := c.dropColumn(table, cmd); err != nil {
-					return err
+				for _, t := range tables {
+					if err := c.dropColumn(t, cmd); err != nil {
+						return err
+					}
 				}
 			case ast.AT_DropNotNull:
-				if err := table.dropNotNull(cmd); err != nil {
-					return err
+				for _, t := range tables {
+					if err := t.dropNotNull(cmd); err != nil {
+						return err
+					}
 				}
 			case ast.AT_SetNotNull:
-				if err := table.setNotNull(cmd); err != nil {
+				for _, t := range tables {
+					if err := t.setNotNull(cmd); err != nil {
+						return err
+					}
+				}
+			case ast.AT_AttachPartition:
+				_, partition, err := c.getTable(cmd.Partition)
+				if err != nil {
+					return err
+				}
+				partition.PartitionOf = table.Rel
+			case ast.AT_DetachPartition:
+				_, partition, err := c.getTable(cmd.Partition)
+				if err != nil {
+					return err
+				}
+				partition.PartitionOf = nil
+			case ast.AT_AddConstraint:
+				table.addConstraint(cmd.Constraint)
+			case ast.AT_DropConstraint:
+				table.dropConstraint(cmd)
+			case ast.AT_ColumnDefault:
+				if err := table.setDefault(cmd); err != nil {
 					return err
 				}
 			}
@@ -222,11 +528,7 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 }
 
 func (c *Catalog) alterTableSetSchema(stmt *ast.AlterTableSetSchemaStmt) error {
-	ns := stmt.Table.Schema
-	if ns == "" {
-		ns = c.DefaultSchema
-	}
-	oldSchema, err := c.getSchema(ns)
+	oldSchema, err := c.tableSchema(stmt.Table)
 	if err != nil {
 		return checkMissing(err, stmt.MissingOk)
 	}
@@ -300,7 +602,26 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		}
 	}
 
+	if stmt.PartitionOf != nil {
+		_, parent, err := c.getTable(stmt.PartitionOf)
+		if err != nil {
+			return err
+		}
+		for _, col := range parent.Columns {
+			newCol := *col // make a copy, so the options of the partition don't propagate
+			newCol.linkedType = false
+			tbl.Columns = append(tbl.Columns, &newCol)
+		}
+		tbl.PartitionOf = parent.Rel
+	}
+
 	for _, col := range stmt.Cols {
+		if col.TypeName == nil {
+			if err := tbl.setColumnOptions(col); err != nil {
+				return err
+			}
+			continue
+		}
 		if notNull, ok := seen[col.Colname]; ok {
 			seen[col.Colname] = notNull || col.IsNotNull
 			if a, ok := coltype[col.Colname]; ok {
@@ -314,6 +635,10 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		if err != nil {
 			return err
 		}
+		if col.IsHidden {
+			tbl.HiddenColumns = append(tbl.HiddenColumns, tc)
+			continue
+		}
 		tbl.Columns = append(tbl.Columns, tc)
 	}
 
@@ -324,20 +649,32 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		}
 	}
 
+	for _, con := range stmt.Constraints {
+		tbl.addConstraint(con)
+	}
+
 	schema.Tables = append(schema.Tables, &tbl)
 	return nil
 }
 
 func (c *Catalog) defineColumn(table *ast.TableName, col *ast.ColumnDef) (*Column, error) {
 	tc := &Column{
-		Name:       col.Colname,
-		Type:       *col.TypeName,
-		IsNotNull:  col.IsNotNull,
-		IsUnsigned: col.IsUnsigned,
-		IsArray:    col.IsArray,
-		ArrayDims:  col.ArrayDims,
-		Comment:    col.Comment,
-		Length:     col.Length,
+		Name:          col.Colname,
+		Type:          *col.TypeName,
+		IsNotNull:     col.IsNotNull,
+		IsUnsigned:    col.IsUnsigned,
+		IsArray:       col.IsArray,
+		ArrayDims:     col.ArrayDims,
+		Comment:       col.Comment,
+		Length:        col.Length,
+		HasDefault:    col.HasDefault,
+		DefaultExpr:   col.DefaultExpr,
+		IsGenerated:   col.IsGenerated,
+		GeneratedExpr: col.GeneratedExpr,
+	}
+	tc.Precision, tc.Scale = numericModifiers(col.TypeName)
+	if length := vectorDimensions(col.TypeName); length != nil {
+		tc.Length = length
 	}
 	if col.Vals != nil {
 		typeName := ast.TypeName{
@@ -355,11 +692,7 @@ func (c *Catalog) defineColumn(table *ast.TableName, col *ast.ColumnDef) (*Colum
 
 func (c *Catalog) dropTable(stmt *ast.DropTableStmt) error {
 	for _, name := range stmt.Tables {
-		ns := name.Schema
-		if ns == "" {
-			ns = c.DefaultSchema
-		}
-		schema, err := c.getSchema(ns)
+		schema, err := c.tableSchema(name)
 		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
 			continue
 		} else if err != nil {
@@ -385,12 +718,56 @@ func (c *Catalog) dropTable(stmt *ast.DropTableStmt) error {
 		}
 
 		schema.Tables = append(schema.Tables[:idx], schema.Tables[idx+1:]...)
+
+		// Partitions are dropped along with the partitioned table
+		for _, partition := range c.partitions(tbl) {
+			if err := c.dropTable(&ast.DropTableStmt{
+				IfExists: true,
+				Tables:   []*ast.TableName{partition.Rel},
+			}); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// partitions returns the partitions of a table, including the partitions of
+// its partitions.
+func (c *Catalog) partitions(parent *Table) []*Table {
+	var out []*Table
+	for _, s := range c.Schemas {
+		for _, t := range s.Tables {
+			if t.PartitionOf == parent.Rel {
+				out = append(out, t)
+				out = append(out, c.partitions(t)...)
+			}
+		}
+	}
+	return out
+}
+
+// setColumnOptions applies the options given for a column of a partition,
+// which has the columns of the partitioned table.
+func (t *Table) setColumnOptions(def *ast.ColumnDef) error {
+	for _, col := range t.Columns {
+		if col.Name != def.Colname {
+			continue
+		}
+		if def.IsNotNull {
+			col.IsNotNull = true
+		}
+		if def.HasDefault {
+			col.HasDefault = true
+			col.DefaultExpr = def.DefaultExpr
+		}
+		return nil
+	}
+	return sqlerr.ColumnNotFound(t.Rel.Name, def.Colname)
+}
+
 func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
-	_, tbl, err := c.getTable(stmt.Table)
+	sch, tbl, err := c.getTable(stmt.Table)
 	if err != nil {
 		return checkMissing(err, stmt.MissingOk)
 	}
@@ -407,6 +784,14 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
 	}
 	tbl.Columns[idx].Name = *stmt.NewName
+	tbl.renameConstraintColumn(stmt.Col.Name, *stmt.NewName)
+	for _, fk := range c.referencingKeys(sch, tbl) {
+		for i := range fk.RefColumns {
+			if fk.RefColumns[i] == stmt.Col.Name {
+				fk.RefColumns[i] = *stmt.NewName
+			}
+		}
+	}
 
 	if tbl.Columns[idx].linkedType {
 		name := fmt.Sprintf("%s_%s", tbl.Rel.Name, *stmt.NewName)
@@ -431,6 +816,9 @@ func (c *Catalog) renameTable(stmt *ast.RenameTableStmt) error {
 		return sqlerr.RelationExists(*stmt.NewName)
 	}
 	if stmt.NewName != nil {
+		for _, fk := range c.referencingKeys(sch, tbl) {
+			fk.RefTable.Name = *stmt.NewName
+		}
 		tbl.Rel.Name = *stmt.NewName
 	}
 
@@ -471,7 +859,9 @@ func (c *Catalog) createTableAs(stmt *ast.CreateTableAsStmt, colGen columnGenera
 			Schema:  schemaName,
 			Name:    *stmt.Into.Rel.Relname,
 		},
-		Columns: cols,
+		Columns:        cols,
+		IsView:         stmt.IsMatview,
+		ViewDefinition: stmt.Definition,
 	}
 
 	ns := tbl.Rel.Schema
@@ -484,6 +874,9 @@ func (c *Catalog) createTableAs(stmt *ast.CreateTableAsStmt, colGen columnGenera
 	}
 	_, _, err = schema.getTable(tbl.Rel)
 	if err == nil {
+		if stmt.IfNotExists {
+			return nil
+		}
 		return sqlerr.RelationExists(tbl.Rel.Name)
 	}
 
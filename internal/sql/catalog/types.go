@@ -3,6 +3,7 @@ package catalog
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
@@ -18,6 +19,7 @@ type Enum struct {
 	Name    string
 	Vals    []string
 	Comment string
+	Source  *Source
 }
 
 func (e *Enum) SetComment(c string) {
@@ -28,8 +30,11 @@ func (e *Enum) isType() {
 }
 
 type CompositeType struct {
-	Name    string
+	Name string
+	// Columns are the fields of the type, which are always nullable.
+	Columns []*Column
 	Comment string
+	Source  *Source
 }
 
 func (ct *CompositeType) isType() {
@@ -39,6 +44,23 @@ func (ct *CompositeType) SetComment(c string) {
 	ct.Comment = c
 }
 
+// Domain is a data type based on another type, usually with constraints on
+// its values.
+type Domain struct {
+	Name      string
+	BaseType  ast.TypeName
+	IsArray   bool
+	ArrayDims int
+	Comment   string
+}
+
+func (d *Domain) isType() {
+}
+
+func (d *Domain) SetComment(c string) {
+	d.Comment = c
+}
+
 func sameType(a, b *ast.TypeName) bool {
 	if a.Catalog != b.Catalog {
 		return false
@@ -91,6 +113,54 @@ func (c *Catalog) createEnum(stmt *ast.CreateEnumStmt) error {
 	return nil
 }
 
+func (c *Catalog) createDomain(stmt *ast.CreateDomainStmt) error {
+	name, err := domainName(stmt.Domainname)
+	if err != nil {
+		return err
+	}
+	ns := name.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	if _, _, err := schema.getType(name); err == nil {
+		return sqlerr.TypeExists(name.Name)
+	}
+	var dims int
+	if stmt.TypeName.ArrayBounds != nil {
+		dims = len(stmt.TypeName.ArrayBounds.Items)
+	}
+	schema.Types = append(schema.Types, &Domain{
+		Name: name.Name,
+		BaseType: ast.TypeName{
+			Catalog: stmt.TypeName.Catalog,
+			Schema:  stmt.TypeName.Schema,
+			Name:    stmt.TypeName.Name,
+			Typmods: stmt.TypeName.Typmods,
+		},
+		IsArray:   dims > 0,
+		ArrayDims: dims,
+	})
+	return nil
+}
+
+func domainName(list *ast.List) (*ast.TypeName, error) {
+	parts := stringSlice(list)
+	switch len(parts) {
+	case 1:
+		return &ast.TypeName{Name: parts[0]}, nil
+	case 2:
+		return &ast.TypeName{Schema: parts[0], Name: parts[1]}, nil
+	case 3:
+		return &ast.TypeName{Catalog: parts[0], Schema: parts[1], Name: parts[2]}, nil
+	default:
+		return nil, fmt.Errorf("invalid domain name: %s", strings.Join(parts, "."))
+	}
+}
+
 func stringSlice(list *ast.List) []string {
 	items := []string{}
 	for _, item := range list.Items {
@@ -135,9 +205,17 @@ func (c *Catalog) createCompositeType(stmt *ast.CompositeTypeStmt) error {
 	if _, _, err := schema.getType(stmt.TypeName); err == nil {
 		return sqlerr.TypeExists(tbl.Name)
 	}
-	schema.Types = append(schema.Types, &CompositeType{
+	ct := &CompositeType{
 		Name: stmt.TypeName.Name,
-	})
+	}
+	for _, col := range stmt.Cols {
+		tc, err := c.defineColumn(tbl, col)
+		if err != nil {
+			return err
+		}
+		ct.Columns = append(ct.Columns, tc)
+	}
+	schema.Types = append(schema.Types, ct)
 	return nil
 }
 
@@ -344,7 +422,9 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 	case *CompositeType:
 		schema.Types[idx] = &CompositeType{
 			Name:    newName,
+			Columns: typ.Columns,
 			Comment: typ.Comment,
+			Source:  typ.Source,
 		}
 
 	case *Enum:
@@ -352,6 +432,16 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 			Name:    newName,
 			Vals:    typ.Vals,
 			Comment: typ.Comment,
+			Source:  typ.Source,
+		}
+
+	case *Domain:
+		schema.Types[idx] = &Domain{
+			Name:      newName,
+			BaseType:  typ.BaseType,
+			IsArray:   typ.IsArray,
+			ArrayDims: typ.ArrayDims,
+			Comment:   typ.Comment,
 		}
 
 	default:
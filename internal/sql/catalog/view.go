@@ -1,6 +1,8 @@
 package catalog
 
 import (
+	"fmt"
+
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 )
@@ -10,6 +12,16 @@ func (c *Catalog) createView(stmt *ast.ViewStmt, colGen columnGenerator) error {
 	if err != nil {
 		return err
 	}
+	if stmt.Aliases != nil {
+		if len(stmt.Aliases.Items) > len(cols) {
+			return fmt.Errorf("CREATE VIEW specifies more column names than columns")
+		}
+		for i, item := range stmt.Aliases.Items {
+			if alias, ok := item.(*ast.String); ok {
+				cols[i].Name = alias.Str
+			}
+		}
+	}
 
 	catName := ""
 	if stmt.View.Catalogname != nil {
@@ -26,7 +38,9 @@ func (c *Catalog) createView(stmt *ast.ViewStmt, colGen columnGenerator) error {
 			Schema:  schemaName,
 			Name:    *stmt.View.Relname,
 		},
-		Columns: cols,
+		Columns:        cols,
+		IsView:         true,
+		ViewDefinition: stmt.Definition,
 	}
 
 	ns := tbl.Rel.Schema
@@ -41,3 +41,53 @@ func IsMathematicalOperator(s string) bool {
 	}
 	return true
 }
+
+// IsVectorDistanceOperator reports whether s is one of the distance operators
+// of the pgvector extension. The <-> operator is shared with the geometric
+// types, so it is not included.
+func IsVectorDistanceOperator(s string) bool {
+	switch s {
+	case "<=>":
+	case "<#>":
+	case "<+>":
+	default:
+		return false
+	}
+	return true
+}
+
+// IsJSONExtractOperator reports whether s is one of the operators extracting
+// a json or jsonb value from another, and IsJSONTextExtractOperator whether it
+// is one of those extracting it as text.
+func IsJSONExtractOperator(s string) bool {
+	switch s {
+	case "->":
+	case "#>":
+	default:
+		return false
+	}
+	return true
+}
+
+func IsJSONTextExtractOperator(s string) bool {
+	switch s {
+	case "->>":
+	case "#>>":
+	default:
+		return false
+	}
+	return true
+}
+
+// IsContainmentOperator reports whether s is one of the operators testing
+// whether a range, array or json document contains a value or another one. &&
+// is left out, as it also combines two tsquery values.
+func IsContainmentOperator(s string) bool {
+	switch s {
+	case "@>":
+	case "<@":
+	default:
+		return false
+	}
+	return true
+}
@@ -12,6 +12,8 @@ type ParamSet struct {
 	positionToName map[int]string
 	// argn keeps track of the last checked positional parameter used
 	argn int
+	// positional parameters inferred to be nullable
+	nullable map[int]bool
 }
 
 // Return the name for a given parameter number and a boolean indicating if it
@@ -47,9 +49,24 @@ func (p *ParamSet) Add(param Param) int {
 	return argn
 }
 
+// SetNullable marks the parameter at the given position as nullable, as if it
+// had been specified with sqlc.narg()
+func (p *ParamSet) SetNullable(idx int) {
+	name, exists := p.positionToName[idx]
+	if !exists || name == "" {
+		p.nullable[idx] = true
+		return
+	}
+	p.namedParams[name] = mergeParam(p.namedParams[name], NewUserNullableParam(name))
+}
+
 // FetchMerge fetches an indexed parameter, and merges `mergeP` into it
 // Returns: the merged parameter and whether it was a named parameter
 func (p *ParamSet) FetchMerge(idx int, mergeP Param) (param Param, isNamed bool) {
+	if p.nullable[idx] {
+		mergeP = mergeParam(mergeP, NewUserNullableParam(""))
+	}
+
 	name, exists := p.positionToName[idx]
 	if !exists || name == "" {
 		return mergeP, false
@@ -81,5 +98,6 @@ func NewParamSet(positionsUsed map[int]bool, hasNamedSupport bool) *ParamSet {
 		namedLocs:       make(map[string][]int),
 		hasNamedSupport: hasNamedSupport,
 		positionToName:  positionToName,
+		nullable:        make(map[int]bool),
 	}
 }
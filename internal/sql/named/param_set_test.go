@@ -56,3 +56,23 @@ func TestParamSet_Add(t *testing.T) {
 		}
 	}
 }
+
+func TestParamSet_SetNullable(t *testing.T) {
+	t.Parallel()
+
+	named := NewParamSet(nil, true)
+	argn := named.Add(NewParam("name"))
+	named.SetNullable(argn)
+	if p, _ := named.FetchMerge(argn, NewInferredParam("name", true)); p.NotNull() {
+		t.Errorf("named parameter %d: expected nullable", argn)
+	}
+
+	positional := NewParamSet(map[int]bool{1: true, 2: true}, true)
+	positional.SetNullable(1)
+	if p, _ := positional.FetchMerge(1, NewInferredParam("name", true)); p.NotNull() {
+		t.Errorf("positional parameter 1: expected nullable")
+	}
+	if p, _ := positional.FetchMerge(2, NewInferredParam("name", true)); !p.NotNull() {
+		t.Errorf("positional parameter 2: expected not null")
+	}
+}
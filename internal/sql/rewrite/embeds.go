@@ -7,15 +7,25 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 )
 
-// Embed is an instance of `sqlc.embed(param)`
+// Embed is an instance of `sqlc.embed(param)` or `sqlc.embed(param, 'name')`
 type Embed struct {
 	Table *ast.TableName
+	// Name is set if the embedded table is named by the second parameter.
+	Name  string
 	param string
 	Node  *ast.ColumnRef
 }
 
+// Param returns the table name or alias passed to `sqlc.embed`.
+func (e Embed) Param() string {
+	return e.param
+}
+
 // Orig string to replace
 func (e Embed) Orig() string {
+	if e.Name != "" {
+		return fmt.Sprintf("sqlc.embed(%s, '%s')", e.param, e.Name)
+	}
 	return fmt.Sprintf("sqlc.embed(%s)", e.param)
 }
 
@@ -49,7 +59,11 @@ func Embeds(raw *ast.RawStmt) (*ast.RawStmt, EmbedSet) {
 				return false
 			}
 
-			param, _ := flatten(fun.Args)
+			param, _ := flatten(fun.Args.Items[0])
+			var name string
+			if len(fun.Args.Items) > 1 {
+				name, _ = flatten(fun.Args.Items[1])
+			}
 
 			node := &ast.ColumnRef{
 				Fields: &ast.List{
@@ -62,6 +76,7 @@ func Embeds(raw *ast.RawStmt) (*ast.RawStmt, EmbedSet) {
 
 			embeds = append(embeds, &Embed{
 				Table: &ast.TableName{Name: param},
+				Name:  name,
 				param: param,
 				Node:  node,
 			})
@@ -0,0 +1,199 @@
+package rewrite
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/source"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// OrderByMarker precedes the column a query is sorted by when it's chosen at
+// runtime. This sequence is also replicated in the golang templates.
+const OrderByMarker = "/*ORDERBY*/"
+
+var orderByColumn = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// OrderBy is an instance of `sqlc.orderby('column', ...)`, which sorts by one
+// of the listed columns, chosen at runtime.
+type OrderBy struct {
+	Columns []string
+}
+
+// Text returns the text that replaces the call in the query. It sorts by the
+// first column, which is also the default at runtime.
+func (o *OrderBy) Text() string {
+	return OrderByMarker + o.Columns[0]
+}
+
+// OrderByColumns rewrites `sqlc.orderby(...)` to a reference to its first
+// column, and returns the edit that replaces the call in query, the text of
+// the statement. The call must be one of the expressions of the ORDER BY
+// clause of a SELECT.
+func OrderByColumns(raw *ast.RawStmt, query string) (*ast.RawStmt, *OrderBy, []source.Edit, error) {
+	var orderBy *OrderBy
+	var edits []source.Edit
+	var err error
+
+	// The calls that sort the rows of a SELECT, which are visited before the
+	// calls themselves
+	sorting := map[*ast.FuncCall]bool{}
+
+	node := astutils.Apply(raw, func(cr *astutils.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		if sel, ok := cr.Node().(*ast.SelectStmt); ok {
+			for _, expr := range sortExprs(sel) {
+				if isOrderBy(expr) {
+					sorting[expr.(*ast.FuncCall)] = true
+				}
+			}
+		}
+		fun, ok := cr.Node().(*ast.FuncCall)
+		if !ok || !isOrderBy(fun) {
+			return true
+		}
+		loc := fun.Location - raw.StmtLocation
+		if orderBy != nil {
+			err = &sqlerr.Error{
+				Message:  "sqlc.orderby may only be used once per query",
+				Location: fun.Location,
+			}
+			return false
+		}
+		if !sorting[fun] {
+			err = &sqlerr.Error{
+				Message:  "sqlc.orderby may only be used in an ORDER BY clause",
+				Location: fun.Location,
+			}
+			return false
+		}
+
+		orderBy = &OrderBy{}
+		seen := map[string]bool{}
+		for _, arg := range fun.Args.Items {
+			var name string
+			if ref, ok := arg.(*ast.ColumnRef); ok {
+				name = astutils.Join(ref.Fields, ".")
+			} else {
+				name, _ = flatten(arg)
+			}
+			if !orderByColumn.MatchString(name) {
+				err = &sqlerr.Error{
+					Message:  fmt.Sprintf("invalid column %q in sqlc.orderby", name),
+					Location: fun.Location,
+				}
+				return false
+			}
+			if seen[name] {
+				err = &sqlerr.Error{
+					Message:  fmt.Sprintf("column %q is listed more than once in sqlc.orderby", name),
+					Location: fun.Location,
+				}
+				return false
+			}
+			seen[name] = true
+			orderBy.Columns = append(orderBy.Columns, name)
+		}
+
+		end := closingParen(query, loc)
+		if end < 0 {
+			err = &sqlerr.Error{
+				Message:  "unterminated call to sqlc.orderby",
+				Location: fun.Location,
+			}
+			return false
+		}
+		rest := strings.Fields(strings.ToUpper(query[end+1:]))
+		if len(rest) > 0 && (strings.HasPrefix(rest[0], "ASC") || strings.HasPrefix(rest[0], "DESC")) {
+			err = &sqlerr.Error{
+				Message:  "the direction of sqlc.orderby is chosen at runtime and may not be specified",
+				Location: fun.Location,
+			}
+			return false
+		}
+
+		var fields []ast.Node
+		for _, part := range strings.Split(orderBy.Columns[0], ".") {
+			fields = append(fields, &ast.String{Str: part})
+		}
+		cr.Replace(&ast.ColumnRef{
+			Fields:   &ast.List{Items: fields},
+			Location: fun.Location,
+		})
+		edits = append(edits, source.Edit{
+			Location: loc,
+			Old:      query[loc : end+1],
+			New:      orderBy.Text(),
+		})
+		return false
+	}, nil)
+
+	if err != nil {
+		return raw, nil, nil, err
+	}
+	return node.(*ast.RawStmt), orderBy, edits, nil
+}
+
+// sortExprs returns the expressions of the ORDER BY clause of sel. The MySQL
+// engine keeps them in a list in the window clause, rather than as the SortBy
+// items of the sort clause.
+func sortExprs(sel *ast.SelectStmt) []ast.Node {
+	var exprs []ast.Node
+	if sel.SortClause != nil {
+		for _, item := range sel.SortClause.Items {
+			if sortBy, ok := item.(*ast.SortBy); ok {
+				exprs = append(exprs, sortBy.Node)
+			}
+		}
+	}
+	if sel.WindowClause != nil {
+		for _, item := range sel.WindowClause.Items {
+			if list, ok := item.(*ast.List); ok {
+				exprs = append(exprs, list.Items...)
+			}
+		}
+	}
+	return exprs
+}
+
+// closingParen returns the index of the parenthesis closing the function call
+// starting at start, skipping over quoted strings.
+func closingParen(query string, start int) int {
+	depth := 0
+	var quote byte
+	for i := start; i < len(query); i++ {
+		ch := query[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isOrderBy(node ast.Node) bool {
+	call, ok := node.(*ast.FuncCall)
+	if !ok {
+		return false
+	}
+	if call.Func == nil {
+		return false
+	}
+	return call.Func.Schema == "sqlc" && call.Func.Name == "orderby"
+}
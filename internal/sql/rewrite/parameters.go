@@ -114,6 +114,11 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool,
 						replace = "?"
 					}
 				}
+			} else if param.IsSqlcSlice() {
+				// The remaining elements of the slice are numbered after
+				// all other parameters and replace the comment at runtime.
+				// This sequence is also replicated in the golang templates.
+				replace = fmt.Sprintf(`$%d/*SLICE:%s*/`, argn, param.Name())
 			} else {
 				replace = fmt.Sprintf("$%d", argn)
 			}
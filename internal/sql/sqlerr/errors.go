@@ -16,7 +16,12 @@ type Error struct {
 	Location int
 	Line     int
 	Column   int
-	// Hint     string
+	// Relation and ColumnName are the names of the relation and column the
+	// error is about, if any.
+	Relation   string
+	ColumnName string
+	// Hint suggests how to fix the error. It follows the message.
+	Hint string
 }
 
 func (e *Error) Unwrap() error {
@@ -24,11 +29,14 @@ func (e *Error) Unwrap() error {
 }
 
 func (e *Error) Error() string {
+	msg := e.Message
 	if e.Err != nil {
-		return fmt.Sprintf("%s %s", e.Message, e.Err.Error())
-	} else {
-		return e.Message
+		msg = fmt.Sprintf("%s %s", e.Message, e.Err.Error())
 	}
+	if e.Hint != "" {
+		msg += ": " + e.Hint
+	}
+	return msg
 }
 
 func ColumnExists(rel, col string) *Error {
@@ -41,9 +49,11 @@ func ColumnExists(rel, col string) *Error {
 
 func ColumnNotFound(rel, col string) *Error {
 	return &Error{
-		Err:     NotFound,
-		Code:    "42703",
-		Message: fmt.Sprintf("column %q of relation %q", col, rel),
+		Err:        NotFound,
+		Code:       "42703",
+		Message:    fmt.Sprintf("column %q of relation %q", col, rel),
+		Relation:   rel,
+		ColumnName: col,
 	}
 }
 
@@ -57,9 +67,10 @@ func RelationExists(rel string) *Error {
 
 func RelationNotFound(rel string) *Error {
 	return &Error{
-		Err:     NotFound,
-		Code:    "42P01",
-		Message: fmt.Sprintf("relation %q", rel),
+		Err:      NotFound,
+		Code:     "42P01",
+		Message:  fmt.Sprintf("relation %q", rel),
+		Relation: rel,
 	}
 }
 
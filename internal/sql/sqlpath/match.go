@@ -0,0 +1,146 @@
+package sqlpath
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Join joins each path with dir, unless it's absolute. Paths starting with !
+// exclude the files they match, and keep the ! in front of the joined path.
+func Join(dir string, paths []string) []string {
+	joined := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if pattern, ok := strings.CutPrefix(p, "!"); ok {
+			joined = append(joined, "!"+join(dir, pattern))
+			continue
+		}
+		joined = append(joined, join(dir, p))
+	}
+	return joined
+}
+
+func join(dir, p string) string {
+	if filepath.IsAbs(p) {
+		return filepath.Clean(p)
+	}
+	return filepath.Join(dir, p)
+}
+
+// Match reports whether Glob would select the file at name, given the same
+// patterns. Unlike Glob, the file doesn't need to exist.
+func Match(patterns []string, name string) bool {
+	includes, excludes := split(patterns)
+	if excluded(excludes, name) {
+		return false
+	}
+	dir := filepath.Dir(name)
+	for _, p := range includes {
+		if isPattern(p) {
+			if match(p, name) || match(p, dir) {
+				return true
+			}
+			continue
+		}
+		if p == name || p == dir {
+			return true
+		}
+	}
+	return false
+}
+
+func isPattern(p string) bool {
+	return strings.ContainsAny(p, "*?[]")
+}
+
+// split separates the patterns that include files from those, starting with
+// !, that exclude them.
+func split(patterns []string) (includes, excludes []string) {
+	for _, p := range patterns {
+		if pattern, ok := strings.CutPrefix(p, "!"); ok {
+			excludes = append(excludes, filepath.Clean(pattern))
+		} else {
+			includes = append(includes, filepath.Clean(p))
+		}
+	}
+	return includes, excludes
+}
+
+// excluded reports whether name, or any directory containing it, matches one
+// of the exclusion patterns.
+func excluded(excludes []string, name string) bool {
+	for _, p := range excludes {
+		for dir := filepath.Clean(name); ; dir = filepath.Dir(dir) {
+			if match(p, dir) {
+				return true
+			}
+			if parent := filepath.Dir(dir); parent == dir {
+				break
+			}
+		}
+	}
+	return false
+}
+
+// match reports whether name matches pattern. In addition to the syntax of
+// path.Match, a ** path element matches any number of directories.
+func match(pattern, name string) bool {
+	return matchElems(
+		strings.Split(filepath.ToSlash(pattern), "/"),
+		strings.Split(filepath.ToSlash(name), "/"),
+	)
+}
+
+func matchElems(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchElems(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// globStar returns the files and directories matching a pattern containing
+// **, in lexical order. Hidden directories are not searched.
+func globStar(pattern string) ([]string, error) {
+	root := pattern
+	for isPattern(root) {
+		root = filepath.Dir(root)
+	}
+	var matches []string
+	err := filepath.WalkDir(root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if name == root && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if d.IsDir() && name != root && strings.HasPrefix(d.Name(), ".") {
+			return fs.SkipDir
+		}
+		if match(pattern, name) {
+			matches = append(matches, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/migrations"
@@ -12,25 +13,41 @@ import (
 // Return a list of SQL files in the listed paths.
 //
 // Only includes files ending in .sql. Omits hidden files, directories, and
-// down migrations.
+// down migrations. The files in a directory are ordered by the version their
+// name starts with, if any.
 
 // If a path contains *, ?, [, or ], treat the path as a pattern and expand it
-// filepath.Glob.
+// filepath.Glob. A ** path element matches any number of directories. Paths
+// starting with ! exclude the files they match, or the files in the
+// directories they match, no matter where they appear in the list.
+//
+// It is an error for a pattern to match nothing. A file matched by several
+// paths is only included once, in the place it is first matched.
 func Glob(patterns []string) ([]string, error) {
-	var files, paths []string
-	for _, pattern := range patterns {
-		if strings.ContainsAny(pattern, "*?[]") {
-			matches, err := filepath.Glob(pattern)
-			if err != nil {
-				return nil, err
-			}
-			// if len(matches) == 0 {
-			// 	slog.Warn("zero files matched", "pattern", pattern)
-			// }
-			paths = append(paths, matches...)
-		} else {
+	includes, excludes := split(patterns)
+	var files, paths, unmatched []string
+	for _, pattern := range includes {
+		var matches []string
+		var err error
+		switch {
+		case strings.Contains(pattern, "**"):
+			matches, err = globStar(pattern)
+		case isPattern(pattern):
+			matches, err = filepath.Glob(pattern)
+		default:
 			paths = append(paths, pattern)
+			continue
 		}
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			unmatched = append(unmatched, fmt.Sprintf("%q", pattern))
+		}
+		paths = append(paths, matches...)
+	}
+	if len(unmatched) > 0 {
+		return nil, fmt.Errorf("no files matched %s", strings.Join(unmatched, ", "))
 	}
 	for _, path := range paths {
 		f, err := os.Stat(path)
@@ -42,6 +59,9 @@ func Glob(patterns []string) ([]string, error) {
 			if err != nil {
 				return nil, err
 			}
+			sort.SliceStable(listing, func(i, j int) bool {
+				return migrations.SortKey(listing[i].Name()) < migrations.SortKey(listing[j].Name())
+			})
 			for _, f := range listing {
 				files = append(files, filepath.Join(path, f.Name()))
 			}
@@ -50,6 +70,7 @@ func Glob(patterns []string) ([]string, error) {
 		}
 	}
 	var sqlFiles []string
+	seen := map[string]bool{}
 	for _, file := range files {
 		if !strings.HasSuffix(file, ".sql") {
 			continue
@@ -60,6 +81,10 @@ func Glob(patterns []string) ([]string, error) {
 		if migrations.IsDown(filepath.Base(file)) {
 			continue
 		}
+		if seen[file] || excluded(excludes, file) {
+			continue
+		}
+		seen[file] = true
 		sqlFiles = append(sqlFiles, file)
 	}
 	return sqlFiles, nil
@@ -241,3 +241,77 @@ func TestGlobPattern(t *testing.T) {
 		}
 	}
 }
+
+func TestGlobStarAndExclusions(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		expected []string
+	}{
+		{
+			patterns: []string{"testdata/glob/**/*.sql"},
+			expected: []string{
+				filepath.Join("testdata", "glob", "sub1", "queries", "file1.sql"),
+				filepath.Join("testdata", "glob", "sub2", "queries", "file2.sql"),
+				filepath.Join("testdata", "glob", "sub3", "queries", "file3.sql"),
+				filepath.Join("testdata", "glob", "sub3", "queries", "file4.sql"),
+			},
+		},
+		{
+			patterns: []string{"testdata/glob/**/file4.sql", "testdata/glob/**/queries"},
+			expected: []string{
+				filepath.Join("testdata", "glob", "sub3", "queries", "file4.sql"),
+				filepath.Join("testdata", "glob", "sub1", "queries", "file1.sql"),
+				filepath.Join("testdata", "glob", "sub2", "queries", "file2.sql"),
+				filepath.Join("testdata", "glob", "sub3", "queries", "file3.sql"),
+			},
+		},
+		{
+			patterns: []string{"!testdata/glob/sub2", "testdata/glob/**/*.sql", "!testdata/**/file4.sql"},
+			expected: []string{
+				filepath.Join("testdata", "glob", "sub1", "queries", "file1.sql"),
+				filepath.Join("testdata", "glob", "sub3", "queries", "file3.sql"),
+			},
+		},
+		{
+			patterns: []string{"testdata/glob/*/queries", "!testdata/glob/sub[13]/**"},
+			expected: []string{
+				filepath.Join("testdata", "glob", "sub2", "queries", "file2.sql"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		result, err := Glob(test.patterns)
+		if err != nil {
+			t.Errorf("Patterns %v: Expected no error, but got %v", test.patterns, err)
+		}
+		if diff := cmp.Diff(test.expected, result); diff != "" {
+			t.Errorf("Patterns %v: unexpected files:\n%s", test.patterns, diff)
+		}
+		for _, file := range test.expected {
+			if !Match(test.patterns, file) {
+				t.Errorf("Patterns %v: Expected %s to match", test.patterns, file)
+			}
+		}
+	}
+}
+
+func TestGlobReportsPatternsMatchingNothing(t *testing.T) {
+	_, err := Glob([]string{"testdata/glob/*/queries", "testdata/nothing/*.sql", "testdata/glob/**/missing"})
+	expected := `no files matched "testdata/nothing/*.sql", "testdata/glob/**/missing"`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, but got %v", expected, err)
+	}
+}
+
+func TestJoinKeepsExclusions(t *testing.T) {
+	result := Join("dir", []string{"queries", "!queries/testdata", "./schema/*.sql"})
+	expected := []string{
+		filepath.Join("dir", "queries"),
+		"!" + filepath.Join("dir", "queries", "testdata"),
+		filepath.Join("dir", "schema", "*.sql"),
+	}
+	if diff := cmp.Diff(expected, result); diff != "" {
+		t.Errorf("unexpected paths:\n%s", diff)
+	}
+}
@@ -66,6 +66,11 @@ func Cmd(n ast.Node, name, cmd string) error {
 	if cmd == metadata.CmdCopyFrom {
 		return validateCopyfrom(n)
 	}
+	if cmd == metadata.CmdExecLastId {
+		if _, ok := n.(*ast.InsertStmt); !ok {
+			return fmt.Errorf("query %q specifies parameter %q without being an INSERT statement", name, cmd)
+		}
+	}
 	if (cmd == metadata.CmdBatchExec || cmd == metadata.CmdBatchMany) || cmd == metadata.CmdBatchOne {
 		if err := validateBatch(n); err != nil {
 			return err
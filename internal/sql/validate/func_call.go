@@ -2,6 +2,7 @@ package validate
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
@@ -21,6 +22,10 @@ func (v *funcCallVisitor) Visit(node ast.Node) astutils.Visitor {
 		return nil
 	}
 
+	if n, ok := node.(*ast.RangeFunction); ok {
+		return v.visitRangeFunction(n)
+	}
+
 	call, ok := node.(*ast.FuncCall)
 	if !ok {
 		return v
@@ -45,6 +50,34 @@ func (v *funcCallVisitor) Visit(node ast.Node) astutils.Visitor {
 	return nil
 }
 
+// visitRangeFunction checks the functions in a FROM clause. An unnest of
+// several arrays is only allowed there, and isn't in the catalog, so only its
+// arguments are checked.
+func (v *funcCallVisitor) visitRangeFunction(n *ast.RangeFunction) astutils.Visitor {
+	if n.Functions == nil {
+		return v
+	}
+	for _, item := range n.Functions.Items {
+		fn := item
+		if list, ok := item.(*ast.List); ok && len(list.Items) > 0 {
+			fn = list.Items[0]
+		}
+		call, ok := fn.(*ast.FuncCall)
+		if ok && isMultiUnnest(call) {
+			astutils.Walk(v, call.Args)
+			continue
+		}
+		astutils.Walk(v, item)
+	}
+	return nil
+}
+
+func isMultiUnnest(call *ast.FuncCall) bool {
+	return call.Func != nil && strings.EqualFold(call.Func.Name, "unnest") &&
+		(call.Func.Schema == "" || call.Func.Schema == "pg_catalog") &&
+		call.Args != nil && len(call.Args.Items) > 1
+}
+
 func FuncCall(c *catalog.Catalog, cs config.CombinedSettings, n ast.Node) error {
 	visitor := funcCallVisitor{catalog: c, settings: cs}
 	astutils.Walk(&visitor, n)
@@ -0,0 +1,107 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// GeneratedColumns checks that an INSERT or UPDATE statement only sets the
+// generated columns of its table to DEFAULT, as their values are computed by
+// the database.
+func GeneratedColumns(c *catalog.Catalog, stmt ast.Node) error {
+	switch n := stmt.(type) {
+	case *ast.InsertStmt:
+		if n.Cols == nil {
+			return nil
+		}
+		generated := generatedColumns(c, n.Relation)
+		if len(generated) == 0 {
+			return nil
+		}
+		sel, _ := n.SelectStmt.(*ast.SelectStmt)
+		for i, item := range n.Cols.Items {
+			target, ok := item.(*ast.ResTarget)
+			if !ok || target.Name == nil || !generated[*target.Name] {
+				continue
+			}
+			if sel != nil && onlyDefaults(sel.ValuesLists, i) {
+				continue
+			}
+			return &sqlerr.Error{
+				Code:     "428C9",
+				Message:  fmt.Sprintf("cannot insert a non-DEFAULT value into column %q", *target.Name),
+				Location: target.Location,
+			}
+		}
+
+	case *ast.UpdateStmt:
+		if n.Relations == nil || len(n.Relations.Items) == 0 || n.TargetList == nil {
+			return nil
+		}
+		rv, ok := n.Relations.Items[0].(*ast.RangeVar)
+		if !ok {
+			return nil
+		}
+		generated := generatedColumns(c, rv)
+		for _, item := range n.TargetList.Items {
+			target, ok := item.(*ast.ResTarget)
+			if !ok || target.Name == nil || !generated[*target.Name] {
+				continue
+			}
+			if _, ok := target.Val.(*ast.SetToDefault); ok {
+				continue
+			}
+			return &sqlerr.Error{
+				Code:     "428C9",
+				Message:  fmt.Sprintf("column %q can only be updated to DEFAULT", *target.Name),
+				Location: target.Location,
+			}
+		}
+	}
+	return nil
+}
+
+// generatedColumns returns the names of the generated columns of the table.
+// Tables missing from the catalog are reported elsewhere.
+func generatedColumns(c *catalog.Catalog, rv *ast.RangeVar) map[string]bool {
+	if rv == nil || rv.Relname == nil {
+		return nil
+	}
+	rel := &ast.TableName{Name: *rv.Relname}
+	if rv.Schemaname != nil {
+		rel.Schema = *rv.Schemaname
+	}
+	table, err := c.GetTable(rel)
+	if err != nil {
+		return nil
+	}
+	generated := map[string]bool{}
+	for _, col := range table.Columns {
+		if col.IsGenerated {
+			generated[col.Name] = true
+		}
+	}
+	return generated
+}
+
+// onlyDefaults reports whether each row of a VALUES list sets the column at
+// index i to DEFAULT. Statements inserting the rows of a SELECT have no VALUES
+// list, and a SELECT can't produce DEFAULT.
+func onlyDefaults(rows *ast.List, i int) bool {
+	if rows == nil || len(rows.Items) == 0 {
+		return false
+	}
+	for _, item := range rows.Items {
+		row, ok := item.(*ast.List)
+		if !ok || len(row.Items) <= i {
+			return false
+		}
+		if _, ok := row.Items[i].(*ast.SetToDefault); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -21,6 +21,11 @@ func InsertStmt(stmt *ast.InsertStmt) error {
 		return nil
 	}
 
+	// Without a column list, the values are checked against the columns of
+	// the table by the compiler
+	if stmt.Cols == nil || len(stmt.Cols.Items) == 0 {
+		return nil
+	}
 	colsLen := len(stmt.Cols.Items)
 	valsLen := len(sublist.Items)
 	switch {
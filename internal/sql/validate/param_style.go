@@ -2,6 +2,7 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
@@ -29,12 +30,42 @@ func (v *sqlcFuncVisitor) Visit(node ast.Node) astutils.Visitor {
 	// Custom validation for sqlc.arg, sqlc.narg and sqlc.slice
 	// TODO: Replace this once type-checking is implemented
 	if fn.Schema == "sqlc" {
-		if !(fn.Name == "arg" || fn.Name == "narg" || fn.Name == "slice" || fn.Name == "embed") {
+		if !(fn.Name == "arg" || fn.Name == "narg" || fn.Name == "slice" || fn.Name == "embed" || fn.Name == "orderby") {
 			v.err = sqlerr.FunctionNotFound("sqlc." + fn.Name)
 			return nil
 		}
 
-		if len(call.Args.Items) != 1 {
+		if fn.Name == "orderby" {
+			if call.Args == nil || len(call.Args.Items) == 0 {
+				v.err = &sqlerr.Error{
+					Message:  "expected at least 1 parameter to sqlc.orderby; got 0",
+					Location: call.Pos(),
+				}
+				return nil
+			}
+			for _, arg := range call.Args.Items {
+				switch n := arg.(type) {
+				case *ast.A_Const:
+				case *ast.ColumnRef:
+				default:
+					v.err = &sqlerr.Error{
+						Message:  fmt.Sprintf("expected parameters to sqlc.orderby to be strings or references; got %T", n),
+						Location: call.Pos(),
+					}
+					return nil
+				}
+			}
+			return nil
+		}
+
+		// sqlc.embed takes the name of the embedded table as an optional
+		// second parameter
+		if fn.Name == "embed" && len(call.Args.Items) == 2 {
+			if err := embedName(call); err != nil {
+				v.err = err
+				return nil
+			}
+		} else if len(call.Args.Items) != 1 {
 			v.err = &sqlerr.Error{
 				Message:  fmt.Sprintf("expected 1 parameter to sqlc.%s; got %d", fn.Name, len(call.Args.Items)),
 				Location: call.Pos(),
@@ -61,6 +92,26 @@ func (v *sqlcFuncVisitor) Visit(node ast.Node) astutils.Visitor {
 	return nil
 }
 
+var validEmbedName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// embedName checks the second parameter of sqlc.embed, which names the
+// embedded table in the results of the query.
+func embedName(call *ast.FuncCall) error {
+	var name string
+	if c, ok := call.Args.Items[1].(*ast.A_Const); ok {
+		if s, ok := c.Val.(*ast.String); ok {
+			name = s.Str
+		}
+	}
+	if !validEmbedName.MatchString(name) {
+		return &sqlerr.Error{
+			Message:  "expected second parameter to sqlc.embed to be a string holding an identifier",
+			Location: call.Pos(),
+		}
+	}
+	return nil
+}
+
 func SqlcFunctions(n ast.Node) error {
 	visitor := sqlcFuncVisitor{}
 	astutils.Walk(&visitor, n)
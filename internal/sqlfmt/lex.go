@@ -0,0 +1,275 @@
+package sqlfmt
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type kind int
+
+const (
+	kindWord kind = iota
+	kindKeyword
+	kindQuoted
+	kindNumber
+	kindParam
+	kindOperator
+	kindPunct
+	kindLineComment
+	kindBlockComment
+	kindVerbatim
+)
+
+type token struct {
+	kind kind
+	text string
+	// newline is set if the token was preceded by a line break, and blank if
+	// it was preceded by an empty line. column0 is set if the token starts a
+	// line.
+	newline bool
+	blank   bool
+	column0 bool
+	start   int
+	end     int
+}
+
+func (t token) is(text string) bool {
+	return (t.kind == kindWord || t.kind == kindKeyword) && strings.EqualFold(t.text, text)
+}
+
+func (t token) isPunct(text string) bool {
+	return t.kind == kindPunct && t.text == text
+}
+
+func isWordStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= utf8.RuneSelf
+}
+
+func isWordChar(c byte) bool {
+	return isWordStart(c) || (c >= '0' && c <= '9') || c == '$'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+const operatorChars = "+-*/<>=~!@#%^&|`?:"
+
+type lexer struct {
+	src     string
+	dialect Dialect
+	pos     int
+	tokens  []token
+}
+
+// lex splits src into tokens, dropping whitespace.
+func lex(src string, dialect Dialect) ([]token, error) {
+	l := &lexer{src: src, dialect: dialect}
+	for {
+		newlines := l.skipSpace()
+		if l.pos >= len(l.src) {
+			return l.tokens, nil
+		}
+		start := l.pos
+		k, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		l.tokens = append(l.tokens, token{
+			kind:    k,
+			text:    strings.TrimRight(l.src[start:l.pos], " \t\r"),
+			newline: newlines > 0,
+			blank:   newlines > 1,
+			column0: start == 0 || l.src[start-1] == '\n',
+			start:   start,
+			end:     l.pos,
+		})
+	}
+}
+
+func (l *lexer) skipSpace() int {
+	newlines := 0
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '\n':
+			newlines++
+		case ' ', '\t', '\r', '\f', '\v':
+		default:
+			return newlines
+		}
+		l.pos++
+	}
+	return newlines
+}
+
+func (l *lexer) peek(offset int) byte {
+	if l.pos+offset < len(l.src) {
+		return l.src[l.pos+offset]
+	}
+	return 0
+}
+
+func (l *lexer) next() (kind, error) {
+	c := l.src[l.pos]
+	switch {
+	case c == '-' && l.peek(1) == '-', c == '#' && l.dialect.HashComments:
+		l.skipLine()
+		return kindLineComment, nil
+	case c == '/' && l.peek(1) == '*':
+		end := strings.Index(l.src[l.pos+2:], "*/")
+		if end < 0 {
+			return 0, fmt.Errorf("unterminated comment at offset %d", l.pos)
+		}
+		l.pos += end + 4
+		return kindBlockComment, nil
+	case c == '\'':
+		return kindQuoted, l.quoted('\'', l.dialect.BackslashEscapes)
+	case c == '"':
+		return kindQuoted, l.quoted('"', l.dialect.BackslashEscapes)
+	case c == '`':
+		return kindQuoted, l.quoted('`', false)
+	case c == '$' && isDigit(l.peek(1)):
+		l.pos++
+		for l.pos < len(l.src) && isDigit(l.src[l.pos]) {
+			l.pos++
+		}
+		return kindParam, nil
+	case c == '$' && l.dialect.DollarQuotes:
+		if ok, err := l.dollarQuoted(); ok || err != nil {
+			return kindQuoted, err
+		}
+	case (c == '@' || (c == ':' && l.dialect.ColonParams)) && isWordStart(l.peek(1)):
+		l.pos++
+		l.skipWord()
+		return kindParam, nil
+	case c == '?' && l.dialect.QuestionParams:
+		l.pos++
+		for l.pos < len(l.src) && isDigit(l.src[l.pos]) {
+			l.pos++
+		}
+		return kindParam, nil
+	case isDigit(c), c == '.' && isDigit(l.peek(1)):
+		l.number()
+		return kindNumber, nil
+	case isWordStart(c):
+		// String constants with a prefix, such as E'\n' or X'ff'
+		if strings.ContainsRune("EeBbXxNn", rune(c)) && l.peek(1) == '\'' {
+			l.pos++
+			return kindQuoted, l.quoted('\'', l.dialect.BackslashEscapes || c == 'E' || c == 'e')
+		}
+		l.skipWord()
+		return kindWord, nil
+	case strings.IndexByte("(),;.[]", c) >= 0:
+		l.pos++
+		return kindPunct, nil
+	}
+	if c == ':' && l.peek(1) == ':' {
+		l.pos += 2
+		return kindOperator, nil
+	}
+	l.operator()
+	return kindOperator, nil
+}
+
+func (l *lexer) skipLine() {
+	end := strings.IndexByte(l.src[l.pos:], '\n')
+	if end < 0 {
+		l.pos = len(l.src)
+	} else {
+		l.pos += end
+	}
+}
+
+func (l *lexer) skipWord() {
+	for l.pos < len(l.src) && isWordChar(l.src[l.pos]) {
+		l.pos++
+	}
+}
+
+func (l *lexer) quoted(quote byte, backslash bool) error {
+	start := l.pos
+	l.pos++
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\\' && backslash:
+			l.pos += 2
+			continue
+		case c == quote && l.peek(1) == quote:
+			l.pos += 2
+			continue
+		case c == quote:
+			l.pos++
+			return nil
+		}
+		l.pos++
+	}
+	return fmt.Errorf("unterminated %c at offset %d", quote, start)
+}
+
+// dollarQuoted reads a dollar-quoted string, such as $$body$$ or $fn$body$fn$.
+func (l *lexer) dollarQuoted() (bool, error) {
+	end := l.pos + 1
+	for end < len(l.src) && l.src[end] != '$' {
+		if !isWordChar(l.src[end]) || l.src[end] == '$' {
+			return false, nil
+		}
+		end++
+	}
+	if end >= len(l.src) {
+		return false, nil
+	}
+	tag := l.src[l.pos : end+1]
+	closing := strings.Index(l.src[end+1:], tag)
+	if closing < 0 {
+		return true, fmt.Errorf("unterminated %s at offset %d", tag, l.pos)
+	}
+	l.pos = end + 1 + closing + len(tag)
+	return true, nil
+}
+
+func (l *lexer) number() {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case isDigit(c) || c == '.' || c == '_' || isWordStart(c):
+			l.pos++
+			// Exponents, such as 1e-5
+			if (c == 'e' || c == 'E') && (l.peek(0) == '+' || l.peek(0) == '-') && isDigit(l.peek(1)) {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// operator reads an operator the way PostgreSQL does: a run of operator
+// characters, which doesn't end in + or - unless it contains one of the
+// characters that only appear in user-defined operators.
+func (l *lexer) operator() {
+	start := l.pos
+	for l.pos < len(l.src) && strings.IndexByte(operatorChars, l.src[l.pos]) >= 0 {
+		if l.pos > start && (strings.HasPrefix(l.src[l.pos:], "--") || strings.HasPrefix(l.src[l.pos:], "/*")) {
+			break
+		}
+		if l.src[l.pos] == '?' && l.dialect.QuestionParams {
+			break
+		}
+		l.pos++
+	}
+	if l.pos == start {
+		// Unknown characters are kept as they are
+		_, size := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += size
+		return
+	}
+	op := l.src[start:l.pos]
+	if !strings.ContainsAny(op, "~!@#%^&|`?") {
+		for len(op) > 1 && (op[len(op)-1] == '+' || op[len(op)-1] == '-') {
+			op = op[:len(op)-1]
+		}
+	}
+	l.pos = start + len(op)
+}
@@ -0,0 +1,440 @@
+// Package sqlfmt formats SQL query files.
+//
+// The formatter works on tokens rather than on a parsed statement, so that
+// comments, sqlc annotations and macros such as sqlc.arg() survive exactly as
+// they were written. Keywords are uppercased, each clause starts a new line
+// and subqueries are indented. Formatting is idempotent: formatting the output
+// again doesn't change it.
+package sqlfmt
+
+import (
+	"strings"
+)
+
+// Dialect describes the lexical rules of a database engine.
+type Dialect struct {
+	// HashComments is set if # starts a line comment
+	HashComments bool
+	// BackslashEscapes is set if a backslash escapes quotes in strings
+	BackslashEscapes bool
+	// DollarQuotes is set if strings can be quoted as $tag$...$tag$
+	DollarQuotes bool
+	// QuestionParams is set if ? is a parameter, rather than an operator
+	QuestionParams bool
+	// ColonParams is set if :name is a parameter
+	ColonParams bool
+}
+
+var (
+	PostgreSQL = Dialect{DollarQuotes: true}
+	MySQL      = Dialect{HashComments: true, BackslashEscapes: true, QuestionParams: true}
+	SQLite     = Dialect{QuestionParams: true, ColonParams: true}
+)
+
+const indent = "    "
+
+// keywords are uppercased. Only reserved words are listed, as others are
+// commonly used as unquoted identifiers.
+var keywords = map[string]struct{}{}
+
+func init() {
+	for _, kw := range strings.Fields(`
+		ALL AND ANY AS ASC BETWEEN BY CASE CONFLICT CROSS DEFAULT DELETE DESC
+		DISTINCT DO DUPLICATE ELSE END EXCEPT EXISTS FALSE FETCH FILTER FOR FROM
+		FULL GROUP HAVING ILIKE IN INNER INSERT INTERSECT INTO IS JOIN LATERAL
+		LEFT LIKE LIMIT NATURAL NOT NOTHING NULL OFFSET ON ONLY OR ORDER OUTER
+		OVER PARTITION RECURSIVE RETURNING RIGHT SELECT SET SOME THEN TRUE UNION
+		UPDATE USING VALUES WHEN WHERE WINDOW WITH WITHIN`) {
+		keywords[kw] = struct{}{}
+	}
+}
+
+// clauses start a new line when they appear outside of an expression.
+var clauses = map[string]struct{}{
+	"SELECT": {}, "FROM": {}, "WHERE": {}, "GROUP": {}, "HAVING": {},
+	"WINDOW": {}, "ORDER": {}, "LIMIT": {}, "OFFSET": {}, "FETCH": {},
+	"UNION": {}, "EXCEPT": {}, "INTERSECT": {}, "RETURNING": {}, "SET": {},
+	"VALUES": {}, "INSERT": {}, "UPDATE": {}, "DELETE": {}, "ON": {},
+	"FOR": {}, "JOIN": {}, "LEFT": {}, "RIGHT": {}, "FULL": {}, "INNER": {},
+	"CROSS": {}, "NATURAL": {},
+}
+
+// lockingWords are uppercased in locking clauses such as FOR UPDATE SKIP
+// LOCKED, where they're used as keywords.
+var lockingWords = map[string]struct{}{
+	"UPDATE": {}, "SHARE": {}, "NO": {}, "KEY": {}, "OF": {}, "SKIP": {},
+	"LOCKED": {}, "NOWAIT": {},
+}
+
+var joinWords = map[string]struct{}{
+	"JOIN": {}, "LEFT": {}, "RIGHT": {}, "FULL": {}, "INNER": {}, "CROSS": {},
+	"NATURAL": {}, "OUTER": {},
+}
+
+// Format formats the statements in src. Files that can't be tokenized, for
+// example because a string is never closed, return an error.
+func Format(src string, dialect Dialect) (string, error) {
+	tokens, err := lex(src, dialect)
+	if err != nil {
+		return "", err
+	}
+	tokens = joinMacros(src, tokens)
+	p := printer{tokens: tokens}
+	for i := range tokens {
+		p.print(i)
+	}
+	if p.out.Len() == 0 {
+		return "", nil
+	}
+	return p.out.String() + "\n", nil
+}
+
+// joinMacros replaces calls to sqlc macros, such as sqlc.arg(name), with a
+// single token holding the call as it was written.
+func joinMacros(src string, tokens []token) []token {
+	var out []token
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if t.is("sqlc") && i+3 < len(tokens) && tokens[i+1].isPunct(".") && tokens[i+2].kind == kindWord && tokens[i+3].isPunct("(") {
+			depth := 0
+			for j := i + 3; j < len(tokens); j++ {
+				switch {
+				case tokens[j].isPunct("("):
+					depth++
+				case tokens[j].isPunct(")"):
+					depth--
+				}
+				if depth == 0 {
+					t.kind = kindVerbatim
+					t.end = tokens[j].end
+					t.text = src[t.start:t.end]
+					i = j
+					break
+				}
+			}
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+type paren struct {
+	subquery bool
+}
+
+type printer struct {
+	tokens []token
+	out    strings.Builder
+
+	parens []paren
+	// pending is the number of line breaks to write before the next token
+	pending int
+	// lineEmpty is set if nothing has been written on the current line
+	lineEmpty bool
+	// prev and prevPrev are the last two tokens written, skipping comments
+	prev, prevPrev *token
+	// unary is set if the last token written was a unary operator
+	unary bool
+	// locking is set while printing a locking clause such as FOR UPDATE
+	locking bool
+	// afterInto is set while writing the name of the table following INTO
+	afterInto bool
+}
+
+func (p *printer) depth() int {
+	n := 0
+	for _, paren := range p.parens {
+		if paren.subquery {
+			n++
+		}
+	}
+	return n
+}
+
+// clauseLevel reports whether the printer is outside of any expression, where
+// clauses start a new line.
+func (p *printer) clauseLevel() bool {
+	return len(p.parens) == 0 || p.parens[len(p.parens)-1].subquery
+}
+
+func (p *printer) breakLine(n int) {
+	if n > p.pending {
+		p.pending = n
+	}
+}
+
+// write writes text, preceded by any pending line breaks or by a space.
+func (p *printer) write(text string, space bool) {
+	p.writeIndented(text, space, p.depth())
+}
+
+func (p *printer) writeIndented(text string, space bool, depth int) {
+	if p.out.Len() > 0 && p.pending > 0 {
+		p.out.WriteString(strings.Repeat("\n", p.pending))
+		p.out.WriteString(strings.Repeat(indent, depth))
+		p.lineEmpty = true
+	}
+	p.pending = 0
+	if space && !p.lineEmpty && p.out.Len() > 0 {
+		p.out.WriteByte(' ')
+	}
+	p.out.WriteString(text)
+	p.lineEmpty = false
+}
+
+// next returns the next token after i that isn't a comment.
+func (p *printer) next(i int) *token {
+	for j := i + 1; j < len(p.tokens); j++ {
+		if k := p.tokens[j].kind; k != kindLineComment && k != kindBlockComment {
+			return &p.tokens[j]
+		}
+	}
+	return nil
+}
+
+func (p *printer) print(i int) {
+	t := p.tokens[i]
+	switch t.kind {
+	case kindLineComment, kindBlockComment:
+		p.comment(i)
+		return
+	}
+
+	text := t.text
+	keyword := ""
+	if t.kind == kindWord && (p.prev == nil || !p.prev.isPunct(".")) {
+		upper := strings.ToUpper(text)
+		_, ok := keywords[upper]
+		if _, lock := lockingWords[upper]; lock && p.locking {
+			ok = true
+		}
+		// KEY is only reserved in MySQL's ON DUPLICATE KEY UPDATE
+		if ok || (upper == "KEY" && p.prev != nil && p.prev.is("DUPLICATE")) {
+			// LEFT and RIGHT are also functions
+			next := p.next(i)
+			if !((upper == "LEFT" || upper == "RIGHT") && next != nil && next.isPunct("(")) {
+				keyword = upper
+				text = upper
+			}
+		}
+	}
+
+	if keyword != "" && p.clauseLevel() && p.startsClause(i, keyword) {
+		p.breakLine(1)
+		p.locking = keyword == "FOR"
+	}
+
+	switch {
+	case t.isPunct("("):
+		next := p.next(i)
+		subquery := next != nil && (next.is("SELECT") || next.is("WITH") || next.is("VALUES"))
+		p.write(text, p.spaceBeforeParen(subquery))
+		p.parens = append(p.parens, paren{subquery: subquery})
+		if subquery {
+			p.breakLine(1)
+		}
+	case t.isPunct(")"):
+		if len(p.parens) > 0 {
+			if p.parens[len(p.parens)-1].subquery {
+				p.parens = p.parens[:len(p.parens)-1]
+				p.breakLine(1)
+			} else {
+				p.parens = p.parens[:len(p.parens)-1]
+			}
+		}
+		p.write(text, false)
+	case t.isPunct(";"):
+		p.parens = nil
+		p.locking = false
+		p.pending = 0
+		p.write(text, false)
+		p.breakLine(2)
+	default:
+		p.write(text, p.space(t))
+	}
+
+	p.unary = t.kind == kindOperator && (t.text == "-" || t.text == "+") && p.operandExpected()
+	switch {
+	case keyword == "INTO":
+		p.afterInto = true
+	case t.kind == kindWord && keyword == "", t.kind == kindQuoted, t.isPunct("."):
+	default:
+		p.afterInto = false
+	}
+	prev := t
+	if keyword != "" {
+		prev = token{kind: kindKeyword, text: keyword}
+	}
+	p.prevPrev, p.prev = p.prev, &prev
+}
+
+// operandExpected reports whether the token before the one being printed
+// leaves an operand to follow, making + and - unary.
+func (p *printer) operandExpected() bool {
+	prev := p.prev
+	if prev == nil {
+		return true
+	}
+	switch prev.kind {
+	case kindOperator:
+		return true
+	case kindPunct:
+		return prev.text != ")" && prev.text != "]"
+	case kindKeyword:
+		switch prev.text {
+		case "NULL", "TRUE", "FALSE", "END":
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (p *printer) space(t token) bool {
+	prev := p.prev
+	if prev == nil || p.unary {
+		return false
+	}
+	switch {
+	case t.kind == kindPunct && t.text != "(":
+		// , ; . [ ] ) hug the previous token
+		return t.text == "[" && prev.kind == kindKeyword
+	case prev.isPunct("(") || prev.isPunct("[") || prev.isPunct("."):
+		return false
+	case t.kind == kindOperator && (t.text == "::" || t.text == ":"):
+		return false
+	case prev.kind == kindOperator && (prev.text == "::" || prev.text == ":"):
+		return false
+	}
+	return true
+}
+
+// spaceBeforeParen reports whether an opening parenthesis is preceded by a
+// space. Function calls aren't, nor are the column lists of tables, unless
+// the table follows INTO.
+func (p *printer) spaceBeforeParen(subquery bool) bool {
+	prev := p.prev
+	if prev == nil || p.unary {
+		return false
+	}
+	switch prev.kind {
+	case kindKeyword:
+		switch prev.text {
+		case "ANY", "SOME", "ALL":
+			return subquery
+		case "VALUES":
+			return !p.valuesFunc()
+		}
+		return true
+	case kindWord, kindQuoted, kindVerbatim:
+		return p.afterInto
+	case kindPunct:
+		return prev.text == ","
+	}
+	return true
+}
+
+// valuesFunc reports whether the VALUES just written is the MySQL function
+// VALUES(col), rather than a VALUES list.
+func (p *printer) valuesFunc() bool {
+	pp := p.prevPrev
+	return pp != nil && (pp.kind == kindOperator || pp.isPunct(",") || pp.isPunct("("))
+}
+
+// startsClause reports whether the keyword at i starts a new clause.
+func (p *printer) startsClause(i int, keyword string) bool {
+	if _, ok := clauses[keyword]; !ok {
+		return false
+	}
+	prevIs := func(words ...string) bool {
+		if p.prev == nil {
+			return false
+		}
+		for _, w := range words {
+			if p.prev.is(w) {
+				return true
+			}
+		}
+		return false
+	}
+	next := p.next(i)
+	nextIs := func(words ...string) bool {
+		if next == nil {
+			return false
+		}
+		for _, w := range words {
+			if next.is(w) {
+				return true
+			}
+		}
+		return false
+	}
+	switch keyword {
+	case "FROM":
+		return !prevIs("DELETE", "DISTINCT")
+	case "GROUP", "ORDER":
+		return nextIs("BY")
+	case "ON":
+		return nextIs("CONFLICT", "DUPLICATE")
+	case "FOR":
+		return nextIs("UPDATE", "SHARE", "NO", "KEY")
+	case "SET":
+		return !prevIs("CHARACTER") && !(prevIs("UPDATE") && p.prevPrev != nil && p.prevPrev.is("DO"))
+	case "VALUES":
+		pp := p.prev
+		return !(pp != nil && (pp.kind == kindOperator || pp.isPunct(",") || pp.isPunct("(")))
+	case "INSERT", "UPDATE", "DELETE":
+		return p.prev != nil && p.prev.isPunct(")")
+	case "JOIN", "LEFT", "RIGHT", "FULL", "INNER", "CROSS", "NATURAL":
+		if p.prev != nil {
+			if _, ok := joinWords[p.prev.text]; ok && p.prev.kind == kindKeyword {
+				return false
+			}
+		}
+		if keyword == "JOIN" {
+			return true
+		}
+		if next == nil || next.kind != kindWord {
+			return false
+		}
+		_, ok := joinWords[strings.ToUpper(next.text)]
+		return ok
+	}
+	return true
+}
+
+// comment writes a comment. Comments on a line of their own stay on a line of
+// their own, as do the empty lines before them. Comments at the end of a line
+// stay there.
+//
+// sqlc turns comments starting a line into doc comments, so those stay at
+// the start of the line, and other comments are always indented.
+func (p *printer) comment(i int) {
+	t := p.tokens[i]
+	standalone := t.newline || p.out.Len() == 0
+	if standalone {
+		if t.blank {
+			p.breakLine(2)
+		} else {
+			p.breakLine(1)
+		}
+		depth := 0
+		if !t.column0 {
+			depth = max(p.depth(), 1)
+		}
+		p.writeIndented(t.text, false, depth)
+	} else {
+		// Keep the comment on the line it was written on
+		pending := p.pending
+		p.pending = 0
+		p.write(t.text, true)
+		p.pending = pending
+	}
+	if t.kind == kindLineComment {
+		p.breakLine(1)
+		return
+	}
+	if i+1 < len(p.tokens) && p.tokens[i+1].newline {
+		p.breakLine(1)
+	}
+}
@@ -0,0 +1,134 @@
+package sqlfmt
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFormat(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		dialect Dialect
+		input   string
+		output  string
+	}{
+		{
+			name:    "clauses",
+			dialect: PostgreSQL,
+			input: `-- name: ListAuthors :many
+select id, name from authors where id in (select author_id from books where title = $1) order by name;
+`,
+			output: `-- name: ListAuthors :many
+SELECT id, name
+FROM authors
+WHERE id IN (
+    SELECT author_id
+    FROM books
+    WHERE title = $1
+)
+ORDER BY name;
+`,
+		},
+		{
+			name:    "macros and comments",
+			dialect: PostgreSQL,
+			input: `-- name: GetAuthor :one
+select * from authors
+where id = sqlc.arg(  id ) and name = @name
+  -- keep me
+limit 1; -- trailing
+
+
+-- name: CountAuthors :one
+SELECT count(*) FROM authors;`,
+			output: `-- name: GetAuthor :one
+SELECT *
+FROM authors
+WHERE id = sqlc.arg(  id ) AND name = @name
+    -- keep me
+LIMIT 1; -- trailing
+
+-- name: CountAuthors :one
+SELECT count(*)
+FROM authors;
+`,
+		},
+		{
+			name:    "mysql",
+			dialect: MySQL,
+			input: `-- name: UpsertAuthor :exec
+insert into authors (id, name) values (?, ?) on duplicate key update name = values(name);
+# done
+`,
+			output: `-- name: UpsertAuthor :exec
+INSERT INTO authors (id, name)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE name = VALUES(name);
+
+# done
+`,
+		},
+		{
+			name:    "locking clauses",
+			dialect: PostgreSQL,
+			input: `-- name: NextJob :one
+select id from jobs where done = false order by id limit 1 for no key update of jobs skip locked;
+
+-- name: LockAuthor :one
+select * from authors where id = $1 for share nowait;
+`,
+			output: `-- name: NextJob :one
+SELECT id
+FROM jobs
+WHERE done = FALSE
+ORDER BY id
+LIMIT 1
+FOR NO KEY UPDATE OF jobs SKIP LOCKED;
+
+-- name: LockAuthor :one
+SELECT *
+FROM authors
+WHERE id = $1
+FOR SHARE NOWAIT;
+`,
+		},
+		{
+			name:    "sqlite",
+			dialect: SQLite,
+			input: `-- name: GetBook :one
+select * from books left join authors on books.author_id = authors.id where books.id = :id and books.year > -1;
+`,
+			output: `-- name: GetBook :one
+SELECT *
+FROM books
+LEFT JOIN authors ON books.author_id = authors.id
+WHERE books.id = :id AND books.year > -1;
+`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := Format(tc.input, tc.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.output, out); diff != "" {
+				t.Errorf("output differs (-want +got):\n%s", diff)
+			}
+			again, err := Format(out, tc.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(out, again); diff != "" {
+				t.Errorf("formatting isn't idempotent (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	if _, err := Format("SELECT 'unterminated", PostgreSQL); err == nil {
+		t.Error("expected an error for an unterminated string")
+	}
+}
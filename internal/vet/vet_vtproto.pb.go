@@ -57,6 +57,11 @@ func (m *Config) CloneVT() *Config {
 		copy(tmpContainer, rhs)
 		r.Queries = tmpContainer
 	}
+	if rhs := m.DeprecatedQueries; rhs != nil {
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		r.DeprecatedQueries = tmpContainer
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -73,9 +78,10 @@ func (m *Query) CloneVT() *Query {
 		return (*Query)(nil)
 	}
 	r := &Query{
-		Sql:  m.Sql,
-		Name: m.Name,
-		Cmd:  m.Cmd,
+		Sql:        m.Sql,
+		Name:       m.Name,
+		Cmd:        m.Cmd,
+		Deprecated: m.Deprecated,
 	}
 	if rhs := m.Params; rhs != nil {
 		tmpContainer := make([]*Parameter, len(rhs))
@@ -450,6 +456,15 @@ func (this *Config) EqualVT(that *Config) bool {
 			return false
 		}
 	}
+	if len(this.DeprecatedQueries) != len(that.DeprecatedQueries) {
+		return false
+	}
+	for i, vx := range this.DeprecatedQueries {
+		vy := that.DeprecatedQueries[i]
+		if vx != vy {
+			return false
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -492,6 +507,9 @@ func (this *Query) EqualVT(that *Query) bool {
 			}
 		}
 	}
+	if this.Deprecated != that.Deprecated {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1054,6 +1072,15 @@ func (m *Config) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.DeprecatedQueries) > 0 {
+		for iNdEx := len(m.DeprecatedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeprecatedQueries[iNdEx])
+			copy(dAtA[i:], m.DeprecatedQueries[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.DeprecatedQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Queries) > 0 {
 		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Queries[iNdEx])
@@ -1119,6 +1146,13 @@ func (m *Query) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.Deprecated) > 0 {
+		i -= len(m.Deprecated)
+		copy(dAtA[i:], m.Deprecated)
+		i = encodeVarint(dAtA, i, uint64(len(m.Deprecated)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Params) > 0 {
 		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
 			size, err := m.Params[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
@@ -2149,6 +2183,15 @@ func (m *Config) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.DeprecatedQueries) > 0 {
+		for iNdEx := len(m.DeprecatedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeprecatedQueries[iNdEx])
+			copy(dAtA[i:], m.DeprecatedQueries[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.DeprecatedQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Queries) > 0 {
 		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Queries[iNdEx])
@@ -2214,6 +2257,13 @@ func (m *Query) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.Deprecated) > 0 {
+		i -= len(m.Deprecated)
+		copy(dAtA[i:], m.Deprecated)
+		i = encodeVarint(dAtA, i, uint64(len(m.Deprecated)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Params) > 0 {
 		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
 			size, err := m.Params[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
@@ -3204,6 +3254,12 @@ func (m *Config) SizeVT() (n int) {
 			n += 1 + l + sov(uint64(l))
 		}
 	}
+	if len(m.DeprecatedQueries) > 0 {
+		for _, s := range m.DeprecatedQueries {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -3232,6 +3288,10 @@ func (m *Query) SizeVT() (n int) {
 			n += 1 + l + sov(uint64(l))
 		}
 	}
+	l = len(m.Deprecated)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -3851,6 +3911,38 @@ func (m *Config) UnmarshalVT(dAtA []byte) error {
 			}
 			m.Queries = append(m.Queries, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedQueries = append(m.DeprecatedQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -4032,6 +4124,38 @@ func (m *Query) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deprecated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])